@@ -61,11 +61,20 @@ func main() {
 	if container.ReconcilerWorker != nil && container.ReconcilerWorker.Enabled() {
 		go container.ReconcilerWorker.Start(ctx)
 	}
+	if container.PaymentRequestEventListener != nil {
+		go container.PaymentRequestEventListener.Start(ctx)
+	}
 
 	serverErrCh := make(chan error, 1)
 	go func() {
 		serverErrCh <- container.Server.Start()
 	}()
+	grpcServerErrCh := make(chan error, 1)
+	if container.GRPCServer != nil {
+		go func() {
+			grpcServerErrCh <- container.GRPCServer.Start()
+		}()
+	}
 
 	select {
 	case err := <-serverErrCh:
@@ -73,10 +82,24 @@ func main() {
 			logger.Printf("server startup failed: %v", err)
 			os.Exit(1)
 		}
+	case err := <-grpcServerErrCh:
+		logger.Printf("grpc server stopped unexpectedly: %v", err)
+		os.Exit(1)
 	case <-ctx.Done():
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 		defer cancel()
 
+		if container.GRPCServer != nil {
+			if err := container.GRPCServer.Shutdown(shutdownCtx); err != nil {
+				logger.Printf("grpc graceful shutdown failed: %v", err)
+				os.Exit(1)
+			}
+			if err := <-grpcServerErrCh; err != nil {
+				logger.Printf("grpc server stopped with error: %v", err)
+				os.Exit(1)
+			}
+		}
+
 		if err := container.Server.Shutdown(shutdownCtx); err != nil {
 			logger.Printf("graceful shutdown failed: %v", err)
 			os.Exit(1)
@@ -43,6 +43,14 @@ func main() {
 			logger.Printf("database close warning error=%v", err)
 		}
 	}()
+	defer func() {
+		if container.EventSink == nil {
+			return
+		}
+		if err := container.EventSink.Close(); err != nil {
+			logger.Printf("event sink close warning error=%v", err)
+		}
+	}()
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"chaintx/internal/application/dto"
+	"chaintx/internal/infrastructure/config"
+	"chaintx/internal/infrastructure/di"
+)
+
+func main() {
+	logger := log.New(os.Stdout, "", log.LstdFlags|log.LUTC)
+	cfg, cfgErr := config.LoadConfig()
+	if cfgErr != nil {
+		logger.Printf("startup config error code=%s message=%s metadata=%v", cfgErr.Code, cfgErr.Message, cfgErr.Metadata)
+		os.Exit(1)
+	}
+	if retentionCfgErr := validateWebhookRetentionWorkerConfig(cfg); retentionCfgErr != nil {
+		logger.Printf(
+			"webhook outbox retention worker config error code=%s message=%s metadata=%v",
+			retentionCfgErr.Code,
+			retentionCfgErr.Message,
+			retentionCfgErr.Metadata,
+		)
+		os.Exit(1)
+	}
+
+	container, buildErr := di.BuildWebhookRetentionWorker(cfg, logger)
+	if buildErr != nil {
+		logger.Printf("dependency wiring error: %v", buildErr)
+		os.Exit(1)
+	}
+	defer func() {
+		if container.Database == nil {
+			return
+		}
+		if err := container.Database.Close(); err != nil {
+			logger.Printf("database close warning error=%v", err)
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger.Printf("webhook outbox retention worker persistence initialization starting database_target=%s", cfg.DatabaseTarget)
+	persistenceErr := container.InitializePersistenceUseCase.Execute(ctx, dto.InitializePersistenceCommand{
+		ReadinessTimeout:       cfg.DBReadinessTimeout,
+		ReadinessRetryInterval: cfg.DBReadinessRetryInterval,
+	})
+	if persistenceErr != nil {
+		logger.Printf(
+			"webhook outbox retention worker persistence initialization failed code=%s message=%s metadata=%v",
+			persistenceErr.Code,
+			persistenceErr.Message,
+			persistenceErr.Details,
+		)
+		os.Exit(1)
+	}
+	logger.Printf("webhook outbox retention worker persistence initialization completed database_target=%s", cfg.DatabaseTarget)
+
+	if container.WebhookRetentionWorker == nil || !container.WebhookRetentionWorker.Enabled() {
+		logger.Printf("webhook outbox retention worker startup failed code=WEBHOOK_RETENTION_WORKER_NOT_ENABLED message=webhook outbox retention worker is not enabled")
+		os.Exit(1)
+	}
+
+	go container.WebhookRetentionWorker.Start(ctx)
+	<-ctx.Done()
+	logger.Printf("webhook outbox retention worker stopped")
+}
+
+func validateWebhookRetentionWorkerConfig(cfg config.Config) *config.ConfigError {
+	if !cfg.WebhookRetentionEnabled {
+		return &config.ConfigError{
+			Code:    "CONFIG_WEBHOOK_RETENTION_DISABLED",
+			Message: "PAYMENT_REQUEST_WEBHOOK_RETENTION_ENABLED must be true for webhook outbox retention worker runtime",
+		}
+	}
+
+	return nil
+}
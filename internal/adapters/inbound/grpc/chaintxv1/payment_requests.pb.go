@@ -0,0 +1,1267 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: chaintx/v1/payment_requests.proto
+
+package chaintxv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePaymentRequestRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Chain               string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network             string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Asset               string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	WebhookUrl          string                 `protobuf:"bytes,4,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	WebhookApiVersion   string                 `protobuf:"bytes,5,opt,name=webhook_api_version,json=webhookApiVersion,proto3" json:"webhook_api_version,omitempty"`
+	ExpectedAmountMinor *string                `protobuf:"bytes,6,opt,name=expected_amount_minor,json=expectedAmountMinor,proto3,oneof" json:"expected_amount_minor,omitempty"`
+	ExpiresInSeconds    *int64                 `protobuf:"varint,7,opt,name=expires_in_seconds,json=expiresInSeconds,proto3,oneof" json:"expires_in_seconds,omitempty"`
+	Metadata            *structpb.Struct       `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	IdempotencyKey      string                 `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestRequest) Reset() {
+	*x = CreatePaymentRequestRequest{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestRequest) ProtoMessage() {}
+
+func (x *CreatePaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePaymentRequestRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetWebhookApiVersion() string {
+	if x != nil {
+		return x.WebhookApiVersion
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetExpectedAmountMinor() string {
+	if x != nil && x.ExpectedAmountMinor != nil {
+		return *x.ExpectedAmountMinor
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetExpiresInSeconds() int64 {
+	if x != nil && x.ExpiresInSeconds != nil {
+		return *x.ExpiresInSeconds
+	}
+	return 0
+}
+
+func (x *CreatePaymentRequestRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreatePaymentRequestRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CreatePaymentRequestResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequest *PaymentRequest        `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	// replayed is true when an earlier create with the same idempotency key is
+	// returned instead of a new payment request.
+	Replayed      bool `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestResponse) Reset() {
+	*x = CreatePaymentRequestResponse{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestResponse) ProtoMessage() {}
+
+func (x *CreatePaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePaymentRequestResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+func (x *CreatePaymentRequestResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type GetPaymentRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentRequestRequest) Reset() {
+	*x = GetPaymentRequestRequest{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentRequestRequest) ProtoMessage() {}
+
+func (x *GetPaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPaymentRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPaymentRequestResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequest *PaymentRequest        `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPaymentRequestResponse) Reset() {
+	*x = GetPaymentRequestResponse{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentRequestResponse) ProtoMessage() {}
+
+func (x *GetPaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPaymentRequestResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+type ListSettlementsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequestId string                 `protobuf:"bytes,1,opt,name=payment_request_id,json=paymentRequestId,proto3" json:"payment_request_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListSettlementsRequest) Reset() {
+	*x = ListSettlementsRequest{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettlementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettlementsRequest) ProtoMessage() {}
+
+func (x *ListSettlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettlementsRequest.ProtoReflect.Descriptor instead.
+func (*ListSettlementsRequest) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSettlementsRequest) GetPaymentRequestId() string {
+	if x != nil {
+		return x.PaymentRequestId
+	}
+	return ""
+}
+
+type ListSettlementsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequestId string                 `protobuf:"bytes,1,opt,name=payment_request_id,json=paymentRequestId,proto3" json:"payment_request_id,omitempty"`
+	Settlements      []*Settlement          `protobuf:"bytes,2,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListSettlementsResponse) Reset() {
+	*x = ListSettlementsResponse{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettlementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettlementsResponse) ProtoMessage() {}
+
+func (x *ListSettlementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettlementsResponse.ProtoReflect.Descriptor instead.
+func (*ListSettlementsResponse) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSettlementsResponse) GetPaymentRequestId() string {
+	if x != nil {
+		return x.PaymentRequestId
+	}
+	return ""
+}
+
+func (x *ListSettlementsResponse) GetSettlements() []*Settlement {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
+}
+
+type ListAssetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssetsRequest) Reset() {
+	*x = ListAssetsRequest{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetsRequest) ProtoMessage() {}
+
+func (x *ListAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{6}
+}
+
+type ListAssetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assets        []*Asset               `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssetsResponse) Reset() {
+	*x = ListAssetsResponse{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetsResponse) ProtoMessage() {}
+
+func (x *ListAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAssetsResponse) GetAssets() []*Asset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+type WatchPaymentRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LastEventId   string                 `protobuf:"bytes,2,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPaymentRequestRequest) Reset() {
+	*x = WatchPaymentRequestRequest{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPaymentRequestRequest) ProtoMessage() {}
+
+func (x *WatchPaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*WatchPaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchPaymentRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchPaymentRequestRequest) GetLastEventId() string {
+	if x != nil {
+		return x.LastEventId
+	}
+	return ""
+}
+
+type PaymentRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status              string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Chain               string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network             string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	Asset               string                 `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset,omitempty"`
+	ExpectedAmountMinor *string                `protobuf:"bytes,6,opt,name=expected_amount_minor,json=expectedAmountMinor,proto3,oneof" json:"expected_amount_minor,omitempty"`
+	WebhookApiVersion   string                 `protobuf:"bytes,7,opt,name=webhook_api_version,json=webhookApiVersion,proto3" json:"webhook_api_version,omitempty"`
+	ExpiresAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PaymentInstructions *PaymentInstructions   `protobuf:"bytes,10,opt,name=payment_instructions,json=paymentInstructions,proto3" json:"payment_instructions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PaymentRequest) Reset() {
+	*x = PaymentRequest{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequest) ProtoMessage() {}
+
+func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequest.ProtoReflect.Descriptor instead.
+func (*PaymentRequest) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetExpectedAmountMinor() string {
+	if x != nil && x.ExpectedAmountMinor != nil {
+		return *x.ExpectedAmountMinor
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetWebhookApiVersion() string {
+	if x != nil {
+		return x.WebhookApiVersion
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetPaymentInstructions() *PaymentInstructions {
+	if x != nil {
+		return x.PaymentInstructions
+	}
+	return nil
+}
+
+type PaymentInstructions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Address         string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AddressScheme   string                 `protobuf:"bytes,2,opt,name=address_scheme,json=addressScheme,proto3" json:"address_scheme,omitempty"`
+	DerivationIndex int64                  `protobuf:"varint,3,opt,name=derivation_index,json=derivationIndex,proto3" json:"derivation_index,omitempty"`
+	ChainId         *int64                 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3,oneof" json:"chain_id,omitempty"`
+	TokenStandard   *string                `protobuf:"bytes,5,opt,name=token_standard,json=tokenStandard,proto3,oneof" json:"token_standard,omitempty"`
+	TokenContract   *string                `protobuf:"bytes,6,opt,name=token_contract,json=tokenContract,proto3,oneof" json:"token_contract,omitempty"`
+	TokenDecimals   *int32                 `protobuf:"varint,7,opt,name=token_decimals,json=tokenDecimals,proto3,oneof" json:"token_decimals,omitempty"`
+	MinorUnit       string                 `protobuf:"bytes,8,opt,name=minor_unit,json=minorUnit,proto3" json:"minor_unit,omitempty"`
+	Decimals        *int32                 `protobuf:"varint,9,opt,name=decimals,proto3,oneof" json:"decimals,omitempty"`
+	DisplayAmount   *string                `protobuf:"bytes,10,opt,name=display_amount,json=displayAmount,proto3,oneof" json:"display_amount,omitempty"`
+	PaymentUri      *string                `protobuf:"bytes,11,opt,name=payment_uri,json=paymentUri,proto3,oneof" json:"payment_uri,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PaymentInstructions) Reset() {
+	*x = PaymentInstructions{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentInstructions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentInstructions) ProtoMessage() {}
+
+func (x *PaymentInstructions) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentInstructions.ProtoReflect.Descriptor instead.
+func (*PaymentInstructions) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PaymentInstructions) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetAddressScheme() string {
+	if x != nil {
+		return x.AddressScheme
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetDerivationIndex() int64 {
+	if x != nil {
+		return x.DerivationIndex
+	}
+	return 0
+}
+
+func (x *PaymentInstructions) GetChainId() int64 {
+	if x != nil && x.ChainId != nil {
+		return *x.ChainId
+	}
+	return 0
+}
+
+func (x *PaymentInstructions) GetTokenStandard() string {
+	if x != nil && x.TokenStandard != nil {
+		return *x.TokenStandard
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetTokenContract() string {
+	if x != nil && x.TokenContract != nil {
+		return *x.TokenContract
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetTokenDecimals() int32 {
+	if x != nil && x.TokenDecimals != nil {
+		return *x.TokenDecimals
+	}
+	return 0
+}
+
+func (x *PaymentInstructions) GetMinorUnit() string {
+	if x != nil {
+		return x.MinorUnit
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetDecimals() int32 {
+	if x != nil && x.Decimals != nil {
+		return *x.Decimals
+	}
+	return 0
+}
+
+func (x *PaymentInstructions) GetDisplayAmount() string {
+	if x != nil && x.DisplayAmount != nil {
+		return *x.DisplayAmount
+	}
+	return ""
+}
+
+func (x *PaymentInstructions) GetPaymentUri() string {
+	if x != nil && x.PaymentUri != nil {
+		return *x.PaymentUri
+	}
+	return ""
+}
+
+type Settlement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EvidenceRef   string                 `protobuf:"bytes,1,opt,name=evidence_ref,json=evidenceRef,proto3" json:"evidence_ref,omitempty"`
+	AmountMinor   string                 `protobuf:"bytes,2,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
+	Confirmations int32                  `protobuf:"varint,3,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	BlockHeight   *int64                 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3,oneof" json:"block_height,omitempty"`
+	BlockHash     *string                `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3,oneof" json:"block_hash,omitempty"`
+	IsCanonical   bool                   `protobuf:"varint,6,opt,name=is_canonical,json=isCanonical,proto3" json:"is_canonical,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	FirstSeenAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settlement) ProtoMessage() {}
+
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Settlement) GetEvidenceRef() string {
+	if x != nil {
+		return x.EvidenceRef
+	}
+	return ""
+}
+
+func (x *Settlement) GetAmountMinor() string {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return ""
+}
+
+func (x *Settlement) GetConfirmations() int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *Settlement) GetBlockHeight() int64 {
+	if x != nil && x.BlockHeight != nil {
+		return *x.BlockHeight
+	}
+	return 0
+}
+
+func (x *Settlement) GetBlockHash() string {
+	if x != nil && x.BlockHash != nil {
+		return *x.BlockHash
+	}
+	return ""
+}
+
+func (x *Settlement) GetIsCanonical() bool {
+	if x != nil {
+		return x.IsCanonical
+	}
+	return false
+}
+
+func (x *Settlement) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Settlement) GetFirstSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return nil
+}
+
+func (x *Settlement) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *Settlement) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Asset struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Chain                   string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network                 string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Asset                   string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	MinorUnit               string                 `protobuf:"bytes,4,opt,name=minor_unit,json=minorUnit,proto3" json:"minor_unit,omitempty"`
+	Decimals                int32                  `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	AddressScheme           string                 `protobuf:"bytes,6,opt,name=address_scheme,json=addressScheme,proto3" json:"address_scheme,omitempty"`
+	DefaultExpiresInSeconds int64                  `protobuf:"varint,7,opt,name=default_expires_in_seconds,json=defaultExpiresInSeconds,proto3" json:"default_expires_in_seconds,omitempty"`
+	ChainId                 *int64                 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3,oneof" json:"chain_id,omitempty"`
+	TokenStandard           *string                `protobuf:"bytes,9,opt,name=token_standard,json=tokenStandard,proto3,oneof" json:"token_standard,omitempty"`
+	TokenContract           *string                `protobuf:"bytes,10,opt,name=token_contract,json=tokenContract,proto3,oneof" json:"token_contract,omitempty"`
+	TokenDecimals           *int32                 `protobuf:"varint,11,opt,name=token_decimals,json=tokenDecimals,proto3,oneof" json:"token_decimals,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Asset) ProtoMessage() {}
+
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Asset) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *Asset) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Asset) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *Asset) GetMinorUnit() string {
+	if x != nil {
+		return x.MinorUnit
+	}
+	return ""
+}
+
+func (x *Asset) GetDecimals() int32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Asset) GetAddressScheme() string {
+	if x != nil {
+		return x.AddressScheme
+	}
+	return ""
+}
+
+func (x *Asset) GetDefaultExpiresInSeconds() int64 {
+	if x != nil {
+		return x.DefaultExpiresInSeconds
+	}
+	return 0
+}
+
+func (x *Asset) GetChainId() int64 {
+	if x != nil && x.ChainId != nil {
+		return *x.ChainId
+	}
+	return 0
+}
+
+func (x *Asset) GetTokenStandard() string {
+	if x != nil && x.TokenStandard != nil {
+		return *x.TokenStandard
+	}
+	return ""
+}
+
+func (x *Asset) GetTokenContract() string {
+	if x != nil && x.TokenContract != nil {
+		return *x.TokenContract
+	}
+	return ""
+}
+
+func (x *Asset) GetTokenDecimals() int32 {
+	if x != nil && x.TokenDecimals != nil {
+		return *x.TokenDecimals
+	}
+	return 0
+}
+
+// PaymentRequestEvent is one entry of the payment request event journal.
+// data is the webhook event envelope, identical to the SSE data line.
+type PaymentRequestEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentRequestEvent) Reset() {
+	*x = PaymentRequestEvent{}
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequestEvent) ProtoMessage() {}
+
+func (x *PaymentRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_chaintx_v1_payment_requests_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequestEvent.ProtoReflect.Descriptor instead.
+func (*PaymentRequestEvent) Descriptor() ([]byte, []int) {
+	return file_chaintx_v1_payment_requests_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PaymentRequestEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PaymentRequestEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PaymentRequestEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *PaymentRequestEvent) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_chaintx_v1_payment_requests_proto protoreflect.FileDescriptor
+
+const file_chaintx_v1_payment_requests_proto_rawDesc = "" +
+	"\n" +
+	"!chaintx/v1/payment_requests.proto\x12\n" +
+	"chaintx.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x03\n" +
+	"\x1bCreatePaymentRequestRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x02 \x01(\tR\anetwork\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12\x1f\n" +
+	"\vwebhook_url\x18\x04 \x01(\tR\n" +
+	"webhookUrl\x12.\n" +
+	"\x13webhook_api_version\x18\x05 \x01(\tR\x11webhookApiVersion\x127\n" +
+	"\x15expected_amount_minor\x18\x06 \x01(\tH\x00R\x13expectedAmountMinor\x88\x01\x01\x121\n" +
+	"\x12expires_in_seconds\x18\a \x01(\x03H\x01R\x10expiresInSeconds\x88\x01\x01\x123\n" +
+	"\bmetadata\x18\b \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12'\n" +
+	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKeyB\x18\n" +
+	"\x16_expected_amount_minorB\x15\n" +
+	"\x13_expires_in_seconds\"\x7f\n" +
+	"\x1cCreatePaymentRequestResponse\x12C\n" +
+	"\x0fpayment_request\x18\x01 \x01(\v2\x1a.chaintx.v1.PaymentRequestR\x0epaymentRequest\x12\x1a\n" +
+	"\breplayed\x18\x02 \x01(\bR\breplayed\"*\n" +
+	"\x18GetPaymentRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
+	"\x19GetPaymentRequestResponse\x12C\n" +
+	"\x0fpayment_request\x18\x01 \x01(\v2\x1a.chaintx.v1.PaymentRequestR\x0epaymentRequest\"F\n" +
+	"\x16ListSettlementsRequest\x12,\n" +
+	"\x12payment_request_id\x18\x01 \x01(\tR\x10paymentRequestId\"\x81\x01\n" +
+	"\x17ListSettlementsResponse\x12,\n" +
+	"\x12payment_request_id\x18\x01 \x01(\tR\x10paymentRequestId\x128\n" +
+	"\vsettlements\x18\x02 \x03(\v2\x16.chaintx.v1.SettlementR\vsettlements\"\x13\n" +
+	"\x11ListAssetsRequest\"?\n" +
+	"\x12ListAssetsResponse\x12)\n" +
+	"\x06assets\x18\x01 \x03(\v2\x11.chaintx.v1.AssetR\x06assets\"P\n" +
+	"\x1aWatchPaymentRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\rlast_event_id\x18\x02 \x01(\tR\vlastEventId\"\xcb\x03\n" +
+	"\x0ePaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05chain\x18\x03 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x12\x14\n" +
+	"\x05asset\x18\x05 \x01(\tR\x05asset\x127\n" +
+	"\x15expected_amount_minor\x18\x06 \x01(\tH\x00R\x13expectedAmountMinor\x88\x01\x01\x12.\n" +
+	"\x13webhook_api_version\x18\a \x01(\tR\x11webhookApiVersion\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12R\n" +
+	"\x14payment_instructions\x18\n" +
+	" \x01(\v2\x1f.chaintx.v1.PaymentInstructionsR\x13paymentInstructionsB\x18\n" +
+	"\x16_expected_amount_minor\"\xad\x04\n" +
+	"\x13PaymentInstructions\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12%\n" +
+	"\x0eaddress_scheme\x18\x02 \x01(\tR\raddressScheme\x12)\n" +
+	"\x10derivation_index\x18\x03 \x01(\x03R\x0fderivationIndex\x12\x1e\n" +
+	"\bchain_id\x18\x04 \x01(\x03H\x00R\achainId\x88\x01\x01\x12*\n" +
+	"\x0etoken_standard\x18\x05 \x01(\tH\x01R\rtokenStandard\x88\x01\x01\x12*\n" +
+	"\x0etoken_contract\x18\x06 \x01(\tH\x02R\rtokenContract\x88\x01\x01\x12*\n" +
+	"\x0etoken_decimals\x18\a \x01(\x05H\x03R\rtokenDecimals\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"minor_unit\x18\b \x01(\tR\tminorUnit\x12\x1f\n" +
+	"\bdecimals\x18\t \x01(\x05H\x04R\bdecimals\x88\x01\x01\x12*\n" +
+	"\x0edisplay_amount\x18\n" +
+	" \x01(\tH\x05R\rdisplayAmount\x88\x01\x01\x12$\n" +
+	"\vpayment_uri\x18\v \x01(\tH\x06R\n" +
+	"paymentUri\x88\x01\x01B\v\n" +
+	"\t_chain_idB\x11\n" +
+	"\x0f_token_standardB\x11\n" +
+	"\x0f_token_contractB\x11\n" +
+	"\x0f_token_decimalsB\v\n" +
+	"\t_decimalsB\x11\n" +
+	"\x0f_display_amountB\x0e\n" +
+	"\f_payment_uri\"\xf5\x03\n" +
+	"\n" +
+	"Settlement\x12!\n" +
+	"\fevidence_ref\x18\x01 \x01(\tR\vevidenceRef\x12!\n" +
+	"\famount_minor\x18\x02 \x01(\tR\vamountMinor\x12$\n" +
+	"\rconfirmations\x18\x03 \x01(\x05R\rconfirmations\x12&\n" +
+	"\fblock_height\x18\x04 \x01(\x03H\x00R\vblockHeight\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"block_hash\x18\x05 \x01(\tH\x01R\tblockHash\x88\x01\x01\x12!\n" +
+	"\fis_canonical\x18\x06 \x01(\bR\visCanonical\x123\n" +
+	"\bmetadata\x18\a \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12>\n" +
+	"\rfirst_seen_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vfirstSeenAt\x12<\n" +
+	"\flast_seen_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0f\n" +
+	"\r_block_heightB\r\n" +
+	"\v_block_hash\"\xd6\x03\n" +
+	"\x05Asset\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x02 \x01(\tR\anetwork\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12\x1d\n" +
+	"\n" +
+	"minor_unit\x18\x04 \x01(\tR\tminorUnit\x12\x1a\n" +
+	"\bdecimals\x18\x05 \x01(\x05R\bdecimals\x12%\n" +
+	"\x0eaddress_scheme\x18\x06 \x01(\tR\raddressScheme\x12;\n" +
+	"\x1adefault_expires_in_seconds\x18\a \x01(\x03R\x17defaultExpiresInSeconds\x12\x1e\n" +
+	"\bchain_id\x18\b \x01(\x03H\x00R\achainId\x88\x01\x01\x12*\n" +
+	"\x0etoken_standard\x18\t \x01(\tH\x01R\rtokenStandard\x88\x01\x01\x12*\n" +
+	"\x0etoken_contract\x18\n" +
+	" \x01(\tH\x02R\rtokenContract\x88\x01\x01\x12*\n" +
+	"\x0etoken_decimals\x18\v \x01(\x05H\x03R\rtokenDecimals\x88\x01\x01B\v\n" +
+	"\t_chain_idB\x11\n" +
+	"\x0f_token_standardB\x11\n" +
+	"\x0f_token_contractB\x11\n" +
+	"\x0f_token_decimals\"\x98\x01\n" +
+	"\x13PaymentRequestEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12+\n" +
+	"\x04data\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x04data2\xef\x03\n" +
+	"\x15PaymentRequestService\x12i\n" +
+	"\x14CreatePaymentRequest\x12'.chaintx.v1.CreatePaymentRequestRequest\x1a(.chaintx.v1.CreatePaymentRequestResponse\x12`\n" +
+	"\x11GetPaymentRequest\x12$.chaintx.v1.GetPaymentRequestRequest\x1a%.chaintx.v1.GetPaymentRequestResponse\x12Z\n" +
+	"\x0fListSettlements\x12\".chaintx.v1.ListSettlementsRequest\x1a#.chaintx.v1.ListSettlementsResponse\x12K\n" +
+	"\n" +
+	"ListAssets\x12\x1d.chaintx.v1.ListAssetsRequest\x1a\x1e.chaintx.v1.ListAssetsResponse\x12`\n" +
+	"\x13WatchPaymentRequest\x12&.chaintx.v1.WatchPaymentRequestRequest\x1a\x1f.chaintx.v1.PaymentRequestEvent0\x01B<Z:chaintx/internal/adapters/inbound/grpc/chaintxv1;chaintxv1b\x06proto3"
+
+var (
+	file_chaintx_v1_payment_requests_proto_rawDescOnce sync.Once
+	file_chaintx_v1_payment_requests_proto_rawDescData []byte
+)
+
+func file_chaintx_v1_payment_requests_proto_rawDescGZIP() []byte {
+	file_chaintx_v1_payment_requests_proto_rawDescOnce.Do(func() {
+		file_chaintx_v1_payment_requests_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_chaintx_v1_payment_requests_proto_rawDesc), len(file_chaintx_v1_payment_requests_proto_rawDesc)))
+	})
+	return file_chaintx_v1_payment_requests_proto_rawDescData
+}
+
+var file_chaintx_v1_payment_requests_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_chaintx_v1_payment_requests_proto_goTypes = []any{
+	(*CreatePaymentRequestRequest)(nil),  // 0: chaintx.v1.CreatePaymentRequestRequest
+	(*CreatePaymentRequestResponse)(nil), // 1: chaintx.v1.CreatePaymentRequestResponse
+	(*GetPaymentRequestRequest)(nil),     // 2: chaintx.v1.GetPaymentRequestRequest
+	(*GetPaymentRequestResponse)(nil),    // 3: chaintx.v1.GetPaymentRequestResponse
+	(*ListSettlementsRequest)(nil),       // 4: chaintx.v1.ListSettlementsRequest
+	(*ListSettlementsResponse)(nil),      // 5: chaintx.v1.ListSettlementsResponse
+	(*ListAssetsRequest)(nil),            // 6: chaintx.v1.ListAssetsRequest
+	(*ListAssetsResponse)(nil),           // 7: chaintx.v1.ListAssetsResponse
+	(*WatchPaymentRequestRequest)(nil),   // 8: chaintx.v1.WatchPaymentRequestRequest
+	(*PaymentRequest)(nil),               // 9: chaintx.v1.PaymentRequest
+	(*PaymentInstructions)(nil),          // 10: chaintx.v1.PaymentInstructions
+	(*Settlement)(nil),                   // 11: chaintx.v1.Settlement
+	(*Asset)(nil),                        // 12: chaintx.v1.Asset
+	(*PaymentRequestEvent)(nil),          // 13: chaintx.v1.PaymentRequestEvent
+	(*structpb.Struct)(nil),              // 14: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),        // 15: google.protobuf.Timestamp
+}
+var file_chaintx_v1_payment_requests_proto_depIdxs = []int32{
+	14, // 0: chaintx.v1.CreatePaymentRequestRequest.metadata:type_name -> google.protobuf.Struct
+	9,  // 1: chaintx.v1.CreatePaymentRequestResponse.payment_request:type_name -> chaintx.v1.PaymentRequest
+	9,  // 2: chaintx.v1.GetPaymentRequestResponse.payment_request:type_name -> chaintx.v1.PaymentRequest
+	11, // 3: chaintx.v1.ListSettlementsResponse.settlements:type_name -> chaintx.v1.Settlement
+	12, // 4: chaintx.v1.ListAssetsResponse.assets:type_name -> chaintx.v1.Asset
+	15, // 5: chaintx.v1.PaymentRequest.expires_at:type_name -> google.protobuf.Timestamp
+	15, // 6: chaintx.v1.PaymentRequest.created_at:type_name -> google.protobuf.Timestamp
+	10, // 7: chaintx.v1.PaymentRequest.payment_instructions:type_name -> chaintx.v1.PaymentInstructions
+	14, // 8: chaintx.v1.Settlement.metadata:type_name -> google.protobuf.Struct
+	15, // 9: chaintx.v1.Settlement.first_seen_at:type_name -> google.protobuf.Timestamp
+	15, // 10: chaintx.v1.Settlement.last_seen_at:type_name -> google.protobuf.Timestamp
+	15, // 11: chaintx.v1.Settlement.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 12: chaintx.v1.PaymentRequestEvent.data:type_name -> google.protobuf.Struct
+	0,  // 13: chaintx.v1.PaymentRequestService.CreatePaymentRequest:input_type -> chaintx.v1.CreatePaymentRequestRequest
+	2,  // 14: chaintx.v1.PaymentRequestService.GetPaymentRequest:input_type -> chaintx.v1.GetPaymentRequestRequest
+	4,  // 15: chaintx.v1.PaymentRequestService.ListSettlements:input_type -> chaintx.v1.ListSettlementsRequest
+	6,  // 16: chaintx.v1.PaymentRequestService.ListAssets:input_type -> chaintx.v1.ListAssetsRequest
+	8,  // 17: chaintx.v1.PaymentRequestService.WatchPaymentRequest:input_type -> chaintx.v1.WatchPaymentRequestRequest
+	1,  // 18: chaintx.v1.PaymentRequestService.CreatePaymentRequest:output_type -> chaintx.v1.CreatePaymentRequestResponse
+	3,  // 19: chaintx.v1.PaymentRequestService.GetPaymentRequest:output_type -> chaintx.v1.GetPaymentRequestResponse
+	5,  // 20: chaintx.v1.PaymentRequestService.ListSettlements:output_type -> chaintx.v1.ListSettlementsResponse
+	7,  // 21: chaintx.v1.PaymentRequestService.ListAssets:output_type -> chaintx.v1.ListAssetsResponse
+	13, // 22: chaintx.v1.PaymentRequestService.WatchPaymentRequest:output_type -> chaintx.v1.PaymentRequestEvent
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_chaintx_v1_payment_requests_proto_init() }
+func file_chaintx_v1_payment_requests_proto_init() {
+	if File_chaintx_v1_payment_requests_proto != nil {
+		return
+	}
+	file_chaintx_v1_payment_requests_proto_msgTypes[0].OneofWrappers = []any{}
+	file_chaintx_v1_payment_requests_proto_msgTypes[9].OneofWrappers = []any{}
+	file_chaintx_v1_payment_requests_proto_msgTypes[10].OneofWrappers = []any{}
+	file_chaintx_v1_payment_requests_proto_msgTypes[11].OneofWrappers = []any{}
+	file_chaintx_v1_payment_requests_proto_msgTypes[12].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chaintx_v1_payment_requests_proto_rawDesc), len(file_chaintx_v1_payment_requests_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_chaintx_v1_payment_requests_proto_goTypes,
+		DependencyIndexes: file_chaintx_v1_payment_requests_proto_depIdxs,
+		MessageInfos:      file_chaintx_v1_payment_requests_proto_msgTypes,
+	}.Build()
+	File_chaintx_v1_payment_requests_proto = out.File
+	file_chaintx_v1_payment_requests_proto_goTypes = nil
+	file_chaintx_v1_payment_requests_proto_depIdxs = nil
+}
@@ -0,0 +1,319 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: chaintx/v1/payment_requests.proto
+
+package chaintxv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PaymentRequestService_CreatePaymentRequest_FullMethodName = "/chaintx.v1.PaymentRequestService/CreatePaymentRequest"
+	PaymentRequestService_GetPaymentRequest_FullMethodName    = "/chaintx.v1.PaymentRequestService/GetPaymentRequest"
+	PaymentRequestService_ListSettlements_FullMethodName      = "/chaintx.v1.PaymentRequestService/ListSettlements"
+	PaymentRequestService_ListAssets_FullMethodName           = "/chaintx.v1.PaymentRequestService/ListAssets"
+	PaymentRequestService_WatchPaymentRequest_FullMethodName  = "/chaintx.v1.PaymentRequestService/WatchPaymentRequest"
+)
+
+// PaymentRequestServiceClient is the client API for PaymentRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PaymentRequestService is the gRPC counterpart of the merchant HTTP API. It
+// runs the same use cases, so validation, idempotency and error codes match
+// the HTTP endpoints.
+//
+// Callers authenticate with `authorization: Bearer <merchant api key or jwt>`
+// metadata. Create needs the `create` scope; every other method needs `read`.
+// When merchant API keys are not required, calls without a credential fall
+// back to the legacy `x-principal-id` metadata.
+//
+// Failed calls carry a google.rpc.ErrorInfo detail whose reason is the HTTP
+// API error code. Rate limited calls are RESOURCE_EXHAUSTED with a
+// google.rpc.RetryInfo detail.
+type PaymentRequestServiceClient interface {
+	// CreatePaymentRequest is POST /v1/payment-requests. Requests sharing an
+	// idempotency key with an HTTP create replay the same payment request.
+	CreatePaymentRequest(ctx context.Context, in *CreatePaymentRequestRequest, opts ...grpc.CallOption) (*CreatePaymentRequestResponse, error)
+	// GetPaymentRequest is GET /v1/payment-requests/{id}.
+	GetPaymentRequest(ctx context.Context, in *GetPaymentRequestRequest, opts ...grpc.CallOption) (*GetPaymentRequestResponse, error)
+	// ListSettlements is GET /v1/payment-requests/{id}/settlements.
+	ListSettlements(ctx context.Context, in *ListSettlementsRequest, opts ...grpc.CallOption) (*ListSettlementsResponse, error)
+	// ListAssets is GET /v1/assets.
+	ListAssets(ctx context.Context, in *ListAssetsRequest, opts ...grpc.CallOption) (*ListAssetsResponse, error)
+	// WatchPaymentRequest is GET /v1/payment-requests/{id}/events: it replays
+	// events after last_event_id, then streams new ones until the client
+	// cancels. Pass the last received sequence to resume.
+	WatchPaymentRequest(ctx context.Context, in *WatchPaymentRequestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PaymentRequestEvent], error)
+}
+
+type paymentRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentRequestServiceClient(cc grpc.ClientConnInterface) PaymentRequestServiceClient {
+	return &paymentRequestServiceClient{cc}
+}
+
+func (c *paymentRequestServiceClient) CreatePaymentRequest(ctx context.Context, in *CreatePaymentRequestRequest, opts ...grpc.CallOption) (*CreatePaymentRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePaymentRequestResponse)
+	err := c.cc.Invoke(ctx, PaymentRequestService_CreatePaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentRequestServiceClient) GetPaymentRequest(ctx context.Context, in *GetPaymentRequestRequest, opts ...grpc.CallOption) (*GetPaymentRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentRequestResponse)
+	err := c.cc.Invoke(ctx, PaymentRequestService_GetPaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentRequestServiceClient) ListSettlements(ctx context.Context, in *ListSettlementsRequest, opts ...grpc.CallOption) (*ListSettlementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSettlementsResponse)
+	err := c.cc.Invoke(ctx, PaymentRequestService_ListSettlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentRequestServiceClient) ListAssets(ctx context.Context, in *ListAssetsRequest, opts ...grpc.CallOption) (*ListAssetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssetsResponse)
+	err := c.cc.Invoke(ctx, PaymentRequestService_ListAssets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentRequestServiceClient) WatchPaymentRequest(ctx context.Context, in *WatchPaymentRequestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PaymentRequestEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PaymentRequestService_ServiceDesc.Streams[0], PaymentRequestService_WatchPaymentRequest_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPaymentRequestRequest, PaymentRequestEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PaymentRequestService_WatchPaymentRequestClient = grpc.ServerStreamingClient[PaymentRequestEvent]
+
+// PaymentRequestServiceServer is the server API for PaymentRequestService service.
+// All implementations must embed UnimplementedPaymentRequestServiceServer
+// for forward compatibility.
+//
+// PaymentRequestService is the gRPC counterpart of the merchant HTTP API. It
+// runs the same use cases, so validation, idempotency and error codes match
+// the HTTP endpoints.
+//
+// Callers authenticate with `authorization: Bearer <merchant api key or jwt>`
+// metadata. Create needs the `create` scope; every other method needs `read`.
+// When merchant API keys are not required, calls without a credential fall
+// back to the legacy `x-principal-id` metadata.
+//
+// Failed calls carry a google.rpc.ErrorInfo detail whose reason is the HTTP
+// API error code. Rate limited calls are RESOURCE_EXHAUSTED with a
+// google.rpc.RetryInfo detail.
+type PaymentRequestServiceServer interface {
+	// CreatePaymentRequest is POST /v1/payment-requests. Requests sharing an
+	// idempotency key with an HTTP create replay the same payment request.
+	CreatePaymentRequest(context.Context, *CreatePaymentRequestRequest) (*CreatePaymentRequestResponse, error)
+	// GetPaymentRequest is GET /v1/payment-requests/{id}.
+	GetPaymentRequest(context.Context, *GetPaymentRequestRequest) (*GetPaymentRequestResponse, error)
+	// ListSettlements is GET /v1/payment-requests/{id}/settlements.
+	ListSettlements(context.Context, *ListSettlementsRequest) (*ListSettlementsResponse, error)
+	// ListAssets is GET /v1/assets.
+	ListAssets(context.Context, *ListAssetsRequest) (*ListAssetsResponse, error)
+	// WatchPaymentRequest is GET /v1/payment-requests/{id}/events: it replays
+	// events after last_event_id, then streams new ones until the client
+	// cancels. Pass the last received sequence to resume.
+	WatchPaymentRequest(*WatchPaymentRequestRequest, grpc.ServerStreamingServer[PaymentRequestEvent]) error
+	mustEmbedUnimplementedPaymentRequestServiceServer()
+}
+
+// UnimplementedPaymentRequestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaymentRequestServiceServer struct{}
+
+func (UnimplementedPaymentRequestServiceServer) CreatePaymentRequest(context.Context, *CreatePaymentRequestRequest) (*CreatePaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentRequest not implemented")
+}
+func (UnimplementedPaymentRequestServiceServer) GetPaymentRequest(context.Context, *GetPaymentRequestRequest) (*GetPaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentRequest not implemented")
+}
+func (UnimplementedPaymentRequestServiceServer) ListSettlements(context.Context, *ListSettlementsRequest) (*ListSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSettlements not implemented")
+}
+func (UnimplementedPaymentRequestServiceServer) ListAssets(context.Context, *ListAssetsRequest) (*ListAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssets not implemented")
+}
+func (UnimplementedPaymentRequestServiceServer) WatchPaymentRequest(*WatchPaymentRequestRequest, grpc.ServerStreamingServer[PaymentRequestEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPaymentRequest not implemented")
+}
+func (UnimplementedPaymentRequestServiceServer) mustEmbedUnimplementedPaymentRequestServiceServer() {}
+func (UnimplementedPaymentRequestServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafePaymentRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentRequestServiceServer will
+// result in compilation errors.
+type UnsafePaymentRequestServiceServer interface {
+	mustEmbedUnimplementedPaymentRequestServiceServer()
+}
+
+func RegisterPaymentRequestServiceServer(s grpc.ServiceRegistrar, srv PaymentRequestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPaymentRequestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaymentRequestService_ServiceDesc, srv)
+}
+
+func _PaymentRequestService_CreatePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentRequestServiceServer).CreatePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentRequestService_CreatePaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentRequestServiceServer).CreatePaymentRequest(ctx, req.(*CreatePaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentRequestService_GetPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentRequestServiceServer).GetPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentRequestService_GetPaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentRequestServiceServer).GetPaymentRequest(ctx, req.(*GetPaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentRequestService_ListSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentRequestServiceServer).ListSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentRequestService_ListSettlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentRequestServiceServer).ListSettlements(ctx, req.(*ListSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentRequestService_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentRequestServiceServer).ListAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentRequestService_ListAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentRequestServiceServer).ListAssets(ctx, req.(*ListAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentRequestService_WatchPaymentRequest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPaymentRequestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PaymentRequestServiceServer).WatchPaymentRequest(m, &grpc.GenericServerStream[WatchPaymentRequestRequest, PaymentRequestEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PaymentRequestService_WatchPaymentRequestServer = grpc.ServerStreamingServer[PaymentRequestEvent]
+
+// PaymentRequestService_ServiceDesc is the grpc.ServiceDesc for PaymentRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chaintx.v1.PaymentRequestService",
+	HandlerType: (*PaymentRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePaymentRequest",
+			Handler:    _PaymentRequestService_CreatePaymentRequest_Handler,
+		},
+		{
+			MethodName: "GetPaymentRequest",
+			Handler:    _PaymentRequestService_GetPaymentRequest_Handler,
+		},
+		{
+			MethodName: "ListSettlements",
+			Handler:    _PaymentRequestService_ListSettlements_Handler,
+		},
+		{
+			MethodName: "ListAssets",
+			Handler:    _PaymentRequestService_ListAssets_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPaymentRequest",
+			Handler:       _PaymentRequestService_WatchPaymentRequest_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "chaintx/v1/payment_requests.proto",
+}
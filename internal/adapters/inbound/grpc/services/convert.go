@@ -0,0 +1,84 @@
+package services
+
+import (
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"chaintx/internal/adapters/inbound/grpc/chaintxv1"
+	"chaintx/internal/application/dto"
+)
+
+func toPaymentRequest(resource dto.PaymentRequestResource) *chaintxv1.PaymentRequest {
+	instructions := resource.PaymentInstructions
+	return &chaintxv1.PaymentRequest{
+		Id:                  resource.ID,
+		Status:              resource.Status,
+		Chain:               resource.Chain,
+		Network:             resource.Network,
+		Asset:               resource.Asset,
+		ExpectedAmountMinor: resource.ExpectedAmountMinor,
+		WebhookApiVersion:   resource.WebhookAPIVersion,
+		ExpiresAt:           timestamppb.New(resource.ExpiresAt),
+		CreatedAt:           timestamppb.New(resource.CreatedAt),
+		PaymentInstructions: &chaintxv1.PaymentInstructions{
+			Address:         instructions.Address,
+			AddressScheme:   instructions.AddressScheme,
+			DerivationIndex: instructions.DerivationIndex,
+			ChainId:         instructions.ChainID,
+			TokenStandard:   instructions.TokenStandard,
+			TokenContract:   instructions.TokenContract,
+			TokenDecimals:   toOptionalInt32(instructions.TokenDecimals),
+			MinorUnit:       instructions.MinorUnit,
+			Decimals:        toOptionalInt32(instructions.Decimals),
+			DisplayAmount:   instructions.DisplayAmount,
+			PaymentUri:      instructions.PaymentURI,
+		},
+	}
+}
+
+func toSettlements(resources []dto.PaymentRequestSettlementResource) ([]*chaintxv1.Settlement, error) {
+	settlements := make([]*chaintxv1.Settlement, 0, len(resources))
+	for _, resource := range resources {
+		metadata, err := structpb.NewStruct(resource.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		settlements = append(settlements, &chaintxv1.Settlement{
+			EvidenceRef:   resource.EvidenceRef,
+			AmountMinor:   resource.AmountMinor,
+			Confirmations: int32(resource.Confirmations),
+			BlockHeight:   resource.BlockHeight,
+			BlockHash:     resource.BlockHash,
+			IsCanonical:   resource.IsCanonical,
+			Metadata:      metadata,
+			FirstSeenAt:   timestamppb.New(resource.FirstSeenAt),
+			LastSeenAt:    timestamppb.New(resource.LastSeenAt),
+			UpdatedAt:     timestamppb.New(resource.UpdatedAt),
+		})
+	}
+	return settlements, nil
+}
+
+func toAsset(entry dto.AssetCatalogEntry) *chaintxv1.Asset {
+	return &chaintxv1.Asset{
+		Chain:                   entry.Chain,
+		Network:                 entry.Network,
+		Asset:                   entry.Asset,
+		MinorUnit:               entry.MinorUnit,
+		Decimals:                int32(entry.Decimals),
+		AddressScheme:           entry.AddressScheme,
+		DefaultExpiresInSeconds: entry.DefaultExpiresInSeconds,
+		ChainId:                 entry.ChainID,
+		TokenStandard:           entry.TokenStandard,
+		TokenContract:           entry.TokenContract,
+		TokenDecimals:           toOptionalInt32(entry.TokenDecimals),
+	}
+}
+
+func toOptionalInt32(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+	converted := int32(*value)
+	return &converted
+}
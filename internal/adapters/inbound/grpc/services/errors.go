@@ -0,0 +1,73 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// errorDomain is the ErrorInfo domain of every app error, whose reason is the
+// same code the HTTP API returns in error.code.
+const errorDomain = "chaintx"
+
+// toStatusError maps an app error onto the gRPC status the HTTP status of
+// writeAppError corresponds to, carrying the code and details as ErrorInfo.
+func toStatusError(appErr *apperrors.AppError) error {
+	code := codes.Internal
+	switch appErr.Type {
+	case apperrors.TypeValidation:
+		code = codes.InvalidArgument
+	case apperrors.TypeNotFound:
+		code = codes.NotFound
+	case apperrors.TypeConflict:
+		code = codes.AlreadyExists
+	case apperrors.TypeRateLimited:
+		code = codes.ResourceExhausted
+	}
+
+	st := status.New(code, appErr.Message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   appErr.Code,
+		Domain:   errorDomain,
+		Metadata: errorMetadata(appErr.Details),
+	}}
+	if seconds, ok := appErr.Details["retry_after_seconds"].(int64); ok && seconds > 0 {
+		details = append(details, &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(time.Duration(seconds) * time.Second),
+		})
+	}
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+func errorMetadata(details map[string]any) map[string]string {
+	if len(details) == 0 {
+		return nil
+	}
+	metadata := make(map[string]string, len(details))
+	for key, value := range details {
+		switch typed := value.(type) {
+		case string:
+			metadata[key] = typed
+		default:
+			encoded, err := json.Marshal(typed)
+			if err != nil {
+				metadata[key] = fmt.Sprint(typed)
+				continue
+			}
+			metadata[key] = string(encoded)
+		}
+	}
+	return metadata
+}
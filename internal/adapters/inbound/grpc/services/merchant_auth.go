@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"chaintx/internal/adapters/inbound/grpc/chaintxv1"
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	metadataAuthorization = "authorization"
+	metadataPrincipalID   = "x-principal-id"
+)
+
+type merchantPrincipalContextKey struct{}
+
+// methodScopes is the merchant scope each PaymentRequestService method needs,
+// the same scope as its HTTP route.
+var methodScopes = map[string]string{
+	chaintxv1.PaymentRequestService_CreatePaymentRequest_FullMethodName: policies.MerchantAPIKeyScopeCreate,
+	chaintxv1.PaymentRequestService_GetPaymentRequest_FullMethodName:    policies.MerchantAPIKeyScopeRead,
+	chaintxv1.PaymentRequestService_ListSettlements_FullMethodName:      policies.MerchantAPIKeyScopeRead,
+	chaintxv1.PaymentRequestService_ListAssets_FullMethodName:           policies.MerchantAPIKeyScopeRead,
+	chaintxv1.PaymentRequestService_WatchPaymentRequest_FullMethodName:  policies.MerchantAPIKeyScopeRead,
+}
+
+// MerchantAuth authenticates merchant API keys and JWT access tokens from the
+// authorization metadata exactly like the HTTP MerchantAuth, and stores the
+// resolved principal on the call context. When credentials are not required,
+// calls without one fall back to the legacy x-principal-id metadata.
+type MerchantAuth struct {
+	authenticateUseCase portsin.AuthenticateMerchantAPIKeyUseCase
+	accessTokenUseCase  portsin.AuthenticateAccessTokenUseCase
+	required            bool
+	logger              *log.Logger
+}
+
+func NewMerchantAuth(
+	authenticateUseCase portsin.AuthenticateMerchantAPIKeyUseCase,
+	accessTokenUseCase portsin.AuthenticateAccessTokenUseCase,
+	required bool,
+	logger *log.Logger,
+) *MerchantAuth {
+	return &MerchantAuth{
+		authenticateUseCase: authenticateUseCase,
+		accessTokenUseCase:  accessTokenUseCase,
+		required:            required,
+		logger:              logger,
+	}
+}
+
+func (m *MerchantAuth) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		authCtx, err := m.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(authCtx, req)
+	}
+}
+
+func (m *MerchantAuth) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		authCtx, err := m.authenticate(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: stream, ctx: authCtx})
+	}
+}
+
+func (m *MerchantAuth) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	scope, ok := methodScopes[fullMethod]
+	if !ok {
+		return ctx, nil
+	}
+
+	token := bearerToken(ctx)
+	if m.accessTokenUseCase != nil && policies.IsAccessToken(token) {
+		return m.authenticateAccessToken(ctx, fullMethod, token, scope)
+	}
+	if !strings.HasPrefix(token, policies.MerchantAPIKeyTokenPrefix) {
+		if !m.required {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "merchant api key is required")
+	}
+	if m.authenticateUseCase == nil {
+		return nil, toStatusError(apperrors.NewInternal(
+			"merchant_api_key_authenticate_use_case_missing",
+			"merchant api key authenticate use case is required",
+			nil,
+		))
+	}
+
+	principal, appErr := m.authenticateUseCase.Execute(ctx, dto.AuthenticateMerchantAPIKeyCommand{
+		Token: token,
+		Now:   time.Now().UTC(),
+	})
+	if appErr != nil {
+		return nil, m.authenticationError(fullMethod, appErr)
+	}
+	if !policies.MerchantAPIKeyScopesAllow(principal.Scopes, scope) {
+		return nil, status.Error(codes.PermissionDenied, "merchant api key lacks the "+scope+" scope")
+	}
+
+	return context.WithValue(ctx, merchantPrincipalContextKey{}, principal), nil
+}
+
+func (m *MerchantAuth) authenticateAccessToken(ctx context.Context, fullMethod, token, scope string) (context.Context, error) {
+	tokenPrincipal, appErr := m.accessTokenUseCase.Execute(ctx, dto.AuthenticateAccessTokenCommand{
+		Token: token,
+		Now:   time.Now().UTC(),
+	})
+	if appErr != nil {
+		return nil, m.authenticationError(fullMethod, appErr)
+	}
+	if !policies.MerchantAPIKeyScopesAllow(tokenPrincipal.Scopes, scope) {
+		return nil, status.Error(codes.PermissionDenied, "access token lacks the "+scope+" scope")
+	}
+
+	principal := dto.MerchantPrincipal{
+		MerchantID:  tokenPrincipal.MerchantID,
+		PrincipalID: tokenPrincipal.PrincipalID,
+		KeyID:       policies.AccessTokenKeyID,
+		Scopes:      tokenPrincipal.Scopes,
+	}
+	return context.WithValue(ctx, merchantPrincipalContextKey{}, principal), nil
+}
+
+func (m *MerchantAuth) authenticationError(fullMethod string, appErr *apperrors.AppError) error {
+	if appErr.Type == apperrors.TypeInternal {
+		if m.logger != nil {
+			m.logger.Printf("grpc merchant auth error method=%s code=%s message=%s", fullMethod, appErr.Code, appErr.Message)
+		}
+		return toStatusError(appErr)
+	}
+	return status.Error(codes.Unauthenticated, appErr.Message)
+}
+
+func bearerToken(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, metadataAuthorization)
+	if len(values) == 0 {
+		return ""
+	}
+	raw := strings.TrimSpace(values[0])
+	if len(raw) > len("bearer ") && strings.EqualFold(raw[:len("bearer ")], "bearer ") {
+		return strings.TrimSpace(raw[len("bearer "):])
+	}
+	return ""
+}
+
+func merchantPrincipalFromContext(ctx context.Context) (dto.MerchantPrincipal, bool) {
+	principal, ok := ctx.Value(merchantPrincipalContextKey{}).(dto.MerchantPrincipal)
+	return principal, ok
+}
+
+// callPrincipalID prefers the authenticated principal over the legacy
+// x-principal-id metadata, which is ignored once a credential is presented.
+func callPrincipalID(ctx context.Context) string {
+	if principal, ok := merchantPrincipalFromContext(ctx); ok {
+		return principal.PrincipalID
+	}
+	values := metadata.ValueFromIncomingContext(ctx, metadataPrincipalID)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(values[0])
+}
+
+// callMerchantID is the merchant of the authenticated principal; calls without
+// one resolve to the default merchant.
+func callMerchantID(ctx context.Context) string {
+	if principal, ok := merchantPrincipalFromContext(ctx); ok {
+		return principal.MerchantID
+	}
+	return ""
+}
+
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
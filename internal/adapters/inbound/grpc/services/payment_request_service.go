@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"chaintx/internal/adapters/inbound/grpc/chaintxv1"
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// Creates share the HTTP idempotency scope, so a key first used over HTTP
+// replays the same payment request over gRPC and the other way round.
+const (
+	createIdempotencyMethod = "POST"
+	createIdempotencyPath   = "/v1/payment-requests"
+)
+
+// PaymentRequestService serves chaintx.v1.PaymentRequestService on the same
+// use cases as the merchant HTTP controllers.
+type PaymentRequestService struct {
+	chaintxv1.UnimplementedPaymentRequestServiceServer
+
+	createUseCase         portsin.CreatePaymentRequestUseCase
+	getUseCase            portsin.GetPaymentRequestUseCase
+	getSettlementsUseCase portsin.GetPaymentRequestSettlementsUseCase
+	listAssetsUseCase     portsin.ListAssetsUseCase
+	streamUseCase         portsin.StreamPaymentRequestEventsUseCase
+	rateLimitUseCase      portsin.CheckPaymentRequestRateLimitUseCase
+	logger                *log.Logger
+}
+
+func NewPaymentRequestService(
+	createUseCase portsin.CreatePaymentRequestUseCase,
+	getUseCase portsin.GetPaymentRequestUseCase,
+	getSettlementsUseCase portsin.GetPaymentRequestSettlementsUseCase,
+	listAssetsUseCase portsin.ListAssetsUseCase,
+	streamUseCase portsin.StreamPaymentRequestEventsUseCase,
+	rateLimitUseCase portsin.CheckPaymentRequestRateLimitUseCase,
+	logger *log.Logger,
+) *PaymentRequestService {
+	return &PaymentRequestService{
+		createUseCase:         createUseCase,
+		getUseCase:            getUseCase,
+		getSettlementsUseCase: getSettlementsUseCase,
+		listAssetsUseCase:     listAssetsUseCase,
+		streamUseCase:         streamUseCase,
+		rateLimitUseCase:      rateLimitUseCase,
+		logger:                logger,
+	}
+}
+
+func (s *PaymentRequestService) CreatePaymentRequest(
+	ctx context.Context,
+	req *chaintxv1.CreatePaymentRequestRequest,
+) (*chaintxv1.CreatePaymentRequestResponse, error) {
+	if s.rateLimitUseCase != nil {
+		if appErr := s.rateLimitUseCase.Execute(ctx, dto.CheckPaymentRequestRateLimitCommand{
+			MerchantID:  callMerchantID(ctx),
+			PrincipalID: callPrincipalID(ctx),
+			Now:         time.Now().UTC(),
+		}); appErr != nil {
+			return nil, s.callError(chaintxv1.PaymentRequestService_CreatePaymentRequest_FullMethodName, appErr)
+		}
+	}
+
+	webhookURL := strings.TrimSpace(req.GetWebhookUrl())
+	if webhookURL == "" {
+		return nil, toStatusError(apperrors.NewValidation(
+			"invalid_request",
+			"webhook_url is required",
+			map[string]any{"field": "webhook_url"},
+		))
+	}
+	requestMetadata := map[string]any{}
+	if req.GetMetadata() != nil {
+		requestMetadata = req.GetMetadata().AsMap()
+	}
+
+	output, appErr := s.createUseCase.Execute(ctx, dto.CreatePaymentRequestCommand{
+		IdempotencyScope: dto.IdempotencyScope{
+			MerchantID:  callMerchantID(ctx),
+			PrincipalID: callPrincipalID(ctx),
+			HTTPMethod:  createIdempotencyMethod,
+			HTTPPath:    createIdempotencyPath,
+		},
+		IdempotencyKey:      strings.TrimSpace(req.GetIdempotencyKey()),
+		Chain:               req.GetChain(),
+		Network:             req.GetNetwork(),
+		Asset:               req.GetAsset(),
+		WebhookURL:          webhookURL,
+		WebhookAPIVersion:   req.GetWebhookApiVersion(),
+		ExpectedAmountMinor: req.ExpectedAmountMinor,
+		ExpiresInSeconds:    req.ExpiresInSeconds,
+		Metadata:            requestMetadata,
+	})
+	if appErr != nil {
+		return nil, s.callError(chaintxv1.PaymentRequestService_CreatePaymentRequest_FullMethodName, appErr)
+	}
+
+	return &chaintxv1.CreatePaymentRequestResponse{
+		PaymentRequest: toPaymentRequest(output.Resource),
+		Replayed:       output.Replayed,
+	}, nil
+}
+
+func (s *PaymentRequestService) GetPaymentRequest(
+	ctx context.Context,
+	req *chaintxv1.GetPaymentRequestRequest,
+) (*chaintxv1.GetPaymentRequestResponse, error) {
+	caller, _ := merchantPrincipalFromContext(ctx)
+	resource, appErr := s.getUseCase.Execute(ctx, dto.GetPaymentRequestQuery{ID: req.GetId(), Caller: caller})
+	if appErr != nil {
+		return nil, s.callError(chaintxv1.PaymentRequestService_GetPaymentRequest_FullMethodName, appErr)
+	}
+
+	return &chaintxv1.GetPaymentRequestResponse{PaymentRequest: toPaymentRequest(resource)}, nil
+}
+
+func (s *PaymentRequestService) ListSettlements(
+	ctx context.Context,
+	req *chaintxv1.ListSettlementsRequest,
+) (*chaintxv1.ListSettlementsResponse, error) {
+	caller, _ := merchantPrincipalFromContext(ctx)
+	resource, appErr := s.getSettlementsUseCase.Execute(ctx, dto.GetPaymentRequestSettlementsQuery{
+		ID:     req.GetPaymentRequestId(),
+		Caller: caller,
+	})
+	if appErr != nil {
+		return nil, s.callError(chaintxv1.PaymentRequestService_ListSettlements_FullMethodName, appErr)
+	}
+
+	settlements, err := toSettlements(resource.Settlements)
+	if err != nil {
+		return nil, s.callError(chaintxv1.PaymentRequestService_ListSettlements_FullMethodName, apperrors.NewInternal(
+			"settlement_metadata_invalid",
+			"settlement metadata cannot be encoded",
+			map[string]any{"error": err.Error()},
+		))
+	}
+	return &chaintxv1.ListSettlementsResponse{
+		PaymentRequestId: resource.PaymentRequestID,
+		Settlements:      settlements,
+	}, nil
+}
+
+func (s *PaymentRequestService) ListAssets(
+	ctx context.Context,
+	_ *chaintxv1.ListAssetsRequest,
+) (*chaintxv1.ListAssetsResponse, error) {
+	output, appErr := s.listAssetsUseCase.Execute(ctx, dto.ListAssetsQuery{MerchantID: callMerchantID(ctx)})
+	if appErr != nil {
+		return nil, s.callError(chaintxv1.PaymentRequestService_ListAssets_FullMethodName, appErr)
+	}
+
+	assets := make([]*chaintxv1.Asset, 0, len(output.Assets))
+	for _, entry := range output.Assets {
+		assets = append(assets, toAsset(entry))
+	}
+	return &chaintxv1.ListAssetsResponse{Assets: assets}, nil
+}
+
+// WatchPaymentRequest streams the payment request event journal. Unlike SSE,
+// a failure after the stream opened still reaches the client as the final
+// status. HTTP/2 keepalives replace the SSE heartbeat frames.
+func (s *PaymentRequestService) WatchPaymentRequest(
+	req *chaintxv1.WatchPaymentRequestRequest,
+	stream grpc.ServerStreamingServer[chaintxv1.PaymentRequestEvent],
+) error {
+	if s.streamUseCase == nil {
+		return toStatusError(apperrors.NewInternal(
+			"payment_request_event_stream_use_case_missing",
+			"payment request event stream use case is required",
+			nil,
+		))
+	}
+
+	ctx := stream.Context()
+	caller, _ := merchantPrincipalFromContext(ctx)
+	appErr := s.streamUseCase.Execute(ctx, dto.StreamPaymentRequestEventsCommand{
+		ID:          req.GetId(),
+		Caller:      caller,
+		LastEventID: req.GetLastEventId(),
+		Open: func() error {
+			return stream.SendHeader(metadata.MD{})
+		},
+		Emit: func(event dto.PaymentRequestEvent) error {
+			message, err := toPaymentRequestEvent(event)
+			if err != nil {
+				return err
+			}
+			return stream.Send(message)
+		},
+		Heartbeat: func() error {
+			return nil
+		},
+	})
+	if appErr != nil {
+		return s.callError(chaintxv1.PaymentRequestService_WatchPaymentRequest_FullMethodName, appErr)
+	}
+	return nil
+}
+
+func (s *PaymentRequestService) callError(fullMethod string, appErr *apperrors.AppError) error {
+	if s.logger != nil {
+		s.logger.Printf("grpc request error method=%s code=%s message=%s", fullMethod, appErr.Code, appErr.Message)
+	}
+	return toStatusError(appErr)
+}
+
+func toPaymentRequestEvent(event dto.PaymentRequestEvent) (*chaintxv1.PaymentRequestEvent, error) {
+	payload := map[string]any{}
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, err
+	}
+	data, err := structpb.NewStruct(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &chaintxv1.PaymentRequestEvent{
+		Sequence:  event.Sequence,
+		EventId:   event.EventID,
+		EventType: event.EventType,
+		Data:      data,
+	}, nil
+}
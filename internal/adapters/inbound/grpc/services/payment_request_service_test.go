@@ -0,0 +1,335 @@
+//go:build !integration
+
+package services
+
+import (
+	"context"
+	"io"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"chaintx/internal/adapters/inbound/grpc/chaintxv1"
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const testMerchantAPIKey = "ctxk_0123456789abcdef_secret"
+
+func TestPaymentRequestServiceCreatePaymentRequest(t *testing.T) {
+	createUseCase := &stubCreatePaymentRequestUseCase{}
+	client := newTestClient(t, NewPaymentRequestService(
+		createUseCase, nil, nil, nil, nil, nil, log.New(io.Discard, "", 0),
+	), newTestMerchantAuth(false))
+
+	metadataValue, _ := structpb.NewStruct(map[string]any{"order_id": "ord-1"})
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+testMerchantAPIKey)
+	response, err := client.CreatePaymentRequest(ctx, &chaintxv1.CreatePaymentRequestRequest{
+		Chain:          "bitcoin",
+		Network:        "testnet",
+		Asset:          "BTC",
+		WebhookUrl:     " https://hooks.example.com/evt ",
+		Metadata:       metadataValue,
+		IdempotencyKey: "key-1",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if response.GetPaymentRequest().GetId() != "pr_1" || response.GetPaymentRequest().GetPaymentInstructions().GetAddress() != "tb1qaddress" {
+		t.Fatalf("unexpected response: %+v", response)
+	}
+	if instructions := response.GetPaymentRequest().GetPaymentInstructions(); instructions.GetPaymentUri() != "bitcoin:tb1qaddress" ||
+		instructions.GetMinorUnit() != "sats" || instructions.DisplayAmount != nil {
+		t.Fatalf("unexpected payment instructions: %+v", instructions)
+	}
+
+	command := createUseCase.command
+	if command.IdempotencyScope != (dto.IdempotencyScope{
+		MerchantID:  "retail",
+		PrincipalID: "merchant_a",
+		HTTPMethod:  "POST",
+		HTTPPath:    "/v1/payment-requests",
+	}) {
+		t.Fatalf("expected the HTTP idempotency scope, got %+v", command.IdempotencyScope)
+	}
+	if command.IdempotencyKey != "key-1" || command.WebhookURL != "https://hooks.example.com/evt" || command.Metadata["order_id"] != "ord-1" {
+		t.Fatalf("unexpected command: %+v", command)
+	}
+}
+
+func TestPaymentRequestServiceCreatePaymentRequestRequiresWebhookURL(t *testing.T) {
+	client := newTestClient(t, NewPaymentRequestService(
+		&stubCreatePaymentRequestUseCase{}, nil, nil, nil, nil, nil, nil,
+	), newTestMerchantAuth(false))
+
+	_, err := client.CreatePaymentRequest(context.Background(), &chaintxv1.CreatePaymentRequestRequest{Chain: "bitcoin"})
+
+	assertStatus(t, err, codes.InvalidArgument, "invalid_request")
+}
+
+func TestPaymentRequestServiceCreatePaymentRequestRateLimited(t *testing.T) {
+	rateLimit := &stubCheckPaymentRequestRateLimitUseCase{
+		err: apperrors.NewRateLimited("rate_limited", "payment request rate limit exceeded", map[string]any{
+			"retry_after_seconds": int64(2),
+		}),
+	}
+	createUseCase := &stubCreatePaymentRequestUseCase{}
+	client := newTestClient(t, NewPaymentRequestService(
+		createUseCase, nil, nil, nil, nil, rateLimit, nil,
+	), newTestMerchantAuth(false))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-principal-id", "legacy-caller")
+	_, err := client.CreatePaymentRequest(ctx, &chaintxv1.CreatePaymentRequestRequest{WebhookUrl: "https://hooks.example.com/evt"})
+
+	st := assertStatus(t, err, codes.ResourceExhausted, "rate_limited")
+	var retryDelay time.Duration
+	for _, detail := range st.Details() {
+		if retry, ok := detail.(*errdetails.RetryInfo); ok {
+			retryDelay = retry.GetRetryDelay().AsDuration()
+		}
+	}
+	if retryDelay != 2*time.Second {
+		t.Fatalf("expected retry delay 2s, got %s", retryDelay)
+	}
+	if rateLimit.command.PrincipalID != "legacy-caller" {
+		t.Fatalf("expected legacy principal to select the bucket, got %+v", rateLimit.command)
+	}
+	if createUseCase.called {
+		t.Fatalf("expected create not to run when rate limited")
+	}
+}
+
+func TestPaymentRequestServiceGetPaymentRequestNotFound(t *testing.T) {
+	getUseCase := &stubGetPaymentRequestUseCase{
+		err: apperrors.NewNotFound("payment_request_not_found", "payment request not found", nil),
+	}
+	client := newTestClient(t, NewPaymentRequestService(
+		nil, getUseCase, nil, nil, nil, nil, nil,
+	), newTestMerchantAuth(false))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+testMerchantAPIKey)
+	_, err := client.GetPaymentRequest(ctx, &chaintxv1.GetPaymentRequestRequest{Id: "pr_missing"})
+
+	assertStatus(t, err, codes.NotFound, "payment_request_not_found")
+	if getUseCase.query.ID != "pr_missing" || getUseCase.query.Caller.PrincipalID != "merchant_a" {
+		t.Fatalf("unexpected query: %+v", getUseCase.query)
+	}
+}
+
+func TestPaymentRequestServiceListSettlementsAndAssets(t *testing.T) {
+	client := newTestClient(t, NewPaymentRequestService(
+		nil, nil, stubGetPaymentRequestSettlementsUseCase{}, stubListAssetsUseCase{}, nil, nil, nil,
+	), newTestMerchantAuth(false))
+
+	settlements, err := client.ListSettlements(context.Background(), &chaintxv1.ListSettlementsRequest{PaymentRequestId: "pr_1"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(settlements.GetSettlements()) != 1 || settlements.GetSettlements()[0].GetMetadata().AsMap()["vout"] != float64(1) {
+		t.Fatalf("unexpected settlements: %+v", settlements)
+	}
+
+	assets, err := client.ListAssets(context.Background(), &chaintxv1.ListAssetsRequest{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(assets.GetAssets()) != 1 || assets.GetAssets()[0].GetTokenDecimals() != 6 || assets.GetAssets()[0].GetChainId() != 1 {
+		t.Fatalf("unexpected assets: %+v", assets)
+	}
+}
+
+func TestPaymentRequestServiceWatchPaymentRequest(t *testing.T) {
+	streamUseCase := &stubStreamPaymentRequestEventsUseCase{events: []dto.PaymentRequestEvent{
+		{Sequence: 3, EventID: "evt_3", EventType: "payment_request.detected", Payload: []byte(`{"type":"payment_request.detected","sequence":3}`)},
+		{Sequence: 4, EventID: "evt_4", EventType: "payment_request.confirmed", Payload: []byte(`{"type":"payment_request.confirmed","sequence":4}`)},
+	}}
+	client := newTestClient(t, NewPaymentRequestService(
+		nil, nil, nil, nil, streamUseCase, nil, nil,
+	), newTestMerchantAuth(false))
+
+	stream, err := client.WatchPaymentRequest(context.Background(), &chaintxv1.WatchPaymentRequestRequest{Id: "pr_1", LastEventId: "2"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var received []*chaintxv1.PaymentRequestEvent
+	for {
+		event, recvErr := stream.Recv()
+		if recvErr == io.EOF {
+			break
+		}
+		if recvErr != nil {
+			t.Fatalf("expected clean end of stream, got %v", recvErr)
+		}
+		received = append(received, event)
+	}
+
+	if len(received) != 2 || received[1].GetSequence() != 4 || received[1].GetData().AsMap()["type"] != "payment_request.confirmed" {
+		t.Fatalf("unexpected events: %+v", received)
+	}
+	if streamUseCase.command.ID != "pr_1" || streamUseCase.command.LastEventID != "2" {
+		t.Fatalf("unexpected stream command: %+v", streamUseCase.command)
+	}
+}
+
+func TestPaymentRequestServiceWatchPaymentRequestNotFound(t *testing.T) {
+	streamUseCase := &stubStreamPaymentRequestEventsUseCase{
+		err: apperrors.NewNotFound("payment_request_not_found", "payment request not found", nil),
+	}
+	client := newTestClient(t, NewPaymentRequestService(
+		nil, nil, nil, nil, streamUseCase, nil, nil,
+	), newTestMerchantAuth(false))
+
+	stream, err := client.WatchPaymentRequest(context.Background(), &chaintxv1.WatchPaymentRequestRequest{Id: "pr_missing"})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+
+	assertStatus(t, err, codes.NotFound, "payment_request_not_found")
+}
+
+func newTestClient(t *testing.T, service *PaymentRequestService, auth *MerchantAuth) chaintxv1.PaymentRequestServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(auth.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(auth.StreamInterceptor()),
+	)
+	chaintxv1.RegisterPaymentRequestServiceServer(server, service)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial bufconn: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return chaintxv1.NewPaymentRequestServiceClient(conn)
+}
+
+func assertStatus(t *testing.T, err error, code codes.Code, reason string) *status.Status {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != code {
+		t.Fatalf("expected %s, got %v", code, err)
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == reason && info.GetDomain() == errorDomain {
+			return st
+		}
+	}
+	t.Fatalf("expected ErrorInfo reason %s, got %+v", reason, st.Details())
+	return nil
+}
+
+type stubCreatePaymentRequestUseCase struct {
+	command dto.CreatePaymentRequestCommand
+	called  bool
+}
+
+func (s *stubCreatePaymentRequestUseCase) Execute(_ context.Context, command dto.CreatePaymentRequestCommand) (dto.CreatePaymentRequestOutput, *apperrors.AppError) {
+	s.command = command
+	s.called = true
+	paymentURI := "bitcoin:tb1qaddress"
+	return dto.CreatePaymentRequestOutput{Resource: dto.PaymentRequestResource{
+		ID:        "pr_1",
+		Status:    "pending",
+		Chain:     command.Chain,
+		Network:   command.Network,
+		Asset:     command.Asset,
+		ExpiresAt: time.Date(2026, 10, 19, 13, 0, 0, 0, time.UTC),
+		CreatedAt: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		PaymentInstructions: dto.PaymentInstructions{
+			Address:         "tb1qaddress",
+			AddressScheme:   "bip84_p2wpkh",
+			DerivationIndex: 7,
+			MinorUnit:       "sats",
+			PaymentURI:      &paymentURI,
+		},
+	}}, nil
+}
+
+type stubGetPaymentRequestUseCase struct {
+	query dto.GetPaymentRequestQuery
+	err   *apperrors.AppError
+}
+
+func (s *stubGetPaymentRequestUseCase) Execute(_ context.Context, query dto.GetPaymentRequestQuery) (dto.PaymentRequestResource, *apperrors.AppError) {
+	s.query = query
+	return dto.PaymentRequestResource{}, s.err
+}
+
+type stubGetPaymentRequestSettlementsUseCase struct{}
+
+func (stubGetPaymentRequestSettlementsUseCase) Execute(_ context.Context, query dto.GetPaymentRequestSettlementsQuery) (dto.PaymentRequestSettlementsResource, *apperrors.AppError) {
+	return dto.PaymentRequestSettlementsResource{
+		PaymentRequestID: query.ID,
+		Settlements: []dto.PaymentRequestSettlementResource{
+			{EvidenceRef: "txid:1", AmountMinor: "1000", Confirmations: 2, IsCanonical: true, Metadata: map[string]any{"vout": 1}},
+		},
+	}, nil
+}
+
+type stubListAssetsUseCase struct{}
+
+func (stubListAssetsUseCase) Execute(context.Context, dto.ListAssetsQuery) (dto.ListAssetsOutput, *apperrors.AppError) {
+	chainID := int64(1)
+	tokenDecimals := 6
+	return dto.ListAssetsOutput{Assets: []dto.AssetCatalogEntry{
+		{Chain: "ethereum", Network: "mainnet", Asset: "USDT", Decimals: 6, ChainID: &chainID, TokenDecimals: &tokenDecimals},
+	}}, nil
+}
+
+type stubStreamPaymentRequestEventsUseCase struct {
+	command dto.StreamPaymentRequestEventsCommand
+	events  []dto.PaymentRequestEvent
+	err     *apperrors.AppError
+}
+
+func (s *stubStreamPaymentRequestEventsUseCase) Execute(_ context.Context, command dto.StreamPaymentRequestEventsCommand) *apperrors.AppError {
+	s.command = command
+	if s.err != nil {
+		return s.err
+	}
+	if err := command.Open(); err != nil {
+		return nil
+	}
+	for _, event := range s.events {
+		if err := command.Emit(event); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+type stubCheckPaymentRequestRateLimitUseCase struct {
+	command dto.CheckPaymentRequestRateLimitCommand
+	err     *apperrors.AppError
+}
+
+func (s *stubCheckPaymentRequestRateLimitUseCase) Execute(_ context.Context, command dto.CheckPaymentRequestRateLimitCommand) *apperrors.AppError {
+	s.command = command
+	return s.err
+}
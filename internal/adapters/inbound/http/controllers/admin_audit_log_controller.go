@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// AdminAuditLogController reads the admin audit log; it needs the admin role.
+type AdminAuditLogController struct {
+	listUseCase portsin.ListAdminAuditEntriesUseCase
+	opsAuth     *OpsAuth
+	logger      *log.Logger
+}
+
+func NewAdminAuditLogController(
+	listUseCase portsin.ListAdminAuditEntriesUseCase,
+	opsAuth *OpsAuth,
+	logger *log.Logger,
+) *AdminAuditLogController {
+	return &AdminAuditLogController{
+		listUseCase: listUseCase,
+		opsAuth:     opsAuth,
+		logger:      logger,
+	}
+}
+
+func (c *AdminAuditLogController) ListEntries(w http.ResponseWriter, r *http.Request) {
+	if _, authErr := c.opsAuth.authorize(r, policies.OpsActionAdminAuditLogRead); authErr != nil {
+		writeWebhookOpsAuthError(w, authErr)
+		return
+	}
+	if c.listUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"admin_audit_log_list_use_case_missing",
+			"admin audit log list use case is required",
+			nil,
+		))
+		return
+	}
+
+	query := r.URL.Query()
+	listQuery := dto.ListAdminAuditEntriesQuery{
+		ActorID: query.Get("actor_id"),
+		Action:  query.Get("action"),
+	}
+	if raw := strings.TrimSpace(query.Get("limit")); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			writeAppError(w, apperrors.NewValidation(
+				"invalid_request",
+				"limit must be an integer",
+				map[string]any{"field": "limit"},
+			))
+			return
+		}
+		listQuery.Limit = parsed
+	}
+	if raw := strings.TrimSpace(query.Get("before_id")); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			writeAppError(w, apperrors.NewValidation(
+				"invalid_request",
+				"before_id must be an integer",
+				map[string]any{"field": "before_id"},
+			))
+			return
+		}
+		listQuery.BeforeID = parsed
+	}
+
+	output, appErr := c.listUseCase.Execute(r.Context(), listQuery)
+	if appErr != nil {
+		if c.logger != nil {
+			c.logger.Printf("request error path=/v1/admin-audit-log method=%s code=%s message=%s", r.Method, appErr.Code, appErr.Message)
+		}
+		writeAppError(w, appErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
@@ -18,7 +18,7 @@ func NewAssetsController(useCase portsin.ListAssetsUseCase, logger *log.Logger)
 }
 
 func (c *AssetsController) ListAssets(w http.ResponseWriter, r *http.Request) {
-	output, appErr := c.useCase.Execute(r.Context(), dto.ListAssetsQuery{})
+	output, appErr := c.useCase.Execute(r.Context(), dto.ListAssetsQuery{MerchantID: requestMerchantID(r)})
 	if appErr != nil {
 		c.logger.Printf("request error path=/v1/assets method=%s code=%s message=%s", r.Method, appErr.Code, appErr.Message)
 		writeAppError(w, appErr)
@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// MerchantAPIKeysController issues and revokes merchant API keys. It is an
+// ops surface: reads need the viewer role, and issuing or revoking needs
+// admin.
+type MerchantAPIKeysController struct {
+	createUseCase portsin.CreateMerchantAPIKeyUseCase
+	listUseCase   portsin.ListMerchantAPIKeysUseCase
+	revokeUseCase portsin.RevokeMerchantAPIKeyUseCase
+	opsAuth       *OpsAuth
+	logger        *log.Logger
+}
+
+type createMerchantAPIKeyPayload struct {
+	MerchantID       string   `json:"merchant_id,omitempty"`
+	PrincipalID      string   `json:"principal_id"`
+	Scopes           []string `json:"scopes"`
+	Label            string   `json:"label,omitempty"`
+	ExpiresInSeconds *int64   `json:"expires_in_seconds,omitempty"`
+}
+
+func NewMerchantAPIKeysController(
+	createUseCase portsin.CreateMerchantAPIKeyUseCase,
+	listUseCase portsin.ListMerchantAPIKeysUseCase,
+	revokeUseCase portsin.RevokeMerchantAPIKeyUseCase,
+	opsAuth *OpsAuth,
+	logger *log.Logger,
+) *MerchantAPIKeysController {
+	return &MerchantAPIKeysController{
+		createUseCase: createUseCase,
+		listUseCase:   listUseCase,
+		revokeUseCase: revokeUseCase,
+		opsAuth:       opsAuth,
+		logger:        logger,
+	}
+}
+
+// CreateKey returns the plaintext key once; only its digest is stored.
+func (c *MerchantAPIKeysController) CreateKey(w http.ResponseWriter, r *http.Request) {
+	actor, authErr := c.opsAuth.authorize(r, policies.OpsActionMerchantAPIKeyCreate)
+	if authErr != nil {
+		writeWebhookOpsAuthError(w, authErr)
+		return
+	}
+	if c.createUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"merchant_api_key_create_use_case_missing",
+			"merchant api key create use case is required",
+			nil,
+		))
+		return
+	}
+
+	payload, appErr := parseCreateMerchantAPIKeyPayload(r.Body)
+	if appErr != nil {
+		writeAppError(w, appErr)
+		return
+	}
+
+	output, appErr := c.createUseCase.Execute(r.Context(), dto.CreateMerchantAPIKeyCommand{
+		MerchantID:       payload.MerchantID,
+		PrincipalID:      payload.PrincipalID,
+		Scopes:           payload.Scopes,
+		Label:            payload.Label,
+		ExpiresInSeconds: payload.ExpiresInSeconds,
+		OperatorID:       actor.ID,
+		Now:              time.Now().UTC(),
+	})
+	c.opsAuth.audit(r, actor, policies.OpsActionMerchantAPIKeyCreate, output.Key.ID, "", appErr, map[string]any{
+		"merchant_id":  output.Key.MerchantID,
+		"principal_id": payload.PrincipalID,
+		"scopes":       payload.Scopes,
+	})
+	if appErr != nil {
+		c.logRequestError("/v1/merchant-api-keys", r.Method, appErr)
+		writeAppError(w, appErr)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, output)
+}
+
+func (c *MerchantAPIKeysController) ListKeys(w http.ResponseWriter, r *http.Request) {
+	if _, authErr := c.opsAuth.authorize(r, policies.OpsActionMerchantAPIKeyRead); authErr != nil {
+		writeWebhookOpsAuthError(w, authErr)
+		return
+	}
+	if c.listUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"merchant_api_key_list_use_case_missing",
+			"merchant api key list use case is required",
+			nil,
+		))
+		return
+	}
+
+	output, appErr := c.listUseCase.Execute(r.Context(), dto.ListMerchantAPIKeysQuery{
+		MerchantID:  r.URL.Query().Get("merchant_id"),
+		PrincipalID: r.URL.Query().Get("principal_id"),
+	})
+	if appErr != nil {
+		c.logRequestError("/v1/merchant-api-keys", r.Method, appErr)
+		writeAppError(w, appErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (c *MerchantAPIKeysController) RevokeKey(w http.ResponseWriter, r *http.Request) {
+	actor, authErr := c.opsAuth.authorize(r, policies.OpsActionMerchantAPIKeyRevoke)
+	if authErr != nil {
+		writeWebhookOpsAuthError(w, authErr)
+		return
+	}
+	if c.revokeUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"merchant_api_key_revoke_use_case_missing",
+			"merchant api key revoke use case is required",
+			nil,
+		))
+		return
+	}
+
+	keyID := r.PathValue("key_id")
+	output, appErr := c.revokeUseCase.Execute(r.Context(), dto.RevokeMerchantAPIKeyCommand{
+		KeyID: keyID,
+		Now:   time.Now().UTC(),
+	})
+	c.opsAuth.audit(r, actor, policies.OpsActionMerchantAPIKeyRevoke, keyID, "", appErr, nil)
+	if appErr != nil {
+		c.logRequestError("/v1/merchant-api-keys/{key_id}/revoke", r.Method, appErr)
+		writeAppError(w, appErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (c *MerchantAPIKeysController) logRequestError(path string, method string, appErr *apperrors.AppError) {
+	if c.logger == nil || appErr == nil {
+		return
+	}
+	c.logger.Printf("request error path=%s method=%s code=%s message=%s", path, method, appErr.Code, appErr.Message)
+}
+
+func parseCreateMerchantAPIKeyPayload(body io.Reader) (createMerchantAPIKeyPayload, *apperrors.AppError) {
+	if body == nil {
+		return createMerchantAPIKeyPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body is required",
+			nil,
+		)
+	}
+
+	decoder := json.NewDecoder(body)
+	decoder.DisallowUnknownFields()
+
+	payload := createMerchantAPIKeyPayload{}
+	if err := decoder.Decode(&payload); err != nil {
+		if err == io.EOF {
+			return createMerchantAPIKeyPayload{}, apperrors.NewValidation(
+				"invalid_request",
+				"request body is required",
+				nil,
+			)
+		}
+		return createMerchantAPIKeyPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body must be valid JSON",
+			map[string]any{"error": err.Error()},
+		)
+	}
+
+	if err := decoder.Decode(&struct{}{}); err != io.EOF {
+		return createMerchantAPIKeyPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body must contain a single JSON object",
+			nil,
+		)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,152 @@
+//go:build !integration
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestMerchantAPIKeysControllerCreateKey(t *testing.T) {
+	useCase := &recordingCreateMerchantAPIKeyUseCase{}
+	controller := NewMerchantAPIKeysController(useCase, nil, nil, newTestOpsAuth("ops-key"), log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/v1/merchant-api-keys",
+		strings.NewReader(`{"merchant_id":"retail","principal_id":"merchant_a","scopes":["create","read"],"label":"checkout","expires_in_seconds":86400}`),
+	)
+	req.Header.Set("Authorization", "Bearer ops-key")
+	req.Header.Set("X-Principal-ID", "ops-user-1")
+	rec := httptest.NewRecorder()
+
+	controller.CreateKey(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("Cache-Control") != "no-store" {
+		t.Fatalf("expected no-store on plaintext key response")
+	}
+	command := useCase.lastCommand
+	if command.PrincipalID != "merchant_a" || len(command.Scopes) != 2 || command.OperatorID != "ops-user-1" {
+		t.Fatalf("unexpected command %+v", command)
+	}
+	if command.MerchantID != "retail" {
+		t.Fatalf("expected merchant_id to pass through, got %q", command.MerchantID)
+	}
+	if command.ExpiresInSeconds == nil || *command.ExpiresInSeconds != 86400 {
+		t.Fatalf("expected expires_in_seconds, got %+v", command.ExpiresInSeconds)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte(`"api_key":"ctxk_`)) {
+		t.Fatalf("expected plaintext key in response, got %s", rec.Body.String())
+	}
+}
+
+func TestMerchantAPIKeysControllerRejectsUnknownFields(t *testing.T) {
+	useCase := &recordingCreateMerchantAPIKeyUseCase{}
+	controller := NewMerchantAPIKeysController(useCase, nil, nil, newTestOpsAuth("ops-key"), log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/merchant-api-keys", strings.NewReader(`{"principal_id":"merchant_a","key_hash":"x"}`))
+	req.Header.Set("Authorization", "Bearer ops-key")
+	rec := httptest.NewRecorder()
+
+	controller.CreateKey(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if useCase.calls != 0 {
+		t.Fatalf("expected use case not to be called, got %d calls", useCase.calls)
+	}
+}
+
+func TestMerchantAPIKeysControllerRequiresAdminKey(t *testing.T) {
+	controller := NewMerchantAPIKeysController(
+		&recordingCreateMerchantAPIKeyUseCase{},
+		stubListMerchantAPIKeysUseCase{},
+		stubRevokeMerchantAPIKeyUseCase{},
+		newTestOpsAuth("ops-key"),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/merchant-api-keys?principal_id=merchant_a", nil)
+	req.Header.Set("Authorization", "Bearer "+testMerchantAPIKey)
+	rec := httptest.NewRecorder()
+
+	controller.ListKeys(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestMerchantAPIKeysControllerRevokeKeyNotFound(t *testing.T) {
+	controller := NewMerchantAPIKeysController(nil, nil, stubRevokeMerchantAPIKeyUseCase{}, newTestOpsAuth("ops-key"), log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/merchant-api-keys/key_missing/revoke", nil)
+	req.SetPathValue("key_id", "key_missing")
+	req.Header.Set("Authorization", "Bearer ops-key")
+	rec := httptest.NewRecorder()
+
+	controller.RevokeKey(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+type recordingCreateMerchantAPIKeyUseCase struct {
+	calls       int
+	lastCommand dto.CreateMerchantAPIKeyCommand
+}
+
+func (u *recordingCreateMerchantAPIKeyUseCase) Execute(
+	_ context.Context,
+	command dto.CreateMerchantAPIKeyCommand,
+) (dto.CreateMerchantAPIKeyOutput, *apperrors.AppError) {
+	u.calls++
+	u.lastCommand = command
+	return dto.CreateMerchantAPIKeyOutput{
+		Key: dto.MerchantAPIKey{
+			ID:          "key_test",
+			PrincipalID: command.PrincipalID,
+			KeyPrefix:   "0123456789abcdef",
+			Scopes:      command.Scopes,
+			CreatedAt:   time.Unix(0, 0).UTC(),
+		},
+		APIKey: testMerchantAPIKey,
+	}, nil
+}
+
+type stubListMerchantAPIKeysUseCase struct{}
+
+func (stubListMerchantAPIKeysUseCase) Execute(
+	_ context.Context,
+	_ dto.ListMerchantAPIKeysQuery,
+) (dto.ListMerchantAPIKeysOutput, *apperrors.AppError) {
+	return dto.ListMerchantAPIKeysOutput{Keys: []dto.MerchantAPIKey{}}, nil
+}
+
+type stubRevokeMerchantAPIKeyUseCase struct{}
+
+func (stubRevokeMerchantAPIKeyUseCase) Execute(
+	_ context.Context,
+	command dto.RevokeMerchantAPIKeyCommand,
+) (dto.MerchantAPIKey, *apperrors.AppError) {
+	return dto.MerchantAPIKey{}, apperrors.NewNotFound(
+		"merchant_api_key_not_found",
+		"merchant api key was not found",
+		map[string]any{"key_id": command.KeyID},
+	)
+}
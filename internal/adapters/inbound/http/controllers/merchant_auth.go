@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type merchantPrincipalContextKey struct{}
+
+// MerchantAuth authenticates merchant API keys, and JWT access tokens when a
+// verifier is configured, on merchant facing routes and stores the resolved
+// principal on the request context. When credentials are not required,
+// requests without one fall back to the legacy trusted X-Principal-ID header.
+type MerchantAuth struct {
+	authenticateUseCase portsin.AuthenticateMerchantAPIKeyUseCase
+	accessTokenUseCase  portsin.AuthenticateAccessTokenUseCase
+	required            bool
+	logger              *log.Logger
+}
+
+func NewMerchantAuth(
+	authenticateUseCase portsin.AuthenticateMerchantAPIKeyUseCase,
+	accessTokenUseCase portsin.AuthenticateAccessTokenUseCase,
+	required bool,
+	logger *log.Logger,
+) *MerchantAuth {
+	return &MerchantAuth{
+		authenticateUseCase: authenticateUseCase,
+		accessTokenUseCase:  accessTokenUseCase,
+		required:            required,
+		logger:              logger,
+	}
+}
+
+// Require guards a merchant route with scope. A nil MerchantAuth leaves the
+// route open, which keeps router wiring optional in tests.
+func (m *MerchantAuth) Require(scope string, next http.HandlerFunc) http.HandlerFunc {
+	return m.wrap(scope, false, next)
+}
+
+// RequireOrOpsKey is Require for routes that also accept a webhook ops admin
+// key: bearer tokens that are not merchant keys are left for the handler to
+// verify.
+func (m *MerchantAuth) RequireOrOpsKey(scope string, next http.HandlerFunc) http.HandlerFunc {
+	return m.wrap(scope, true, next)
+}
+
+func (m *MerchantAuth) wrap(scope string, allowOpsKey bool, next http.HandlerFunc) http.HandlerFunc {
+	if m == nil {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := extractAdminToken(r)
+		if m.accessTokenUseCase != nil && policies.IsAccessToken(token) {
+			m.serveAccessToken(w, r, token, scope, allowOpsKey, next)
+			return
+		}
+		if !strings.HasPrefix(token, policies.MerchantAPIKeyTokenPrefix) {
+			if !m.required || (allowOpsKey && token != "") {
+				next(w, r)
+				return
+			}
+			writeMerchantAuthError(w, http.StatusUnauthorized, "unauthorized", "merchant api key is required")
+			return
+		}
+		if m.authenticateUseCase == nil {
+			writeAppError(w, apperrors.NewInternal(
+				"merchant_api_key_authenticate_use_case_missing",
+				"merchant api key authenticate use case is required",
+				nil,
+			))
+			return
+		}
+
+		principal, appErr := m.authenticateUseCase.Execute(r.Context(), dto.AuthenticateMerchantAPIKeyCommand{
+			Token: token,
+			Now:   time.Now().UTC(),
+		})
+		if appErr != nil {
+			m.writeAuthenticationError(w, r, appErr)
+			return
+		}
+		if !policies.MerchantAPIKeyScopesAllow(principal.Scopes, scope) {
+			writeMerchantAuthError(w, http.StatusForbidden, "forbidden", "merchant api key lacks the "+scope+" scope")
+			return
+		}
+
+		next(w, r.WithContext(context.WithValue(r.Context(), merchantPrincipalContextKey{}, principal)))
+	}
+}
+
+// serveAccessToken authenticates a JWT bearer token. On routes that also
+// accept ops credentials, a token without the merchant scope but with an ops
+// role is left for the handler to authorize.
+func (m *MerchantAuth) serveAccessToken(
+	w http.ResponseWriter,
+	r *http.Request,
+	token string,
+	scope string,
+	allowOpsKey bool,
+	next http.HandlerFunc,
+) {
+	tokenPrincipal, appErr := m.accessTokenUseCase.Execute(r.Context(), dto.AuthenticateAccessTokenCommand{
+		Token: token,
+		Now:   time.Now().UTC(),
+	})
+	if appErr != nil {
+		m.writeAuthenticationError(w, r, appErr)
+		return
+	}
+	if !policies.MerchantAPIKeyScopesAllow(tokenPrincipal.Scopes, scope) {
+		if allowOpsKey && tokenPrincipal.OpsRole != "" {
+			next(w, r)
+			return
+		}
+		writeMerchantAuthError(w, http.StatusForbidden, "forbidden", "access token lacks the "+scope+" scope")
+		return
+	}
+
+	principal := dto.MerchantPrincipal{
+		MerchantID:  tokenPrincipal.MerchantID,
+		PrincipalID: tokenPrincipal.PrincipalID,
+		KeyID:       policies.AccessTokenKeyID,
+		Scopes:      tokenPrincipal.Scopes,
+	}
+	next(w, r.WithContext(context.WithValue(r.Context(), merchantPrincipalContextKey{}, principal)))
+}
+
+func (m *MerchantAuth) writeAuthenticationError(w http.ResponseWriter, r *http.Request, appErr *apperrors.AppError) {
+	if appErr.Type == apperrors.TypeInternal {
+		if m.logger != nil {
+			m.logger.Printf("merchant auth error path=%s method=%s code=%s message=%s", r.URL.Path, r.Method, appErr.Code, appErr.Message)
+		}
+		writeAppError(w, appErr)
+		return
+	}
+	writeMerchantAuthError(w, http.StatusUnauthorized, "unauthorized", appErr.Message)
+}
+
+func merchantPrincipalFromContext(ctx context.Context) (dto.MerchantPrincipal, bool) {
+	principal, ok := ctx.Value(merchantPrincipalContextKey{}).(dto.MerchantPrincipal)
+	return principal, ok
+}
+
+// requestPrincipalID prefers the authenticated key's principal over the
+// legacy X-Principal-ID header, which is ignored once a key is presented.
+func requestPrincipalID(r *http.Request) string {
+	if principal, ok := merchantPrincipalFromContext(r.Context()); ok {
+		return principal.PrincipalID
+	}
+	return strings.TrimSpace(r.Header.Get(headerPrincipalID))
+}
+
+// requestMerchantID is the merchant of the authenticated key. Requests without
+// a key return "", which the use cases resolve to the default merchant; the
+// X-Principal-ID header can never select another merchant.
+func requestMerchantID(r *http.Request) string {
+	if principal, ok := merchantPrincipalFromContext(r.Context()); ok {
+		return principal.MerchantID
+	}
+	return ""
+}
+
+func writeMerchantAuthError(w http.ResponseWriter, status int, code, message string) {
+	if status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="chaintx"`)
+	}
+	writeJSON(w, status, errorResponse{
+		Error: errorEnvelope{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
@@ -0,0 +1,287 @@
+package controllers
+
+import (
+	"crypto/subtle"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	headerForwardedFor = "X-Forwarded-For"
+	headerOpsReason    = "X-Ops-Reason"
+
+	// legacyOpsActorID stands in for a legacy admin key caller that did not
+	// send X-Principal-ID.
+	legacyOpsActorID = "legacy_admin_key"
+)
+
+// OpsOperator is a named ops credential with a role.
+type OpsOperator struct {
+	ID   string
+	Role string
+	Key  string
+}
+
+type opsActor struct {
+	ID   string
+	Role string
+}
+
+// OpsAuth authenticates ops endpoints, checks the caller's role against each
+// action, and records actions in the admin audit log. Named operators act
+// under their own id, and JWT access tokens under their principal claim with
+// the role from their ops role claim. Legacy shared admin keys act as admin
+// and keep taking the actor from X-Principal-ID.
+type OpsAuth struct {
+	operators          []OpsOperator
+	legacyKeys         []string
+	accessTokenUseCase portsin.AuthenticateAccessTokenUseCase
+	auditUseCase       portsin.RecordAdminAuditEntryUseCase
+	logger             *log.Logger
+}
+
+func NewOpsAuth(
+	operators []OpsOperator,
+	legacyAdminKeys []string,
+	accessTokenUseCase portsin.AuthenticateAccessTokenUseCase,
+	auditUseCase portsin.RecordAdminAuditEntryUseCase,
+	logger *log.Logger,
+) *OpsAuth {
+	cloned := make([]OpsOperator, 0, len(operators))
+	for _, operator := range operators {
+		key := strings.TrimSpace(operator.Key)
+		if key == "" {
+			continue
+		}
+		cloned = append(cloned, OpsOperator{
+			ID:   strings.TrimSpace(operator.ID),
+			Role: strings.TrimSpace(operator.Role),
+			Key:  key,
+		})
+	}
+
+	return &OpsAuth{
+		operators:          cloned,
+		legacyKeys:         cloneNonEmptyStrings(legacyAdminKeys),
+		accessTokenUseCase: accessTokenUseCase,
+		auditUseCase:       auditUseCase,
+		logger:             logger,
+	}
+}
+
+// authorize authenticates the bearer token and checks that its role allows
+// action. A role that falls short is recorded as a forbidden attempt.
+func (a *OpsAuth) authorize(r *http.Request, action string) (opsActor, *webhookOpsAuthError) {
+	if a == nil || (len(a.operators) == 0 && len(a.legacyKeys) == 0 && a.accessTokenUseCase == nil) {
+		return opsActor{}, &webhookOpsAuthError{
+			Status:  http.StatusServiceUnavailable,
+			Code:    "webhook_ops_auth_not_configured",
+			Message: "webhook ops authentication is not configured",
+		}
+	}
+
+	token := extractAdminToken(r)
+	if token == "" {
+		return opsActor{}, &webhookOpsAuthError{
+			Status:  http.StatusUnauthorized,
+			Code:    "unauthorized",
+			Message: "admin authentication is required",
+		}
+	}
+
+	actor, authenticated := a.lookup(token, r)
+	if !authenticated && a.accessTokenUseCase != nil && policies.IsAccessToken(token) {
+		var authErr *webhookOpsAuthError
+		actor, authErr = a.authenticateAccessToken(r, token)
+		if authErr != nil {
+			return opsActor{}, authErr
+		}
+		authenticated = true
+	}
+	if !authenticated {
+		return opsActor{}, &webhookOpsAuthError{
+			Status:  http.StatusUnauthorized,
+			Code:    "unauthorized",
+			Message: "admin authentication failed",
+		}
+	}
+
+	if !policies.OpsRoleAllows(actor.Role, action) {
+		a.record(r, actor, action, "", "", dto.AdminAuditOutcomeForbidden, "forbidden", map[string]any{
+			"required_role": policies.RequiredOpsRole(action),
+		})
+		return opsActor{}, &webhookOpsAuthError{
+			Status:  http.StatusForbidden,
+			Code:    "forbidden",
+			Message: "ops role does not allow this action",
+		}
+	}
+
+	return actor, nil
+}
+
+func (a *OpsAuth) lookup(token string, r *http.Request) (opsActor, bool) {
+	for _, operator := range a.operators {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(operator.Key)) == 1 {
+			return opsActor{ID: operator.ID, Role: operator.Role}, true
+		}
+	}
+	for _, candidate := range a.legacyKeys {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(candidate)) == 1 {
+			return opsActor{
+				ID:   strings.TrimSpace(r.Header.Get(headerPrincipalID)),
+				Role: policies.OpsRoleAdmin,
+			}, true
+		}
+	}
+	return opsActor{}, false
+}
+
+// authenticateAccessToken maps a JWT onto an ops actor. A valid token without
+// an ops role is refused without an audit entry, since it names no role.
+func (a *OpsAuth) authenticateAccessToken(r *http.Request, token string) (opsActor, *webhookOpsAuthError) {
+	principal, appErr := a.accessTokenUseCase.Execute(r.Context(), dto.AuthenticateAccessTokenCommand{
+		Token: token,
+		Now:   time.Now().UTC(),
+	})
+	if appErr != nil {
+		if appErr.Type == apperrors.TypeInternal {
+			if a.logger != nil {
+				a.logger.Printf("ops auth error path=%s method=%s code=%s message=%s", r.URL.Path, r.Method, appErr.Code, appErr.Message)
+			}
+			return opsActor{}, &webhookOpsAuthError{
+				Status:  http.StatusServiceUnavailable,
+				Code:    appErr.Code,
+				Message: appErr.Message,
+			}
+		}
+		return opsActor{}, &webhookOpsAuthError{
+			Status:  http.StatusUnauthorized,
+			Code:    "unauthorized",
+			Message: "admin authentication failed",
+		}
+	}
+	if principal.OpsRole == "" {
+		return opsActor{}, &webhookOpsAuthError{
+			Status:  http.StatusForbidden,
+			Code:    "forbidden",
+			Message: "access token carries no ops role",
+		}
+	}
+	return opsActor{ID: principal.PrincipalID, Role: principal.OpsRole}, nil
+}
+
+// audit records an ops action that has run. The action has already taken
+// effect, so a failed audit write is logged instead of failing the request.
+func (a *OpsAuth) audit(
+	r *http.Request,
+	actor opsActor,
+	action string,
+	target string,
+	reason string,
+	appErr *apperrors.AppError,
+	details map[string]any,
+) {
+	outcome := dto.AdminAuditOutcomeSucceeded
+	errorCode := ""
+	if appErr != nil {
+		outcome = dto.AdminAuditOutcomeFailed
+		errorCode = appErr.Code
+	}
+	a.record(r, actor, action, target, reason, outcome, errorCode, details)
+}
+
+func (a *OpsAuth) record(
+	r *http.Request,
+	actor opsActor,
+	action string,
+	target string,
+	reason string,
+	outcome string,
+	errorCode string,
+	details map[string]any,
+) {
+	if a == nil || a.auditUseCase == nil {
+		return
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		reason = r.Header.Get(headerOpsReason)
+	}
+	if details == nil {
+		details = map[string]any{}
+	}
+	if forwardedFor := strings.TrimSpace(r.Header.Get(headerForwardedFor)); forwardedFor != "" {
+		details["forwarded_for"] = forwardedFor
+	}
+	actorID := actor.ID
+	if actorID == "" {
+		actorID = legacyOpsActorID
+	}
+
+	_, appErr := a.auditUseCase.Execute(r.Context(), dto.RecordAdminAuditEntryCommand{
+		ActorID:   actorID,
+		ActorRole: actor.Role,
+		Action:    action,
+		Target:    target,
+		Reason:    reason,
+		SourceIP:  requestSourceIP(r),
+		Outcome:   outcome,
+		ErrorCode: errorCode,
+		Details:   details,
+		Now:       time.Now().UTC(),
+	})
+	if appErr != nil && a.logger != nil {
+		a.logger.Printf(
+			"admin audit error action=%s actor_id=%s code=%s message=%s",
+			action,
+			actorID,
+			appErr.Code,
+			appErr.Message,
+		)
+	}
+}
+
+// requestSourceIP is the peer address. X-Forwarded-For is client supplied,
+// so it is kept in the audit details rather than trusted here.
+func requestSourceIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(strings.TrimSpace(r.RemoteAddr))
+	if err != nil {
+		return strings.TrimSpace(r.RemoteAddr)
+	}
+	return host
+}
+
+func writeWebhookOpsAuthError(w http.ResponseWriter, authErr *webhookOpsAuthError) {
+	if authErr == nil {
+		return
+	}
+	writeJSON(w, authErr.Status, errorResponse{
+		Error: errorEnvelope{
+			Code:    authErr.Code,
+			Message: authErr.Message,
+		},
+	})
+}
+
+func extractAdminToken(r *http.Request) string {
+	if r == nil {
+		return ""
+	}
+	authHeader := strings.TrimSpace(r.Header.Get(headerAuthorization))
+	if len(authHeader) >= len("Bearer ") && strings.EqualFold(authHeader[:len("Bearer ")], "Bearer ") {
+		token := strings.TrimSpace(authHeader[len("Bearer "):])
+		if token != "" {
+			return token
+		}
+	}
+	return ""
+}
@@ -0,0 +1,327 @@
+//go:build !integration
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"chaintx/internal/application/dto"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func newTestOpsAuth(legacyKeys ...string) *OpsAuth {
+	return NewOpsAuth(nil, legacyKeys, nil, nil, nil)
+}
+
+func newTestRoleOpsAuth(audit *stubRecordAdminAuditUseCase) *OpsAuth {
+	return NewOpsAuth(
+		[]OpsOperator{
+			{ID: "alice", Role: policies.OpsRoleViewer, Key: "viewer-key"},
+			{ID: "bob", Role: policies.OpsRoleOperator, Key: "operator-key"},
+			{ID: "carol", Role: policies.OpsRoleAdmin, Key: "admin-key"},
+		},
+		nil,
+		nil,
+		audit,
+		log.New(io.Discard, "", 0),
+	)
+}
+
+func newTestOpsOutboxController(opsAuth *OpsAuth, cancelUseCase *stubCancelEventCaptureUseCase) *WebhookOutboxController {
+	return NewWebhookOutboxController(
+		stubOverviewUseCase{},
+		stubListDLQUseCase{},
+		stubRequeueDLQUseCase{},
+		cancelUseCase,
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		opsAuth,
+		log.New(io.Discard, "", 0),
+	)
+}
+
+func TestOpsAuthViewerCanReadButNotRequeue(t *testing.T) {
+	audit := &stubRecordAdminAuditUseCase{}
+	controller := newTestOpsOutboxController(newTestRoleOpsAuth(audit), &stubCancelEventCaptureUseCase{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/webhook-outbox/overview", nil)
+	req.Header.Set("Authorization", "Bearer viewer-key")
+	rec := httptest.NewRecorder()
+	controller.GetOverview(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected viewer overview status 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/webhook-outbox/dlq/evt_1/requeue", nil)
+	req.SetPathValue("event_id", "evt_1")
+	req.Header.Set("Authorization", "Bearer viewer-key")
+	rec = httptest.NewRecorder()
+	controller.RequeueDLQEvent(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected viewer requeue status 403, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte(`"code":"forbidden"`)) {
+		t.Fatalf("expected forbidden error code, got %s", rec.Body.String())
+	}
+
+	if len(audit.commands) != 1 {
+		t.Fatalf("expected one audit entry, got %+v", audit.commands)
+	}
+	entry := audit.commands[0]
+	if entry.ActorID != "alice" || entry.ActorRole != policies.OpsRoleViewer {
+		t.Fatalf("unexpected audit actor: %+v", entry)
+	}
+	if entry.Action != policies.OpsActionWebhookOutboxRequeue || entry.Outcome != dto.AdminAuditOutcomeForbidden {
+		t.Fatalf("unexpected audit action/outcome: %+v", entry)
+	}
+	if entry.Details["required_role"] != policies.OpsRoleOperator {
+		t.Fatalf("expected required_role operator, got %+v", entry.Details)
+	}
+}
+
+func TestOpsAuthNamedOperatorActsUnderOwnID(t *testing.T) {
+	audit := &stubRecordAdminAuditUseCase{}
+	cancelUseCase := &stubCancelEventCaptureUseCase{}
+	controller := newTestOpsOutboxController(newTestRoleOpsAuth(audit), cancelUseCase)
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/v1/webhook-outbox/events/evt_1/cancel",
+		bytes.NewBufferString(`{"reason":"stuck consumer"}`),
+	)
+	req.SetPathValue("event_id", "evt_1")
+	req.RemoteAddr = "10.0.0.7:51234"
+	req.Header.Set("Authorization", "Bearer operator-key")
+	req.Header.Set("X-Principal-ID", "someone-else")
+	req.Header.Set("X-Forwarded-For", "203.0.113.9")
+	rec := httptest.NewRecorder()
+	controller.CancelEvent(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if cancelUseCase.lastCommand.OperatorID != "bob" {
+		t.Fatalf("expected operator id bob, got %+v", cancelUseCase.lastCommand)
+	}
+
+	if len(audit.commands) != 1 {
+		t.Fatalf("expected one audit entry, got %+v", audit.commands)
+	}
+	entry := audit.commands[0]
+	if entry.ActorID != "bob" || entry.ActorRole != policies.OpsRoleOperator {
+		t.Fatalf("unexpected audit actor: %+v", entry)
+	}
+	if entry.Action != policies.OpsActionWebhookOutboxCancel || entry.Outcome != dto.AdminAuditOutcomeSucceeded {
+		t.Fatalf("unexpected audit action/outcome: %+v", entry)
+	}
+	if entry.Target != "evt_1" || entry.Reason != "stuck consumer" {
+		t.Fatalf("unexpected audit target/reason: %+v", entry)
+	}
+	if entry.SourceIP != "10.0.0.7" {
+		t.Fatalf("expected source ip 10.0.0.7, got %q", entry.SourceIP)
+	}
+	if entry.Details["forwarded_for"] != "203.0.113.9" {
+		t.Fatalf("expected forwarded_for detail, got %+v", entry.Details)
+	}
+}
+
+func TestOpsAuthRecordsFailedOutcomeAndReasonHeader(t *testing.T) {
+	audit := &stubRecordAdminAuditUseCase{}
+	controller := NewWebhookOutboxController(
+		stubOverviewUseCase{},
+		stubListDLQUseCase{},
+		stubRequeueFailingUseCase{},
+		stubCancelEventUseCase{},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestRoleOpsAuth(audit),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/webhook-outbox/dlq/evt_9/requeue", nil)
+	req.SetPathValue("event_id", "evt_9")
+	req.Header.Set("Authorization", "Bearer admin-key")
+	req.Header.Set("X-Ops-Reason", "INC-42 replay")
+	rec := httptest.NewRecorder()
+	controller.RequeueDLQEvent(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if len(audit.commands) != 1 {
+		t.Fatalf("expected one audit entry, got %+v", audit.commands)
+	}
+	entry := audit.commands[0]
+	if entry.Outcome != dto.AdminAuditOutcomeFailed || entry.ErrorCode != "webhook_event_not_found" {
+		t.Fatalf("unexpected audit outcome: %+v", entry)
+	}
+	if entry.Reason != "INC-42 replay" {
+		t.Fatalf("expected reason from header, got %q", entry.Reason)
+	}
+}
+
+func TestOpsAuthLegacyKeyActsAsAdmin(t *testing.T) {
+	audit := &stubRecordAdminAuditUseCase{}
+	opsAuth := NewOpsAuth(nil, []string{"ops-key"}, nil, audit, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin-audit-log", nil)
+	req.Header.Set("Authorization", "Bearer ops-key")
+	actor, authErr := opsAuth.authorize(req, policies.OpsActionAdminAuditLogRead)
+	if authErr != nil {
+		t.Fatalf("expected legacy key to be authorized, got %+v", authErr)
+	}
+	if actor.Role != policies.OpsRoleAdmin || actor.ID != "" {
+		t.Fatalf("unexpected legacy actor: %+v", actor)
+	}
+
+	opsAuth.audit(req, actor, policies.OpsActionAdminAuditLogRead, "", "", nil, nil)
+	if len(audit.commands) != 1 || audit.commands[0].ActorID != legacyOpsActorID {
+		t.Fatalf("expected legacy actor id, got %+v", audit.commands)
+	}
+}
+
+func TestOpsAuthAccessTokenActsUnderPrincipalAndRole(t *testing.T) {
+	audit := &stubRecordAdminAuditUseCase{}
+	opsAuth := NewOpsAuth(nil, nil, stubAuthenticateAccessTokenUseCase{
+		principal: dto.AccessTokenPrincipal{PrincipalID: "dave@example.com", OpsRole: policies.OpsRoleViewer},
+	}, audit, log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/webhook-outbox/overview", nil)
+	req.Header.Set("Authorization", "Bearer "+testAccessToken)
+	actor, authErr := opsAuth.authorize(req, policies.OpsActionWebhookOutboxOverviewRead)
+	if authErr != nil {
+		t.Fatalf("expected access token to be authorized, got %+v", authErr)
+	}
+	if actor.ID != "dave@example.com" || actor.Role != policies.OpsRoleViewer {
+		t.Fatalf("unexpected access token actor: %+v", actor)
+	}
+
+	_, authErr = opsAuth.authorize(req, policies.OpsActionWebhookOutboxRequeue)
+	if authErr == nil || authErr.Status != http.StatusForbidden {
+		t.Fatalf("expected viewer token to be forbidden from requeue, got %+v", authErr)
+	}
+	if len(audit.commands) != 1 || audit.commands[0].ActorID != "dave@example.com" {
+		t.Fatalf("expected forbidden attempt audited under token principal, got %+v", audit.commands)
+	}
+}
+
+func TestOpsAuthAccessTokenRejects(t *testing.T) {
+	testCases := []struct {
+		name    string
+		useCase stubAuthenticateAccessTokenUseCase
+		status  int
+		code    string
+	}{
+		{
+			name:    "invalid token",
+			useCase: stubAuthenticateAccessTokenUseCase{err: apperrors.NewValidation("unauthorized", "access token is invalid or expired", nil)},
+			status:  http.StatusUnauthorized,
+			code:    "unauthorized",
+		},
+		{
+			name:    "no ops role",
+			useCase: stubAuthenticateAccessTokenUseCase{principal: dto.AccessTokenPrincipal{PrincipalID: "user-1", Scopes: []string{"read"}}},
+			status:  http.StatusForbidden,
+			code:    "forbidden",
+		},
+		{
+			name:    "jwks unavailable",
+			useCase: stubAuthenticateAccessTokenUseCase{err: apperrors.NewInternal("access_token_jwks_unavailable", "access token signing keys could not be loaded", nil)},
+			status:  http.StatusServiceUnavailable,
+			code:    "access_token_jwks_unavailable",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			audit := &stubRecordAdminAuditUseCase{}
+			opsAuth := NewOpsAuth(nil, nil, testCase.useCase, audit, log.New(io.Discard, "", 0))
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/webhook-outbox/overview", nil)
+			req.Header.Set("Authorization", "Bearer "+testAccessToken)
+			_, authErr := opsAuth.authorize(req, policies.OpsActionWebhookOutboxOverviewRead)
+			if authErr == nil || authErr.Status != testCase.status || authErr.Code != testCase.code {
+				t.Fatalf("expected %d %s, got %+v", testCase.status, testCase.code, authErr)
+			}
+			if len(audit.commands) != 0 {
+				t.Fatalf("expected no audit entry, got %+v", audit.commands)
+			}
+		})
+	}
+}
+
+func TestAdminAuditLogControllerListEntries(t *testing.T) {
+	listUseCase := &stubListAdminAuditUseCase{}
+	controller := NewAdminAuditLogController(listUseCase, newTestRoleOpsAuth(&stubRecordAdminAuditUseCase{}), log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin-audit-log?actor_id=bob&action=webhook_outbox.cancel&limit=10&before_id=99", nil)
+	req.Header.Set("Authorization", "Bearer admin-key")
+	rec := httptest.NewRecorder()
+	controller.ListEntries(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if listUseCase.lastQuery.ActorID != "bob" || listUseCase.lastQuery.Action != "webhook_outbox.cancel" {
+		t.Fatalf("unexpected filters: %+v", listUseCase.lastQuery)
+	}
+	if listUseCase.lastQuery.Limit != 10 || listUseCase.lastQuery.BeforeID != 99 {
+		t.Fatalf("unexpected paging: %+v", listUseCase.lastQuery)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte(`"actor_id":"bob"`)) {
+		t.Fatalf("expected entry in body, got %s", rec.Body.String())
+	}
+}
+
+func TestAdminAuditLogControllerRejectsOperatorRoleAndInvalidLimit(t *testing.T) {
+	controller := NewAdminAuditLogController(&stubListAdminAuditUseCase{}, newTestRoleOpsAuth(&stubRecordAdminAuditUseCase{}), log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin-audit-log", nil)
+	req.Header.Set("Authorization", "Bearer operator-key")
+	rec := httptest.NewRecorder()
+	controller.ListEntries(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected status 403, got %d body=%s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/admin-audit-log?limit=abc", nil)
+	req.Header.Set("Authorization", "Bearer admin-key")
+	rec = httptest.NewRecorder()
+	controller.ListEntries(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+type stubRecordAdminAuditUseCase struct {
+	commands []dto.RecordAdminAuditEntryCommand
+}
+
+func (s *stubRecordAdminAuditUseCase) Execute(_ context.Context, command dto.RecordAdminAuditEntryCommand) (dto.AdminAuditEntry, *apperrors.AppError) {
+	s.commands = append(s.commands, command)
+	return dto.AdminAuditEntry{ID: int64(len(s.commands))}, nil
+}
+
+type stubListAdminAuditUseCase struct {
+	lastQuery dto.ListAdminAuditEntriesQuery
+}
+
+func (s *stubListAdminAuditUseCase) Execute(_ context.Context, query dto.ListAdminAuditEntriesQuery) (dto.ListAdminAuditEntriesOutput, *apperrors.AppError) {
+	s.lastQuery = query
+	return dto.ListAdminAuditEntriesOutput{
+		Entries: []dto.AdminAuditEntry{{ID: 98, ActorID: "bob", ActorRole: policies.OpsRoleOperator, Action: query.Action, Outcome: dto.AdminAuditOutcomeSucceeded}},
+	}, nil
+}
+
+type stubRequeueFailingUseCase struct{}
+
+func (stubRequeueFailingUseCase) Execute(_ context.Context, _ dto.RequeueWebhookDLQEventCommand) (dto.RequeueWebhookDLQEventOutput, *apperrors.AppError) {
+	return dto.RequeueWebhookDLQEventOutput{}, apperrors.NewNotFound("webhook_event_not_found", "webhook event not found", nil)
+}
@@ -0,0 +1,200 @@
+package controllers
+
+import (
+	"bytes"
+	"html/template"
+	"log"
+	"net/http"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	// checkoutRefreshSeconds is how often an open checkout page reloads to
+	// pick up status changes; the page carries no script.
+	checkoutRefreshSeconds = 15
+
+	// checkoutContentSecurityPolicy allows the inline stylesheet and nothing
+	// else; the QR code is inline SVG markup.
+	checkoutContentSecurityPolicy = "default-src 'none'; style-src 'unsafe-inline'; base-uri 'none'; form-action 'none'"
+
+	// A payment request's instructions never change, so its QR code can be
+	// cached by the caller.
+	qrCodeCacheControl = "private, max-age=3600"
+)
+
+var checkoutStatusText = map[string]string{
+	"pending":   "Waiting for payment",
+	"detected":  "Payment detected, waiting for confirmations",
+	"confirmed": "Payment confirmed",
+	"reorged":   "Payment was dropped by a chain reorganization",
+	"expired":   "This payment request has expired",
+	"failed":    "This payment request has failed",
+}
+
+var checkoutPageTemplate = template.Must(template.New("checkout").Parse(`<!doctype html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+{{if .RefreshSeconds}}<meta http-equiv="refresh" content="{{.RefreshSeconds}}">
+{{end}}<title>{{.Title}}</title>
+<style>
+body{margin:0;font-family:system-ui,-apple-system,sans-serif;background:#f4f5f7;color:#1d2330}
+main{max-width:420px;margin:40px auto;padding:24px;background:#fff;border-radius:12px;box-shadow:0 1px 4px rgba(0,0,0,.08)}
+h1{margin:0 0 4px;font-size:1.4rem}
+.network{margin:0 0 16px;color:#5b6475;text-transform:capitalize}
+.status{padding:10px 12px;border-radius:8px;background:#eef1f6}
+.status-confirmed{background:#e3f6e8}
+.status-expired,.status-failed,.status-reorged{background:#fbe9e9}
+.qr svg{display:block;width:100%;height:auto;margin:16px 0}
+dt{margin-top:12px;font-size:.8rem;color:#5b6475;text-transform:uppercase}
+dd{margin:2px 0 0;word-break:break-all}
+.wallet{display:block;margin-top:20px;padding:12px;border-radius:8px;background:#1d2330;color:#fff;text-align:center;text-decoration:none}
+</style>
+</head>
+<body>
+<main>
+{{if .Checkout}}{{with .Checkout}}<h1>Pay {{with .Resource.PaymentInstructions.DisplayAmount}}{{.}} {{end}}{{.Resource.Asset}}</h1>
+<p class="network">{{.Resource.Chain}} {{.Resource.Network}}</p>
+<p class="status status-{{.Resource.Status}}">{{$.StatusText}}</p>
+{{if .PaymentURI}}<div class="qr">{{$.QRCode}}</div>
+<dl>
+<dt>Address</dt>
+<dd><code>{{.Resource.PaymentInstructions.Address}}</code></dd>
+{{with .Resource.PaymentInstructions.TokenContract}}<dt>Token contract</dt>
+<dd><code>{{.}}</code></dd>
+{{end}}<dt>Expires</dt>
+<dd><time datetime="{{$.ExpiresAt}}">{{$.ExpiresAt}}</time></dd>
+</dl>
+<a class="wallet" href="{{$.WalletURL}}">Open in wallet</a>
+{{end}}{{end}}{{else}}<h1>{{.Title}}</h1>
+<p class="status status-failed">{{.StatusText}}</p>
+{{end}}</main>
+</body>
+</html>
+`))
+
+type checkoutPage struct {
+	Title          string
+	StatusText     string
+	RefreshSeconds int
+	Checkout       *dto.PaymentRequestCheckout
+	// QRCode and WalletURL are produced by the application layer, not taken
+	// from the request, so they bypass template escaping.
+	QRCode    template.HTML
+	WalletURL template.URL
+	ExpiresAt string
+}
+
+type PaymentRequestCheckoutController struct {
+	checkoutUseCase portsin.GetPaymentRequestCheckoutUseCase
+	qrCodeUseCase   portsin.GetPaymentRequestQRCodeUseCase
+	logger          *log.Logger
+}
+
+func NewPaymentRequestCheckoutController(
+	checkoutUseCase portsin.GetPaymentRequestCheckoutUseCase,
+	qrCodeUseCase portsin.GetPaymentRequestQRCodeUseCase,
+	logger *log.Logger,
+) *PaymentRequestCheckoutController {
+	return &PaymentRequestCheckoutController{
+		checkoutUseCase: checkoutUseCase,
+		qrCodeUseCase:   qrCodeUseCase,
+		logger:          logger,
+	}
+}
+
+// GetCheckoutPage serves the hosted checkout page. It is public: payers open
+// it from a link the merchant shares, and the payment request id is the only
+// credential.
+func (c *PaymentRequestCheckoutController) GetCheckoutPage(w http.ResponseWriter, r *http.Request) {
+	checkout, appErr := c.checkoutUseCase.Execute(r.Context(), dto.GetPaymentRequestCheckoutQuery{ID: r.PathValue("id")})
+	if appErr != nil {
+		c.logger.Printf("request error path=/pay/{id} method=%s code=%s message=%s", r.Method, appErr.Code, appErr.Message)
+		c.writeCheckoutPage(w, r, appErrorStatus(appErr), checkoutErrorPage(appErr))
+		return
+	}
+
+	page := checkoutPage{
+		Title:      "Pay " + checkout.Resource.Asset,
+		StatusText: checkoutStatusText[checkout.Resource.Status],
+		Checkout:   &checkout,
+		QRCode:     template.HTML(checkout.QRCodeSVG),
+		WalletURL:  template.URL(checkout.PaymentURI),
+		ExpiresAt:  checkout.Resource.ExpiresAt.UTC().Format(time.RFC3339),
+	}
+	if page.StatusText == "" {
+		page.StatusText = checkout.Resource.Status
+	}
+	if checkout.Open {
+		page.RefreshSeconds = checkoutRefreshSeconds
+	}
+	c.writeCheckoutPage(w, r, http.StatusOK, page)
+}
+
+func (c *PaymentRequestCheckoutController) GetQRCodePNG(w http.ResponseWriter, r *http.Request) {
+	c.writeQRCode(w, r, dto.QRCodeFormatPNG)
+}
+
+func (c *PaymentRequestCheckoutController) GetQRCodeSVG(w http.ResponseWriter, r *http.Request) {
+	c.writeQRCode(w, r, dto.QRCodeFormatSVG)
+}
+
+func (c *PaymentRequestCheckoutController) writeQRCode(w http.ResponseWriter, r *http.Request, format string) {
+	caller, _ := merchantPrincipalFromContext(r.Context())
+	output, appErr := c.qrCodeUseCase.Execute(r.Context(), dto.GetPaymentRequestQRCodeQuery{
+		ID:     r.PathValue("id"),
+		Caller: caller,
+		Format: format,
+	})
+	if appErr != nil {
+		c.logger.Printf("request error path=/v1/payment-requests/{id}/qr.%s method=%s code=%s message=%s", format, r.Method, appErr.Code, appErr.Message)
+		writeAppError(w, appErr)
+		return
+	}
+
+	w.Header().Set("Content-Type", output.ContentType)
+	w.Header().Set("Cache-Control", qrCodeCacheControl)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(output.Content); err != nil {
+		c.logger.Printf("response write error path=/v1/payment-requests/{id}/qr.%s method=%s error=%v", format, r.Method, err)
+	}
+}
+
+func (c *PaymentRequestCheckoutController) writeCheckoutPage(w http.ResponseWriter, r *http.Request, status int, page checkoutPage) {
+	var body bytes.Buffer
+	if err := checkoutPageTemplate.Execute(&body, page); err != nil {
+		c.logger.Printf("response render error path=/pay/{id} method=%s error=%v", r.Method, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// The id in the URL is a bearer credential: keep it out of caches and
+	// Referer headers.
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("Content-Security-Policy", checkoutContentSecurityPolicy)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	if _, err := body.WriteTo(w); err != nil {
+		c.logger.Printf("response write error path=/pay/{id} method=%s error=%v", r.Method, err)
+	}
+}
+
+func checkoutErrorPage(appErr *apperrors.AppError) checkoutPage {
+	if appErr.Type == apperrors.TypeNotFound || appErr.Type == apperrors.TypeValidation {
+		return checkoutPage{
+			Title:      "Payment request not found",
+			StatusText: "Check the link you were given, or ask the merchant for a new one.",
+		}
+	}
+	return checkoutPage{
+		Title:      "Checkout unavailable",
+		StatusText: "Something went wrong loading this payment request. Try again shortly.",
+	}
+}
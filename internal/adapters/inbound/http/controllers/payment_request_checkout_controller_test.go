@@ -0,0 +1,193 @@
+//go:build !integration
+
+package controllers
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestPaymentRequestCheckoutControllerRendersPendingPage(t *testing.T) {
+	tokenContract := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	displayAmount := "1.5"
+	checkoutUseCase := &stubCheckoutUseCase{checkout: dto.PaymentRequestCheckout{
+		Resource: dto.PaymentRequestResource{
+			ID:        "pr_1",
+			Status:    "pending",
+			Chain:     "ethereum",
+			Network:   "sepolia",
+			Asset:     "USDT",
+			ExpiresAt: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+			PaymentInstructions: dto.PaymentInstructions{
+				Address:       "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+				TokenContract: &tokenContract,
+				DisplayAmount: &displayAmount,
+			},
+		},
+		PaymentURI: "ethereum:0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed@11155111/transfer?address=0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359&uint256=1500000",
+		QRCodeSVG:  `<svg xmlns="http://www.w3.org/2000/svg"></svg>`,
+		Open:       true,
+	}}
+	controller := NewPaymentRequestCheckoutController(checkoutUseCase, &stubQRCodeUseCase{}, log.New(io.Discard, "", 0))
+
+	request := httptest.NewRequest(http.MethodGet, "/pay/pr_1", nil)
+	request.SetPathValue("id", "pr_1")
+	recorder := httptest.NewRecorder()
+	controller.GetCheckoutPage(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d body=%s", recorder.Code, recorder.Body.String())
+	}
+	if checkoutUseCase.query.ID != "pr_1" {
+		t.Fatalf("unexpected query %+v", checkoutUseCase.query)
+	}
+	for header, expected := range map[string]string{
+		"Content-Type":    "text/html; charset=utf-8",
+		"Cache-Control":   "no-store",
+		"Referrer-Policy": "no-referrer",
+	} {
+		if got := recorder.Header().Get(header); got != expected {
+			t.Fatalf("expected %s=%q, got %q", header, expected, got)
+		}
+	}
+
+	body := recorder.Body.String()
+	for _, fragment := range []string{
+		`<meta http-equiv="refresh" content="15">`,
+		`<h1>Pay 1.5 USDT</h1>`,
+		`Waiting for payment`,
+		`<div class="qr"><svg xmlns="http://www.w3.org/2000/svg"></svg></div>`,
+		`<code>0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed</code>`,
+		`<time datetime="2026-10-19T12:00:00Z">`,
+		`href="ethereum:0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed@11155111/transfer?address=0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359&amp;uint256=1500000"`,
+	} {
+		if !strings.Contains(body, fragment) {
+			t.Fatalf("expected %q in body %s", fragment, body)
+		}
+	}
+}
+
+func TestPaymentRequestCheckoutControllerRendersClosedPageWithoutInstructions(t *testing.T) {
+	checkoutUseCase := &stubCheckoutUseCase{checkout: dto.PaymentRequestCheckout{
+		Resource: dto.PaymentRequestResource{ID: "pr_1", Status: "confirmed", Chain: "bitcoin", Network: "mainnet", Asset: "BTC"},
+	}}
+	controller := NewPaymentRequestCheckoutController(checkoutUseCase, &stubQRCodeUseCase{}, log.New(io.Discard, "", 0))
+
+	request := httptest.NewRequest(http.MethodGet, "/pay/pr_1", nil)
+	request.SetPathValue("id", "pr_1")
+	recorder := httptest.NewRecorder()
+	controller.GetCheckoutPage(recorder, request)
+
+	body := recorder.Body.String()
+	if recorder.Code != http.StatusOK || !strings.Contains(body, "Payment confirmed") {
+		t.Fatalf("expected confirmed page, got %d body=%s", recorder.Code, body)
+	}
+	if strings.Contains(body, "http-equiv") || strings.Contains(body, `class="qr"`) || strings.Contains(body, "Open in wallet") {
+		t.Fatalf("expected no refresh or payment instructions, got %s", body)
+	}
+}
+
+func TestPaymentRequestCheckoutControllerRendersNotFoundPage(t *testing.T) {
+	checkoutUseCase := &stubCheckoutUseCase{err: apperrors.NewNotFound("payment_request_not_found", "payment request was not found", nil)}
+	controller := NewPaymentRequestCheckoutController(checkoutUseCase, &stubQRCodeUseCase{}, log.New(io.Discard, "", 0))
+
+	request := httptest.NewRequest(http.MethodGet, "/pay/pr_missing", nil)
+	request.SetPathValue("id", "pr_missing")
+	recorder := httptest.NewRecorder()
+	controller.GetCheckoutPage(recorder, request)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", recorder.Code)
+	}
+	if got := recorder.Header().Get("Content-Type"); got != "text/html; charset=utf-8" {
+		t.Fatalf("expected html error page, got %s", got)
+	}
+	if !strings.Contains(recorder.Body.String(), "<h1>Payment request not found</h1>") {
+		t.Fatalf("expected not found page, got %s", recorder.Body.String())
+	}
+}
+
+func TestPaymentRequestCheckoutControllerServesQRCodes(t *testing.T) {
+	qrCodeUseCase := &stubQRCodeUseCase{}
+	controller := NewPaymentRequestCheckoutController(&stubCheckoutUseCase{}, qrCodeUseCase, log.New(io.Discard, "", 0))
+	caller := dto.MerchantPrincipal{PrincipalID: "merchant_a", KeyID: "key_a", Scopes: []string{"read"}}
+
+	for format, handler := range map[string]http.HandlerFunc{
+		dto.QRCodeFormatPNG: controller.GetQRCodePNG,
+		dto.QRCodeFormatSVG: controller.GetQRCodeSVG,
+	} {
+		contentType := map[string]string{dto.QRCodeFormatPNG: "image/png", dto.QRCodeFormatSVG: "image/svg+xml"}[format]
+		request := httptest.NewRequest(http.MethodGet, "/v1/payment-requests/pr_1/qr."+format, nil)
+		request.SetPathValue("id", "pr_1")
+		request = request.WithContext(context.WithValue(request.Context(), merchantPrincipalContextKey{}, caller))
+		recorder := httptest.NewRecorder()
+		handler(recorder, request)
+
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d body=%s", format, recorder.Code, recorder.Body.String())
+		}
+		if qrCodeUseCase.query.ID != "pr_1" || qrCodeUseCase.query.Format != format || qrCodeUseCase.query.Caller.KeyID != "key_a" {
+			t.Fatalf("%s: unexpected query %+v", format, qrCodeUseCase.query)
+		}
+		if recorder.Header().Get("Content-Type") != contentType || recorder.Body.String() != "qr:"+format {
+			t.Fatalf("%s: unexpected response %v %q", format, recorder.Header(), recorder.Body.String())
+		}
+		if recorder.Header().Get("Cache-Control") != "private, max-age=3600" {
+			t.Fatalf("%s: expected private cache control, got %q", format, recorder.Header().Get("Cache-Control"))
+		}
+	}
+
+	qrCodeUseCase.err = apperrors.NewNotFound("payment_request_not_found", "payment request was not found", nil)
+	request := httptest.NewRequest(http.MethodGet, "/v1/payment-requests/pr_missing/qr.svg", nil)
+	recorder := httptest.NewRecorder()
+	controller.GetQRCodeSVG(recorder, request)
+	if recorder.Code != http.StatusNotFound || !strings.Contains(recorder.Body.String(), `"payment_request_not_found"`) {
+		t.Fatalf("expected json 404, got %d body=%s", recorder.Code, recorder.Body.String())
+	}
+}
+
+type stubCheckoutUseCase struct {
+	query    dto.GetPaymentRequestCheckoutQuery
+	checkout dto.PaymentRequestCheckout
+	err      *apperrors.AppError
+}
+
+func (s *stubCheckoutUseCase) Execute(
+	_ context.Context,
+	query dto.GetPaymentRequestCheckoutQuery,
+) (dto.PaymentRequestCheckout, *apperrors.AppError) {
+	s.query = query
+	if s.err != nil {
+		return dto.PaymentRequestCheckout{}, s.err
+	}
+	return s.checkout, nil
+}
+
+type stubQRCodeUseCase struct {
+	query dto.GetPaymentRequestQRCodeQuery
+	err   *apperrors.AppError
+}
+
+func (s *stubQRCodeUseCase) Execute(
+	_ context.Context,
+	query dto.GetPaymentRequestQRCodeQuery,
+) (dto.PaymentRequestQRCode, *apperrors.AppError) {
+	s.query = query
+	if s.err != nil {
+		return dto.PaymentRequestQRCode{}, s.err
+	}
+	contentType := "image/png"
+	if query.Format == dto.QRCodeFormatSVG {
+		contentType = "image/svg+xml"
+	}
+	return dto.PaymentRequestQRCode{ContentType: contentType, Content: []byte("qr:" + query.Format)}, nil
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	headerLastEventID = "Last-Event-ID"
+	// eventStreamRetryMillis is the reconnect delay suggested to EventSource
+	// clients.
+	eventStreamRetryMillis = 3000
+)
+
+type PaymentRequestEventsController struct {
+	streamUseCase portsin.StreamPaymentRequestEventsUseCase
+	shutdown      shutdownSignal
+	logger        *log.Logger
+}
+
+func NewPaymentRequestEventsController(
+	streamUseCase portsin.StreamPaymentRequestEventsUseCase,
+	logger *log.Logger,
+) *PaymentRequestEventsController {
+	return &PaymentRequestEventsController{
+		streamUseCase: streamUseCase,
+		shutdown:      newShutdownSignal(),
+		logger:        logger,
+	}
+}
+
+// Shutdown ends every open event stream; clients reconnect with
+// Last-Event-ID. Register it with the HTTP server's shutdown hooks.
+func (c *PaymentRequestEventsController) Shutdown() {
+	c.shutdown.cancel()
+}
+
+// StreamPaymentRequestEvents serves the payment request event journal as
+// Server-Sent Events. Each event id is the per-request sequence, so browsers
+// resume with Last-Event-ID after a reconnect; clients that cannot set headers
+// may pass ?last_event_id= instead.
+func (c *PaymentRequestEventsController) StreamPaymentRequestEvents(w http.ResponseWriter, r *http.Request) {
+	if c.streamUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"payment_request_event_stream_use_case_missing",
+			"payment request event stream use case is required",
+			nil,
+		))
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeAppError(w, apperrors.NewInternal(
+			"event_stream_unsupported",
+			"response writer does not support streaming",
+			nil,
+		))
+		return
+	}
+
+	lastEventID := r.Header.Get(headerLastEventID)
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+
+	ctx, stop := c.shutdown.requestContext(r)
+	defer stop()
+	caller, _ := merchantPrincipalFromContext(ctx)
+	stream := &serverSentEventWriter{w: w, flusher: flusher}
+	appErr := c.streamUseCase.Execute(ctx, dto.StreamPaymentRequestEventsCommand{
+		ID:          r.PathValue("id"),
+		Caller:      caller,
+		LastEventID: lastEventID,
+		Open:        stream.open,
+		Emit:        stream.emit,
+		Heartbeat:   stream.heartbeat,
+	})
+	if appErr == nil {
+		return
+	}
+	if c.logger != nil {
+		c.logger.Printf("request error path=/v1/payment-requests/{id}/events method=%s code=%s message=%s", r.Method, appErr.Code, appErr.Message)
+	}
+	if !stream.opened {
+		writeAppError(w, appErr)
+	}
+}
+
+type serverSentEventWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	opened  bool
+}
+
+func (s *serverSentEventWriter) open() error {
+	header := s.w.Header()
+	header.Set("Content-Type", "text/event-stream; charset=utf-8")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http.StatusOK)
+	s.opened = true
+	return s.write("retry: " + strconv.Itoa(eventStreamRetryMillis) + "\n\n")
+}
+
+func (s *serverSentEventWriter) emit(event dto.PaymentRequestEvent) error {
+	// SSE data lines end at a newline, so the stored JSON is compacted first.
+	var data bytes.Buffer
+	if err := json.Compact(&data, event.Payload); err != nil {
+		return err
+	}
+	return s.write(fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", event.Sequence, event.EventType, data.Bytes()))
+}
+
+func (s *serverSentEventWriter) heartbeat() error {
+	return s.write(": keepalive\n\n")
+}
+
+func (s *serverSentEventWriter) write(frame string) error {
+	if _, err := s.w.Write([]byte(frame)); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
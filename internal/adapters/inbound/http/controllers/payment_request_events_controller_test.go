@@ -0,0 +1,168 @@
+//go:build !integration
+
+package controllers
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestPaymentRequestEventsControllerStreamsServerSentEvents(t *testing.T) {
+	useCase := &scriptedStreamUseCase{
+		events: []dto.PaymentRequestEvent{
+			{
+				Sequence:  2,
+				EventID:   "evt_2",
+				EventType: "payment_request.status_changed",
+				Payload:   []byte("{\n  \"event_id\": \"evt_2\"\n}"),
+			},
+		},
+	}
+	controller := NewPaymentRequestEventsController(useCase, log.New(io.Discard, "", 0))
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/payment-requests/pr_1/events", nil)
+	request.SetPathValue("id", "pr_1")
+	request.Header.Set("Last-Event-ID", "1")
+	recorder := httptest.NewRecorder()
+	controller.StreamPaymentRequestEvents(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", recorder.Code)
+	}
+	if got := recorder.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/event-stream") {
+		t.Fatalf("expected event stream content type, got %s", got)
+	}
+	if useCase.command.ID != "pr_1" || useCase.command.LastEventID != "1" {
+		t.Fatalf("unexpected command %+v", useCase.command)
+	}
+	body := recorder.Body.String()
+	expectedFrame := "id: 2\nevent: payment_request.status_changed\ndata: {\"event_id\":\"evt_2\"}\n\n"
+	if !strings.Contains(body, expectedFrame) {
+		t.Fatalf("expected frame %q in body %q", expectedFrame, body)
+	}
+	if !strings.HasPrefix(body, "retry: 3000\n\n") {
+		t.Fatalf("expected retry hint first, got %q", body)
+	}
+	if !strings.Contains(body, ": keepalive\n\n") {
+		t.Fatalf("expected keepalive comment, got %q", body)
+	}
+}
+
+func TestPaymentRequestEventsControllerAcceptsLastEventIDQuery(t *testing.T) {
+	useCase := &scriptedStreamUseCase{}
+	controller := NewPaymentRequestEventsController(useCase, log.New(io.Discard, "", 0))
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/payment-requests/pr_1/events?last_event_id=4", nil)
+	request.SetPathValue("id", "pr_1")
+	controller.StreamPaymentRequestEvents(httptest.NewRecorder(), request)
+
+	if useCase.command.LastEventID != "4" {
+		t.Fatalf("expected last event id 4, got %q", useCase.command.LastEventID)
+	}
+}
+
+func TestPaymentRequestEventsControllerWritesErrorBeforeOpen(t *testing.T) {
+	useCase := &scriptedStreamUseCase{
+		err: apperrors.NewNotFound("payment_request_not_found", "payment request was not found", nil),
+	}
+	controller := NewPaymentRequestEventsController(useCase, log.New(io.Discard, "", 0))
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/payment-requests/pr_missing/events", nil)
+	request.SetPathValue("id", "pr_missing")
+	recorder := httptest.NewRecorder()
+	controller.StreamPaymentRequestEvents(recorder, request)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", recorder.Code)
+	}
+	if !strings.Contains(recorder.Body.String(), "payment_request_not_found") {
+		t.Fatalf("expected error body, got %s", recorder.Body.String())
+	}
+}
+
+func TestPaymentRequestEventsControllerToleratesNilLogger(t *testing.T) {
+	useCase := &scriptedStreamUseCase{
+		err: apperrors.NewNotFound("payment_request_not_found", "payment request was not found", nil),
+	}
+	controller := NewPaymentRequestEventsController(useCase, nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/payment-requests/pr_missing/events", nil)
+	request.SetPathValue("id", "pr_missing")
+	recorder := httptest.NewRecorder()
+	controller.StreamPaymentRequestEvents(recorder, request)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", recorder.Code)
+	}
+}
+
+func TestPaymentRequestEventsControllerShutdownEndsOpenStreams(t *testing.T) {
+	useCase := &scriptedStreamUseCase{streaming: make(chan struct{})}
+	controller := NewPaymentRequestEventsController(useCase, log.New(io.Discard, "", 0))
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/payment-requests/pr_1/events", nil)
+	request.SetPathValue("id", "pr_1")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		controller.StreamPaymentRequestEvents(httptest.NewRecorder(), request)
+	}()
+
+	<-useCase.streaming
+	select {
+	case <-done:
+		t.Fatalf("expected stream to stay open before shutdown")
+	case <-time.After(20 * time.Millisecond):
+	}
+	controller.Shutdown()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("expected shutdown to end the stream")
+	}
+	if request.Context().Err() != nil {
+		t.Fatalf("expected request context to stay intact, got %v", request.Context().Err())
+	}
+}
+
+type scriptedStreamUseCase struct {
+	command dto.StreamPaymentRequestEventsCommand
+	events  []dto.PaymentRequestEvent
+	err     *apperrors.AppError
+	// streaming, when set, keeps the stream open until ctx ends and is
+	// closed once the initial events are written.
+	streaming chan struct{}
+}
+
+func (s *scriptedStreamUseCase) Execute(
+	ctx context.Context,
+	command dto.StreamPaymentRequestEventsCommand,
+) *apperrors.AppError {
+	s.command = command
+	if s.err != nil {
+		return s.err
+	}
+	if err := command.Open(); err != nil {
+		return nil
+	}
+	for _, event := range s.events {
+		if err := command.Emit(event); err != nil {
+			return nil
+		}
+	}
+	_ = command.Heartbeat()
+	if s.streaming != nil {
+		close(s.streaming)
+		<-ctx.Done()
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// PaymentRequestLimitsController rate limits payment request creation per
+// principal and reports the configured limits and refusal counters to ops.
+type PaymentRequestLimitsController struct {
+	rateLimitUseCase portsin.CheckPaymentRequestRateLimitUseCase
+	overviewUseCase  portsin.GetPaymentRequestLimitsOverviewUseCase
+	opsAuth          *OpsAuth
+	logger           *log.Logger
+}
+
+func NewPaymentRequestLimitsController(
+	rateLimitUseCase portsin.CheckPaymentRequestRateLimitUseCase,
+	overviewUseCase portsin.GetPaymentRequestLimitsOverviewUseCase,
+	opsAuth *OpsAuth,
+	logger *log.Logger,
+) *PaymentRequestLimitsController {
+	return &PaymentRequestLimitsController{
+		rateLimitUseCase: rateLimitUseCase,
+		overviewUseCase:  overviewUseCase,
+		opsAuth:          opsAuth,
+		logger:           logger,
+	}
+}
+
+// RateLimit takes a token from the caller's bucket before next runs and
+// answers 429 with Retry-After when the bucket is empty. It must wrap inside
+// MerchantAuth so the bucket belongs to the authenticated principal. A nil
+// controller leaves the route unlimited.
+func (c *PaymentRequestLimitsController) RateLimit(next http.HandlerFunc) http.HandlerFunc {
+	if c == nil || c.rateLimitUseCase == nil {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		appErr := c.rateLimitUseCase.Execute(r.Context(), dto.CheckPaymentRequestRateLimitCommand{
+			MerchantID:  requestMerchantID(r),
+			PrincipalID: requestPrincipalID(r),
+			Now:         time.Now().UTC(),
+		})
+		if appErr != nil {
+			if appErr.Type == apperrors.TypeInternal {
+				c.logRequestError(r, appErr)
+			}
+			writeAppError(w, appErr)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (c *PaymentRequestLimitsController) GetOverview(w http.ResponseWriter, r *http.Request) {
+	if _, authErr := c.opsAuth.authorize(r, policies.OpsActionPaymentRequestLimitsRead); authErr != nil {
+		writeWebhookOpsAuthError(w, authErr)
+		return
+	}
+	if c.overviewUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"payment_request_limits_overview_use_case_missing",
+			"payment request limits overview use case is required",
+			nil,
+		))
+		return
+	}
+
+	output, appErr := c.overviewUseCase.Execute(r.Context(), dto.GetPaymentRequestLimitsOverviewQuery{})
+	if appErr != nil {
+		c.logRequestError(r, appErr)
+		writeAppError(w, appErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (c *PaymentRequestLimitsController) logRequestError(r *http.Request, appErr *apperrors.AppError) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.Printf("request error path=%s method=%s code=%s message=%s", r.URL.Path, r.Method, appErr.Code, appErr.Message)
+}
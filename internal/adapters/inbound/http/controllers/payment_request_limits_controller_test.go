@@ -0,0 +1,119 @@
+//go:build !integration
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestPaymentRequestLimitsControllerRateLimitRejectsWithRetryAfter(t *testing.T) {
+	rateLimit := &stubCheckPaymentRequestRateLimitUseCase{
+		err: apperrors.NewRateLimited("rate_limited", "payment request rate limit exceeded", map[string]any{
+			"retry_after_seconds": int64(3),
+		}),
+	}
+	controller := NewPaymentRequestLimitsController(rateLimit, nil, nil, log.New(io.Discard, "", 0))
+	called := false
+	handler := controller.RateLimit(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/payment-requests", nil)
+	req.Header.Set(headerPrincipalID, "merchant_a")
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+
+	if called {
+		t.Fatalf("expected handler not to run when rate limited")
+	}
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("Retry-After") != "3" {
+		t.Fatalf("expected Retry-After 3, got %q", rec.Header().Get("Retry-After"))
+	}
+	if rateLimit.command.PrincipalID != "merchant_a" || rateLimit.command.Now.IsZero() {
+		t.Fatalf("unexpected rate limit command: %+v", rateLimit.command)
+	}
+}
+
+func TestPaymentRequestLimitsControllerRateLimitPassesThrough(t *testing.T) {
+	controller := NewPaymentRequestLimitsController(&stubCheckPaymentRequestRateLimitUseCase{}, nil, nil, nil)
+	handler := controller.RateLimit(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/v1/payment-requests", nil))
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d", rec.Code)
+	}
+
+	var unlimited *PaymentRequestLimitsController
+	rec = httptest.NewRecorder()
+	unlimited.RateLimit(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})(rec, httptest.NewRequest(http.MethodPost, "/v1/payment-requests", nil))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected nil controller to leave the route open, got %d", rec.Code)
+	}
+}
+
+func TestPaymentRequestLimitsControllerGetOverview(t *testing.T) {
+	controller := NewPaymentRequestLimitsController(
+		nil,
+		stubGetPaymentRequestLimitsOverviewUseCase{},
+		newTestRoleOpsAuth(nil),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/payment-request-limits/overview", nil)
+	req.Header.Set("Authorization", "Bearer viewer-key")
+	rec := httptest.NewRecorder()
+	controller.GetOverview(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte(`"quota_exceeded":4`)) {
+		t.Fatalf("expected counters in response, got %s", rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/payment-request-limits/overview", nil)
+	rec = httptest.NewRecorder()
+	controller.GetOverview(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401 without ops key, got %d", rec.Code)
+	}
+}
+
+type stubCheckPaymentRequestRateLimitUseCase struct {
+	command dto.CheckPaymentRequestRateLimitCommand
+	err     *apperrors.AppError
+}
+
+func (s *stubCheckPaymentRequestRateLimitUseCase) Execute(_ context.Context, command dto.CheckPaymentRequestRateLimitCommand) *apperrors.AppError {
+	s.command = command
+	return s.err
+}
+
+type stubGetPaymentRequestLimitsOverviewUseCase struct{}
+
+func (stubGetPaymentRequestLimitsOverviewUseCase) Execute(context.Context, dto.GetPaymentRequestLimitsOverviewQuery) (dto.PaymentRequestLimitsOverview, *apperrors.AppError) {
+	return dto.PaymentRequestLimitsOverview{
+		RateLimit:                   dto.PaymentRequestRateLimitSettings{Enabled: true, RequestsPerSecond: 5, Burst: 20},
+		MaxOpenRequestsPerPrincipal: 100,
+		Counters:                    dto.PaymentRequestLimitCounters{RateLimited: 2, QuotaExceeded: 4},
+	}, nil
+}
@@ -5,6 +5,8 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 
 	"chaintx/internal/application/dto"
@@ -16,12 +18,17 @@ const (
 	headerIdempotencyKey      = "Idempotency-Key"
 	headerIdempotencyReplayed = "X-Idempotency-Replayed"
 	headerPrincipalID         = "X-Principal-ID"
+
+	// paymentRequestCacheControl lets clients keep a payment request but makes
+	// them revalidate with If-None-Match before every reuse.
+	paymentRequestCacheControl = "private, no-cache"
 )
 
 type PaymentRequestsController struct {
 	createUseCase         portsin.CreatePaymentRequestUseCase
 	getUseCase            portsin.GetPaymentRequestUseCase
 	getSettlementsUseCase portsin.GetPaymentRequestSettlementsUseCase
+	shutdown              shutdownSignal
 	logger                *log.Logger
 }
 
@@ -30,6 +37,7 @@ type createPaymentRequestPayload struct {
 	Network             string         `json:"network"`
 	Asset               string         `json:"asset"`
 	WebhookURL          string         `json:"webhook_url"`
+	WebhookAPIVersion   string         `json:"webhook_api_version,omitempty"`
 	ExpectedAmountMinor *string        `json:"expected_amount_minor,omitempty"`
 	ExpiresInSeconds    *int64         `json:"expires_in_seconds,omitempty"`
 	Metadata            map[string]any `json:"metadata,omitempty"`
@@ -45,10 +53,17 @@ func NewPaymentRequestsController(
 		createUseCase:         createUseCase,
 		getUseCase:            getUseCase,
 		getSettlementsUseCase: getSettlementsUseCase,
+		shutdown:              newShutdownSignal(),
 		logger:                logger,
 	}
 }
 
+// Shutdown answers pending wait_for_change reads with the current state.
+// Register it with the HTTP server's shutdown hooks.
+func (c *PaymentRequestsController) Shutdown() {
+	c.shutdown.cancel()
+}
+
 func (c *PaymentRequestsController) CreatePaymentRequest(w http.ResponseWriter, r *http.Request) {
 	payload, appErr := parseCreatePaymentRequestPayload(r.Body)
 	if appErr != nil {
@@ -58,7 +73,8 @@ func (c *PaymentRequestsController) CreatePaymentRequest(w http.ResponseWriter,
 
 	output, appErr := c.createUseCase.Execute(r.Context(), dto.CreatePaymentRequestCommand{
 		IdempotencyScope: dto.IdempotencyScope{
-			PrincipalID: strings.TrimSpace(r.Header.Get(headerPrincipalID)),
+			MerchantID:  requestMerchantID(r),
+			PrincipalID: requestPrincipalID(r),
 			HTTPMethod:  r.Method,
 			HTTPPath:    "/v1/payment-requests",
 		},
@@ -67,6 +83,7 @@ func (c *PaymentRequestsController) CreatePaymentRequest(w http.ResponseWriter,
 		Network:             payload.Network,
 		Asset:               payload.Asset,
 		WebhookURL:          payload.WebhookURL,
+		WebhookAPIVersion:   payload.WebhookAPIVersion,
 		ExpectedAmountMinor: payload.ExpectedAmountMinor,
 		ExpiresInSeconds:    payload.ExpiresInSeconds,
 		Metadata:            payload.Metadata,
@@ -88,23 +105,51 @@ func (c *PaymentRequestsController) CreatePaymentRequest(w http.ResponseWriter,
 	writeJSON(w, http.StatusCreated, output.Resource)
 }
 
+// GetPaymentRequest supports conditional reads: the ETag is the payment
+// request version, and If-None-Match answers 304 while it is unchanged. With
+// ?wait_for_change= the read long-polls until the version moves past the one
+// in If-None-Match or the wait ends.
 func (c *PaymentRequestsController) GetPaymentRequest(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
-	resource, appErr := c.getUseCase.Execute(r.Context(), dto.GetPaymentRequestQuery{ID: id})
+	caller, _ := merchantPrincipalFromContext(r.Context())
+	knownTags, matchAny := parseIfNoneMatch(r.Header.Get("If-None-Match"))
+	query := dto.GetPaymentRequestQuery{
+		ID:            id,
+		Caller:        caller,
+		WaitForChange: r.URL.Query().Get("wait_for_change"),
+		Expand:        r.URL.Query().Get("expand"),
+	}
+	for _, tag := range knownTags {
+		if version := entityTagVersion(tag); version > 0 {
+			query.KnownVersion = version
+			break
+		}
+	}
+	ctx, stop := c.shutdown.requestContext(r)
+	defer stop()
+	resource, appErr := c.getUseCase.Execute(ctx, query)
 	if appErr != nil {
 		c.logger.Printf("request error path=/v1/payment-requests/{id} method=%s code=%s message=%s", r.Method, appErr.Code, appErr.Message)
 		writeAppError(w, appErr)
 		return
 	}
 
+	tag := paymentRequestEntityTag(resource, query.Expand)
+	w.Header().Set("ETag", `W/"`+tag+`"`)
+	w.Header().Set("Cache-Control", paymentRequestCacheControl)
+	if matchAny || slices.Contains(knownTags, tag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
 	writeJSON(w, http.StatusOK, resource)
 }
 
 func (c *PaymentRequestsController) GetPaymentRequestSettlements(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
+	caller, _ := merchantPrincipalFromContext(r.Context())
 	resource, appErr := c.getSettlementsUseCase.Execute(
 		r.Context(),
-		dto.GetPaymentRequestSettlementsQuery{ID: id},
+		dto.GetPaymentRequestSettlementsQuery{ID: id, Caller: caller},
 	)
 	if appErr != nil {
 		c.logger.Printf("request error path=/v1/payment-requests/{id}/settlements method=%s code=%s message=%s", r.Method, appErr.Code, appErr.Message)
@@ -151,3 +196,73 @@ func parseCreatePaymentRequestPayload(body io.Reader) (createPaymentRequestPaylo
 
 	return payload, nil
 }
+
+// paymentRequestEntityTag is the opaque part of a payment request ETag: the
+// version, plus updated_at when reconciliation is expanded, because reconcile
+// passes rewrite it without moving the version, plus the expansions after a
+// ";", because each expand set is a different representation.
+func paymentRequestEntityTag(resource dto.PaymentRequestResource, expand string) string {
+	tag := strconv.FormatInt(resource.Version, 10)
+	if resource.Reconciliation != nil && resource.UpdatedAt != nil {
+		tag += "-" + strconv.FormatInt(resource.UpdatedAt.UnixMicro(), 10)
+	}
+	if expansions := normalizedExpand(expand); len(expansions) > 0 {
+		tag += ";" + strings.Join(expansions, ",")
+	}
+	return tag
+}
+
+// normalizedExpand returns the expand values sorted and deduplicated, so
+// equivalent query strings share an ETag. The use case has already rejected
+// unknown values.
+func normalizedExpand(expand string) []string {
+	expansions := []string{}
+	for _, item := range strings.Split(expand, ",") {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			expansions = append(expansions, item)
+		}
+	}
+	slices.Sort(expansions)
+	return slices.Compact(expansions)
+}
+
+// parseIfNoneMatch returns the opaque entity tags named by an If-None-Match
+// header, and whether it is "*". Weak and strong tags compare the same. Tags
+// may contain commas, so the list is split outside quotes only.
+func parseIfNoneMatch(header string) ([]string, bool) {
+	tags := []string{}
+	rest := header
+	for {
+		rest = strings.TrimLeft(rest, " \t,")
+		if rest == "" {
+			return tags, false
+		}
+		if rest[0] == '*' {
+			return tags, true
+		}
+		rest = strings.TrimPrefix(rest, "W/")
+		if rest == "" || rest[0] != '"' {
+			// Skip a malformed entry up to the next separator.
+			_, rest, _ = strings.Cut(rest, ",")
+			continue
+		}
+		tag, after, closed := strings.Cut(rest[1:], `"`)
+		if !closed {
+			return tags, false
+		}
+		tags = append(tags, tag)
+		rest = after
+	}
+}
+
+// entityTagVersion returns the payment request version an entity tag was
+// issued for, or 0 for tags this server did not issue.
+func entityTagVersion(tag string) int64 {
+	tag, _, _ = strings.Cut(tag, ";")
+	versionPart, _, _ := strings.Cut(tag, "-")
+	version, err := strconv.ParseInt(versionPart, 10, 64)
+	if err != nil || version <= 0 {
+		return 0
+	}
+	return version
+}
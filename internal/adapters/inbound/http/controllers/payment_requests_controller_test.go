@@ -132,6 +132,142 @@ func TestPaymentRequestsControllerGetPaymentRequest(t *testing.T) {
 	if !bytes.Contains(rec.Body.Bytes(), []byte(`"id":"pr_test"`)) {
 		t.Fatalf("expected id in payload, got %s", rec.Body.String())
 	}
+	if rec.Header().Get("ETag") != `W/"3"` || rec.Header().Get("Cache-Control") != "private, no-cache" {
+		t.Fatalf("expected ETag and Cache-Control, got %v", rec.Header())
+	}
+}
+
+func TestPaymentRequestsControllerGetPaymentRequestConditional(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		ifNoneMatch          string
+		rawQuery             string
+		expectedStatus       int
+		expectedKnownVersion int64
+		expectedWait         string
+		expectedETag         string
+	}{
+		{name: "current version", ifNoneMatch: `W/"3"`, expectedStatus: http.StatusNotModified, expectedKnownVersion: 3},
+		{name: "strong tag", ifNoneMatch: `"3"`, expectedStatus: http.StatusNotModified, expectedKnownVersion: 3},
+		{name: "tag list", ifNoneMatch: `"etag-from-elsewhere", W/"2", W/"3"`, expectedStatus: http.StatusNotModified, expectedKnownVersion: 2},
+		{name: "any", ifNoneMatch: "*", expectedStatus: http.StatusNotModified},
+		{name: "malformed entries skipped", ifNoneMatch: `bogus, W/, W/"3`, expectedStatus: http.StatusOK},
+		{name: "malformed then valid", ifNoneMatch: `bogus, W/, W/"3"`, expectedStatus: http.StatusNotModified, expectedKnownVersion: 3},
+		{name: "stale version", ifNoneMatch: `W/"2"`, expectedStatus: http.StatusOK, expectedKnownVersion: 2},
+		{
+			name:                 "long poll",
+			ifNoneMatch:          `W/"3"`,
+			rawQuery:             "wait_for_change=30s",
+			expectedStatus:       http.StatusNotModified,
+			expectedKnownVersion: 3,
+			expectedWait:         "30s",
+		},
+		{
+			name:                 "reconciliation expanded",
+			ifNoneMatch:          `W/"3-1000000;reconciliation"`,
+			rawQuery:             "expand=reconciliation",
+			expectedStatus:       http.StatusNotModified,
+			expectedKnownVersion: 3,
+			expectedETag:         `W/"3-1000000;reconciliation"`,
+		},
+		{
+			name:                 "reconciliation rewritten",
+			ifNoneMatch:          `W/"3-999;reconciliation"`,
+			rawQuery:             "expand=reconciliation",
+			expectedStatus:       http.StatusOK,
+			expectedKnownVersion: 3,
+			expectedETag:         `W/"3-1000000;reconciliation"`,
+		},
+		{
+			name:                 "expand set normalized",
+			ifNoneMatch:          `W/"2", W/"3;settlements,status_history"`,
+			rawQuery:             "expand=Status_History,settlements,status_history",
+			expectedStatus:       http.StatusNotModified,
+			expectedKnownVersion: 2,
+			expectedETag:         `W/"3;settlements,status_history"`,
+		},
+		{
+			name:                 "plain tag on expanded read",
+			ifNoneMatch:          `W/"3"`,
+			rawQuery:             "expand=settlements",
+			expectedStatus:       http.StatusOK,
+			expectedKnownVersion: 3,
+			expectedETag:         `W/"3;settlements"`,
+		},
+		{
+			name:                 "expanded tag on plain read",
+			ifNoneMatch:          `W/"3;settlements"`,
+			expectedStatus:       http.StatusOK,
+			expectedKnownVersion: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		getUseCase := &recordingGetUseCase{}
+		controller := NewPaymentRequestsController(
+			stubCreateUseCase{replayed: false},
+			getUseCase,
+			stubGetSettlementsUseCase{},
+			log.New(io.Discard, "", 0),
+		)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/payment-requests/pr_test?"+testCase.rawQuery, nil)
+		req.SetPathValue("id", "pr_test")
+		req.Header.Set("If-None-Match", testCase.ifNoneMatch)
+		rec := httptest.NewRecorder()
+
+		controller.GetPaymentRequest(rec, req)
+
+		if rec.Code != testCase.expectedStatus {
+			t.Fatalf("%s: expected status %d, got %d", testCase.name, testCase.expectedStatus, rec.Code)
+		}
+		if testCase.expectedStatus == http.StatusNotModified && rec.Body.Len() != 0 {
+			t.Fatalf("%s: expected empty 304 body, got %s", testCase.name, rec.Body.String())
+		}
+		expectedETag := testCase.expectedETag
+		if expectedETag == "" {
+			expectedETag = `W/"3"`
+		}
+		if rec.Header().Get("ETag") != expectedETag {
+			t.Fatalf("%s: expected ETag %s, got %q", testCase.name, expectedETag, rec.Header().Get("ETag"))
+		}
+		if getUseCase.lastQuery.KnownVersion != testCase.expectedKnownVersion ||
+			getUseCase.lastQuery.WaitForChange != testCase.expectedWait ||
+			getUseCase.lastQuery.Expand != req.URL.Query().Get("expand") {
+			t.Fatalf("%s: unexpected query %+v", testCase.name, getUseCase.lastQuery)
+		}
+	}
+}
+
+func TestPaymentRequestsControllerShutdownAnswersLongPolls(t *testing.T) {
+	getUseCase := &waitingGetUseCase{waiting: make(chan struct{})}
+	controller := NewPaymentRequestsController(
+		stubCreateUseCase{replayed: false},
+		getUseCase,
+		stubGetSettlementsUseCase{},
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/payment-requests/pr_test?wait_for_change=60s", nil)
+	req.SetPathValue("id", "pr_test")
+	req.Header.Set("If-None-Match", `W/"3"`)
+	rec := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		controller.GetPaymentRequest(rec, req)
+	}()
+
+	<-getUseCase.waiting
+	controller.Shutdown()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("expected shutdown to answer the long poll")
+	}
+	if rec.Code != http.StatusNotModified {
+		t.Fatalf("expected status 304, got %d", rec.Code)
+	}
 }
 
 func TestPaymentRequestsControllerGetPaymentRequestSettlements(t *testing.T) {
@@ -191,8 +327,9 @@ type stubGetUseCase struct{}
 func (stubGetUseCase) Execute(_ context.Context, query dto.GetPaymentRequestQuery) (dto.PaymentRequestResource, *apperrors.AppError) {
 	createdAt := time.Unix(0, 0).UTC()
 	expiresAt := createdAt.Add(time.Hour)
+	updatedAt := createdAt.Add(time.Second)
 
-	return dto.PaymentRequestResource{
+	resource := dto.PaymentRequestResource{
 		ID:        query.ID,
 		Status:    "pending",
 		Chain:     "bitcoin",
@@ -200,12 +337,30 @@ func (stubGetUseCase) Execute(_ context.Context, query dto.GetPaymentRequestQuer
 		Asset:     "BTC",
 		CreatedAt: createdAt,
 		ExpiresAt: expiresAt,
+		UpdatedAt: &updatedAt,
 		PaymentInstructions: dto.PaymentInstructions{
 			Address:         "bc1qexample",
 			AddressScheme:   "bip84_p2wpkh",
 			DerivationIndex: 1,
 		},
-	}, nil
+		Version: 3,
+	}
+	if query.Expand == dto.PaymentRequestExpandReconciliation {
+		resource.Reconciliation = &dto.ReconcileTransitionMetadata{ObservedAmountMinor: "0", UpdatedAt: updatedAt}
+	}
+	return resource, nil
+}
+
+// waitingGetUseCase long-polls until ctx ends, then returns the unchanged
+// resource.
+type waitingGetUseCase struct {
+	waiting chan struct{}
+}
+
+func (w *waitingGetUseCase) Execute(ctx context.Context, query dto.GetPaymentRequestQuery) (dto.PaymentRequestResource, *apperrors.AppError) {
+	close(w.waiting)
+	<-ctx.Done()
+	return stubGetUseCase{}.Execute(ctx, query)
 }
 
 type stubGetSettlementsUseCase struct{}
@@ -3,6 +3,7 @@ package controllers
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
@@ -24,14 +25,11 @@ func writeJSON(w http.ResponseWriter, status int, payload any) {
 }
 
 func writeAppError(w http.ResponseWriter, appErr *apperrors.AppError) {
-	status := http.StatusInternalServerError
-	switch appErr.Type {
-	case apperrors.TypeValidation:
-		status = http.StatusBadRequest
-	case apperrors.TypeNotFound:
-		status = http.StatusNotFound
-	case apperrors.TypeConflict:
-		status = http.StatusConflict
+	status := appErrorStatus(appErr)
+	if appErr.Type == apperrors.TypeRateLimited {
+		if seconds, ok := appErr.Details["retry_after_seconds"].(int64); ok && seconds > 0 {
+			w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+		}
 	}
 
 	writeJSON(w, status, errorResponse{
@@ -42,3 +40,18 @@ func writeAppError(w http.ResponseWriter, appErr *apperrors.AppError) {
 		},
 	})
 }
+
+func appErrorStatus(appErr *apperrors.AppError) int {
+	switch appErr.Type {
+	case apperrors.TypeValidation:
+		return http.StatusBadRequest
+	case apperrors.TypeNotFound:
+		return http.StatusNotFound
+	case apperrors.TypeConflict:
+		return http.StatusConflict
+	case apperrors.TypeRateLimited:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+)
+
+// shutdownSignal ends long-lived handlers (event streams, long-polls) when
+// the server starts shutting down. http.Server.Shutdown waits for active
+// handlers, so without it an open stream holds shutdown until its deadline;
+// ordinary requests keep their own context and finish normally.
+type shutdownSignal struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newShutdownSignal() shutdownSignal {
+	ctx, cancel := context.WithCancel(context.Background())
+	return shutdownSignal{ctx: ctx, cancel: cancel}
+}
+
+// requestContext returns the request context, additionally cancelled at
+// shutdown. Callers must call the returned stop function.
+func (s shutdownSignal) requestContext(r *http.Request) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(r.Context())
+	stop := context.AfterFunc(s.ctx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const webhookRetryPolicyPath = "/v1/webhook-destinations/{destination_host}/retry-policy"
+
+type WebhookDestinationsController struct {
+	putRetryPolicyUseCase    portsin.PutWebhookRetryPolicyUseCase
+	getRetryPolicyUseCase    portsin.GetWebhookRetryPolicyUseCase
+	deleteRetryPolicyUseCase portsin.DeleteWebhookRetryPolicyUseCase
+	opsAuth                  *OpsAuth
+	logger                   *log.Logger
+}
+
+type webhookRetryPolicyPayload struct {
+	MaxAttempts           int    `json:"max_attempts"`
+	BackoffCurve          string `json:"backoff_curve,omitempty"`
+	InitialBackoffSeconds int    `json:"initial_backoff_seconds"`
+	MaxBackoffSeconds     int    `json:"max_backoff_seconds"`
+	MaxAgeSeconds         *int   `json:"max_age_seconds,omitempty"`
+}
+
+func NewWebhookDestinationsController(
+	putRetryPolicyUseCase portsin.PutWebhookRetryPolicyUseCase,
+	getRetryPolicyUseCase portsin.GetWebhookRetryPolicyUseCase,
+	deleteRetryPolicyUseCase portsin.DeleteWebhookRetryPolicyUseCase,
+	opsAuth *OpsAuth,
+	logger *log.Logger,
+) *WebhookDestinationsController {
+	return &WebhookDestinationsController{
+		putRetryPolicyUseCase:    putRetryPolicyUseCase,
+		getRetryPolicyUseCase:    getRetryPolicyUseCase,
+		deleteRetryPolicyUseCase: deleteRetryPolicyUseCase,
+		opsAuth:                  opsAuth,
+		logger:                   logger,
+	}
+}
+
+func (c *WebhookDestinationsController) PutRetryPolicy(w http.ResponseWriter, r *http.Request) {
+	actor, authErr := c.opsAuth.authorize(r, policies.OpsActionWebhookRetryPolicyPut)
+	if authErr != nil {
+		writeWebhookOpsAuthError(w, authErr)
+		return
+	}
+	if c.putRetryPolicyUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"webhook_retry_policy_put_use_case_missing",
+			"webhook retry policy put use case is required",
+			nil,
+		))
+		return
+	}
+
+	payload, appErr := parseWebhookRetryPolicyPayload(r.Body)
+	if appErr != nil {
+		writeAppError(w, appErr)
+		return
+	}
+
+	destinationHost := r.PathValue("destination_host")
+	output, appErr := c.putRetryPolicyUseCase.Execute(r.Context(), dto.PutWebhookRetryPolicyCommand{
+		DestinationHost:       destinationHost,
+		MaxAttempts:           payload.MaxAttempts,
+		BackoffCurve:          payload.BackoffCurve,
+		InitialBackoffSeconds: payload.InitialBackoffSeconds,
+		MaxBackoffSeconds:     payload.MaxBackoffSeconds,
+		MaxAgeSeconds:         payload.MaxAgeSeconds,
+		OperatorID:            actor.ID,
+		Now:                   time.Now().UTC(),
+	})
+	c.opsAuth.audit(r, actor, policies.OpsActionWebhookRetryPolicyPut, destinationHost, "", appErr, map[string]any{
+		"policy": payload,
+	})
+	if appErr != nil {
+		c.logRequestError(r.Method, appErr)
+		writeAppError(w, appErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (c *WebhookDestinationsController) GetRetryPolicy(w http.ResponseWriter, r *http.Request) {
+	if _, authErr := c.opsAuth.authorize(r, policies.OpsActionWebhookRetryPolicyRead); authErr != nil {
+		writeWebhookOpsAuthError(w, authErr)
+		return
+	}
+	if c.getRetryPolicyUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"webhook_retry_policy_get_use_case_missing",
+			"webhook retry policy get use case is required",
+			nil,
+		))
+		return
+	}
+
+	output, appErr := c.getRetryPolicyUseCase.Execute(r.Context(), dto.GetWebhookRetryPolicyQuery{
+		DestinationHost: r.PathValue("destination_host"),
+	})
+	if appErr != nil {
+		c.logRequestError(r.Method, appErr)
+		writeAppError(w, appErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (c *WebhookDestinationsController) DeleteRetryPolicy(w http.ResponseWriter, r *http.Request) {
+	actor, authErr := c.opsAuth.authorize(r, policies.OpsActionWebhookRetryPolicyDelete)
+	if authErr != nil {
+		writeWebhookOpsAuthError(w, authErr)
+		return
+	}
+	if c.deleteRetryPolicyUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"webhook_retry_policy_delete_use_case_missing",
+			"webhook retry policy delete use case is required",
+			nil,
+		))
+		return
+	}
+
+	destinationHost := r.PathValue("destination_host")
+	output, appErr := c.deleteRetryPolicyUseCase.Execute(r.Context(), dto.DeleteWebhookRetryPolicyCommand{
+		DestinationHost: destinationHost,
+	})
+	c.opsAuth.audit(r, actor, policies.OpsActionWebhookRetryPolicyDelete, destinationHost, "", appErr, nil)
+	if appErr != nil {
+		c.logRequestError(r.Method, appErr)
+		writeAppError(w, appErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (c *WebhookDestinationsController) logRequestError(method string, appErr *apperrors.AppError) {
+	if c.logger == nil || appErr == nil {
+		return
+	}
+	c.logger.Printf("request error path=%s method=%s code=%s message=%s", webhookRetryPolicyPath, method, appErr.Code, appErr.Message)
+}
+
+func parseWebhookRetryPolicyPayload(body io.Reader) (webhookRetryPolicyPayload, *apperrors.AppError) {
+	if body == nil {
+		return webhookRetryPolicyPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body is required",
+			nil,
+		)
+	}
+
+	decoder := json.NewDecoder(body)
+	decoder.DisallowUnknownFields()
+
+	payload := webhookRetryPolicyPayload{}
+	if err := decoder.Decode(&payload); err != nil {
+		if err == io.EOF {
+			return webhookRetryPolicyPayload{}, apperrors.NewValidation(
+				"invalid_request",
+				"request body is required",
+				nil,
+			)
+		}
+		return webhookRetryPolicyPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body must be valid JSON",
+			map[string]any{"error": err.Error()},
+		)
+	}
+
+	if err := decoder.Decode(&struct{}{}); err != io.EOF {
+		return webhookRetryPolicyPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body must contain a single JSON object",
+			nil,
+		)
+	}
+
+	return payload, nil
+}
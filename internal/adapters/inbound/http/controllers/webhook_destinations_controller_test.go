@@ -0,0 +1,157 @@
+//go:build !integration
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestWebhookDestinationsControllerPutRetryPolicy(t *testing.T) {
+	useCase := &recordingPutWebhookRetryPolicyUseCase{}
+	controller := NewWebhookDestinationsController(useCase, nil, nil, newTestOpsAuth("ops-key"), log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/v1/webhook-destinations/hooks.example.com/retry-policy",
+		strings.NewReader(`{"max_attempts":40,"backoff_curve":"linear","initial_backoff_seconds":60,"max_backoff_seconds":3600,"max_age_seconds":259200}`),
+	)
+	req.SetPathValue("destination_host", "hooks.example.com")
+	req.Header.Set("Authorization", "Bearer ops-key")
+	req.Header.Set("X-Principal-ID", "ops-user-1")
+	rec := httptest.NewRecorder()
+
+	controller.PutRetryPolicy(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	command := useCase.lastCommand
+	if command.DestinationHost != "hooks.example.com" || command.OperatorID != "ops-user-1" {
+		t.Fatalf("unexpected command %+v", command)
+	}
+	if command.MaxAttempts != 40 || command.BackoffCurve != "linear" || command.MaxAgeSeconds == nil || *command.MaxAgeSeconds != 259200 {
+		t.Fatalf("unexpected policy fields %+v", command)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte(`"destination_host":"hooks.example.com"`)) {
+		t.Fatalf("expected policy in response, got %s", rec.Body.String())
+	}
+}
+
+func TestWebhookDestinationsControllerPutRetryPolicyRejectsUnknownFields(t *testing.T) {
+	useCase := &recordingPutWebhookRetryPolicyUseCase{}
+	controller := NewWebhookDestinationsController(useCase, nil, nil, newTestOpsAuth("ops-key"), log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/v1/webhook-destinations/hooks.example.com/retry-policy",
+		strings.NewReader(`{"max_attempts":5,"retry_jitter_bps":100}`),
+	)
+	req.SetPathValue("destination_host", "hooks.example.com")
+	req.Header.Set("Authorization", "Bearer ops-key")
+	rec := httptest.NewRecorder()
+
+	controller.PutRetryPolicy(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if useCase.calls != 0 {
+		t.Fatalf("expected use case not to be called, got %d calls", useCase.calls)
+	}
+}
+
+func TestWebhookDestinationsControllerRequiresAdminKey(t *testing.T) {
+	controller := NewWebhookDestinationsController(
+		&recordingPutWebhookRetryPolicyUseCase{},
+		stubGetWebhookRetryPolicyUseCase{},
+		stubDeleteWebhookRetryPolicyUseCase{},
+		newTestOpsAuth("ops-key"),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/webhook-destinations/hooks.example.com/retry-policy", nil)
+	req.SetPathValue("destination_host", "hooks.example.com")
+	rec := httptest.NewRecorder()
+
+	controller.GetRetryPolicy(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestWebhookDestinationsControllerDeleteRetryPolicyNotFound(t *testing.T) {
+	controller := NewWebhookDestinationsController(
+		nil,
+		nil,
+		stubDeleteWebhookRetryPolicyUseCase{},
+		newTestOpsAuth("ops-key"),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/webhook-destinations/missing.example.com/retry-policy", nil)
+	req.SetPathValue("destination_host", "missing.example.com")
+	req.Header.Set("Authorization", "Bearer ops-key")
+	rec := httptest.NewRecorder()
+
+	controller.DeleteRetryPolicy(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+type recordingPutWebhookRetryPolicyUseCase struct {
+	calls       int
+	lastCommand dto.PutWebhookRetryPolicyCommand
+}
+
+func (u *recordingPutWebhookRetryPolicyUseCase) Execute(
+	_ context.Context,
+	command dto.PutWebhookRetryPolicyCommand,
+) (dto.WebhookRetryPolicy, *apperrors.AppError) {
+	u.calls++
+	u.lastCommand = command
+	return dto.WebhookRetryPolicy{
+		DestinationHost:       command.DestinationHost,
+		MaxAttempts:           command.MaxAttempts,
+		BackoffCurve:          command.BackoffCurve,
+		InitialBackoffSeconds: command.InitialBackoffSeconds,
+		MaxBackoffSeconds:     command.MaxBackoffSeconds,
+		MaxAgeSeconds:         command.MaxAgeSeconds,
+		CreatedAt:             command.Now,
+		UpdatedAt:             command.Now,
+	}, nil
+}
+
+type stubGetWebhookRetryPolicyUseCase struct{}
+
+func (stubGetWebhookRetryPolicyUseCase) Execute(
+	_ context.Context,
+	query dto.GetWebhookRetryPolicyQuery,
+) (dto.WebhookRetryPolicy, *apperrors.AppError) {
+	return dto.WebhookRetryPolicy{DestinationHost: query.DestinationHost, MaxAttempts: 8}, nil
+}
+
+type stubDeleteWebhookRetryPolicyUseCase struct{}
+
+func (stubDeleteWebhookRetryPolicyUseCase) Execute(
+	_ context.Context,
+	command dto.DeleteWebhookRetryPolicyCommand,
+) (dto.DeleteWebhookRetryPolicyOutput, *apperrors.AppError) {
+	return dto.DeleteWebhookRetryPolicyOutput{}, apperrors.NewNotFound(
+		"webhook_retry_policy_not_found",
+		"webhook retry policy was not found",
+		map[string]any{"destination_host": command.DestinationHost},
+	)
+}
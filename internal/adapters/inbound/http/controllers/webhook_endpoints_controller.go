@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type WebhookEndpointsController struct {
+	testUseCase portsin.TestWebhookEndpointUseCase
+	opsAuth     *OpsAuth
+	logger      *log.Logger
+}
+
+type testWebhookEndpointPayload struct {
+	WebhookURL        string `json:"webhook_url"`
+	WebhookAPIVersion string `json:"webhook_api_version,omitempty"`
+}
+
+func NewWebhookEndpointsController(
+	testUseCase portsin.TestWebhookEndpointUseCase,
+	opsAuth *OpsAuth,
+	logger *log.Logger,
+) *WebhookEndpointsController {
+	return &WebhookEndpointsController{
+		testUseCase: testUseCase,
+		opsAuth:     opsAuth,
+		logger:      logger,
+	}
+}
+
+// TestWebhookEndpoint sends a signed ping to a receiver. A merchant API key
+// makes the call merchant scoped to the key's principal; any other bearer
+// token must be an ops credential with the operator role, and the call is
+// audited. A credential is required even when merchant keys are optional:
+// the call makes an outbound request, so a self-asserted X-Principal-ID is
+// not enough.
+func (c *WebhookEndpointsController) TestWebhookEndpoint(w http.ResponseWriter, r *http.Request) {
+	scope := dto.WebhookEndpointTestScopeMerchant
+	var actor opsActor
+	if _, authenticated := merchantPrincipalFromContext(r.Context()); !authenticated {
+		if extractAdminToken(r) == "" {
+			writeMerchantAuthError(w, http.StatusUnauthorized, "unauthorized", "merchant api key or ops credential is required")
+			return
+		}
+		var authErr *webhookOpsAuthError
+		actor, authErr = c.opsAuth.authorize(r, policies.OpsActionWebhookEndpointTest)
+		if authErr != nil {
+			writeWebhookOpsAuthError(w, authErr)
+			return
+		}
+		scope = dto.WebhookEndpointTestScopeAdmin
+	}
+	if c.testUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"webhook_endpoint_test_use_case_missing",
+			"webhook endpoint test use case is required",
+			nil,
+		))
+		return
+	}
+
+	payload, appErr := parseTestWebhookEndpointPayload(r.Body)
+	if appErr != nil {
+		writeAppError(w, appErr)
+		return
+	}
+
+	output, appErr := c.testUseCase.Execute(r.Context(), dto.TestWebhookEndpointCommand{
+		WebhookURL:        payload.WebhookURL,
+		WebhookAPIVersion: payload.WebhookAPIVersion,
+		Scope:             scope,
+		MerchantID:        requestMerchantID(r),
+		PrincipalID:       requestPrincipalID(r),
+	})
+	if scope == dto.WebhookEndpointTestScopeAdmin {
+		c.opsAuth.audit(r, actor, policies.OpsActionWebhookEndpointTest, webhookURLHost(payload.WebhookURL), "", appErr, nil)
+	}
+	if appErr != nil {
+		if c.logger != nil {
+			c.logger.Printf("request error path=/v1/webhook-endpoints/test method=%s code=%s message=%s", r.Method, appErr.Code, appErr.Message)
+		}
+		writeAppError(w, appErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func parseTestWebhookEndpointPayload(body io.Reader) (testWebhookEndpointPayload, *apperrors.AppError) {
+	if body == nil {
+		return testWebhookEndpointPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body is required",
+			nil,
+		)
+	}
+
+	decoder := json.NewDecoder(body)
+	decoder.DisallowUnknownFields()
+
+	payload := testWebhookEndpointPayload{}
+	if err := decoder.Decode(&payload); err != nil {
+		if err == io.EOF {
+			return testWebhookEndpointPayload{}, apperrors.NewValidation(
+				"invalid_request",
+				"request body is required",
+				nil,
+			)
+		}
+		return testWebhookEndpointPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body must be valid JSON",
+			map[string]any{"error": err.Error()},
+		)
+	}
+
+	if err := decoder.Decode(&struct{}{}); err != io.EOF {
+		return testWebhookEndpointPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body must contain a single JSON object",
+			nil,
+		)
+	}
+
+	return payload, nil
+}
+
+// webhookURLHost keeps the audit target free of paths and query strings,
+// which may carry receiver secrets.
+func webhookURLHost(raw string) string {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return ""
+	}
+	return parsed.Hostname()
+}
@@ -0,0 +1,183 @@
+//go:build !integration
+
+package controllers
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestWebhookEndpointsControllerTestWebhookEndpointScopes(t *testing.T) {
+	testCases := []struct {
+		name          string
+		authorization string
+		principalID   string
+		expectedCode  int
+		expectedScope string
+	}{
+		{
+			name:          "admin key",
+			authorization: "Bearer ops-key",
+			expectedCode:  http.StatusOK,
+			expectedScope: dto.WebhookEndpointTestScopeAdmin,
+		},
+		{
+			name:         "keyless merchant principal",
+			principalID:  "merchant_1",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "wrong admin key",
+			authorization: "Bearer wrong",
+			principalID:   "merchant_1",
+			expectedCode:  http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := &recordingTestWebhookEndpointUseCase{}
+			controller := NewWebhookEndpointsController(useCase, newTestOpsAuth("ops-key"), log.New(io.Discard, "", 0))
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/v1/webhook-endpoints/test",
+				strings.NewReader(`{"webhook_url":"https://hooks.example.com/chaintx","webhook_api_version":"v2"}`),
+			)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			if tc.principalID != "" {
+				req.Header.Set("X-Principal-ID", tc.principalID)
+			}
+			rec := httptest.NewRecorder()
+
+			controller.TestWebhookEndpoint(rec, req)
+
+			if rec.Code != tc.expectedCode {
+				t.Fatalf("expected status %d, got %d body=%s", tc.expectedCode, rec.Code, rec.Body.String())
+			}
+			if tc.expectedCode != http.StatusOK {
+				if len(useCase.commands) != 0 {
+					t.Fatalf("expected use case not to run")
+				}
+				return
+			}
+			if len(useCase.commands) != 1 {
+				t.Fatalf("expected one use case call, got %d", len(useCase.commands))
+			}
+			command := useCase.commands[0]
+			if command.Scope != tc.expectedScope || command.PrincipalID != tc.principalID {
+				t.Fatalf("unexpected command: %+v", command)
+			}
+			if command.WebhookURL != "https://hooks.example.com/chaintx" || command.WebhookAPIVersion != "v2" {
+				t.Fatalf("unexpected command payload: %+v", command)
+			}
+			if !strings.Contains(rec.Body.String(), `"latency_ms":42`) {
+				t.Fatalf("expected latency in response, got %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWebhookEndpointsControllerRejectsUnknownFields(t *testing.T) {
+	useCase := &recordingTestWebhookEndpointUseCase{}
+	controller := NewWebhookEndpointsController(useCase, newTestOpsAuth("ops-key"), log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/v1/webhook-endpoints/test",
+		strings.NewReader(`{"webhook_url":"https://hooks.example.com/chaintx","secret":"x"}`),
+	)
+	req.Header.Set("Authorization", "Bearer ops-key")
+	rec := httptest.NewRecorder()
+
+	controller.TestWebhookEndpoint(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if len(useCase.commands) != 0 {
+		t.Fatalf("expected use case not to run")
+	}
+}
+
+func TestWebhookEndpointsControllerUsesMerchantKeyPrincipal(t *testing.T) {
+	useCase := &recordingTestWebhookEndpointUseCase{}
+	controller := NewWebhookEndpointsController(useCase, newTestOpsAuth("ops-key"), log.New(io.Discard, "", 0))
+	auth := NewMerchantAuth(stubAuthenticateMerchantAPIKeyUseCase{
+		principal: dto.MerchantPrincipal{PrincipalID: "merchant_a", KeyID: "key_a", Scopes: []string{"create"}},
+	}, nil, true, log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/v1/webhook-endpoints/test",
+		strings.NewReader(`{"webhook_url":"https://hooks.example.com/chaintx"}`),
+	)
+	req.Header.Set("Authorization", "Bearer "+testMerchantAPIKey)
+	req.Header.Set("X-Principal-ID", "spoofed")
+	rec := httptest.NewRecorder()
+
+	auth.RequireOrOpsKey("create", controller.TestWebhookEndpoint)(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	command := useCase.commands[0]
+	if command.Scope != dto.WebhookEndpointTestScopeMerchant || command.PrincipalID != "merchant_a" {
+		t.Fatalf("expected merchant scope for key principal, got %+v", command)
+	}
+}
+
+func TestWebhookEndpointsControllerRequiresCredentialWhenKeysOptional(t *testing.T) {
+	useCase := &recordingTestWebhookEndpointUseCase{}
+	controller := NewWebhookEndpointsController(useCase, newTestOpsAuth("ops-key"), log.New(io.Discard, "", 0))
+	auth := NewMerchantAuth(stubAuthenticateMerchantAPIKeyUseCase{}, nil, false, log.New(io.Discard, "", 0))
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/v1/webhook-endpoints/test",
+		strings.NewReader(`{"webhook_url":"https://hooks.example.com/chaintx"}`),
+	)
+	req.Header.Set("X-Principal-ID", "merchant_1")
+	rec := httptest.NewRecorder()
+
+	auth.RequireOrOpsKey("create", controller.TestWebhookEndpoint)(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("WWW-Authenticate") == "" {
+		t.Fatalf("expected WWW-Authenticate header")
+	}
+	if len(useCase.commands) != 0 {
+		t.Fatalf("expected use case not to run")
+	}
+}
+
+type recordingTestWebhookEndpointUseCase struct {
+	commands []dto.TestWebhookEndpointCommand
+}
+
+func (u *recordingTestWebhookEndpointUseCase) Execute(
+	_ context.Context,
+	command dto.TestWebhookEndpointCommand,
+) (dto.TestWebhookEndpointOutput, *apperrors.AppError) {
+	u.commands = append(u.commands, command)
+	return dto.TestWebhookEndpointOutput{
+		EventID:    "evt_1",
+		WebhookURL: command.WebhookURL,
+		APIVersion: "v2",
+		Delivered:  true,
+		StatusCode: 204,
+		LatencyMS:  42,
+	}, nil
+}
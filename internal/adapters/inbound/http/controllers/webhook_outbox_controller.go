@@ -1,7 +1,6 @@
 package controllers
 
 import (
-	"crypto/subtle"
 	"encoding/json"
 	"io"
 	"log"
@@ -12,22 +11,40 @@ import (
 
 	"chaintx/internal/application/dto"
 	portsin "chaintx/internal/application/ports/in"
+	"chaintx/internal/domain/policies"
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
 type WebhookOutboxController struct {
-	overviewUseCase portsin.GetWebhookOutboxOverviewUseCase
-	listDLQUseCase  portsin.ListWebhookDLQEventsUseCase
-	requeueUseCase  portsin.RequeueWebhookDLQEventUseCase
-	cancelUseCase   portsin.CancelWebhookOutboxEventUseCase
-	adminKeys       []string
-	logger          *log.Logger
+	overviewUseCase    portsin.GetWebhookOutboxOverviewUseCase
+	listDLQUseCase     portsin.ListWebhookDLQEventsUseCase
+	requeueUseCase     portsin.RequeueWebhookDLQEventUseCase
+	cancelUseCase      portsin.CancelWebhookOutboxEventUseCase
+	bulkRequeueUseCase portsin.BulkRequeueWebhookDLQEventsUseCase
+	bulkCancelUseCase  portsin.BulkCancelWebhookOutboxEventsUseCase
+	opsAuth            *OpsAuth
+	logger             *log.Logger
 }
 
 type webhookCancelPayload struct {
 	Reason string `json:"reason,omitempty"`
 }
 
+type webhookOutboxFilterPayload struct {
+	DestinationHost string `json:"destination_host,omitempty"`
+	EventType       string `json:"event_type,omitempty"`
+	ErrorContains   string `json:"error_contains,omitempty"`
+	CreatedFrom     string `json:"created_from,omitempty"`
+	CreatedTo       string `json:"created_to,omitempty"`
+}
+
+type webhookBulkMutationPayload struct {
+	EventIDs  []string                   `json:"event_ids,omitempty"`
+	Filter    webhookOutboxFilterPayload `json:"filter"`
+	MaxEvents int                        `json:"max_events,omitempty"`
+	Reason    string                     `json:"reason,omitempty"`
+}
+
 type webhookOpsAuthError struct {
 	Status  int
 	Code    string
@@ -43,21 +60,25 @@ func NewWebhookOutboxController(
 	listDLQUseCase portsin.ListWebhookDLQEventsUseCase,
 	requeueUseCase portsin.RequeueWebhookDLQEventUseCase,
 	cancelUseCase portsin.CancelWebhookOutboxEventUseCase,
-	adminKeys []string,
+	bulkRequeueUseCase portsin.BulkRequeueWebhookDLQEventsUseCase,
+	bulkCancelUseCase portsin.BulkCancelWebhookOutboxEventsUseCase,
+	opsAuth *OpsAuth,
 	logger *log.Logger,
 ) *WebhookOutboxController {
 	return &WebhookOutboxController{
-		overviewUseCase: overviewUseCase,
-		listDLQUseCase:  listDLQUseCase,
-		requeueUseCase:  requeueUseCase,
-		cancelUseCase:   cancelUseCase,
-		adminKeys:       cloneNonEmptyStrings(adminKeys),
-		logger:          logger,
+		overviewUseCase:    overviewUseCase,
+		listDLQUseCase:     listDLQUseCase,
+		requeueUseCase:     requeueUseCase,
+		cancelUseCase:      cancelUseCase,
+		bulkRequeueUseCase: bulkRequeueUseCase,
+		bulkCancelUseCase:  bulkCancelUseCase,
+		opsAuth:            opsAuth,
+		logger:             logger,
 	}
 }
 
 func (c *WebhookOutboxController) GetOverview(w http.ResponseWriter, r *http.Request) {
-	if authErr := c.requireAdminAuth(r); authErr != nil {
+	if _, authErr := c.requireAdminAuth(r, policies.OpsActionWebhookOutboxOverviewRead); authErr != nil {
 		c.writeAuthError(w, authErr)
 		return
 	}
@@ -70,7 +91,37 @@ func (c *WebhookOutboxController) GetOverview(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	output, appErr := c.overviewUseCase.Execute(r.Context(), dto.GetWebhookOutboxOverviewQuery{Now: time.Now().UTC()})
+	query := r.URL.Query()
+	overviewQuery := dto.GetWebhookOutboxOverviewQuery{
+		Now:     time.Now().UTC(),
+		GroupBy: strings.TrimSpace(query.Get("group_by")),
+	}
+	if raw := strings.TrimSpace(query.Get("window_seconds")); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			writeAppError(w, apperrors.NewValidation(
+				"invalid_request",
+				"window_seconds must be an integer",
+				map[string]any{"field": "window_seconds"},
+			))
+			return
+		}
+		overviewQuery.Window = time.Duration(parsed) * time.Second
+	}
+	if raw := strings.TrimSpace(query.Get("limit")); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			writeAppError(w, apperrors.NewValidation(
+				"invalid_request",
+				"limit must be an integer",
+				map[string]any{"field": "limit"},
+			))
+			return
+		}
+		overviewQuery.Limit = parsed
+	}
+
+	output, appErr := c.overviewUseCase.Execute(r.Context(), overviewQuery)
 	if appErr != nil {
 		c.logRequestError(r.Method, "/v1/webhook-outbox/overview", appErr)
 		writeAppError(w, appErr)
@@ -81,7 +132,7 @@ func (c *WebhookOutboxController) GetOverview(w http.ResponseWriter, r *http.Req
 }
 
 func (c *WebhookOutboxController) ListDLQ(w http.ResponseWriter, r *http.Request) {
-	if authErr := c.requireAdminAuth(r); authErr != nil {
+	if _, authErr := c.requireAdminAuth(r, policies.OpsActionWebhookOutboxDLQRead); authErr != nil {
 		c.writeAuthError(w, authErr)
 		return
 	}
@@ -94,8 +145,9 @@ func (c *WebhookOutboxController) ListDLQ(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	query := r.URL.Query()
 	limit := 0
-	rawLimit := strings.TrimSpace(r.URL.Query().Get("limit"))
+	rawLimit := strings.TrimSpace(query.Get("limit"))
 	if rawLimit != "" {
 		parsed, err := strconv.Atoi(rawLimit)
 		if err != nil {
@@ -109,7 +161,23 @@ func (c *WebhookOutboxController) ListDLQ(w http.ResponseWriter, r *http.Request
 		limit = parsed
 	}
 
-	output, appErr := c.listDLQUseCase.Execute(r.Context(), dto.ListWebhookDLQEventsQuery{Limit: limit})
+	filter, appErr := parseWebhookOutboxFilter(webhookOutboxFilterPayload{
+		DestinationHost: query.Get("destination_host"),
+		EventType:       query.Get("event_type"),
+		ErrorContains:   query.Get("error_contains"),
+		CreatedFrom:     query.Get("created_from"),
+		CreatedTo:       query.Get("created_to"),
+	})
+	if appErr != nil {
+		writeAppError(w, appErr)
+		return
+	}
+
+	output, appErr := c.listDLQUseCase.Execute(r.Context(), dto.ListWebhookDLQEventsQuery{
+		Limit:  limit,
+		Cursor: strings.TrimSpace(query.Get("cursor")),
+		Filter: filter,
+	})
 	if appErr != nil {
 		c.logRequestError(r.Method, "/v1/webhook-outbox/dlq", appErr)
 		writeAppError(w, appErr)
@@ -120,7 +188,8 @@ func (c *WebhookOutboxController) ListDLQ(w http.ResponseWriter, r *http.Request
 }
 
 func (c *WebhookOutboxController) RequeueDLQEvent(w http.ResponseWriter, r *http.Request) {
-	if authErr := c.requireAdminAuth(r); authErr != nil {
+	actor, authErr := c.requireAdminAuth(r, policies.OpsActionWebhookOutboxRequeue)
+	if authErr != nil {
 		c.writeAuthError(w, authErr)
 		return
 	}
@@ -134,12 +203,13 @@ func (c *WebhookOutboxController) RequeueDLQEvent(w http.ResponseWriter, r *http
 	}
 
 	eventID := strings.TrimSpace(r.PathValue("event_id"))
-	operatorID := strings.TrimSpace(r.Header.Get(headerPrincipalID))
 	output, appErr := c.requeueUseCase.Execute(r.Context(), dto.RequeueWebhookDLQEventCommand{
 		EventID:    eventID,
-		OperatorID: operatorID,
+		Sink:       r.URL.Query().Get("sink"),
+		OperatorID: actor.ID,
 		Now:        time.Now().UTC(),
 	})
+	c.opsAuth.audit(r, actor, policies.OpsActionWebhookOutboxRequeue, eventID, "", appErr, nil)
 	if appErr != nil {
 		c.logRequestError(r.Method, "/v1/webhook-outbox/dlq/{event_id}/requeue", appErr)
 		writeAppError(w, appErr)
@@ -150,7 +220,8 @@ func (c *WebhookOutboxController) RequeueDLQEvent(w http.ResponseWriter, r *http
 }
 
 func (c *WebhookOutboxController) CancelEvent(w http.ResponseWriter, r *http.Request) {
-	if authErr := c.requireAdminAuth(r); authErr != nil {
+	actor, authErr := c.requireAdminAuth(r, policies.OpsActionWebhookOutboxCancel)
+	if authErr != nil {
 		c.writeAuthError(w, authErr)
 		return
 	}
@@ -170,13 +241,14 @@ func (c *WebhookOutboxController) CancelEvent(w http.ResponseWriter, r *http.Req
 	}
 
 	eventID := strings.TrimSpace(r.PathValue("event_id"))
-	operatorID := strings.TrimSpace(r.Header.Get(headerPrincipalID))
 	output, appErr := c.cancelUseCase.Execute(r.Context(), dto.CancelWebhookOutboxEventCommand{
 		EventID:    eventID,
-		OperatorID: operatorID,
+		Sink:       r.URL.Query().Get("sink"),
+		OperatorID: actor.ID,
 		Reason:     payload.Reason,
 		Now:        time.Now().UTC(),
 	})
+	c.opsAuth.audit(r, actor, policies.OpsActionWebhookOutboxCancel, eventID, payload.Reason, appErr, nil)
 	if appErr != nil {
 		c.logRequestError(r.Method, "/v1/webhook-outbox/events/{event_id}/cancel", appErr)
 		writeAppError(w, appErr)
@@ -186,6 +258,99 @@ func (c *WebhookOutboxController) CancelEvent(w http.ResponseWriter, r *http.Req
 	writeJSON(w, http.StatusOK, output)
 }
 
+func (c *WebhookOutboxController) BulkRequeueDLQEvents(w http.ResponseWriter, r *http.Request) {
+	actor, authErr := c.requireAdminAuth(r, policies.OpsActionWebhookOutboxBulkRequeue)
+	if authErr != nil {
+		c.writeAuthError(w, authErr)
+		return
+	}
+	if c.bulkRequeueUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"webhook_outbox_bulk_requeue_use_case_missing",
+			"webhook outbox bulk requeue use case is required",
+			nil,
+		))
+		return
+	}
+
+	payload, appErr := parseWebhookBulkMutationPayload(r.Body)
+	if appErr != nil {
+		writeAppError(w, appErr)
+		return
+	}
+	if payload.Reason != "" {
+		writeAppError(w, apperrors.NewValidation(
+			"invalid_request",
+			"reason is not supported for bulk requeue",
+			map[string]any{"field": "reason"},
+		))
+		return
+	}
+	selection, appErr := buildWebhookOutboxBulkSelection(payload)
+	if appErr != nil {
+		writeAppError(w, appErr)
+		return
+	}
+
+	output, appErr := c.bulkRequeueUseCase.Execute(r.Context(), dto.BulkRequeueWebhookDLQEventsCommand{
+		Selection:  selection,
+		MaxEvents:  payload.MaxEvents,
+		OperatorID: actor.ID,
+		Now:        time.Now().UTC(),
+	})
+	c.opsAuth.audit(r, actor, policies.OpsActionWebhookOutboxBulkRequeue, "", "", appErr, webhookBulkAuditDetails(payload, output))
+	if appErr != nil {
+		c.logRequestError(r.Method, "/v1/webhook-outbox/dlq/bulk-requeue", appErr)
+		writeAppError(w, appErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (c *WebhookOutboxController) BulkCancelEvents(w http.ResponseWriter, r *http.Request) {
+	actor, authErr := c.requireAdminAuth(r, policies.OpsActionWebhookOutboxBulkCancel)
+	if authErr != nil {
+		c.writeAuthError(w, authErr)
+		return
+	}
+	if c.bulkCancelUseCase == nil {
+		writeAppError(w, apperrors.NewInternal(
+			"webhook_outbox_bulk_cancel_use_case_missing",
+			"webhook outbox bulk cancel use case is required",
+			nil,
+		))
+		return
+	}
+
+	payload, appErr := parseWebhookBulkMutationPayload(r.Body)
+	if appErr != nil {
+		writeAppError(w, appErr)
+		return
+	}
+	selection, appErr := buildWebhookOutboxBulkSelection(payload)
+	if appErr != nil {
+		writeAppError(w, appErr)
+		return
+	}
+
+	output, appErr := c.bulkCancelUseCase.Execute(r.Context(), dto.BulkCancelWebhookOutboxEventsCommand{
+		Selection:  selection,
+		MaxEvents:  payload.MaxEvents,
+		OperatorID: actor.ID,
+		Reason:     payload.Reason,
+		Now:        time.Now().UTC(),
+	})
+	c.opsAuth.audit(r, actor, policies.OpsActionWebhookOutboxBulkCancel, "", payload.Reason, appErr, webhookBulkAuditDetails(payload, output))
+	if appErr != nil {
+		c.logRequestError(r.Method, "/v1/webhook-outbox/events/bulk-cancel", appErr)
+		writeAppError(w, appErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
 func (c *WebhookOutboxController) logRequestError(method string, path string, appErr *apperrors.AppError) {
 	if c == nil || c.logger == nil || appErr == nil {
 		return
@@ -226,67 +391,125 @@ func parseWebhookCancelPayload(body io.Reader) (webhookCancelPayload, *apperrors
 	return payload, nil
 }
 
-func (c *WebhookOutboxController) requireAdminAuth(r *http.Request) *webhookOpsAuthError {
-	if c == nil {
-		return &webhookOpsAuthError{
-			Status:  http.StatusServiceUnavailable,
-			Code:    "webhook_ops_auth_not_configured",
-			Message: "webhook ops authentication is not configured",
-		}
-	}
-	if len(c.adminKeys) == 0 {
-		return &webhookOpsAuthError{
-			Status:  http.StatusServiceUnavailable,
-			Code:    "webhook_ops_auth_not_configured",
-			Message: "webhook ops authentication is not configured",
-		}
+func parseWebhookBulkMutationPayload(body io.Reader) (webhookBulkMutationPayload, *apperrors.AppError) {
+	if body == nil {
+		return webhookBulkMutationPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body is required",
+			nil,
+		)
 	}
 
-	token := extractAdminToken(r)
-	if token == "" {
-		return &webhookOpsAuthError{
-			Status:  http.StatusUnauthorized,
-			Code:    "unauthorized",
-			Message: "admin authentication is required",
+	decoder := json.NewDecoder(body)
+	decoder.DisallowUnknownFields()
+
+	payload := webhookBulkMutationPayload{}
+	if err := decoder.Decode(&payload); err != nil {
+		if err == io.EOF {
+			return webhookBulkMutationPayload{}, apperrors.NewValidation(
+				"invalid_request",
+				"request body is required",
+				nil,
+			)
 		}
+		return webhookBulkMutationPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body must be valid JSON",
+			map[string]any{"error": err.Error()},
+		)
 	}
-	for _, candidate := range c.adminKeys {
-		if subtle.ConstantTimeCompare([]byte(token), []byte(candidate)) == 1 {
-			return nil
-		}
+
+	if err := decoder.Decode(&struct{}{}); err != io.EOF {
+		return webhookBulkMutationPayload{}, apperrors.NewValidation(
+			"invalid_request",
+			"request body must contain a single JSON object",
+			nil,
+		)
 	}
 
-	return &webhookOpsAuthError{
-		Status:  http.StatusUnauthorized,
-		Code:    "unauthorized",
-		Message: "admin authentication failed",
+	payload.Reason = strings.TrimSpace(payload.Reason)
+	return payload, nil
+}
+
+func buildWebhookOutboxBulkSelection(
+	payload webhookBulkMutationPayload,
+) (dto.WebhookOutboxBulkSelection, *apperrors.AppError) {
+	filter, appErr := parseWebhookOutboxFilter(payload.Filter)
+	if appErr != nil {
+		return dto.WebhookOutboxBulkSelection{}, appErr
 	}
+	return dto.WebhookOutboxBulkSelection{
+		EventIDs: payload.EventIDs,
+		Filter:   filter,
+	}, nil
 }
 
-func (c *WebhookOutboxController) writeAuthError(w http.ResponseWriter, authErr *webhookOpsAuthError) {
-	if authErr == nil {
-		return
+func parseWebhookOutboxFilter(payload webhookOutboxFilterPayload) (dto.WebhookOutboxEventFilter, *apperrors.AppError) {
+	createdFrom, appErr := parseWebhookOutboxFilterTime("created_from", payload.CreatedFrom)
+	if appErr != nil {
+		return dto.WebhookOutboxEventFilter{}, appErr
 	}
-	writeJSON(w, authErr.Status, errorResponse{
-		Error: errorEnvelope{
-			Code:    authErr.Code,
-			Message: authErr.Message,
-		},
-	})
+	createdTo, appErr := parseWebhookOutboxFilterTime("created_to", payload.CreatedTo)
+	if appErr != nil {
+		return dto.WebhookOutboxEventFilter{}, appErr
+	}
+
+	return dto.WebhookOutboxEventFilter{
+		DestinationHost: strings.TrimSpace(payload.DestinationHost),
+		EventType:       strings.TrimSpace(payload.EventType),
+		ErrorContains:   strings.TrimSpace(payload.ErrorContains),
+		CreatedFrom:     createdFrom,
+		CreatedTo:       createdTo,
+	}, nil
 }
 
-func extractAdminToken(r *http.Request) string {
-	if r == nil {
-		return ""
+func parseWebhookOutboxFilterTime(field string, raw string) (*time.Time, *apperrors.AppError) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, trimmed)
+	if err != nil {
+		return nil, apperrors.NewValidation(
+			"invalid_request",
+			field+" must be an RFC3339 timestamp",
+			map[string]any{"field": field},
+		)
 	}
-	authHeader := strings.TrimSpace(r.Header.Get(headerAuthorization))
-	if len(authHeader) >= len("Bearer ") && strings.EqualFold(authHeader[:len("Bearer ")], "Bearer ") {
-		token := strings.TrimSpace(authHeader[len("Bearer "):])
-		if token != "" {
-			return token
+	value := parsed.UTC()
+	return &value, nil
+}
+
+// requireAdminAuth authenticates the ops caller and checks that its role
+// allows action.
+func (c *WebhookOutboxController) requireAdminAuth(r *http.Request, action string) (opsActor, *webhookOpsAuthError) {
+	if c == nil {
+		return opsActor{}, &webhookOpsAuthError{
+			Status:  http.StatusServiceUnavailable,
+			Code:    "webhook_ops_auth_not_configured",
+			Message: "webhook ops authentication is not configured",
 		}
 	}
-	return ""
+	return c.opsAuth.authorize(r, action)
+}
+
+func (c *WebhookOutboxController) writeAuthError(w http.ResponseWriter, authErr *webhookOpsAuthError) {
+	writeWebhookOpsAuthError(w, authErr)
+}
+
+// webhookBulkAuditDetails keeps the selection and the affected event ids, so
+// the audit entry stays meaningful after the filter no longer matches.
+func webhookBulkAuditDetails(payload webhookBulkMutationPayload, output dto.WebhookOutboxBulkMutationOutput) map[string]any {
+	details := map[string]any{
+		"event_ids":     payload.EventIDs,
+		"filter":        payload.Filter,
+		"max_events":    payload.MaxEvents,
+		"updated_count": output.UpdatedCount,
+	}
+	if len(output.EventIDs) > 0 {
+		details["updated_event_ids"] = output.EventIDs
+	}
+	return details
 }
 
 func cloneNonEmptyStrings(values []string) []string {
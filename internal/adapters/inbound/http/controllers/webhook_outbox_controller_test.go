@@ -22,7 +22,9 @@ func TestWebhookOutboxControllerGetOverview(t *testing.T) {
 		stubListDLQUseCase{},
 		stubRequeueDLQUseCase{},
 		stubCancelEventUseCase{},
-		[]string{"ops-key"},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
 		log.New(io.Discard, "", 0),
 	)
 
@@ -46,7 +48,9 @@ func TestWebhookOutboxControllerGetOverviewWithBearerAuth(t *testing.T) {
 		stubListDLQUseCase{},
 		stubRequeueDLQUseCase{},
 		stubCancelEventUseCase{},
-		[]string{"ops-key"},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
 		log.New(io.Discard, "", 0),
 	)
 
@@ -67,7 +71,9 @@ func TestWebhookOutboxControllerRejectsLegacyAdminHeaderOnly(t *testing.T) {
 		stubListDLQUseCase{},
 		stubRequeueDLQUseCase{},
 		stubCancelEventUseCase{},
-		[]string{"ops-key"},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
 		log.New(io.Discard, "", 0),
 	)
 
@@ -82,13 +88,67 @@ func TestWebhookOutboxControllerRejectsLegacyAdminHeaderOnly(t *testing.T) {
 	}
 }
 
+func TestWebhookOutboxControllerGetOverviewGroupsByDestination(t *testing.T) {
+	controller := NewWebhookOutboxController(
+		stubOverviewUseCase{},
+		stubListDLQUseCase{},
+		stubRequeueDLQUseCase{},
+		stubCancelEventUseCase{},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/webhook-outbox/overview?group_by=destination&window_seconds=600", nil)
+	req.Header.Set("Authorization", "Bearer ops-key")
+	rec := httptest.NewRecorder()
+
+	controller.GetOverview(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte(`"window_seconds":600`)) {
+		t.Fatalf("expected window_seconds in response, got %s", rec.Body.String())
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte(`"destination_host":"merchant.example"`)) {
+		t.Fatalf("expected destination breakdown in response, got %s", rec.Body.String())
+	}
+}
+
+func TestWebhookOutboxControllerGetOverviewRejectsInvalidWindow(t *testing.T) {
+	controller := NewWebhookOutboxController(
+		stubOverviewUseCase{},
+		stubListDLQUseCase{},
+		stubRequeueDLQUseCase{},
+		stubCancelEventUseCase{},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/webhook-outbox/overview?group_by=destination&window_seconds=1h", nil)
+	req.Header.Set("Authorization", "Bearer ops-key")
+	rec := httptest.NewRecorder()
+
+	controller.GetOverview(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
 func TestWebhookOutboxControllerListDLQRejectsInvalidLimit(t *testing.T) {
 	controller := NewWebhookOutboxController(
 		stubOverviewUseCase{},
 		stubListDLQUseCase{},
 		stubRequeueDLQUseCase{},
 		stubCancelEventUseCase{},
-		[]string{"ops-key"},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
 		log.New(io.Discard, "", 0),
 	)
 
@@ -109,7 +169,9 @@ func TestWebhookOutboxControllerRequeueDLQEvent(t *testing.T) {
 		stubListDLQUseCase{},
 		stubRequeueDLQUseCase{},
 		stubCancelEventUseCase{},
-		[]string{"ops-key"},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
 		log.New(io.Discard, "", 0),
 	)
 
@@ -135,7 +197,9 @@ func TestWebhookOutboxControllerCancelEventInvalidJSON(t *testing.T) {
 		stubListDLQUseCase{},
 		stubRequeueDLQUseCase{},
 		stubCancelEventUseCase{},
-		[]string{"ops-key"},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
 		log.New(io.Discard, "", 0),
 	)
 
@@ -159,13 +223,15 @@ func TestWebhookOutboxControllerCancelEventSuccess(t *testing.T) {
 		stubListDLQUseCase{},
 		stubRequeueDLQUseCase{},
 		cancelUseCase,
-		[]string{"ops-key"},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
 		log.New(io.Discard, "", 0),
 	)
 
 	req := httptest.NewRequest(
 		http.MethodPost,
-		"/v1/webhook-outbox/events/evt_1/cancel",
+		"/v1/webhook-outbox/events/evt_1/cancel?sink=kafka",
 		bytes.NewBufferString(`{"reason":"operator action"}`),
 	)
 	req.SetPathValue("event_id", "evt_1")
@@ -178,7 +244,7 @@ func TestWebhookOutboxControllerCancelEventSuccess(t *testing.T) {
 	if rec.Code != http.StatusOK {
 		t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
 	}
-	if cancelUseCase.lastCommand.EventID != "evt_1" {
+	if cancelUseCase.lastCommand.EventID != "evt_1" || cancelUseCase.lastCommand.Sink != "kafka" {
 		t.Fatalf("expected event id evt_1, got %+v", cancelUseCase.lastCommand)
 	}
 	if cancelUseCase.lastCommand.Reason != "operator action" {
@@ -189,13 +255,144 @@ func TestWebhookOutboxControllerCancelEventSuccess(t *testing.T) {
 	}
 }
 
+func TestWebhookOutboxControllerListDLQPassesFilters(t *testing.T) {
+	listUseCase := &stubListDLQCaptureUseCase{}
+	controller := NewWebhookOutboxController(
+		stubOverviewUseCase{},
+		listUseCase,
+		stubRequeueDLQUseCase{},
+		stubCancelEventUseCase{},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/v1/webhook-outbox/dlq?destination_host=hooks.example.com&event_type=payment_request.status_changed"+
+			"&error_contains=timeout&created_from=2026-02-20T00:00:00Z&cursor=abc",
+		nil,
+	)
+	req.Header.Set("Authorization", "Bearer ops-key")
+	rec := httptest.NewRecorder()
+
+	controller.ListDLQ(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	filter := listUseCase.lastQuery.Filter
+	if filter.DestinationHost != "hooks.example.com" || filter.EventType != "payment_request.status_changed" {
+		t.Fatalf("unexpected filter %+v", filter)
+	}
+	if filter.ErrorContains != "timeout" || filter.CreatedFrom == nil || filter.CreatedTo != nil {
+		t.Fatalf("unexpected filter %+v", filter)
+	}
+	if listUseCase.lastQuery.Cursor != "abc" {
+		t.Fatalf("expected cursor abc, got %+v", listUseCase.lastQuery)
+	}
+}
+
+func TestWebhookOutboxControllerListDLQRejectsInvalidCreatedFrom(t *testing.T) {
+	controller := NewWebhookOutboxController(
+		stubOverviewUseCase{},
+		stubListDLQUseCase{},
+		stubRequeueDLQUseCase{},
+		stubCancelEventUseCase{},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/webhook-outbox/dlq?created_from=yesterday", nil)
+	req.Header.Set("Authorization", "Bearer ops-key")
+	rec := httptest.NewRecorder()
+
+	controller.ListDLQ(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestWebhookOutboxControllerBulkRequeueDLQEvents(t *testing.T) {
+	bulkRequeueUseCase := &stubBulkRequeueCaptureUseCase{}
+	controller := NewWebhookOutboxController(
+		stubOverviewUseCase{},
+		stubListDLQUseCase{},
+		stubRequeueDLQUseCase{},
+		stubCancelEventUseCase{},
+		bulkRequeueUseCase,
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/v1/webhook-outbox/dlq/bulk-requeue",
+		bytes.NewBufferString(`{"filter":{"destination_host":"hooks.example.com"},"max_events":500}`),
+	)
+	req.Header.Set("Authorization", "Bearer ops-key")
+	req.Header.Set("X-Principal-ID", "ops-user-1")
+	rec := httptest.NewRecorder()
+
+	controller.BulkRequeueDLQEvents(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	command := bulkRequeueUseCase.lastCommand
+	if command.Selection.Filter.DestinationHost != "hooks.example.com" || command.MaxEvents != 500 {
+		t.Fatalf("unexpected command %+v", command)
+	}
+	if command.OperatorID != "ops-user-1" {
+		t.Fatalf("expected operator id ops-user-1, got %+v", command)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte(`"updated_count":2`)) {
+		t.Fatalf("expected updated_count in response, got %s", rec.Body.String())
+	}
+}
+
+func TestWebhookOutboxControllerBulkCancelRejectsUnknownField(t *testing.T) {
+	controller := NewWebhookOutboxController(
+		stubOverviewUseCase{},
+		stubListDLQUseCase{},
+		stubRequeueDLQUseCase{},
+		stubCancelEventUseCase{},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
+		log.New(io.Discard, "", 0),
+	)
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/v1/webhook-outbox/events/bulk-cancel",
+		bytes.NewBufferString(`{"event_ids":["evt_1"],"status":"failed"}`),
+	)
+	req.Header.Set("Authorization", "Bearer ops-key")
+	req.Header.Set("X-Principal-ID", "ops-user-1")
+	rec := httptest.NewRecorder()
+
+	controller.BulkCancelEvents(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d body=%s", rec.Code, rec.Body.String())
+	}
+}
+
 func TestWebhookOutboxControllerRejectsUnauthorized(t *testing.T) {
 	controller := NewWebhookOutboxController(
 		stubOverviewUseCase{},
 		stubListDLQUseCase{},
 		stubRequeueDLQUseCase{},
 		stubCancelEventUseCase{},
-		[]string{"ops-key"},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
+		newTestOpsAuth("ops-key"),
 		log.New(io.Discard, "", 0),
 	)
 
@@ -214,6 +411,8 @@ func TestWebhookOutboxControllerRejectsWhenAdminKeysNotConfigured(t *testing.T)
 		stubListDLQUseCase{},
 		stubRequeueDLQUseCase{},
 		stubCancelEventUseCase{},
+		stubBulkRequeueUseCase{},
+		stubBulkCancelUseCase{},
 		nil,
 		log.New(io.Discard, "", 0),
 	)
@@ -229,14 +428,22 @@ func TestWebhookOutboxControllerRejectsWhenAdminKeysNotConfigured(t *testing.T)
 
 type stubOverviewUseCase struct{}
 
-func (stubOverviewUseCase) Execute(_ context.Context, _ dto.GetWebhookOutboxOverviewQuery) (dto.WebhookOutboxOverview, *apperrors.AppError) {
-	return dto.WebhookOutboxOverview{
+func (stubOverviewUseCase) Execute(_ context.Context, query dto.GetWebhookOutboxOverviewQuery) (dto.WebhookOutboxOverview, *apperrors.AppError) {
+	output := dto.WebhookOutboxOverview{
 		PendingCount:      2,
 		PendingReadyCount: 1,
 		RetryingCount:     1,
 		FailedCount:       3,
 		DeliveredCount:    5,
-	}, nil
+	}
+	if query.GroupBy == dto.WebhookOutboxOverviewGroupByDestination {
+		windowSeconds := int64(query.Window / time.Second)
+		output.WindowSeconds = &windowSeconds
+		output.Destinations = []dto.WebhookOutboxDestinationOverview{
+			{Sink: "webhook", DestinationHost: "merchant.example", PendingCount: 2, FailedCount: 3},
+		}
+	}
+	return output, nil
 }
 
 type stubListDLQUseCase struct{}
@@ -284,3 +491,48 @@ func (s *stubCancelEventCaptureUseCase) Execute(_ context.Context, command dto.C
 		UpdatedAt:      time.Now().UTC(),
 	}, nil
 }
+
+type stubListDLQCaptureUseCase struct {
+	lastQuery dto.ListWebhookDLQEventsQuery
+}
+
+func (s *stubListDLQCaptureUseCase) Execute(_ context.Context, query dto.ListWebhookDLQEventsQuery) (dto.ListWebhookDLQEventsOutput, *apperrors.AppError) {
+	s.lastQuery = query
+	return dto.ListWebhookDLQEventsOutput{Events: []dto.WebhookDLQEvent{}}, nil
+}
+
+type stubBulkRequeueUseCase struct{}
+
+func (stubBulkRequeueUseCase) Execute(_ context.Context, _ dto.BulkRequeueWebhookDLQEventsCommand) (dto.WebhookOutboxBulkMutationOutput, *apperrors.AppError) {
+	return dto.WebhookOutboxBulkMutationOutput{
+		Action:         "requeue",
+		UpdatedCount:   2,
+		BatchCount:     1,
+		EventIDs:       []string{"evt_1", "evt_2"},
+		DeliveryStatus: "pending",
+		UpdatedAt:      time.Now().UTC(),
+	}, nil
+}
+
+type stubBulkRequeueCaptureUseCase struct {
+	lastCommand dto.BulkRequeueWebhookDLQEventsCommand
+}
+
+func (s *stubBulkRequeueCaptureUseCase) Execute(_ context.Context, command dto.BulkRequeueWebhookDLQEventsCommand) (dto.WebhookOutboxBulkMutationOutput, *apperrors.AppError) {
+	s.lastCommand = command
+	return stubBulkRequeueUseCase{}.Execute(context.Background(), command)
+}
+
+type stubBulkCancelUseCase struct{}
+
+func (stubBulkCancelUseCase) Execute(_ context.Context, _ dto.BulkCancelWebhookOutboxEventsCommand) (dto.WebhookOutboxBulkMutationOutput, *apperrors.AppError) {
+	return dto.WebhookOutboxBulkMutationOutput{
+		Action:         "cancel",
+		UpdatedCount:   1,
+		BatchCount:     1,
+		EventIDs:       []string{"evt_1"},
+		DeliveryStatus: "failed",
+		LastError:      "manual_cancelled",
+		UpdatedAt:      time.Now().UTC(),
+	}, nil
+}
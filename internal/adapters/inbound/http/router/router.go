@@ -4,14 +4,23 @@ import (
 	"net/http"
 
 	"chaintx/internal/adapters/inbound/http/controllers"
+	"chaintx/internal/domain/policies"
 )
 
 type Dependencies struct {
-	HealthController          *controllers.HealthController
-	SwaggerController         *controllers.SwaggerController
-	AssetsController          *controllers.AssetsController
-	PaymentRequestsController *controllers.PaymentRequestsController
-	WebhookOutboxController   *controllers.WebhookOutboxController
+	HealthController                 *controllers.HealthController
+	SwaggerController                *controllers.SwaggerController
+	AssetsController                 *controllers.AssetsController
+	PaymentRequestsController        *controllers.PaymentRequestsController
+	PaymentRequestEventsController   *controllers.PaymentRequestEventsController
+	PaymentRequestCheckoutController *controllers.PaymentRequestCheckoutController
+	WebhookOutboxController          *controllers.WebhookOutboxController
+	WebhookEndpointsController       *controllers.WebhookEndpointsController
+	WebhookDestinationsController    *controllers.WebhookDestinationsController
+	MerchantAPIKeysController        *controllers.MerchantAPIKeysController
+	AdminAuditLogController          *controllers.AdminAuditLogController
+	PaymentRequestLimitsController   *controllers.PaymentRequestLimitsController
+	MerchantAuth                     *controllers.MerchantAuth
 }
 
 func New(deps Dependencies) *http.ServeMux {
@@ -21,14 +30,53 @@ func New(deps Dependencies) *http.ServeMux {
 	mux.HandleFunc("GET /swagger", deps.SwaggerController.RedirectToIndex)
 	mux.HandleFunc("GET /swagger/openapi.yaml", deps.SwaggerController.GetOpenAPISpec)
 	mux.HandleFunc("GET /swagger/", deps.SwaggerController.ServeUI)
-	mux.HandleFunc("GET /v1/assets", deps.AssetsController.ListAssets)
-	mux.HandleFunc("POST /v1/payment-requests", deps.PaymentRequestsController.CreatePaymentRequest)
-	mux.HandleFunc("GET /v1/payment-requests/{id}", deps.PaymentRequestsController.GetPaymentRequest)
-	mux.HandleFunc("GET /v1/payment-requests/{id}/settlements", deps.PaymentRequestsController.GetPaymentRequestSettlements)
+	mux.HandleFunc("GET /v1/assets", deps.MerchantAuth.Require(
+		policies.MerchantAPIKeyScopeRead,
+		deps.AssetsController.ListAssets,
+	))
+	mux.HandleFunc("POST /v1/payment-requests", deps.MerchantAuth.Require(
+		policies.MerchantAPIKeyScopeCreate,
+		deps.PaymentRequestLimitsController.RateLimit(deps.PaymentRequestsController.CreatePaymentRequest),
+	))
+	mux.HandleFunc("GET /v1/payment-requests/{id}", deps.MerchantAuth.Require(
+		policies.MerchantAPIKeyScopeRead,
+		deps.PaymentRequestsController.GetPaymentRequest,
+	))
+	mux.HandleFunc("GET /v1/payment-requests/{id}/settlements", deps.MerchantAuth.Require(
+		policies.MerchantAPIKeyScopeRead,
+		deps.PaymentRequestsController.GetPaymentRequestSettlements,
+	))
+	mux.HandleFunc("GET /v1/payment-requests/{id}/events", deps.MerchantAuth.Require(
+		policies.MerchantAPIKeyScopeRead,
+		deps.PaymentRequestEventsController.StreamPaymentRequestEvents,
+	))
+	mux.HandleFunc("GET /v1/payment-requests/{id}/qr.png", deps.MerchantAuth.Require(
+		policies.MerchantAPIKeyScopeRead,
+		deps.PaymentRequestCheckoutController.GetQRCodePNG,
+	))
+	mux.HandleFunc("GET /v1/payment-requests/{id}/qr.svg", deps.MerchantAuth.Require(
+		policies.MerchantAPIKeyScopeRead,
+		deps.PaymentRequestCheckoutController.GetQRCodeSVG,
+	))
+	mux.HandleFunc("GET /pay/{id}", deps.PaymentRequestCheckoutController.GetCheckoutPage)
 	mux.HandleFunc("GET /v1/webhook-outbox/overview", deps.WebhookOutboxController.GetOverview)
 	mux.HandleFunc("GET /v1/webhook-outbox/dlq", deps.WebhookOutboxController.ListDLQ)
 	mux.HandleFunc("POST /v1/webhook-outbox/dlq/{event_id}/requeue", deps.WebhookOutboxController.RequeueDLQEvent)
+	mux.HandleFunc("POST /v1/webhook-outbox/dlq/bulk-requeue", deps.WebhookOutboxController.BulkRequeueDLQEvents)
 	mux.HandleFunc("POST /v1/webhook-outbox/events/{event_id}/cancel", deps.WebhookOutboxController.CancelEvent)
+	mux.HandleFunc("POST /v1/webhook-outbox/events/bulk-cancel", deps.WebhookOutboxController.BulkCancelEvents)
+	mux.HandleFunc("POST /v1/webhook-endpoints/test", deps.MerchantAuth.RequireOrOpsKey(
+		policies.MerchantAPIKeyScopeCreate,
+		deps.WebhookEndpointsController.TestWebhookEndpoint,
+	))
+	mux.HandleFunc("PUT /v1/webhook-destinations/{destination_host}/retry-policy", deps.WebhookDestinationsController.PutRetryPolicy)
+	mux.HandleFunc("GET /v1/webhook-destinations/{destination_host}/retry-policy", deps.WebhookDestinationsController.GetRetryPolicy)
+	mux.HandleFunc("DELETE /v1/webhook-destinations/{destination_host}/retry-policy", deps.WebhookDestinationsController.DeleteRetryPolicy)
+	mux.HandleFunc("POST /v1/merchant-api-keys", deps.MerchantAPIKeysController.CreateKey)
+	mux.HandleFunc("GET /v1/merchant-api-keys", deps.MerchantAPIKeysController.ListKeys)
+	mux.HandleFunc("POST /v1/merchant-api-keys/{key_id}/revoke", deps.MerchantAPIKeysController.RevokeKey)
+	mux.HandleFunc("GET /v1/admin-audit-log", deps.AdminAuditLogController.ListEntries)
+	mux.HandleFunc("GET /v1/payment-request-limits/overview", deps.PaymentRequestLimitsController.GetOverview)
 
 	return mux
 }
@@ -169,6 +169,26 @@ func TestRouterHealthAndSwaggerRoutes(t *testing.T) {
 			t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
 		}
 	})
+
+	t.Run("webhook outbox bulk requeue route returns 200", func(t *testing.T) {
+		req := httptest.NewRequest(
+			http.MethodPost,
+			"/v1/webhook-outbox/dlq/bulk-requeue",
+			bytes.NewBufferString(`{"event_ids":["evt_1","evt_2"]}`),
+		)
+		req.Header.Set("Authorization", "Bearer ops-key")
+		req.Header.Set("X-Principal-ID", "ops-test")
+		rec := httptest.NewRecorder()
+
+		mux.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d body=%s", rec.Code, rec.Body.String())
+		}
+		if !strings.Contains(rec.Body.String(), `"updated_count":2`) {
+			t.Fatalf("expected updated_count in body, got %s", rec.Body.String())
+		}
+	})
 }
 
 func TestRouterHealthzRejectsNonGET(t *testing.T) {
@@ -204,7 +224,9 @@ func newTestRouter(openAPISpecPath string) *http.ServeMux {
 		stubListWebhookDLQEventsUseCase{},
 		stubRequeueWebhookDLQEventUseCase{},
 		stubCancelWebhookOutboxEventUseCase{},
-		[]string{"ops-key"},
+		stubBulkRequeueWebhookDLQEventsUseCase{},
+		stubBulkCancelWebhookOutboxEventsUseCase{},
+		controllers.NewOpsAuth(nil, []string{"ops-key"}, nil, nil, nil),
 		logger,
 	)
 
@@ -335,3 +357,28 @@ func (stubCancelWebhookOutboxEventUseCase) Execute(_ context.Context, command dt
 		LastError:      "manual_cancelled",
 	}, nil
 }
+
+type stubBulkRequeueWebhookDLQEventsUseCase struct{}
+
+func (stubBulkRequeueWebhookDLQEventsUseCase) Execute(_ context.Context, command dto.BulkRequeueWebhookDLQEventsCommand) (dto.WebhookOutboxBulkMutationOutput, *apperrors.AppError) {
+	return dto.WebhookOutboxBulkMutationOutput{
+		Action:         "requeue",
+		UpdatedCount:   len(command.Selection.EventIDs),
+		BatchCount:     1,
+		EventIDs:       command.Selection.EventIDs,
+		DeliveryStatus: "pending",
+	}, nil
+}
+
+type stubBulkCancelWebhookOutboxEventsUseCase struct{}
+
+func (stubBulkCancelWebhookOutboxEventsUseCase) Execute(_ context.Context, command dto.BulkCancelWebhookOutboxEventsCommand) (dto.WebhookOutboxBulkMutationOutput, *apperrors.AppError) {
+	return dto.WebhookOutboxBulkMutationOutput{
+		Action:         "cancel",
+		UpdatedCount:   len(command.Selection.EventIDs),
+		BatchCount:     1,
+		EventIDs:       command.Selection.EventIDs,
+		DeliveryStatus: "failed",
+		LastError:      "manual_cancelled",
+	}, nil
+}
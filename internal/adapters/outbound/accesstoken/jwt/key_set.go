@@ -0,0 +1,108 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+)
+
+// minRSAKeyBits rejects weak RSA keys published in a JWKS.
+const minRSAKeyBits = 2048
+
+type verificationKey struct {
+	kid       string
+	algorithm string
+	rsaKey    *rsa.PublicKey
+	ecdsaKey  *ecdsa.PublicKey
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseKeySet keeps the RSA and P-256 signing keys of a JWKS document. Keys
+// of other types, curves or uses are skipped so a provider can publish them
+// alongside; a set without any usable key is an error.
+func parseKeySet(raw []byte) ([]verificationKey, error) {
+	var document jsonWebKeySet
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return nil, errors.New("jwks is not valid JSON")
+	}
+
+	keys := make([]verificationKey, 0, len(document.Keys))
+	for _, webKey := range document.Keys {
+		if webKey.Use != "" && webKey.Use != "sig" {
+			continue
+		}
+		key, ok := parseVerificationKey(webKey)
+		if !ok {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jwks has no usable RS256 or ES256 signing key")
+	}
+	return keys, nil
+}
+
+func parseVerificationKey(webKey jsonWebKey) (verificationKey, bool) {
+	switch webKey.Kty {
+	case "RSA":
+		if webKey.Alg != "" && webKey.Alg != algorithmRS256 {
+			return verificationKey{}, false
+		}
+		modulus, err := base64.RawURLEncoding.DecodeString(webKey.N)
+		if err != nil {
+			return verificationKey{}, false
+		}
+		exponent, err := base64.RawURLEncoding.DecodeString(webKey.E)
+		if err != nil || len(exponent) == 0 || len(exponent) > 4 {
+			return verificationKey{}, false
+		}
+		publicKey := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(modulus),
+			E: int(new(big.Int).SetBytes(exponent).Int64()),
+		}
+		if publicKey.N.BitLen() < minRSAKeyBits || publicKey.E < 3 {
+			return verificationKey{}, false
+		}
+		return verificationKey{kid: webKey.Kid, algorithm: algorithmRS256, rsaKey: publicKey}, true
+	case "EC":
+		if webKey.Crv != "P-256" || (webKey.Alg != "" && webKey.Alg != algorithmES256) {
+			return verificationKey{}, false
+		}
+		x, err := base64.RawURLEncoding.DecodeString(webKey.X)
+		if err != nil || len(x) != 32 {
+			return verificationKey{}, false
+		}
+		y, err := base64.RawURLEncoding.DecodeString(webKey.Y)
+		if err != nil || len(y) != 32 {
+			return verificationKey{}, false
+		}
+		uncompressed := append(append([]byte{4}, x...), y...)
+		publicKey, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), uncompressed)
+		if err != nil {
+			return verificationKey{}, false
+		}
+		return verificationKey{kid: webKey.Kid, algorithm: algorithmES256, ecdsaKey: publicKey}, true
+	default:
+		return verificationKey{}, false
+	}
+}
@@ -0,0 +1,318 @@
+package jwt
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	nethttp "net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	algorithmRS256 = "RS256"
+	algorithmES256 = "ES256"
+
+	defaultJWKSCacheTTL   = 5 * time.Minute
+	defaultJWKSTimeout    = 5 * time.Second
+	maxJWKSResponseBytes  = 1 << 20
+	jwksMinRefreshBackoff = 30 * time.Second
+)
+
+type Config struct {
+	JWKSURL  string
+	JWKSFile string
+	Issuer   string
+	Audience string
+	Leeway   time.Duration
+	CacheTTL time.Duration
+	Timeout  time.Duration
+}
+
+// Verifier checks RS256 and ES256 bearer JWTs against a JWKS fetched from a
+// URL or read from a local file. Keys are cached for CacheTTL; a token signed
+// with an unknown kid triggers an early refresh, at most once per
+// jwksMinRefreshBackoff so forged kids cannot hammer the key source.
+type Verifier struct {
+	jwksURL  string
+	jwksFile string
+	issuer   string
+	audience string
+	leeway   time.Duration
+	cacheTTL time.Duration
+	client   *nethttp.Client
+
+	mu            sync.Mutex
+	keys          []verificationKey
+	loadedAt      time.Time
+	lastAttemptAt time.Time
+	lastErr       *apperrors.AppError
+}
+
+var _ portsout.AccessTokenVerifier = (*Verifier)(nil)
+
+type tokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+func NewVerifier(cfg Config) *Verifier {
+	leeway := cfg.Leeway
+	if leeway < 0 {
+		leeway = 0
+	}
+	cacheTTL := cfg.CacheTTL
+	if cacheTTL <= 0 {
+		cacheTTL = defaultJWKSCacheTTL
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultJWKSTimeout
+	}
+
+	return &Verifier{
+		jwksURL:  strings.TrimSpace(cfg.JWKSURL),
+		jwksFile: strings.TrimSpace(cfg.JWKSFile),
+		issuer:   strings.TrimSpace(cfg.Issuer),
+		audience: strings.TrimSpace(cfg.Audience),
+		leeway:   leeway,
+		cacheTTL: cacheTTL,
+		client:   &nethttp.Client{Timeout: timeout},
+	}
+}
+
+// Verify rejects every malformed, unsigned, mis-signed, expired or foreign
+// token with the same error so callers cannot probe which check failed.
+func (v *Verifier) Verify(ctx context.Context, token string, now time.Time) (dto.AccessTokenClaims, *apperrors.AppError) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return dto.AccessTokenClaims{}, accessTokenInvalid()
+	}
+
+	var header tokenHeader
+	if !decodeSegment(parts[0], &header) {
+		return dto.AccessTokenClaims{}, accessTokenInvalid()
+	}
+	if header.Alg != algorithmRS256 && header.Alg != algorithmES256 {
+		return dto.AccessTokenClaims{}, accessTokenInvalid()
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return dto.AccessTokenClaims{}, accessTokenInvalid()
+	}
+
+	candidates, appErr := v.signingKeys(ctx, header, now)
+	if appErr != nil {
+		return dto.AccessTokenClaims{}, appErr
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	verified := false
+	for _, key := range candidates {
+		if verifySignature(key, digest[:], signature) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return dto.AccessTokenClaims{}, accessTokenInvalid()
+	}
+
+	claims := map[string]any{}
+	if !decodeSegment(parts[1], &claims) {
+		return dto.AccessTokenClaims{}, accessTokenInvalid()
+	}
+	if !v.claimsValid(claims, now) {
+		return dto.AccessTokenClaims{}, accessTokenInvalid()
+	}
+
+	subject, _ := claims["sub"].(string)
+	return dto.AccessTokenClaims{
+		Subject: subject,
+		Claims:  claims,
+	}, nil
+}
+
+func (v *Verifier) claimsValid(claims map[string]any, now time.Time) bool {
+	expiresAt, ok := numericDateClaim(claims, "exp")
+	if !ok || !now.Before(expiresAt.Add(v.leeway)) {
+		return false
+	}
+	if _, exists := claims["nbf"]; exists {
+		notBefore, ok := numericDateClaim(claims, "nbf")
+		if !ok || now.Add(v.leeway).Before(notBefore) {
+			return false
+		}
+	}
+	if v.issuer != "" {
+		issuer, _ := claims["iss"].(string)
+		if issuer != v.issuer {
+			return false
+		}
+	}
+	if v.audience != "" && !audienceContains(claims["aud"], v.audience) {
+		return false
+	}
+	return true
+}
+
+// signingKeys returns the cached keys that may have signed a token with
+// header, refreshing the cache when it is stale or the kid is unknown. A
+// failed refresh keeps serving the previous keys, and refreshes are spaced by
+// jwksMinRefreshBackoff so an unreachable key source is not retried on every
+// request.
+func (v *Verifier) signingKeys(ctx context.Context, header tokenHeader, now time.Time) ([]verificationKey, *apperrors.AppError) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	stale := v.keys == nil || now.Sub(v.loadedAt) >= v.cacheTTL
+	if stale && v.canRefreshLocked(now) {
+		v.refreshLocked(ctx, now)
+	}
+	candidates := matchingKeys(v.keys, header)
+	if len(candidates) == 0 && v.keys != nil && v.canRefreshLocked(now) {
+		v.refreshLocked(ctx, now)
+		candidates = matchingKeys(v.keys, header)
+	}
+	if v.keys == nil {
+		return nil, v.lastErr
+	}
+	return candidates, nil
+}
+
+func (v *Verifier) canRefreshLocked(now time.Time) bool {
+	return v.lastAttemptAt.IsZero() || now.Sub(v.lastAttemptAt) >= jwksMinRefreshBackoff
+}
+
+func (v *Verifier) refreshLocked(ctx context.Context, now time.Time) {
+	v.lastAttemptAt = now
+	raw, source, err := v.readKeySet(ctx)
+	if err == nil {
+		var keys []verificationKey
+		keys, err = parseKeySet(raw)
+		if err == nil {
+			v.keys = keys
+			v.loadedAt = now
+			v.lastErr = nil
+			return
+		}
+	}
+	v.lastErr = apperrors.NewInternal(
+		"access_token_jwks_unavailable",
+		"access token signing keys could not be loaded",
+		map[string]any{"source": source, "error": err.Error()},
+	)
+}
+
+func (v *Verifier) readKeySet(ctx context.Context) ([]byte, string, error) {
+	if v.jwksFile != "" {
+		raw, err := os.ReadFile(v.jwksFile)
+		return raw, "file", err
+	}
+
+	request, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, v.jwksURL, nil)
+	if err != nil {
+		return nil, "url", err
+	}
+	request.Header.Set("Accept", "application/json")
+	response, err := v.client.Do(request)
+	if err != nil {
+		return nil, "url", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != nethttp.StatusOK {
+		return nil, "url", fmt.Errorf("jwks endpoint returned status %d", response.StatusCode)
+	}
+	raw, err := io.ReadAll(io.LimitReader(response.Body, maxJWKSResponseBytes))
+	return raw, "url", err
+}
+
+// matchingKeys selects keys by kid. A token without a kid may be signed by
+// any key of its algorithm, which covers providers that publish a single key.
+func matchingKeys(keys []verificationKey, header tokenHeader) []verificationKey {
+	matches := []verificationKey{}
+	for _, key := range keys {
+		if key.algorithm != header.Alg {
+			continue
+		}
+		if header.Kid != "" && key.kid != header.Kid {
+			continue
+		}
+		matches = append(matches, key)
+	}
+	return matches
+}
+
+func verifySignature(key verificationKey, digest []byte, signature []byte) bool {
+	switch {
+	case key.rsaKey != nil:
+		return rsa.VerifyPKCS1v15(key.rsaKey, crypto.SHA256, digest, signature) == nil
+	case key.ecdsaKey != nil:
+		// JWS encodes ES256 signatures as the fixed-width r || s, not ASN.1.
+		if len(signature) != 64 {
+			return false
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		return ecdsa.Verify(key.ecdsaKey, digest, r, s)
+	default:
+		return false
+	}
+}
+
+func decodeSegment(segment string, target any) bool {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return false
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	return decoder.Decode(target) == nil
+}
+
+func numericDateClaim(claims map[string]any, name string) (time.Time, bool) {
+	number, ok := claims[name].(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(seconds), 0).UTC(), true
+}
+
+func audienceContains(raw any, audience string) bool {
+	switch value := raw.(type) {
+	case string:
+		return value == audience
+	case []any:
+		for _, item := range value {
+			if item == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func accessTokenInvalid() *apperrors.AppError {
+	return apperrors.NewValidation(
+		"unauthorized",
+		"access token is invalid or expired",
+		nil,
+	)
+}
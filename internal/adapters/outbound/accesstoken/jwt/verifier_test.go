@@ -0,0 +1,343 @@
+//go:build !integration
+
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer   = "https://idp.example.com/"
+	testAudience = "chaintx"
+)
+
+var testNow = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func TestVerifierAcceptsRS256AndES256FromFile(t *testing.T) {
+	rsaKey := newTestRSAKey(t)
+	ecKey := newTestECKey(t)
+	verifier := NewVerifier(Config{
+		JWKSFile: writeTestJWKS(t, rsaJWK("rsa-1", &rsaKey.PublicKey), ecJWK("ec-1", &ecKey.PublicKey)),
+		Issuer:   testIssuer,
+		Audience: testAudience,
+		Leeway:   time.Minute,
+	})
+
+	for name, token := range map[string]string{
+		"rs256": signRS256(t, rsaKey, "rsa-1", validTestClaims()),
+		"es256": signES256(t, ecKey, "ec-1", validTestClaims()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			claims, appErr := verifier.Verify(context.Background(), token, testNow)
+			if appErr != nil {
+				t.Fatalf("expected token to verify, got %+v", appErr)
+			}
+			if claims.Subject != "user-1" || claims.Claims["merchant_id"] != "retail" {
+				t.Fatalf("unexpected claims: %+v", claims)
+			}
+		})
+	}
+}
+
+func TestVerifierRejectsInvalidTokens(t *testing.T) {
+	rsaKey := newTestRSAKey(t)
+	otherKey := newTestRSAKey(t)
+	verifier := NewVerifier(Config{
+		JWKSFile: writeTestJWKS(t, rsaJWK("rsa-1", &rsaKey.PublicKey)),
+		Issuer:   testIssuer,
+		Audience: testAudience,
+		Leeway:   time.Minute,
+	})
+
+	withClaim := func(name string, value any) map[string]any {
+		claims := validTestClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+	valid := signRS256(t, rsaKey, "rsa-1", validTestClaims())
+
+	testCases := map[string]string{
+		"malformed":          "not-a-jwt",
+		"alg none":           encodeTestSegment(t, map[string]any{"alg": "none"}) + "." + encodeTestSegment(t, validTestClaims()) + ".",
+		"hs256":              encodeTestSegment(t, map[string]any{"alg": "HS256", "kid": "rsa-1"}) + "." + encodeTestSegment(t, validTestClaims()) + ".c2ln",
+		"wrong key":          signRS256(t, otherKey, "rsa-1", validTestClaims()),
+		"unknown kid":        signRS256(t, rsaKey, "rsa-2", validTestClaims()),
+		"tampered signature": valid[:len(valid)-4] + "AAAA",
+		"expired":            signRS256(t, rsaKey, "rsa-1", withClaim("exp", testNow.Add(-2*time.Minute).Unix())),
+		"missing exp":        signRS256(t, rsaKey, "rsa-1", withClaim("exp", nil)),
+		"not yet valid":      signRS256(t, rsaKey, "rsa-1", withClaim("nbf", testNow.Add(5*time.Minute).Unix())),
+		"wrong issuer":       signRS256(t, rsaKey, "rsa-1", withClaim("iss", "https://evil.example.com/")),
+		"wrong audience":     signRS256(t, rsaKey, "rsa-1", withClaim("aud", "other")),
+		"audience list off":  signRS256(t, rsaKey, "rsa-1", withClaim("aud", []string{"other", "another"})),
+	}
+
+	for name, token := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, appErr := verifier.Verify(context.Background(), token, testNow)
+			if appErr == nil {
+				t.Fatalf("expected token to be rejected")
+			}
+			if appErr.Code != "unauthorized" {
+				t.Fatalf("expected unauthorized, got %s", appErr.Code)
+			}
+		})
+	}
+}
+
+func TestVerifierAcceptsAudienceListAndLeeway(t *testing.T) {
+	rsaKey := newTestRSAKey(t)
+	verifier := NewVerifier(Config{
+		JWKSFile: writeTestJWKS(t, rsaJWK("rsa-1", &rsaKey.PublicKey)),
+		Issuer:   testIssuer,
+		Audience: testAudience,
+		Leeway:   time.Minute,
+	})
+
+	claims := validTestClaims()
+	claims["aud"] = []string{"other", testAudience}
+	claims["exp"] = testNow.Add(-30 * time.Second).Unix()
+	if _, appErr := verifier.Verify(context.Background(), signRS256(t, rsaKey, "", claims), testNow); appErr != nil {
+		t.Fatalf("expected token within leeway and without kid to verify, got %+v", appErr)
+	}
+}
+
+func TestVerifierFetchesJWKSFromURLAndRefreshesOnUnknownKid(t *testing.T) {
+	oldKey := newTestRSAKey(t)
+	newKey := newTestRSAKey(t)
+	var fetches atomic.Int32
+	var document atomic.Value
+	document.Store(jwksDocument(t, rsaJWK("old", &oldKey.PublicKey)))
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		fetches.Add(1)
+		_, _ = w.Write(document.Load().([]byte))
+	}))
+	defer server.Close()
+
+	verifier := NewVerifier(Config{
+		JWKSURL:  server.URL,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	})
+
+	if _, appErr := verifier.Verify(context.Background(), signRS256(t, oldKey, "old", validTestClaims()), testNow); appErr != nil {
+		t.Fatalf("expected old key to verify, got %+v", appErr)
+	}
+	if _, appErr := verifier.Verify(context.Background(), signRS256(t, oldKey, "old", validTestClaims()), testNow.Add(time.Second)); appErr != nil {
+		t.Fatalf("expected cached key to verify, got %+v", appErr)
+	}
+	if fetches.Load() != 1 {
+		t.Fatalf("expected one fetch while cached, got %d", fetches.Load())
+	}
+
+	document.Store(jwksDocument(t, rsaJWK("old", &oldKey.PublicKey), rsaJWK("new", &newKey.PublicKey)))
+	rotated := signRS256(t, newKey, "new", validTestClaims())
+	if _, appErr := verifier.Verify(context.Background(), rotated, testNow.Add(10*time.Second)); appErr == nil {
+		t.Fatalf("expected unknown kid to be rejected inside the refresh backoff")
+	}
+	if _, appErr := verifier.Verify(context.Background(), rotated, testNow.Add(time.Minute)); appErr != nil {
+		t.Fatalf("expected rotated key to verify after refresh, got %+v", appErr)
+	}
+	if fetches.Load() != 2 {
+		t.Fatalf("expected a refetch for the unknown kid, got %d fetches", fetches.Load())
+	}
+}
+
+func TestVerifierKeepsCachedKeysWhenRefreshFails(t *testing.T) {
+	rsaKey := newTestRSAKey(t)
+	var failing atomic.Bool
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		if failing.Load() {
+			w.WriteHeader(nethttp.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write(jwksDocument(t, rsaJWK("rsa-1", &rsaKey.PublicKey)))
+	}))
+	defer server.Close()
+
+	verifier := NewVerifier(Config{
+		JWKSURL:  server.URL,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+		CacheTTL: time.Minute,
+	})
+	token := signRS256(t, rsaKey, "rsa-1", validTestClaims())
+	if _, appErr := verifier.Verify(context.Background(), token, testNow); appErr != nil {
+		t.Fatalf("expected token to verify, got %+v", appErr)
+	}
+
+	failing.Store(true)
+	if _, appErr := verifier.Verify(context.Background(), token, testNow.Add(2*time.Minute)); appErr != nil {
+		t.Fatalf("expected stale cached key to verify after failed refresh, got %+v", appErr)
+	}
+}
+
+func TestVerifierReportsUnavailableKeySource(t *testing.T) {
+	verifier := NewVerifier(Config{JWKSFile: filepath.Join(t.TempDir(), "missing.json")})
+
+	rsaKey := newTestRSAKey(t)
+	_, appErr := verifier.Verify(context.Background(), signRS256(t, rsaKey, "rsa-1", validTestClaims()), testNow)
+	if appErr == nil || appErr.Code != "access_token_jwks_unavailable" {
+		t.Fatalf("expected access_token_jwks_unavailable, got %+v", appErr)
+	}
+}
+
+func TestParseKeySetSkipsUnusableKeys(t *testing.T) {
+	rsaKey := newTestRSAKey(t)
+	weakKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generate weak key: %v", err)
+	}
+	encryptionKey := rsaJWK("enc", &rsaKey.PublicKey)
+	encryptionKey["use"] = "enc"
+
+	keys, parseErr := parseKeySet(jwksDocument(t,
+		rsaJWK("sig", &rsaKey.PublicKey),
+		rsaJWK("weak", &weakKey.PublicKey),
+		encryptionKey,
+		map[string]any{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"},
+	))
+	if parseErr != nil {
+		t.Fatalf("expected key set to parse, got %v", parseErr)
+	}
+	if len(keys) != 1 || keys[0].kid != "sig" {
+		t.Fatalf("expected only the strong signing key, got %+v", keys)
+	}
+
+	if _, parseErr := parseKeySet(jwksDocument(t, encryptionKey)); parseErr == nil {
+		t.Fatalf("expected a key set without signing keys to fail")
+	}
+}
+
+func validTestClaims() map[string]any {
+	return map[string]any{
+		"iss":         testIssuer,
+		"aud":         testAudience,
+		"sub":         "user-1",
+		"merchant_id": "retail",
+		"exp":         testNow.Add(10 * time.Minute).Unix(),
+		"iat":         testNow.Add(-time.Minute).Unix(),
+	}
+}
+
+func newTestRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	return key
+}
+
+func newTestECKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ec key: %v", err)
+	}
+	return key
+}
+
+func rsaJWK(kid string, key *rsa.PublicKey) map[string]any {
+	return map[string]any{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"alg": "RS256",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PublicKey) map[string]any {
+	uncompressed, err := key.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	return map[string]any{
+		"kty": "EC",
+		"kid": kid,
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(uncompressed[1:33]),
+		"y":   base64.RawURLEncoding.EncodeToString(uncompressed[33:]),
+	}
+}
+
+func jwksDocument(t *testing.T, keys ...map[string]any) []byte {
+	t.Helper()
+	raw, err := json.Marshal(map[string]any{"keys": keys})
+	if err != nil {
+		t.Fatalf("marshal jwks: %v", err)
+	}
+	return raw
+}
+
+func writeTestJWKS(t *testing.T, keys ...map[string]any) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, jwksDocument(t, keys...), 0o600); err != nil {
+		t.Fatalf("write jwks: %v", err)
+	}
+	return path
+}
+
+func encodeTestSegment(t *testing.T, value any) string {
+	t.Helper()
+	raw, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("marshal segment: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func testSigningInput(t *testing.T, alg string, kid string, claims map[string]any) string {
+	header := map[string]any{"alg": alg, "typ": "JWT"}
+	if kid != "" {
+		header["kid"] = kid
+	}
+	return encodeTestSegment(t, header) + "." + encodeTestSegment(t, claims)
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+	signingInput := testSigningInput(t, "RS256", kid, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("sign rs256: %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func signES256(t *testing.T, key *ecdsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+	signingInput := testSigningInput(t, "ES256", kid, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatalf("sign es256: %v", err)
+	}
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
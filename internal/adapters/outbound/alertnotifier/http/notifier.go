@@ -0,0 +1,69 @@
+package http
+
+import (
+	"context"
+	nethttp "net/http"
+	"strings"
+	"time"
+
+	"chaintx/internal/adapters/outbound/alertnotifier"
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const channelName = "http"
+
+type Config struct {
+	URL     string
+	Headers map[string]string
+	Timeout time.Duration
+}
+
+// Notifier posts the alert as a flat JSON document to a generic receiver.
+type Notifier struct {
+	url     string
+	headers map[string]string
+	client  *nethttp.Client
+}
+
+var _ portsout.AlertNotifier = (*Notifier)(nil)
+
+type payload struct {
+	Source     string `json:"source"`
+	Signal     string `json:"signal"`
+	Scope      string `json:"scope,omitempty"`
+	State      string `json:"state"`
+	Severity   string `json:"severity"`
+	Current    int64  `json:"current"`
+	Threshold  int64  `json:"threshold"`
+	OccurredAt string `json:"occurred_at"`
+	DedupKey   string `json:"dedup_key"`
+	Summary    string `json:"summary"`
+}
+
+func NewNotifier(cfg Config) *Notifier {
+	return &Notifier{
+		url:     strings.TrimSpace(cfg.URL),
+		headers: cfg.Headers,
+		client:  alertnotifier.NewHTTPClient(cfg.Timeout),
+	}
+}
+
+func (n *Notifier) NotifyAlert(ctx context.Context, notification dto.AlertNotification) *apperrors.AppError {
+	if validationErr := alertnotifier.ValidateNotification(channelName, notification); validationErr != nil {
+		return validationErr
+	}
+	return alertnotifier.PostJSON(ctx, n.client, channelName, n.url, n.headers, payload{
+		Source:     notification.Source,
+		Signal:     notification.Signal,
+		Scope:      notification.Scope,
+		State:      notification.State,
+		Severity:   notification.Severity,
+		Current:    notification.Current,
+		Threshold:  notification.Threshold,
+		OccurredAt: notification.OccurredAt.UTC().Format(time.RFC3339),
+		DedupKey:   alertnotifier.DedupKey(notification),
+		Summary:    alertnotifier.Summary(notification),
+	})
+}
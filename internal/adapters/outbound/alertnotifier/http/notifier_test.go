@@ -0,0 +1,73 @@
+//go:build !integration
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+)
+
+func TestNotifyAlertPostsFlatPayloadWithHeaders(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer ops-token" {
+			t.Errorf("expected authorization header, got %q", got)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.WriteHeader(nethttp.StatusAccepted)
+	}))
+	defer server.Close()
+
+	notifier := NewNotifier(Config{
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer ops-token"},
+	})
+	appErr := notifier.NotifyAlert(context.Background(), dto.AlertNotification{
+		Source:     "webhook_outbox",
+		Signal:     "failed_count",
+		State:      "triggered",
+		Severity:   "critical",
+		Current:    12,
+		Threshold:  10,
+		OccurredAt: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC),
+	})
+	if appErr != nil {
+		t.Fatalf("expected notify success, got %+v", appErr)
+	}
+	if received["signal"] != "failed_count" || received["state"] != "triggered" || received["current"] != float64(12) {
+		t.Fatalf("unexpected payload %+v", received)
+	}
+	if received["dedup_key"] != "chaintx:webhook_outbox:failed_count" {
+		t.Fatalf("unexpected dedup key %v", received["dedup_key"])
+	}
+	if received["occurred_at"] != "2026-10-19T08:00:00Z" {
+		t.Fatalf("unexpected occurred_at %v", received["occurred_at"])
+	}
+}
+
+func TestNotifyAlertMapsNon2xxToFailure(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		nethttp.Error(w, "nope", nethttp.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	appErr := NewNotifier(Config{URL: server.URL}).NotifyAlert(context.Background(), dto.AlertNotification{
+		Source: "webhook_outbox",
+		Signal: "failed_count",
+		State:  "ongoing",
+	})
+	if appErr == nil || appErr.Code != "alert_notification_failed" {
+		t.Fatalf("expected alert_notification_failed, got %+v", appErr)
+	}
+	if appErr.Details["status_code"] != nethttp.StatusBadGateway {
+		t.Fatalf("expected status_code 502 in details, got %+v", appErr.Details)
+	}
+}
@@ -0,0 +1,122 @@
+package alertnotifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	DefaultTimeout  = 10 * time.Second
+	ContentTypeJSON = "application/json"
+	// maxErrorBodyBytes bounds how much of a rejected response is kept in the
+	// error details.
+	maxErrorBodyBytes = 512
+)
+
+// Summary is the one-line text every channel uses as title or subject.
+func Summary(notification dto.AlertNotification) string {
+	signal := notification.Signal
+	if notification.Scope != "" {
+		signal += "[" + notification.Scope + "]"
+	}
+	return fmt.Sprintf(
+		"[%s] chaintx %s alert %s: %s=%d threshold=%d",
+		strings.ToUpper(notification.Severity),
+		notification.Source,
+		notification.State,
+		signal,
+		notification.Current,
+		notification.Threshold,
+	)
+}
+
+// DedupKey is stable for the lifetime of one alert so channels that group
+// incidents can resolve the one they opened.
+func DedupKey(notification dto.AlertNotification) string {
+	key := "chaintx:" + notification.Source + ":" + notification.Signal
+	if notification.Scope != "" {
+		key += ":" + notification.Scope
+	}
+	return key
+}
+
+func ValidateNotification(channel string, notification dto.AlertNotification) *apperrors.AppError {
+	if notification.Signal == "" || notification.State == "" {
+		return apperrors.NewValidation(
+			"alert_notification_invalid",
+			"alert notification requires signal and state",
+			map[string]any{"channel": channel},
+		)
+	}
+	return nil
+}
+
+// PostJSON sends body to url and treats any 2xx response as delivered.
+func PostJSON(
+	ctx context.Context,
+	client *nethttp.Client,
+	channel string,
+	url string,
+	headers map[string]string,
+	body any,
+) *apperrors.AppError {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return NotifyFailed(channel, err, nil)
+	}
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, url, bytes.NewReader(raw))
+	if err != nil {
+		return NotifyFailed(channel, err, nil)
+	}
+	req.Header.Set("Content-Type", ContentTypeJSON)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return NotifyFailed(channel, err, nil)
+	}
+	defer resp.Body.Close()
+	responseBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return NotifyFailed(
+			channel,
+			fmt.Errorf("unexpected status %d", resp.StatusCode),
+			map[string]any{
+				"status_code": resp.StatusCode,
+				"body":        strings.TrimSpace(string(responseBody)),
+			},
+		)
+	}
+	return nil
+}
+
+// NotifyFailed is the error every notifier returns when a channel does not
+// accept an alert.
+func NotifyFailed(channel string, err error, details map[string]any) *apperrors.AppError {
+	merged := map[string]any{
+		"channel": channel,
+		"error":   err.Error(),
+	}
+	for key, value := range details {
+		merged[key] = value
+	}
+	return apperrors.NewInternal("alert_notification_failed", "alert notification failed", merged)
+}
+
+func NewHTTPClient(timeout time.Duration) *nethttp.Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &nethttp.Client{Timeout: timeout}
+}
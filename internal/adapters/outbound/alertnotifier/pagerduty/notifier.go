@@ -0,0 +1,100 @@
+package pagerduty
+
+import (
+	"context"
+	nethttp "net/http"
+	"strings"
+	"time"
+
+	"chaintx/internal/adapters/outbound/alertnotifier"
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	channelName        = "pagerduty"
+	DefaultEventsURL   = "https://events.pagerduty.com/v2/enqueue"
+	eventActionTrigger = "trigger"
+	eventActionResolve = "resolve"
+)
+
+type Config struct {
+	RoutingKey string
+	// EventsURL overrides the Events API v2 endpoint, e.g. for a local stub.
+	EventsURL string
+	Timeout   time.Duration
+}
+
+// Notifier sends Events API v2 events. Triggered and ongoing alerts share a
+// dedup key, so PagerDuty keeps one incident until the resolve event.
+type Notifier struct {
+	routingKey string
+	eventsURL  string
+	client     *nethttp.Client
+}
+
+var _ portsout.AlertNotifier = (*Notifier)(nil)
+
+type event struct {
+	RoutingKey  string        `json:"routing_key"`
+	EventAction string        `json:"event_action"`
+	DedupKey    string        `json:"dedup_key"`
+	Payload     *eventPayload `json:"payload,omitempty"`
+}
+
+type eventPayload struct {
+	Summary       string         `json:"summary"`
+	Source        string         `json:"source"`
+	Severity      string         `json:"severity"`
+	Timestamp     string         `json:"timestamp"`
+	Component     string         `json:"component"`
+	Class         string         `json:"class"`
+	CustomDetails map[string]any `json:"custom_details"`
+}
+
+func NewNotifier(cfg Config) *Notifier {
+	eventsURL := strings.TrimSpace(cfg.EventsURL)
+	if eventsURL == "" {
+		eventsURL = DefaultEventsURL
+	}
+	return &Notifier{
+		routingKey: strings.TrimSpace(cfg.RoutingKey),
+		eventsURL:  eventsURL,
+		client:     alertnotifier.NewHTTPClient(cfg.Timeout),
+	}
+}
+
+func (n *Notifier) NotifyAlert(ctx context.Context, notification dto.AlertNotification) *apperrors.AppError {
+	if validationErr := alertnotifier.ValidateNotification(channelName, notification); validationErr != nil {
+		return validationErr
+	}
+
+	body := event{
+		RoutingKey:  n.routingKey,
+		EventAction: eventActionTrigger,
+		DedupKey:    alertnotifier.DedupKey(notification),
+	}
+	if notification.State == "resolved" {
+		// Resolve events only need the dedup key.
+		body.EventAction = eventActionResolve
+	} else {
+		body.Payload = &eventPayload{
+			Summary:   alertnotifier.Summary(notification),
+			Source:    "chaintx",
+			Severity:  notification.Severity,
+			Timestamp: notification.OccurredAt.UTC().Format(time.RFC3339),
+			Component: notification.Source,
+			Class:     notification.Signal,
+			CustomDetails: map[string]any{
+				"state":     notification.State,
+				"current":   notification.Current,
+				"threshold": notification.Threshold,
+			},
+		}
+		if notification.Scope != "" {
+			body.Payload.CustomDetails["scope"] = notification.Scope
+		}
+	}
+	return alertnotifier.PostJSON(ctx, n.client, channelName, n.eventsURL, nil, body)
+}
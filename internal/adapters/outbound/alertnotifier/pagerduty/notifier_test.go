@@ -0,0 +1,111 @@
+//go:build !integration
+
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+)
+
+func TestNotifyAlertSendsTriggerThenResolveWithSameDedupKey(t *testing.T) {
+	received := []event{}
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		decoded := event{}
+		if err := json.NewDecoder(r.Body).Decode(&decoded); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		received = append(received, decoded)
+		w.WriteHeader(nethttp.StatusAccepted)
+		_, _ = w.Write([]byte(`{"status":"success","dedup_key":"` + decoded.DedupKey + `"}`))
+	}))
+	defer server.Close()
+
+	notifier := NewNotifier(Config{RoutingKey: "routing-key-1", EventsURL: server.URL})
+	notification := dto.AlertNotification{
+		Source:     "webhook_outbox",
+		Signal:     "oldest_pending_age_seconds",
+		State:      "triggered",
+		Severity:   "critical",
+		Current:    900,
+		Threshold:  600,
+		OccurredAt: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC),
+	}
+	if appErr := notifier.NotifyAlert(context.Background(), notification); appErr != nil {
+		t.Fatalf("expected trigger success, got %+v", appErr)
+	}
+	notification.State = "resolved"
+	if appErr := notifier.NotifyAlert(context.Background(), notification); appErr != nil {
+		t.Fatalf("expected resolve success, got %+v", appErr)
+	}
+
+	if len(received) != 2 {
+		t.Fatalf("expected two events, got %d", len(received))
+	}
+	trigger, resolve := received[0], received[1]
+	if trigger.EventAction != "trigger" || trigger.RoutingKey != "routing-key-1" || trigger.Payload == nil {
+		t.Fatalf("unexpected trigger event %+v", trigger)
+	}
+	if trigger.Payload.Severity != "critical" || trigger.Payload.Component != "webhook_outbox" {
+		t.Fatalf("unexpected trigger payload %+v", trigger.Payload)
+	}
+	if resolve.EventAction != "resolve" || resolve.Payload != nil {
+		t.Fatalf("unexpected resolve event %+v", resolve)
+	}
+	if trigger.DedupKey != resolve.DedupKey || trigger.DedupKey == "" {
+		t.Fatalf("expected matching dedup keys, got %q and %q", trigger.DedupKey, resolve.DedupKey)
+	}
+}
+
+func TestNotifyAlertKeysScopedAlertsSeparately(t *testing.T) {
+	received := []event{}
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		decoded := event{}
+		if err := json.NewDecoder(r.Body).Decode(&decoded); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		received = append(received, decoded)
+		w.WriteHeader(nethttp.StatusAccepted)
+	}))
+	defer server.Close()
+
+	notifier := NewNotifier(Config{RoutingKey: "routing-key-1", EventsURL: server.URL})
+	for _, scope := range []string{"bitcoin:mainnet", "ethereum:mainnet"} {
+		appErr := notifier.NotifyAlert(context.Background(), dto.AlertNotification{
+			Source:    "reconciler",
+			Signal:    "chain_tip_stale_seconds",
+			Scope:     scope,
+			State:     "triggered",
+			Severity:  "critical",
+			Current:   1800,
+			Threshold: 900,
+		})
+		if appErr != nil {
+			t.Fatalf("expected success, got %+v", appErr)
+		}
+	}
+
+	if len(received) != 2 {
+		t.Fatalf("expected two events, got %d", len(received))
+	}
+	if received[0].DedupKey != "chaintx:reconciler:chain_tip_stale_seconds:bitcoin:mainnet" {
+		t.Fatalf("unexpected dedup key %q", received[0].DedupKey)
+	}
+	if received[0].DedupKey == received[1].DedupKey {
+		t.Fatalf("expected distinct dedup keys per scope, got %q", received[0].DedupKey)
+	}
+	if received[1].Payload.CustomDetails["scope"] != "ethereum:mainnet" {
+		t.Fatalf("expected scope in custom details, got %+v", received[1].Payload.CustomDetails)
+	}
+}
+
+func TestNewNotifierDefaultsEventsURL(t *testing.T) {
+	if got := NewNotifier(Config{RoutingKey: "key"}).eventsURL; got != DefaultEventsURL {
+		t.Fatalf("expected default events url, got %s", got)
+	}
+}
@@ -0,0 +1,90 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"strings"
+	"time"
+
+	"chaintx/internal/adapters/outbound/alertnotifier"
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const channelName = "slack"
+
+type Config struct {
+	WebhookURL string
+	Timeout    time.Duration
+}
+
+// Notifier posts to a Slack-compatible incoming webhook. Only the `text` and
+// `attachments` fields are used so Mattermost and Rocket.Chat accept it too.
+type Notifier struct {
+	webhookURL string
+	client     *nethttp.Client
+}
+
+var _ portsout.AlertNotifier = (*Notifier)(nil)
+
+type message struct {
+	Text        string       `json:"text"`
+	Attachments []attachment `json:"attachments"`
+}
+
+type attachment struct {
+	Color  string  `json:"color"`
+	Fields []field `json:"fields"`
+}
+
+type field struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+func NewNotifier(cfg Config) *Notifier {
+	return &Notifier{
+		webhookURL: strings.TrimSpace(cfg.WebhookURL),
+		client:     alertnotifier.NewHTTPClient(cfg.Timeout),
+	}
+}
+
+func (n *Notifier) NotifyAlert(ctx context.Context, notification dto.AlertNotification) *apperrors.AppError {
+	if validationErr := alertnotifier.ValidateNotification(channelName, notification); validationErr != nil {
+		return validationErr
+	}
+	fields := []field{
+		{Title: "Signal", Value: notification.Signal, Short: true},
+		{Title: "State", Value: notification.State, Short: true},
+		{Title: "Current", Value: fmt.Sprintf("%d", notification.Current), Short: true},
+		{Title: "Threshold", Value: fmt.Sprintf("%d", notification.Threshold), Short: true},
+	}
+	if notification.Scope != "" {
+		fields = append(fields, field{Title: "Scope", Value: notification.Scope, Short: true})
+	}
+	fields = append(fields, field{
+		Title: "Occurred at",
+		Value: notification.OccurredAt.UTC().Format(time.RFC3339),
+		Short: false,
+	})
+	return alertnotifier.PostJSON(ctx, n.client, channelName, n.webhookURL, nil, message{
+		Text: alertnotifier.Summary(notification),
+		Attachments: []attachment{{
+			Color:  color(notification),
+			Fields: fields,
+		}},
+	})
+}
+
+func color(notification dto.AlertNotification) string {
+	if notification.State == "resolved" {
+		return "good"
+	}
+	if notification.Severity == "critical" {
+		return "danger"
+	}
+	return "warning"
+}
@@ -0,0 +1,54 @@
+//go:build !integration
+
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+)
+
+func TestNotifyAlertPostsIncomingWebhookMessage(t *testing.T) {
+	var received message
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	appErr := NewNotifier(Config{WebhookURL: server.URL}).NotifyAlert(context.Background(), dto.AlertNotification{
+		Source:     "webhook_outbox",
+		Signal:     "pending_ready_count",
+		State:      "triggered",
+		Severity:   "critical",
+		Current:    250,
+		Threshold:  100,
+		OccurredAt: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC),
+	})
+	if appErr != nil {
+		t.Fatalf("expected notify success, got %+v", appErr)
+	}
+	if !strings.HasPrefix(received.Text, "[CRITICAL] chaintx webhook_outbox alert triggered: pending_ready_count=250") {
+		t.Fatalf("unexpected text %q", received.Text)
+	}
+	if len(received.Attachments) != 1 || received.Attachments[0].Color != "danger" {
+		t.Fatalf("expected one danger attachment, got %+v", received.Attachments)
+	}
+}
+
+func TestColorMarksResolvedAlertsGood(t *testing.T) {
+	if got := color(dto.AlertNotification{State: "resolved", Severity: "critical"}); got != "good" {
+		t.Fatalf("expected good, got %s", got)
+	}
+	if got := color(dto.AlertNotification{State: "ongoing", Severity: "warning"}); got != "warning" {
+		t.Fatalf("expected warning, got %s", got)
+	}
+}
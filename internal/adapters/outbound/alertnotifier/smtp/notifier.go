@@ -0,0 +1,154 @@
+package smtp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	netsmtp "net/smtp"
+	"strings"
+	"time"
+
+	"chaintx/internal/adapters/outbound/alertnotifier"
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const channelName = "smtp"
+
+type Config struct {
+	// Addr is the relay host:port.
+	Addr     string
+	From     string
+	To       []string
+	Username string
+	Password string
+	Timeout  time.Duration
+}
+
+// Notifier sends one plain-text email per alert event. STARTTLS is used
+// whenever the relay offers it; credentials are only sent over TLS or to a
+// loopback relay.
+type Notifier struct {
+	addr     string
+	host     string
+	from     string
+	to       []string
+	username string
+	password string
+	timeout  time.Duration
+}
+
+var _ portsout.AlertNotifier = (*Notifier)(nil)
+
+func NewNotifier(cfg Config) *Notifier {
+	addr := strings.TrimSpace(cfg.Addr)
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = alertnotifier.DefaultTimeout
+	}
+	return &Notifier{
+		addr:     addr,
+		host:     host,
+		from:     strings.TrimSpace(cfg.From),
+		to:       cfg.To,
+		username: cfg.Username,
+		password: cfg.Password,
+		timeout:  timeout,
+	}
+}
+
+func (n *Notifier) NotifyAlert(ctx context.Context, notification dto.AlertNotification) *apperrors.AppError {
+	if validationErr := alertnotifier.ValidateNotification(channelName, notification); validationErr != nil {
+		return validationErr
+	}
+	if err := n.send(ctx, buildMessage(n.from, n.to, notification)); err != nil {
+		return alertnotifier.NotifyFailed(channelName, err, map[string]any{"addr": n.addr})
+	}
+	return nil
+}
+
+func (n *Notifier) send(ctx context.Context, message []byte) error {
+	dialer := &net.Dialer{Timeout: n.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(n.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := netsmtp.NewClient(conn, n.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if n.username != "" {
+		if err := client.Auth(netsmtp.PlainAuth("", n.username, n.password, n.host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(n.from); err != nil {
+		return err
+	}
+	for _, recipient := range n.to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		writer.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func buildMessage(from string, to []string, notification dto.AlertNotification) []byte {
+	var builder strings.Builder
+	header := func(name string, value string) {
+		builder.WriteString(name + ": " + value + "\r\n")
+	}
+	header("From", from)
+	header("To", strings.Join(to, ", "))
+	header("Subject", alertnotifier.Summary(notification))
+	header("Date", notification.OccurredAt.UTC().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	builder.WriteString("\r\n")
+	fmt.Fprintf(&builder, "source: %s\r\n", notification.Source)
+	fmt.Fprintf(&builder, "signal: %s\r\n", notification.Signal)
+	if notification.Scope != "" {
+		fmt.Fprintf(&builder, "scope: %s\r\n", notification.Scope)
+	}
+	fmt.Fprintf(&builder, "state: %s\r\n", notification.State)
+	fmt.Fprintf(&builder, "severity: %s\r\n", notification.Severity)
+	fmt.Fprintf(&builder, "current: %d\r\n", notification.Current)
+	fmt.Fprintf(&builder, "threshold: %d\r\n", notification.Threshold)
+	fmt.Fprintf(&builder, "occurred_at: %s\r\n", notification.OccurredAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&builder, "dedup_key: %s\r\n", alertnotifier.DedupKey(notification))
+	return []byte(builder.String())
+}
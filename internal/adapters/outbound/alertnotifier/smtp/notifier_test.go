@@ -0,0 +1,174 @@
+//go:build !integration
+
+package smtp
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+)
+
+func TestNotifyAlertSendsMailThroughRelay(t *testing.T) {
+	relay := startSMTPStub(t)
+
+	notifier := NewNotifier(Config{
+		Addr: relay.addr,
+		From: "alerts@chaintx.local",
+		To:   []string{"oncall@chaintx.local", "ops@chaintx.local"},
+	})
+	appErr := notifier.NotifyAlert(context.Background(), dto.AlertNotification{
+		Source:     "webhook_outbox",
+		Signal:     "failed_count",
+		State:      "triggered",
+		Severity:   "warning",
+		Current:    7,
+		Threshold:  5,
+		OccurredAt: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC),
+	})
+	if appErr != nil {
+		t.Fatalf("expected notify success, got %+v", appErr)
+	}
+
+	mail := relay.waitForMail(t)
+	if mail.from != "alerts@chaintx.local" {
+		t.Fatalf("unexpected sender %q", mail.from)
+	}
+	if strings.Join(mail.to, ",") != "oncall@chaintx.local,ops@chaintx.local" {
+		t.Fatalf("unexpected recipients %v", mail.to)
+	}
+	if !strings.Contains(mail.data, "Subject: [WARNING] chaintx webhook_outbox alert triggered: failed_count=7 threshold=5") {
+		t.Fatalf("expected subject in message, got:\n%s", mail.data)
+	}
+	if !strings.Contains(mail.data, "dedup_key: chaintx:webhook_outbox:failed_count") {
+		t.Fatalf("expected dedup key in body, got:\n%s", mail.data)
+	}
+}
+
+func TestNotifyAlertMapsRelayFailure(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	appErr := NewNotifier(Config{
+		Addr:    addr,
+		From:    "alerts@chaintx.local",
+		To:      []string{"oncall@chaintx.local"},
+		Timeout: time.Second,
+	}).NotifyAlert(context.Background(), dto.AlertNotification{Signal: "failed_count", State: "ongoing"})
+	if appErr == nil || appErr.Code != "alert_notification_failed" {
+		t.Fatalf("expected alert_notification_failed, got %+v", appErr)
+	}
+}
+
+type receivedMail struct {
+	from string
+	to   []string
+	data string
+}
+
+type smtpStub struct {
+	addr  string
+	mu    sync.Mutex
+	mails []receivedMail
+}
+
+// startSMTPStub accepts one session at a time and speaks just enough SMTP for
+// net/smtp: no extensions, no auth.
+func startSMTPStub(t *testing.T) *smtpStub {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	stub := &smtpStub{addr: listener.Addr().String()}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			stub.serve(conn)
+		}
+	}()
+	return stub
+}
+
+func (s *smtpStub) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+
+	mail := receivedMail{}
+	reply("220 stub ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.TrimSpace(line)
+		upper := strings.ToUpper(command)
+		switch {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			reply("250 stub")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			mail.from = strings.Trim(command[len("MAIL FROM:"):], "<> ")
+			reply("250 ok")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			mail.to = append(mail.to, strings.Trim(command[len("RCPT TO:"):], "<> "))
+			reply("250 ok")
+		case upper == "DATA":
+			reply("354 end with .")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			mail.data = data.String()
+			s.mu.Lock()
+			s.mails = append(s.mails, mail)
+			s.mu.Unlock()
+			reply("250 queued")
+		case upper == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func (s *smtpStub) waitForMail(t *testing.T) receivedMail {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		s.mu.Lock()
+		if len(s.mails) > 0 {
+			mail := s.mails[0]
+			s.mu.Unlock()
+			return mail
+		}
+		s.mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected a mail to reach the stub relay")
+	return receivedMail{}
+}
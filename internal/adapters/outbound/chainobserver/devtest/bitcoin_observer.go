@@ -133,6 +133,7 @@ func (o *bitcoinObserver) Observe(
 			AmountMinor:   strconv.FormatInt(utxo.Value, 10),
 			Confirmations: confirmations,
 			IsCanonical:   true,
+			Confirmed:     confirmations >= o.confirmations.btcBusinessMin,
 			BlockHeight:   blockHeight,
 			BlockHash:     blockHash,
 			Metadata: map[string]any{
@@ -122,6 +122,10 @@ func (o *evmObserver) Observe(
 		}
 	}
 
+	for index := range settlements {
+		settlements[index].Confirmed = settlements[index].IsCanonical &&
+			settlements[index].Confirmations >= o.confirmations.evmBusinessMin
+	}
 	latestAmount, confirmedAmount, finalityAmount := o.aggregateAmounts(settlements)
 	confirmedRequired := o.thresholds.confirmedRequired(expected)
 	detectedRequired := o.thresholds.detectedRequired(expected)
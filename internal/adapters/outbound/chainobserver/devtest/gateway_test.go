@@ -134,6 +134,9 @@ func TestObservePaymentRequestBitcoinDepthGatedDetected(t *testing.T) {
 	if output.Settlements[0].Confirmations != 1 {
 		t.Fatalf("expected 1 confirmation, got %d", output.Settlements[0].Confirmations)
 	}
+	if output.Settlements[0].Confirmed {
+		t.Fatalf("expected depth-gated settlement to stay unconfirmed")
+	}
 }
 
 func TestObservePaymentRequestETHConfirmedUsesTransactionSettlements(t *testing.T) {
@@ -213,6 +216,11 @@ func TestObservePaymentRequestETHConfirmedUsesTransactionSettlements(t *testing.
 	if len(output.Settlements) != 2 {
 		t.Fatalf("expected 2 settlement items, got %d", len(output.Settlements))
 	}
+	for _, settlement := range output.Settlements {
+		if !settlement.Confirmed {
+			t.Fatalf("expected settlement %s to be confirmed", settlement.EvidenceRef)
+		}
+	}
 	if output.Settlements[0].EvidenceRef == output.Settlements[1].EvidenceRef {
 		t.Fatalf("expected distinct tx evidence refs, got %+v", output.Settlements)
 	}
@@ -277,6 +285,9 @@ func TestObservePaymentRequestETHDetectedWhenMinConfirmationsNotMet(t *testing.T
 	if output.Settlements[0].Confirmations != 1 {
 		t.Fatalf("expected one confirmation, got %d", output.Settlements[0].Confirmations)
 	}
+	if output.Settlements[0].Confirmed {
+		t.Fatalf("expected settlement below min confirmations to stay unconfirmed")
+	}
 }
 
 func TestObservePaymentRequestERC20ConfirmedUsesLogSettlements(t *testing.T) {
@@ -0,0 +1,186 @@
+package amqp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"chaintx/internal/adapters/outbound/eventsink"
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	valueobjects "chaintx/internal/domain/value_objects"
+	apperrors "chaintx/internal/shared_kernel/errors"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+)
+
+const defaultPublishTimeout = 10 * time.Second
+
+var errPublishNacked = errors.New("broker negatively acknowledged the message")
+
+type Config struct {
+	BrokerURL      string
+	PublishTimeout time.Duration
+}
+
+// confirmPublisher publishes one message and reports whether the broker
+// confirmed it.
+type confirmPublisher interface {
+	Publish(ctx context.Context, exchange string, routingKey string, msg amqp091.Publishing) (bool, error)
+	IsClosed() bool
+	Close() error
+}
+
+type dialFunc func(url string) (confirmPublisher, error)
+
+// Sink publishes to the exchange named by the outbox topic with the event type
+// as routing key. The broker connection is dialed lazily and re-dialed after
+// any failure, so a broker outage surfaces as retryable publish errors.
+type Sink struct {
+	brokerURL      string
+	publishTimeout time.Duration
+	dial           dialFunc
+
+	mu        sync.Mutex
+	publisher confirmPublisher
+}
+
+var _ portsout.EventSink = (*Sink)(nil)
+
+func NewSink(cfg Config) *Sink {
+	return newSinkWithDialer(cfg, dialConfirmPublisher)
+}
+
+func newSinkWithDialer(cfg Config, dial dialFunc) *Sink {
+	publishTimeout := cfg.PublishTimeout
+	if publishTimeout <= 0 {
+		publishTimeout = defaultPublishTimeout
+	}
+	return &Sink{
+		brokerURL:      strings.TrimSpace(cfg.BrokerURL),
+		publishTimeout: publishTimeout,
+		dial:           dial,
+	}
+}
+
+func (s *Sink) PublishEvent(ctx context.Context, input dto.PublishEventInput) *apperrors.AppError {
+	if s == nil || s.dial == nil {
+		return apperrors.NewInternal("event_sink_not_configured", "amqp event sink is not configured", nil)
+	}
+	if validationErr := eventsink.ValidateInput(input); validationErr != nil {
+		return validationErr
+	}
+
+	headers := amqp091.Table{}
+	for _, header := range eventsink.Headers(input) {
+		headers[header.Key] = header.Value
+	}
+	msg := amqp091.Publishing{
+		Headers:      headers,
+		ContentType:  eventsink.ContentTypeJSON,
+		DeliveryMode: amqp091.Persistent,
+		MessageId:    input.EventID,
+		Type:         input.EventType,
+		Body:         input.Payload,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	publisher, err := s.connectedPublisherLocked()
+	if err != nil {
+		return eventsink.PublishFailed(valueobjects.EventSinkDriverAMQP.String(), input, err)
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, s.publishTimeout)
+	defer cancel()
+	acked, err := publisher.Publish(publishCtx, input.Topic, input.EventType, msg)
+	if err != nil {
+		s.resetPublisherLocked()
+		return eventsink.PublishFailed(valueobjects.EventSinkDriverAMQP.String(), input, err)
+	}
+	if !acked {
+		return eventsink.PublishFailed(valueobjects.EventSinkDriverAMQP.String(), input, errPublishNacked)
+	}
+	return nil
+}
+
+func (s *Sink) Close() error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.publisher == nil {
+		return nil
+	}
+	err := s.publisher.Close()
+	s.publisher = nil
+	return err
+}
+
+func (s *Sink) connectedPublisherLocked() (confirmPublisher, error) {
+	if s.publisher != nil && !s.publisher.IsClosed() {
+		return s.publisher, nil
+	}
+	s.resetPublisherLocked()
+	publisher, err := s.dial(s.brokerURL)
+	if err != nil {
+		return nil, err
+	}
+	s.publisher = publisher
+	return publisher, nil
+}
+
+func (s *Sink) resetPublisherLocked() {
+	if s.publisher == nil {
+		return
+	}
+	_ = s.publisher.Close()
+	s.publisher = nil
+}
+
+type channelPublisher struct {
+	conn    *amqp091.Connection
+	channel *amqp091.Channel
+}
+
+func dialConfirmPublisher(url string) (confirmPublisher, error) {
+	conn, err := amqp091.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	channel, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := channel.Confirm(false); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return &channelPublisher{conn: conn, channel: channel}, nil
+}
+
+func (p *channelPublisher) Publish(
+	ctx context.Context,
+	exchange string,
+	routingKey string,
+	msg amqp091.Publishing,
+) (bool, error) {
+	confirmation, err := p.channel.PublishWithDeferredConfirmWithContext(ctx, exchange, routingKey, false, false, msg)
+	if err != nil {
+		return false, err
+	}
+	return confirmation.WaitContext(ctx)
+}
+
+func (p *channelPublisher) IsClosed() bool {
+	return p.channel.IsClosed() || p.conn.IsClosed()
+}
+
+func (p *channelPublisher) Close() error {
+	return p.conn.Close()
+}
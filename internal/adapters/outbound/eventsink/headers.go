@@ -0,0 +1,63 @@
+package eventsink
+
+import (
+	"strconv"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// Header names mirror the webhook HTTP headers so consumers can share
+// de-duplication logic across channels.
+const (
+	HeaderEventID          = "X-ChainTx-Event-Id"
+	HeaderEventType        = "X-ChainTx-Event-Type"
+	HeaderPaymentRequestID = "X-ChainTx-Payment-Request-Id"
+	HeaderDeliveryAttempt  = "X-ChainTx-Delivery-Attempt"
+	ContentTypeJSON        = "application/json"
+)
+
+// Header is an ordered key/value pair; adapters translate it to the broker's
+// native header type.
+type Header struct {
+	Key   string
+	Value string
+}
+
+func Headers(input dto.PublishEventInput) []Header {
+	return []Header{
+		{Key: HeaderEventID, Value: input.EventID},
+		{Key: HeaderEventType, Value: input.EventType},
+		{Key: HeaderPaymentRequestID, Value: input.PaymentRequestID},
+		{Key: HeaderDeliveryAttempt, Value: strconv.Itoa(input.DeliveryAttempt)},
+	}
+}
+
+// ValidateInput rejects inputs no broker could route or de-duplicate.
+func ValidateInput(input dto.PublishEventInput) *apperrors.AppError {
+	if input.EventID == "" {
+		return apperrors.NewValidation("event_sink_event_id_missing", "event id is required", nil)
+	}
+	if input.Topic == "" {
+		return apperrors.NewValidation("event_sink_topic_missing", "event sink topic is required", nil)
+	}
+	if len(input.Payload) == 0 {
+		return apperrors.NewValidation("event_sink_payload_missing", "event payload is required", nil)
+	}
+	return nil
+}
+
+// PublishFailed is the retryable error every adapter returns when the broker
+// rejects or does not acknowledge a message.
+func PublishFailed(driver string, input dto.PublishEventInput, err error) *apperrors.AppError {
+	return apperrors.NewInternal(
+		"event_sink_publish_failed",
+		"event sink publish failed",
+		map[string]any{
+			"driver":   driver,
+			"topic":    input.Topic,
+			"event_id": input.EventID,
+			"error":    err.Error(),
+		},
+	)
+}
@@ -0,0 +1,101 @@
+package kafka
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"chaintx/internal/adapters/outbound/eventsink"
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	valueobjects "chaintx/internal/domain/value_objects"
+	apperrors "chaintx/internal/shared_kernel/errors"
+
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+const defaultWriteTimeout = 10 * time.Second
+
+type Config struct {
+	// BrokerURL is a comma-separated broker list, optionally prefixed with
+	// kafka://, e.g. "kafka://broker-1:9092,broker-2:9092".
+	BrokerURL    string
+	WriteTimeout time.Duration
+}
+
+// messageWriter is the subset of *kafkago.Writer the sink depends on.
+type messageWriter interface {
+	WriteMessages(ctx context.Context, messages ...kafkago.Message) error
+	Close() error
+}
+
+type Sink struct {
+	writer messageWriter
+}
+
+var _ portsout.EventSink = (*Sink)(nil)
+
+// NewSink builds a writer that waits for all in-sync replicas and keys
+// messages by payment request id, so one request's events share a partition
+// and keep their outbox order.
+func NewSink(cfg Config) *Sink {
+	writeTimeout := cfg.WriteTimeout
+	if writeTimeout <= 0 {
+		writeTimeout = defaultWriteTimeout
+	}
+	return newSinkWithWriter(&kafkago.Writer{
+		Addr:                   kafkago.TCP(ParseBrokers(cfg.BrokerURL)...),
+		Balancer:               &kafkago.Hash{},
+		RequiredAcks:           kafkago.RequireAll,
+		WriteTimeout:           writeTimeout,
+		AllowAutoTopicCreation: false,
+	})
+}
+
+func newSinkWithWriter(writer messageWriter) *Sink {
+	return &Sink{writer: writer}
+}
+
+func ParseBrokers(raw string) []string {
+	trimmed := strings.TrimPrefix(strings.TrimSpace(raw), "kafka://")
+	brokers := []string{}
+	for _, part := range strings.Split(trimmed, ",") {
+		if broker := strings.TrimSpace(part); broker != "" {
+			brokers = append(brokers, broker)
+		}
+	}
+	return brokers
+}
+
+func (s *Sink) PublishEvent(ctx context.Context, input dto.PublishEventInput) *apperrors.AppError {
+	if s == nil || s.writer == nil {
+		return apperrors.NewInternal("event_sink_not_configured", "kafka event sink is not configured", nil)
+	}
+	if validationErr := eventsink.ValidateInput(input); validationErr != nil {
+		return validationErr
+	}
+
+	headers := eventsink.Headers(input)
+	messageHeaders := make([]kafkago.Header, 0, len(headers)+1)
+	for _, header := range headers {
+		messageHeaders = append(messageHeaders, kafkago.Header{Key: header.Key, Value: []byte(header.Value)})
+	}
+	messageHeaders = append(messageHeaders, kafkago.Header{Key: "Content-Type", Value: []byte(eventsink.ContentTypeJSON)})
+
+	if err := s.writer.WriteMessages(ctx, kafkago.Message{
+		Topic:   input.Topic,
+		Key:     []byte(input.PaymentRequestID),
+		Value:   input.Payload,
+		Headers: messageHeaders,
+	}); err != nil {
+		return eventsink.PublishFailed(valueobjects.EventSinkDriverKafka.String(), input, err)
+	}
+	return nil
+}
+
+func (s *Sink) Close() error {
+	if s == nil || s.writer == nil {
+		return nil
+	}
+	return s.writer.Close()
+}
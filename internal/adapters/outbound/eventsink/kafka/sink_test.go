@@ -0,0 +1,112 @@
+//go:build !integration
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"chaintx/internal/adapters/outbound/eventsink"
+	"chaintx/internal/application/dto"
+
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+func TestPublishEventWritesKeyedMessageWithHeaders(t *testing.T) {
+	writer := &fakeMessageWriter{}
+	sink := newSinkWithWriter(writer)
+
+	appErr := sink.PublishEvent(context.Background(), dto.PublishEventInput{
+		EventID:          "evt_1",
+		EventType:        "payment_request.status_changed",
+		PaymentRequestID: "pr_1",
+		Topic:            "chaintx.payment_requests",
+		DeliveryAttempt:  2,
+		Payload:          []byte(`{"event_id":"evt_1"}`),
+	})
+	if appErr != nil {
+		t.Fatalf("expected publish success, got %+v", appErr)
+	}
+	if len(writer.messages) != 1 {
+		t.Fatalf("expected one message, got %d", len(writer.messages))
+	}
+	message := writer.messages[0]
+	if message.Topic != "chaintx.payment_requests" {
+		t.Fatalf("expected topic chaintx.payment_requests, got %s", message.Topic)
+	}
+	if string(message.Key) != "pr_1" {
+		t.Fatalf("expected payment request key, got %s", message.Key)
+	}
+	if string(message.Value) != `{"event_id":"evt_1"}` {
+		t.Fatalf("unexpected payload %s", message.Value)
+	}
+	if got := headerValue(message.Headers, eventsink.HeaderEventID); got != "evt_1" {
+		t.Fatalf("expected event id header evt_1, got %s", got)
+	}
+	if got := headerValue(message.Headers, eventsink.HeaderDeliveryAttempt); got != "2" {
+		t.Fatalf("expected delivery attempt header 2, got %s", got)
+	}
+}
+
+func TestPublishEventMapsWriterFailure(t *testing.T) {
+	sink := newSinkWithWriter(&fakeMessageWriter{err: errors.New("leader not available")})
+
+	appErr := sink.PublishEvent(context.Background(), dto.PublishEventInput{
+		EventID: "evt_1",
+		Topic:   "chaintx.payment_requests",
+		Payload: []byte(`{}`),
+	})
+	if appErr == nil || appErr.Code != "event_sink_publish_failed" {
+		t.Fatalf("expected event_sink_publish_failed, got %+v", appErr)
+	}
+}
+
+func TestPublishEventRejectsMissingTopic(t *testing.T) {
+	writer := &fakeMessageWriter{}
+	sink := newSinkWithWriter(writer)
+
+	appErr := sink.PublishEvent(context.Background(), dto.PublishEventInput{
+		EventID: "evt_1",
+		Payload: []byte(`{}`),
+	})
+	if appErr == nil || appErr.Code != "event_sink_topic_missing" {
+		t.Fatalf("expected event_sink_topic_missing, got %+v", appErr)
+	}
+	if len(writer.messages) != 0 {
+		t.Fatalf("expected no message written")
+	}
+}
+
+func TestParseBrokers(t *testing.T) {
+	brokers := ParseBrokers(" kafka://broker-1:9092, broker-2:9092 ,")
+	if len(brokers) != 2 || brokers[0] != "broker-1:9092" || brokers[1] != "broker-2:9092" {
+		t.Fatalf("unexpected brokers %v", brokers)
+	}
+}
+
+func headerValue(headers []kafkago.Header, key string) string {
+	for _, header := range headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+type fakeMessageWriter struct {
+	messages []kafkago.Message
+	err      error
+}
+
+func (f *fakeMessageWriter) WriteMessages(_ context.Context, messages ...kafkago.Message) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.messages = append(f.messages, messages...)
+	return nil
+}
+
+func (f *fakeMessageWriter) Close() error {
+	return nil
+}
@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"chaintx/internal/adapters/outbound/eventsink"
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const driverName = "memory"
+
+var errInjectedFailure = errors.New("injected broker failure")
+
+// Message is one acknowledged publish.
+type Message struct {
+	Topic   string
+	Key     string
+	Headers map[string]string
+	Payload []byte
+}
+
+// Broker is an in-process EventSink for tests and local runs. It acknowledges
+// every publish unless failures were injected, and de-duplicates by event id
+// the way JetStream and idempotent Kafka producers do.
+type Broker struct {
+	mu       sync.Mutex
+	messages []Message
+	seen     map[string]struct{}
+	failNext int
+}
+
+var _ portsout.EventSink = (*Broker)(nil)
+
+func NewBroker() *Broker {
+	return &Broker{seen: map[string]struct{}{}}
+}
+
+// FailNext makes the next n publishes fail without recording a message.
+func (b *Broker) FailNext(n int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failNext = n
+}
+
+func (b *Broker) PublishEvent(_ context.Context, input dto.PublishEventInput) *apperrors.AppError {
+	if validationErr := eventsink.ValidateInput(input); validationErr != nil {
+		return validationErr
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failNext > 0 {
+		b.failNext--
+		return eventsink.PublishFailed(driverName, input, errInjectedFailure)
+	}
+	dedupeKey := input.Topic + "\x00" + input.EventID
+	if _, duplicate := b.seen[dedupeKey]; duplicate {
+		return nil
+	}
+	b.seen[dedupeKey] = struct{}{}
+
+	headers := map[string]string{}
+	for _, header := range eventsink.Headers(input) {
+		headers[header.Key] = header.Value
+	}
+	b.messages = append(b.messages, Message{
+		Topic:   input.Topic,
+		Key:     input.PaymentRequestID,
+		Headers: headers,
+		Payload: append([]byte(nil), input.Payload...),
+	})
+	return nil
+}
+
+// Messages returns a copy of the acknowledged messages in publish order.
+func (b *Broker) Messages() []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]Message(nil), b.messages...)
+}
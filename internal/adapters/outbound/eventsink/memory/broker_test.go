@@ -0,0 +1,59 @@
+//go:build !integration
+
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"chaintx/internal/adapters/outbound/eventsink"
+	"chaintx/internal/application/dto"
+)
+
+func TestBrokerRecordsAndDeduplicatesMessages(t *testing.T) {
+	broker := NewBroker()
+	input := dto.PublishEventInput{
+		EventID:          "evt_1",
+		EventType:        "payment_request.created",
+		PaymentRequestID: "pr_1",
+		Topic:            "chaintx.payment_requests",
+		DeliveryAttempt:  1,
+		Payload:          []byte(`{"event_id":"evt_1"}`),
+	}
+
+	if appErr := broker.PublishEvent(context.Background(), input); appErr != nil {
+		t.Fatalf("expected publish success, got %+v", appErr)
+	}
+	input.DeliveryAttempt = 2
+	if appErr := broker.PublishEvent(context.Background(), input); appErr != nil {
+		t.Fatalf("expected duplicate publish to be acknowledged, got %+v", appErr)
+	}
+
+	messages := broker.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("expected one message after de-duplication, got %d", len(messages))
+	}
+	if messages[0].Key != "pr_1" || messages[0].Headers[eventsink.HeaderEventID] != "evt_1" {
+		t.Fatalf("unexpected message %+v", messages[0])
+	}
+}
+
+func TestBrokerFailNext(t *testing.T) {
+	broker := NewBroker()
+	broker.FailNext(1)
+	input := dto.PublishEventInput{
+		EventID: "evt_1",
+		Topic:   "chaintx.payment_requests",
+		Payload: []byte(`{}`),
+	}
+
+	if appErr := broker.PublishEvent(context.Background(), input); appErr == nil || appErr.Code != "event_sink_publish_failed" {
+		t.Fatalf("expected injected failure, got %+v", appErr)
+	}
+	if appErr := broker.PublishEvent(context.Background(), input); appErr != nil {
+		t.Fatalf("expected retry success, got %+v", appErr)
+	}
+	if len(broker.Messages()) != 1 {
+		t.Fatalf("expected one message after retry")
+	}
+}
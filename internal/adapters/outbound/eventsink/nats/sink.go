@@ -0,0 +1,103 @@
+package nats
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"chaintx/internal/adapters/outbound/eventsink"
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	valueobjects "chaintx/internal/domain/value_objects"
+	apperrors "chaintx/internal/shared_kernel/errors"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const defaultPublishTimeout = 10 * time.Second
+
+type Config struct {
+	ServerURL      string
+	PublishTimeout time.Duration
+}
+
+// messagePublisher is the subset of jetstream.JetStream the sink depends on.
+type messagePublisher interface {
+	PublishMsg(ctx context.Context, msg *natsgo.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error)
+}
+
+type Sink struct {
+	conn           *natsgo.Conn
+	publisher      messagePublisher
+	publishTimeout time.Duration
+}
+
+var _ portsout.EventSink = (*Sink)(nil)
+
+// NewSink connects to NATS and publishes through JetStream so every event is
+// acknowledged by a stream. The connection retries in the background, so an
+// unavailable server surfaces as retryable publish errors rather than a
+// startup failure.
+func NewSink(cfg Config) (*Sink, error) {
+	conn, err := natsgo.Connect(
+		strings.TrimSpace(cfg.ServerURL),
+		natsgo.Name("chaintx-webhook-dispatcher"),
+		natsgo.RetryOnFailedConnect(true),
+		natsgo.MaxReconnects(-1),
+	)
+	if err != nil {
+		return nil, err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	sink := newSinkWithPublisher(js, cfg.PublishTimeout)
+	sink.conn = conn
+	return sink, nil
+}
+
+func newSinkWithPublisher(publisher messagePublisher, publishTimeout time.Duration) *Sink {
+	if publishTimeout <= 0 {
+		publishTimeout = defaultPublishTimeout
+	}
+	return &Sink{
+		publisher:      publisher,
+		publishTimeout: publishTimeout,
+	}
+}
+
+func (s *Sink) PublishEvent(ctx context.Context, input dto.PublishEventInput) *apperrors.AppError {
+	if s == nil || s.publisher == nil {
+		return apperrors.NewInternal("event_sink_not_configured", "nats event sink is not configured", nil)
+	}
+	if validationErr := eventsink.ValidateInput(input); validationErr != nil {
+		return validationErr
+	}
+
+	msg := natsgo.NewMsg(input.Topic)
+	msg.Data = input.Payload
+	for _, header := range eventsink.Headers(input) {
+		msg.Header.Set(header.Key, header.Value)
+	}
+	msg.Header.Set("Content-Type", eventsink.ContentTypeJSON)
+	// JetStream drops duplicates with the same message id inside the stream's
+	// duplicate window, which absorbs re-publishes after a lost ack.
+	msg.Header.Set(jetstream.MsgIDHeader, input.EventID)
+
+	publishCtx, cancel := context.WithTimeout(ctx, s.publishTimeout)
+	defer cancel()
+	if _, err := s.publisher.PublishMsg(publishCtx, msg); err != nil {
+		return eventsink.PublishFailed(valueobjects.EventSinkDriverNATS.String(), input, err)
+	}
+	return nil
+}
+
+func (s *Sink) Close() error {
+	if s == nil || s.conn == nil {
+		return nil
+	}
+	return s.conn.Drain()
+}
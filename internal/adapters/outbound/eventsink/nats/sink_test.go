@@ -0,0 +1,103 @@
+//go:build !integration
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"chaintx/internal/adapters/outbound/eventsink"
+	"chaintx/internal/application/dto"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+func TestPublishEventPublishesToSubjectWithMsgID(t *testing.T) {
+	publisher := &fakeMessagePublisher{}
+	sink := newSinkWithPublisher(publisher, time.Second)
+
+	appErr := sink.PublishEvent(context.Background(), dto.PublishEventInput{
+		EventID:          "evt_1",
+		EventType:        "payment_request.status_changed",
+		PaymentRequestID: "pr_1",
+		Topic:            "chaintx.payment_requests",
+		DeliveryAttempt:  1,
+		Payload:          []byte(`{"event_id":"evt_1"}`),
+	})
+	if appErr != nil {
+		t.Fatalf("expected publish success, got %+v", appErr)
+	}
+	if len(publisher.messages) != 1 {
+		t.Fatalf("expected one message, got %d", len(publisher.messages))
+	}
+	msg := publisher.messages[0]
+	if msg.Subject != "chaintx.payment_requests" {
+		t.Fatalf("expected subject chaintx.payment_requests, got %s", msg.Subject)
+	}
+	if got := msg.Header.Get(jetstream.MsgIDHeader); got != "evt_1" {
+		t.Fatalf("expected msg id evt_1, got %s", got)
+	}
+	if got := msg.Header.Get(eventsink.HeaderPaymentRequestID); got != "pr_1" {
+		t.Fatalf("expected payment request header pr_1, got %s", got)
+	}
+	if string(msg.Data) != `{"event_id":"evt_1"}` {
+		t.Fatalf("unexpected payload %s", msg.Data)
+	}
+}
+
+func TestPublishEventMapsMissingAck(t *testing.T) {
+	sink := newSinkWithPublisher(&fakeMessagePublisher{err: jetstream.ErrNoStreamResponse}, time.Second)
+
+	appErr := sink.PublishEvent(context.Background(), dto.PublishEventInput{
+		EventID: "evt_1",
+		Topic:   "chaintx.payment_requests",
+		Payload: []byte(`{}`),
+	})
+	if appErr == nil || appErr.Code != "event_sink_publish_failed" {
+		t.Fatalf("expected event_sink_publish_failed, got %+v", appErr)
+	}
+	if appErr.Details["driver"] != "nats" {
+		t.Fatalf("expected nats driver detail, got %v", appErr.Details["driver"])
+	}
+}
+
+func TestPublishEventAppliesTimeout(t *testing.T) {
+	publisher := &fakeMessagePublisher{}
+	sink := newSinkWithPublisher(publisher, time.Second)
+
+	if appErr := sink.PublishEvent(context.Background(), dto.PublishEventInput{
+		EventID: "evt_1",
+		Topic:   "chaintx.payment_requests",
+		Payload: []byte(`{}`),
+	}); appErr != nil {
+		t.Fatalf("expected publish success, got %+v", appErr)
+	}
+	if !publisher.hadDeadline {
+		t.Fatalf("expected publish context deadline")
+	}
+}
+
+type fakeMessagePublisher struct {
+	messages    []*natsgo.Msg
+	hadDeadline bool
+	err         error
+}
+
+func (f *fakeMessagePublisher) PublishMsg(
+	ctx context.Context,
+	msg *natsgo.Msg,
+	_ ...jetstream.PublishOpt,
+) (*jetstream.PubAck, error) {
+	_, f.hadDeadline = ctx.Deadline()
+	if f.err != nil {
+		return nil, f.err
+	}
+	if msg == nil {
+		return nil, errors.New("nil message")
+	}
+	f.messages = append(f.messages, msg)
+	return &jetstream.PubAck{Stream: "CHAINTX", Sequence: uint64(len(f.messages))}, nil
+}
@@ -0,0 +1,192 @@
+package adminauditlog
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const adminAuditLogColumns = `
+  id,
+  occurred_at,
+  actor_id,
+  actor_role,
+  action,
+  target,
+  reason,
+  source_ip,
+  outcome,
+  error_code,
+  details`
+
+type Repository struct {
+	db *sql.DB
+}
+
+var _ portsout.AdminAuditLogRepository = (*Repository)(nil)
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Append(ctx context.Context, entry dto.AdminAuditEntry) (dto.AdminAuditEntry, *apperrors.AppError) {
+	details, err := json.Marshal(entry.Details)
+	if err != nil {
+		return dto.AdminAuditEntry{}, adminAuditLogQueryFailed("failed to encode admin audit details", err)
+	}
+
+	query := `
+INSERT INTO app.admin_audit_log (
+  occurred_at,
+  actor_id,
+  actor_role,
+  action,
+  target,
+  reason,
+  source_ip,
+  outcome,
+  error_code,
+  details
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::jsonb)
+RETURNING` + adminAuditLogColumns
+
+	item, err := scanAdminAuditEntry(r.db.QueryRowContext(
+		ctx,
+		query,
+		entry.OccurredAt.UTC(),
+		entry.ActorID,
+		entry.ActorRole,
+		entry.Action,
+		nullableString(entry.Target),
+		nullableString(entry.Reason),
+		nullableString(entry.SourceIP),
+		entry.Outcome,
+		nullableString(entry.ErrorCode),
+		string(details),
+	))
+	if err != nil {
+		return dto.AdminAuditEntry{}, adminAuditLogQueryFailed("failed to insert admin audit entry", err)
+	}
+	return item, nil
+}
+
+func (r *Repository) List(
+	ctx context.Context,
+	query dto.ListAdminAuditEntriesQuery,
+) ([]dto.AdminAuditEntry, *apperrors.AppError) {
+	conditions := []string{"TRUE"}
+	args := []any{}
+	if query.BeforeID > 0 {
+		args = append(args, query.BeforeID)
+		conditions = append(conditions, fmt.Sprintf("id < $%d", len(args)))
+	}
+	if query.ActorID != "" {
+		args = append(args, query.ActorID)
+		conditions = append(conditions, fmt.Sprintf("actor_id = $%d", len(args)))
+	}
+	if query.Action != "" {
+		args = append(args, query.Action)
+		conditions = append(conditions, fmt.Sprintf("action = $%d", len(args)))
+	}
+	args = append(args, query.Limit)
+
+	statement := fmt.Sprintf(`
+SELECT`+adminAuditLogColumns+`
+FROM app.admin_audit_log
+WHERE %s
+ORDER BY id DESC
+LIMIT $%d
+`, strings.Join(conditions, "\n  AND "), len(args))
+
+	rows, err := r.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, adminAuditLogQueryFailed("failed to query admin audit log", err)
+	}
+	defer rows.Close()
+
+	items := []dto.AdminAuditEntry{}
+	for rows.Next() {
+		item, err := scanAdminAuditEntry(rows)
+		if err != nil {
+			return nil, adminAuditLogQueryFailed("failed to parse admin audit entry", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, adminAuditLogQueryFailed("failed while iterating admin audit log", err)
+	}
+	return items, nil
+}
+
+type adminAuditEntryScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAdminAuditEntry(scanner adminAuditEntryScanner) (dto.AdminAuditEntry, error) {
+	item := dto.AdminAuditEntry{}
+	var (
+		target    sql.NullString
+		reason    sql.NullString
+		sourceIP  sql.NullString
+		errorCode sql.NullString
+		details   []byte
+	)
+	if err := scanner.Scan(
+		&item.ID,
+		&item.OccurredAt,
+		&item.ActorID,
+		&item.ActorRole,
+		&item.Action,
+		&target,
+		&reason,
+		&sourceIP,
+		&item.Outcome,
+		&errorCode,
+		&details,
+	); err != nil {
+		return dto.AdminAuditEntry{}, err
+	}
+
+	item.OccurredAt = item.OccurredAt.UTC()
+	item.Target = stringPointer(target)
+	item.Reason = stringPointer(reason)
+	item.SourceIP = stringPointer(sourceIP)
+	item.ErrorCode = stringPointer(errorCode)
+	item.Details = map[string]any{}
+	if len(details) > 0 {
+		if err := json.Unmarshal(details, &item.Details); err != nil {
+			return dto.AdminAuditEntry{}, err
+		}
+	}
+	return item, nil
+}
+
+func nullableString(value *string) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *value, Valid: true}
+}
+
+func stringPointer(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	out := value.String
+	return &out
+}
+
+func adminAuditLogQueryFailed(message string, err error) *apperrors.AppError {
+	return apperrors.NewInternal(
+		"admin_audit_log_query_failed",
+		message,
+		map[string]any{"error": err.Error()},
+	)
+}
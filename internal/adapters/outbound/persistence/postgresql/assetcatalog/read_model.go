@@ -21,7 +21,7 @@ func NewReadModel(db *sql.DB) *ReadModel {
 	return &ReadModel{db: db}
 }
 
-func (r *ReadModel) ListEnabled(ctx context.Context) ([]dto.AssetCatalogEntry, *apperrors.AppError) {
+func (r *ReadModel) ListEnabled(ctx context.Context, merchantID string) ([]dto.AssetCatalogEntry, *apperrors.AppError) {
 	const query = `
 SELECT
   chain,
@@ -37,11 +37,12 @@ SELECT
   token_decimals,
   wallet_account_id
 FROM app.asset_catalog
-WHERE enabled = TRUE
+WHERE merchant_id = $1
+  AND enabled = TRUE
 ORDER BY chain ASC, network ASC, asset ASC
 `
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.db.QueryContext(ctx, query, merchantID)
 	if err != nil {
 		return nil, apperrors.NewInternal(
 			"asset_catalog_query_failed",
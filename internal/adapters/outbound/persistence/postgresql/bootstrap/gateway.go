@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
 	"chaintx/internal/infrastructure/walletkeys"
 	apperrors "chaintx/internal/shared_kernel/errors"
 
@@ -28,6 +29,7 @@ type ValidationRules struct {
 	DevtestAllowMainnet    bool
 	DevtestKeysets         map[string]string
 	DevtestKeysetPreflight []DevtestKeysetPreflightEntry
+	Merchants              []Merchant
 	DevtestKeyNormalizers  map[string]DevtestKeyNormalizer
 	KeysetHashAlgorithm    string
 	KeysetHashHMACSecret   string
@@ -41,7 +43,17 @@ type DevtestKeyNormalizer func(raw string) (walletkeys.ExtendedPublicKey, string
 type AllocationModeStartupValidator func(g *Gateway) *apperrors.AppError
 type AllocationModeCatalogRowValidator func(g *Gateway, row catalogValidationRow, details map[string]any) *apperrors.AppError
 
+// Merchant is a configured tenant that startup sync upserts into
+// app.merchants before binding its wallet accounts.
+type Merchant struct {
+	ID          string
+	DisplayName string
+}
+
+// DevtestKeysetPreflightEntry belongs to MerchantID; an empty MerchantID is
+// the default merchant.
 type DevtestKeysetPreflightEntry struct {
+	MerchantID            string
 	Chain                 string
 	Network               string
 	KeysetID              string
@@ -75,6 +87,7 @@ func NewGateway(
 			DevtestAllowMainnet:    validationRules.DevtestAllowMainnet,
 			DevtestKeysets:         copyStringMap(validationRules.DevtestKeysets),
 			DevtestKeysetPreflight: copyPreflightEntries(validationRules.DevtestKeysetPreflight),
+			Merchants:              append([]Merchant{}, validationRules.Merchants...),
 			DevtestKeyNormalizers:  mergeDevtestKeyNormalizers(validationRules.DevtestKeyNormalizers),
 			KeysetHashAlgorithm:    strings.ToLower(strings.TrimSpace(validationRules.KeysetHashAlgorithm)),
 			KeysetHashHMACSecret:   strings.TrimSpace(validationRules.KeysetHashHMACSecret),
@@ -223,6 +236,7 @@ func (g *Gateway) ValidateAssetCatalogIntegrity(ctx context.Context) *apperrors.
 
 	const query = `
 SELECT
+  ac.merchant_id,
   ac.chain,
   ac.network,
   ac.asset,
@@ -234,6 +248,7 @@ SELECT
   ac.token_contract,
   ac.token_decimals,
   wa.id,
+  wa.merchant_id,
   wa.chain,
   wa.network,
   wa.keyset_id,
@@ -243,7 +258,7 @@ SELECT
 FROM app.asset_catalog ac
 LEFT JOIN app.wallet_accounts wa ON wa.id = ac.wallet_account_id
 WHERE ac.enabled = TRUE
-ORDER BY ac.chain, ac.network, ac.asset
+ORDER BY ac.merchant_id, ac.chain, ac.network, ac.asset
 `
 
 	rows, err := db.QueryContext(ctx, query)
@@ -279,6 +294,7 @@ ORDER BY ac.chain, ac.network, ac.asset
 }
 
 type catalogValidationRow struct {
+	MerchantID              string
 	Chain                   string
 	Network                 string
 	Asset                   string
@@ -290,6 +306,7 @@ type catalogValidationRow struct {
 	TokenContract           sql.NullString
 	TokenDecimals           sql.NullInt64
 	WalletID                sql.NullString
+	WalletMerchantID        sql.NullString
 	WalletChain             sql.NullString
 	WalletNetwork           sql.NullString
 	WalletKeysetID          sql.NullString
@@ -301,6 +318,7 @@ type catalogValidationRow struct {
 func scanCatalogValidationRow(rows *sql.Rows) (catalogValidationRow, *apperrors.AppError) {
 	row := catalogValidationRow{}
 	if err := rows.Scan(
+		&row.MerchantID,
 		&row.Chain,
 		&row.Network,
 		&row.Asset,
@@ -312,6 +330,7 @@ func scanCatalogValidationRow(rows *sql.Rows) (catalogValidationRow, *apperrors.
 		&row.TokenContract,
 		&row.TokenDecimals,
 		&row.WalletID,
+		&row.WalletMerchantID,
 		&row.WalletChain,
 		&row.WalletNetwork,
 		&row.WalletKeysetID,
@@ -336,6 +355,7 @@ func scanCatalogValidationRow(rows *sql.Rows) (catalogValidationRow, *apperrors.
 
 func (g *Gateway) validateCatalogRow(row catalogValidationRow) *apperrors.AppError {
 	baseDetails := map[string]any{
+		"merchant_id":       row.MerchantID,
 		"chain":             row.Chain,
 		"network":           row.Network,
 		"asset":             row.Asset,
@@ -349,6 +369,15 @@ func (g *Gateway) validateCatalogRow(row catalogValidationRow) *apperrors.AppErr
 			baseDetails,
 		)
 	}
+	if !row.WalletMerchantID.Valid || row.WalletMerchantID.String != row.MerchantID {
+		return apperrors.NewInternal(
+			"invalid_configuration",
+			"enabled asset catalog row references another merchant's wallet account",
+			mergeDetails(baseDetails, map[string]any{
+				"wallet_merchant_id": row.WalletMerchantID.String,
+			}),
+		)
+	}
 	if !row.WalletIsActive.Valid || !row.WalletIsActive.Bool {
 		return apperrors.NewInternal(
 			"invalid_configuration",
@@ -603,6 +632,7 @@ func copyPreflightEntries(input []DevtestKeysetPreflightEntry) []DevtestKeysetPr
 			continue
 		}
 		out = append(out, DevtestKeysetPreflightEntry{
+			MerchantID:            policies.NormalizeMerchantID(entry.MerchantID),
 			Chain:                 chain,
 			Network:               network,
 			KeysetID:              keysetID,
@@ -21,6 +21,7 @@ func TestValidateCatalogRowHappyPathBitcoin(t *testing.T) {
 	}, false)
 
 	row := catalogValidationRow{
+		MerchantID:              "default",
 		Chain:                   "bitcoin",
 		Network:                 "testnet",
 		Asset:                   "BTC",
@@ -31,6 +32,7 @@ func TestValidateCatalogRowHappyPathBitcoin(t *testing.T) {
 		TokenContract:           sql.NullString{},
 		TokenDecimals:           sql.NullInt64{},
 		WalletID:                sql.NullString{String: "wa_btc_testnet_001", Valid: true},
+		WalletMerchantID:        sql.NullString{String: "default", Valid: true},
 		WalletChain:             sql.NullString{String: "bitcoin", Valid: true},
 		WalletNetwork:           sql.NullString{String: "testnet", Valid: true},
 		WalletKeysetID:          sql.NullString{String: "ks_btc_testnet", Valid: true},
@@ -111,12 +113,30 @@ func TestValidateCatalogRowRejectsMainnetWhenGuardDisabled(t *testing.T) {
 	}
 }
 
+func TestValidateCatalogRowRejectsWalletOfAnotherMerchant(t *testing.T) {
+	gateway := newValidationTestGateway(map[string]string{
+		"ks_btc_testnet": testTPub,
+	}, false)
+
+	row := newBitcoinValidationRow()
+	row.MerchantID = "retail"
+
+	appErr := gateway.validateCatalogRow(row)
+	if appErr == nil || appErr.Code != "invalid_configuration" {
+		t.Fatalf("expected invalid_configuration, got %+v", appErr)
+	}
+	if appErr.Details["wallet_merchant_id"] != "default" {
+		t.Fatalf("expected wallet merchant in details, got %+v", appErr.Details)
+	}
+}
+
 func TestValidateCatalogRowRejectsTokenMetadataInvariant(t *testing.T) {
 	gateway := newValidationTestGateway(map[string]string{
 		"ks_eth_sepolia": testXPub,
 	}, false)
 
 	row := catalogValidationRow{
+		MerchantID:              "default",
 		Chain:                   "ethereum",
 		Network:                 "sepolia",
 		Asset:                   "USDT",
@@ -128,6 +148,7 @@ func TestValidateCatalogRowRejectsTokenMetadataInvariant(t *testing.T) {
 		TokenContract:           sql.NullString{}, // invalid: token row but missing token_contract
 		TokenDecimals:           sql.NullInt64{Int64: 6, Valid: true},
 		WalletID:                sql.NullString{String: "wa_eth_sepolia_001", Valid: true},
+		WalletMerchantID:        sql.NullString{String: "default", Valid: true},
 		WalletChain:             sql.NullString{String: "ethereum", Valid: true},
 		WalletNetwork:           sql.NullString{String: "sepolia", Valid: true},
 		WalletKeysetID:          sql.NullString{String: "ks_eth_sepolia", Valid: true},
@@ -190,6 +211,35 @@ func TestVerifyIndexZeroPreflightMismatch(t *testing.T) {
 	}
 }
 
+func TestAppendMerchantSyncTargetsAddsUnprovisionedMerchants(t *testing.T) {
+	existing := []catalogSyncTarget{
+		{MerchantID: "default", Chain: "bitcoin", Network: "testnet", KeysetID: "ks_btc_testnet"},
+		{MerchantID: "retail", Chain: "bitcoin", Network: "testnet", KeysetID: "ks_retail_btc"},
+	}
+	preflights := map[string]DevtestKeysetPreflightEntry{}
+	for _, entry := range []DevtestKeysetPreflightEntry{
+		{MerchantID: "default", Chain: "ethereum", Network: "sepolia", KeysetID: "ks_eth_sepolia"},
+		{MerchantID: "retail", Chain: "bitcoin", Network: "testnet", KeysetID: "ks_retail_btc"},
+		{MerchantID: "retail", Chain: "ethereum", Network: "sepolia", KeysetID: "ks_retail_eth"},
+	} {
+		preflights[catalogSyncTarget{
+			MerchantID: entry.MerchantID,
+			Chain:      entry.Chain,
+			Network:    entry.Network,
+			KeysetID:   entry.KeysetID,
+		}.key()] = entry
+	}
+
+	targets := appendMerchantSyncTargets(existing, preflights)
+	if len(targets) != 3 {
+		t.Fatalf("expected 3 targets, got %+v", targets)
+	}
+	added := targets[2]
+	if added.MerchantID != "retail" || added.Chain != "ethereum" || added.KeysetID != "ks_retail_eth" {
+		t.Fatalf("unexpected appended target: %+v", added)
+	}
+}
+
 func TestClassifyHashMatch(t *testing.T) {
 	active := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 	legacy := map[string]struct{}{
@@ -238,6 +288,7 @@ func newValidationTestGateway(keysets map[string]string, allowMainnet bool) *Gat
 
 func newBitcoinValidationRow() catalogValidationRow {
 	return catalogValidationRow{
+		MerchantID:              "default",
 		Chain:                   "bitcoin",
 		Network:                 "testnet",
 		Asset:                   "BTC",
@@ -248,6 +299,7 @@ func newBitcoinValidationRow() catalogValidationRow {
 		TokenContract:           sql.NullString{},
 		TokenDecimals:           sql.NullInt64{},
 		WalletID:                sql.NullString{String: "wa_btc_testnet_001", Valid: true},
+		WalletMerchantID:        sql.NullString{String: "default", Valid: true},
 		WalletChain:             sql.NullString{String: "bitcoin", Valid: true},
 		WalletNetwork:           sql.NullString{String: "testnet", Valid: true},
 		WalletKeysetID:          sql.NullString{String: "ks_btc_testnet", Valid: true},
@@ -8,9 +8,11 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	"chaintx/internal/domain/policies"
 	"chaintx/internal/infrastructure/walletkeys"
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
@@ -28,13 +30,14 @@ const (
 )
 
 type catalogSyncTarget struct {
-	Chain    string
-	Network  string
-	KeysetID string
+	MerchantID string
+	Chain      string
+	Network    string
+	KeysetID   string
 }
 
 func (t catalogSyncTarget) key() string {
-	return t.Chain + "|" + t.Network + "|" + t.KeysetID
+	return t.MerchantID + "|" + t.Chain + "|" + t.Network + "|" + t.KeysetID
 }
 
 type hashCandidate struct {
@@ -79,6 +82,7 @@ func (g *Gateway) syncDevtestWalletAllocationState(ctx context.Context) *apperro
 	preflightByTarget := map[string]DevtestKeysetPreflightEntry{}
 	for _, entry := range g.validationRules.DevtestKeysetPreflight {
 		normalizedEntry := DevtestKeysetPreflightEntry{
+			MerchantID:            policies.NormalizeMerchantID(entry.MerchantID),
 			Chain:                 strings.ToLower(strings.TrimSpace(entry.Chain)),
 			Network:               strings.ToLower(strings.TrimSpace(entry.Network)),
 			KeysetID:              strings.TrimSpace(entry.KeysetID),
@@ -88,7 +92,12 @@ func (g *Gateway) syncDevtestWalletAllocationState(ctx context.Context) *apperro
 		if normalizedEntry.Chain == "" || normalizedEntry.Network == "" || normalizedEntry.KeysetID == "" || normalizedEntry.ExtendedPublicKey == "" || normalizedEntry.ExpectedIndex0Address == "" {
 			continue
 		}
-		preflightByTarget[normalizedEntry.Chain+"|"+normalizedEntry.Network+"|"+normalizedEntry.KeysetID] = normalizedEntry
+		preflightByTarget[catalogSyncTarget{
+			MerchantID: normalizedEntry.MerchantID,
+			Chain:      normalizedEntry.Chain,
+			Network:    normalizedEntry.Network,
+			KeysetID:   normalizedEntry.KeysetID,
+		}.key()] = normalizedEntry
 	}
 	if len(preflightByTarget) == 0 {
 		return apperrors.NewInternal(
@@ -108,10 +117,15 @@ func (g *Gateway) syncDevtestWalletAllocationState(ctx context.Context) *apperro
 	}
 	defer db.Close()
 
+	if appErr := g.syncMerchants(ctx, db); appErr != nil {
+		return appErr
+	}
+
 	targets, appErr := g.loadEnabledCatalogSyncTargets(ctx, db)
 	if appErr != nil {
 		return appErr
 	}
+	targets = appendMerchantSyncTargets(targets, preflightByTarget)
 	if len(targets) == 0 {
 		g.logf("wallet-account startup sync skipped: no enabled catalog targets")
 		return nil
@@ -124,9 +138,10 @@ func (g *Gateway) syncDevtestWalletAllocationState(ctx context.Context) *apperro
 				"invalid_configuration",
 				"devtest keyset is missing for startup wallet sync",
 				map[string]any{
-					"chain":     target.Chain,
-					"network":   target.Network,
-					"keyset_id": target.KeysetID,
+					"merchant_id": target.MerchantID,
+					"chain":       target.Chain,
+					"network":     target.Network,
+					"keyset_id":   target.KeysetID,
 				},
 			)
 		}
@@ -137,9 +152,10 @@ func (g *Gateway) syncDevtestWalletAllocationState(ctx context.Context) *apperro
 				"invalid_configuration",
 				"missing preflight entry for enabled wallet target",
 				map[string]any{
-					"chain":     target.Chain,
-					"network":   target.Network,
-					"keyset_id": target.KeysetID,
+					"merchant_id": target.MerchantID,
+					"chain":       target.Chain,
+					"network":     target.Network,
+					"keyset_id":   target.KeysetID,
 				},
 			)
 		}
@@ -165,8 +181,9 @@ func (g *Gateway) syncDevtestWalletAllocationState(ctx context.Context) *apperro
 		}
 
 		g.logf(
-			"wallet-account startup sync action=%s chain=%s network=%s keyset_id=%s wallet_account_id=%s hash_prefix=%s match_source=%s",
+			"wallet-account startup sync action=%s merchant_id=%s chain=%s network=%s keyset_id=%s wallet_account_id=%s hash_prefix=%s match_source=%s",
 			outcome.Action,
+			target.MerchantID,
 			target.Chain,
 			target.Network,
 			target.KeysetID,
@@ -182,11 +199,11 @@ func (g *Gateway) syncDevtestWalletAllocationState(ctx context.Context) *apperro
 
 func (g *Gateway) loadEnabledCatalogSyncTargets(ctx context.Context, db *sql.DB) ([]catalogSyncTarget, *apperrors.AppError) {
 	const query = `
-SELECT DISTINCT ac.chain, ac.network, wa.keyset_id
+SELECT DISTINCT ac.merchant_id, ac.chain, ac.network, wa.keyset_id
 FROM app.asset_catalog ac
 JOIN app.wallet_accounts wa ON wa.id = ac.wallet_account_id
 WHERE ac.enabled = TRUE
-ORDER BY ac.chain, ac.network, wa.keyset_id
+ORDER BY ac.merchant_id, ac.chain, ac.network, wa.keyset_id
 `
 
 	rows, err := db.QueryContext(ctx, query)
@@ -201,10 +218,11 @@ ORDER BY ac.chain, ac.network, wa.keyset_id
 
 	targets := []catalogSyncTarget{}
 	for rows.Next() {
+		var merchantID string
 		var chain string
 		var network string
 		var keysetID string
-		if err := rows.Scan(&chain, &network, &keysetID); err != nil {
+		if err := rows.Scan(&merchantID, &chain, &network, &keysetID); err != nil {
 			return nil, apperrors.NewInternal(
 				"invalid_configuration",
 				"failed to parse wallet sync target row",
@@ -213,9 +231,10 @@ ORDER BY ac.chain, ac.network, wa.keyset_id
 		}
 
 		target := catalogSyncTarget{
-			Chain:    strings.ToLower(strings.TrimSpace(chain)),
-			Network:  strings.ToLower(strings.TrimSpace(network)),
-			KeysetID: strings.TrimSpace(keysetID),
+			MerchantID: strings.TrimSpace(merchantID),
+			Chain:      strings.ToLower(strings.TrimSpace(chain)),
+			Network:    strings.ToLower(strings.TrimSpace(network)),
+			KeysetID:   strings.TrimSpace(keysetID),
 		}
 		if target.Chain == "" || target.Network == "" || target.KeysetID == "" {
 			return nil, apperrors.NewInternal(
@@ -243,6 +262,70 @@ ORDER BY ac.chain, ac.network, wa.keyset_id
 	return targets, nil
 }
 
+func (g *Gateway) syncMerchants(ctx context.Context, db *sql.DB) *apperrors.AppError {
+	const query = `
+INSERT INTO app.merchants (id, display_name, status, created_at, updated_at)
+VALUES ($1, $2, 'active', now(), now())
+ON CONFLICT (id) DO UPDATE
+SET display_name = EXCLUDED.display_name,
+    status = 'active',
+    updated_at = now()
+`
+
+	for _, merchant := range g.validationRules.Merchants {
+		merchantID := policies.NormalizeMerchantID(merchant.ID)
+		if merchantID == policies.DefaultMerchantID {
+			continue
+		}
+		displayName := strings.TrimSpace(merchant.DisplayName)
+		if displayName == "" {
+			displayName = merchantID
+		}
+		if _, err := db.ExecContext(ctx, query, merchantID, displayName); err != nil {
+			return apperrors.NewInternal(
+				"invalid_configuration",
+				"failed to upsert configured merchant",
+				map[string]any{"error": err.Error(), "merchant_id": merchantID},
+			)
+		}
+	}
+
+	return nil
+}
+
+// appendMerchantSyncTargets adds preflight targets of non-default merchants
+// that have no catalog rows yet, so their first startup provisions wallets.
+func appendMerchantSyncTargets(
+	targets []catalogSyncTarget,
+	preflightByTarget map[string]DevtestKeysetPreflightEntry,
+) []catalogSyncTarget {
+	seen := make(map[string]struct{}, len(targets))
+	for _, target := range targets {
+		seen[target.key()] = struct{}{}
+	}
+
+	extra := []catalogSyncTarget{}
+	for key, entry := range preflightByTarget {
+		if entry.MerchantID == policies.DefaultMerchantID {
+			continue
+		}
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		extra = append(extra, catalogSyncTarget{
+			MerchantID: entry.MerchantID,
+			Chain:      entry.Chain,
+			Network:    entry.Network,
+			KeysetID:   entry.KeysetID,
+		})
+	}
+	sort.Slice(extra, func(i, j int) bool {
+		return extra[i].key() < extra[j].key()
+	})
+
+	return append(targets, extra...)
+}
+
 func (g *Gateway) verifyIndexZeroPreflight(target catalogSyncTarget, rawKey string, expectedAddress string) *apperrors.AppError {
 	normalizer, exists := g.validationRules.DevtestKeyNormalizers[target.Chain]
 	if !exists {
@@ -474,13 +557,14 @@ FROM app.wallet_accounts
 WHERE chain = $1
   AND network = $2
   AND keyset_id = $3
+  AND merchant_id = $4
   AND is_active = TRUE
 ORDER BY updated_at DESC, created_at DESC
 LIMIT 1
 FOR UPDATE
 `
 
-	row := tx.QueryRowContext(ctx, query, target.Chain, target.Network, target.KeysetID)
+	row := tx.QueryRowContext(ctx, query, target.Chain, target.Network, target.KeysetID, target.MerchantID)
 	ref := walletAccountRef{}
 	err := row.Scan(&ref.ID, &ref.Hash)
 	if err == sql.ErrNoRows {
@@ -512,6 +596,7 @@ WHERE chain = $1
   AND keyset_id = $3
   AND is_active = FALSE
   AND key_material_hash = $4
+  AND merchant_id = $5
 ORDER BY updated_at DESC, created_at DESC
 LIMIT 1
 FOR UPDATE
@@ -522,7 +607,7 @@ FOR UPDATE
 			continue
 		}
 		ref := walletAccountRef{}
-		err := tx.QueryRowContext(ctx, query, target.Chain, target.Network, target.KeysetID, candidate.Hash).Scan(&ref.ID, &ref.Hash)
+		err := tx.QueryRowContext(ctx, query, target.Chain, target.Network, target.KeysetID, candidate.Hash, target.MerchantID).Scan(&ref.ID, &ref.Hash)
 		if err == sql.ErrNoRows {
 			continue
 		}
@@ -549,9 +634,10 @@ SET is_active = FALSE,
 WHERE chain = $1
   AND network = $2
   AND keyset_id = $3
+  AND merchant_id = $4
   AND is_active = TRUE
 `
-	if _, err := tx.ExecContext(ctx, query, target.Chain, target.Network, target.KeysetID); err != nil {
+	if _, err := tx.ExecContext(ctx, query, target.Chain, target.Network, target.KeysetID, target.MerchantID); err != nil {
 		return apperrors.NewInternal(
 			"wallet_account_sync_failed",
 			"failed to deactivate active wallet accounts",
@@ -569,10 +655,11 @@ SET is_active = FALSE,
 WHERE chain = $1
   AND network = $2
   AND keyset_id = $3
+  AND merchant_id = $5
   AND is_active = TRUE
   AND id <> $4
 `
-	if _, err := tx.ExecContext(ctx, query, target.Chain, target.Network, target.KeysetID, keepID); err != nil {
+	if _, err := tx.ExecContext(ctx, query, target.Chain, target.Network, target.KeysetID, keepID, target.MerchantID); err != nil {
 		return apperrors.NewInternal(
 			"wallet_account_sync_failed",
 			"failed to deactivate stale active wallet accounts",
@@ -644,13 +731,14 @@ INSERT INTO app.wallet_accounts (
   is_active,
   key_material_hash,
   key_material_hash_algo,
+  merchant_id,
   created_at,
   updated_at
 )
-VALUES ($1, $2, $3, $4, $5, 0, TRUE, $6, $7, now(), now())
+VALUES ($1, $2, $3, $4, $5, 0, TRUE, $6, $7, $8, now(), now())
 `
 
-	if _, err := tx.ExecContext(ctx, query, walletAccountID, target.Chain, target.Network, target.KeysetID, derivationPathTemplate, keyHash, keyHashAlgo); err != nil {
+	if _, err := tx.ExecContext(ctx, query, walletAccountID, target.Chain, target.Network, target.KeysetID, derivationPathTemplate, keyHash, keyHashAlgo, target.MerchantID); err != nil {
 		return apperrors.NewInternal(
 			"wallet_account_sync_failed",
 			"failed to insert rotated wallet account",
@@ -674,6 +762,7 @@ WITH updated AS (
       updated_at = now()
   FROM app.wallet_accounts wa
   WHERE ac.wallet_account_id = wa.id
+    AND ac.merchant_id = $5
     AND ac.chain = $2
     AND ac.network = $3
     AND wa.keyset_id = $4
@@ -683,21 +772,29 @@ SELECT count(*) FROM updated
 `
 
 	var count int64
-	if err := tx.QueryRowContext(ctx, query, walletAccountID, target.Chain, target.Network, target.KeysetID).Scan(&count); err != nil {
+	if err := tx.QueryRowContext(ctx, query, walletAccountID, target.Chain, target.Network, target.KeysetID, target.MerchantID).Scan(&count); err != nil {
 		return apperrors.NewInternal(
 			"wallet_account_sync_failed",
 			"failed to bind asset catalog wallet account",
 			map[string]any{"error": err.Error(), "wallet_account_id": walletAccountID},
 		)
 	}
+	if count == 0 && target.MerchantID != policies.DefaultMerchantID {
+		provisioned, appErr := provisionMerchantAssetCatalog(ctx, tx, target, walletAccountID)
+		if appErr != nil {
+			return appErr
+		}
+		count = provisioned
+	}
 	if count == 0 {
 		return apperrors.NewInternal(
 			"invalid_configuration",
 			"no asset catalog row found for wallet sync target",
 			map[string]any{
-				"chain":     target.Chain,
-				"network":   target.Network,
-				"keyset_id": target.KeysetID,
+				"merchant_id": target.MerchantID,
+				"chain":       target.Chain,
+				"network":     target.Network,
+				"keyset_id":   target.KeysetID,
 			},
 		)
 	}
@@ -705,6 +802,88 @@ SELECT count(*) FROM updated
 	return nil
 }
 
+// provisionMerchantAssetCatalog seeds a merchant's catalog for one
+// chain/network from the default merchant's rows, bound to the merchant's
+// own wallet account.
+func provisionMerchantAssetCatalog(
+	ctx context.Context,
+	tx *sql.Tx,
+	target catalogSyncTarget,
+	walletAccountID string,
+) (int64, *apperrors.AppError) {
+	const query = `
+WITH inserted AS (
+  INSERT INTO app.asset_catalog (
+    merchant_id,
+    chain,
+    network,
+    asset,
+    wallet_account_id,
+    minor_unit,
+    decimals,
+    address_scheme,
+    chain_id,
+    token_standard,
+    token_contract,
+    token_decimals,
+    default_expires_in_seconds,
+    enabled,
+    source_ref,
+    approved_by,
+    approved_at,
+    created_at,
+    updated_at
+  )
+  SELECT
+    $1,
+    ac.chain,
+    ac.network,
+    ac.asset,
+    $2,
+    ac.minor_unit,
+    ac.decimals,
+    ac.address_scheme,
+    ac.chain_id,
+    ac.token_standard,
+    ac.token_contract,
+    ac.token_decimals,
+    ac.default_expires_in_seconds,
+    ac.enabled,
+    ac.source_ref,
+    ac.approved_by,
+    ac.approved_at,
+    now(),
+    now()
+  FROM app.asset_catalog ac
+  WHERE ac.merchant_id = $5
+    AND ac.chain = $3
+    AND ac.network = $4
+  ON CONFLICT (merchant_id, chain, network, asset) DO NOTHING
+  RETURNING 1
+)
+SELECT count(*) FROM inserted
+`
+
+	var count int64
+	if err := tx.QueryRowContext(
+		ctx,
+		query,
+		target.MerchantID,
+		walletAccountID,
+		target.Chain,
+		target.Network,
+		policies.DefaultMerchantID,
+	).Scan(&count); err != nil {
+		return 0, apperrors.NewInternal(
+			"wallet_account_sync_failed",
+			"failed to provision merchant asset catalog",
+			map[string]any{"error": err.Error(), "merchant_id": target.MerchantID, "wallet_account_id": walletAccountID},
+		)
+	}
+
+	return count, nil
+}
+
 func insertWalletSyncEvent(
 	ctx context.Context,
 	tx *sql.Tx,
@@ -727,9 +906,10 @@ INSERT INTO app.wallet_account_sync_events (
   key_material_hash,
   key_material_hash_algo,
   details,
+  merchant_id,
   created_at
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now())
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now())
 `
 
 	detailsPayload := []byte("{}")
@@ -757,6 +937,7 @@ VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now())
 		keyHash,
 		keyHashAlgo,
 		detailsPayload,
+		target.MerchantID,
 	); err != nil {
 		return apperrors.NewInternal(
 			"wallet_account_sync_failed",
@@ -0,0 +1,257 @@
+package merchantapikey
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// merchantAPIKeyColumns flattens scopes to a comma separated string; scope
+// names are constrained to a fixed alphabet so the split is lossless.
+const merchantAPIKeyColumns = `
+  id,
+  merchant_id,
+  principal_id,
+  key_prefix,
+  array_to_string(scopes, ','),
+  label,
+  created_by,
+  created_at,
+  expires_at,
+  revoked_at,
+  last_used_at`
+
+type Repository struct {
+	db *sql.DB
+}
+
+var _ portsout.MerchantAPIKeyRepository = (*Repository)(nil)
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Create(
+	ctx context.Context,
+	credential dto.MerchantAPIKeyCredential,
+) (dto.MerchantAPIKey, *apperrors.AppError) {
+	query := `
+INSERT INTO app.merchant_api_keys (
+  id,
+  principal_id,
+  key_prefix,
+  key_hash,
+  scopes,
+  label,
+  created_by,
+  created_at,
+  expires_at,
+  merchant_id
+)
+VALUES ($1, $2, $3, $4, $5::text[], $6, $7, $8, $9, $10)
+RETURNING` + merchantAPIKeyColumns
+
+	key := credential.Key
+	item, err := scanMerchantAPIKey(r.db.QueryRowContext(
+		ctx,
+		query,
+		key.ID,
+		key.PrincipalID,
+		key.KeyPrefix,
+		credential.KeyHash,
+		key.Scopes,
+		nullableString(key.Label),
+		nullableString(key.CreatedBy),
+		key.CreatedAt.UTC(),
+		nullableTime(key.ExpiresAt),
+		key.MerchantID,
+	))
+	if isForeignKeyViolation(err) {
+		return dto.MerchantAPIKey{}, apperrors.NewValidation(
+			"invalid_request",
+			"merchant_id is not a known merchant",
+			map[string]any{"field": "merchant_id", "merchant_id": key.MerchantID},
+		)
+	}
+	if err != nil {
+		return dto.MerchantAPIKey{}, merchantAPIKeyQueryFailed("failed to insert merchant api key", err)
+	}
+	return item, nil
+}
+
+func (r *Repository) FindByPrefix(
+	ctx context.Context,
+	keyPrefix string,
+) (dto.MerchantAPIKeyCredential, bool, *apperrors.AppError) {
+	query := `
+SELECT` + merchantAPIKeyColumns + `,
+  key_hash
+FROM app.merchant_api_keys
+WHERE key_prefix = $1
+`
+	credential := dto.MerchantAPIKeyCredential{}
+	item, err := scanMerchantAPIKey(r.db.QueryRowContext(ctx, query, keyPrefix), &credential.KeyHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return dto.MerchantAPIKeyCredential{}, false, nil
+	}
+	if err != nil {
+		return dto.MerchantAPIKeyCredential{}, false, merchantAPIKeyQueryFailed("failed to query merchant api key", err)
+	}
+	credential.Key = item
+	return credential, true, nil
+}
+
+func (r *Repository) List(ctx context.Context, merchantID string, principalID string) ([]dto.MerchantAPIKey, *apperrors.AppError) {
+	query := `
+SELECT` + merchantAPIKeyColumns + `
+FROM app.merchant_api_keys
+WHERE merchant_id = $1
+  AND principal_id = $2
+ORDER BY created_at DESC, id DESC
+`
+	rows, err := r.db.QueryContext(ctx, query, merchantID, principalID)
+	if err != nil {
+		return nil, merchantAPIKeyQueryFailed("failed to query merchant api keys", err)
+	}
+	defer rows.Close()
+
+	items := []dto.MerchantAPIKey{}
+	for rows.Next() {
+		item, err := scanMerchantAPIKey(rows)
+		if err != nil {
+			return nil, merchantAPIKeyQueryFailed("failed to parse merchant api key", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, merchantAPIKeyQueryFailed("failed while iterating merchant api keys", err)
+	}
+	return items, nil
+}
+
+func (r *Repository) Revoke(
+	ctx context.Context,
+	keyID string,
+	revokedAt time.Time,
+) (dto.MerchantAPIKey, bool, *apperrors.AppError) {
+	query := `
+UPDATE app.merchant_api_keys
+SET revoked_at = COALESCE(revoked_at, $2)
+WHERE id = $1
+RETURNING` + merchantAPIKeyColumns
+
+	item, err := scanMerchantAPIKey(r.db.QueryRowContext(ctx, query, keyID, revokedAt.UTC()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return dto.MerchantAPIKey{}, false, nil
+	}
+	if err != nil {
+		return dto.MerchantAPIKey{}, false, merchantAPIKeyQueryFailed("failed to revoke merchant api key", err)
+	}
+	return item, true, nil
+}
+
+func (r *Repository) TouchLastUsed(ctx context.Context, keyID string, usedAt time.Time) *apperrors.AppError {
+	const query = `
+UPDATE app.merchant_api_keys
+SET last_used_at = $2
+WHERE id = $1
+  AND (last_used_at IS NULL OR last_used_at < $2)
+`
+	if _, err := r.db.ExecContext(ctx, query, keyID, usedAt.UTC()); err != nil {
+		return merchantAPIKeyQueryFailed("failed to update merchant api key usage", err)
+	}
+	return nil
+}
+
+type merchantAPIKeyScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMerchantAPIKey(scanner merchantAPIKeyScanner, extra ...any) (dto.MerchantAPIKey, error) {
+	item := dto.MerchantAPIKey{}
+	var (
+		scopes     string
+		label      sql.NullString
+		createdBy  sql.NullString
+		expiresAt  sql.NullTime
+		revokedAt  sql.NullTime
+		lastUsedAt sql.NullTime
+	)
+	dest := append([]any{
+		&item.ID,
+		&item.MerchantID,
+		&item.PrincipalID,
+		&item.KeyPrefix,
+		&scopes,
+		&label,
+		&createdBy,
+		&item.CreatedAt,
+		&expiresAt,
+		&revokedAt,
+		&lastUsedAt,
+	}, extra...)
+	if err := scanner.Scan(dest...); err != nil {
+		return dto.MerchantAPIKey{}, err
+	}
+
+	item.Scopes = []string{}
+	if scopes != "" {
+		item.Scopes = strings.Split(scopes, ",")
+	}
+	if label.Valid {
+		value := label.String
+		item.Label = &value
+	}
+	if createdBy.Valid {
+		value := createdBy.String
+		item.CreatedBy = &value
+	}
+	item.CreatedAt = item.CreatedAt.UTC()
+	item.ExpiresAt = utcTimePointer(expiresAt)
+	item.RevokedAt = utcTimePointer(revokedAt)
+	item.LastUsedAt = utcTimePointer(lastUsedAt)
+	return item, nil
+}
+
+func nullableString(value *string) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *value, Valid: true}
+}
+
+func nullableTime(value *time.Time) sql.NullTime {
+	if value == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: value.UTC(), Valid: true}
+}
+
+func utcTimePointer(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	utc := value.Time.UTC()
+	return &utc
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
+}
+
+func merchantAPIKeyQueryFailed(message string, err error) *apperrors.AppError {
+	return apperrors.NewInternal(
+		"merchant_api_key_query_failed",
+		message,
+		map[string]any{"error": err.Error()},
+	)
+}
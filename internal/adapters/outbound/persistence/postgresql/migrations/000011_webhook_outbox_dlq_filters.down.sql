@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_webhook_outbox_destination_host;
+DROP INDEX IF EXISTS idx_webhook_outbox_dlq_scan;
+
+ALTER TABLE app.webhook_outbox_events
+  DROP COLUMN IF EXISTS destination_host;
@@ -0,0 +1,18 @@
+ALTER TABLE app.webhook_outbox_events
+  ADD COLUMN IF NOT EXISTS destination_host text
+  GENERATED ALWAYS AS (
+    lower(
+      btrim(
+        substring(
+          destination_url FROM '^[A-Za-z][A-Za-z0-9+.-]*://(?:[^/@?#]*@)?(\[[^]]*\]|[^/:?#]+)'
+        ),
+        '[]'
+      )
+    )
+  ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_dlq_scan
+  ON app.webhook_outbox_events (delivery_status, updated_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_destination_host
+  ON app.webhook_outbox_events (destination_host, delivery_status);
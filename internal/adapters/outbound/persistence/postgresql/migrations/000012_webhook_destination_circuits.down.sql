@@ -0,0 +1 @@
+DROP TABLE IF EXISTS app.webhook_destination_circuits;
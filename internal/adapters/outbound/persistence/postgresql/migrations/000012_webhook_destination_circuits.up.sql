@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS app.webhook_destination_circuits (
+  destination_host text PRIMARY KEY,
+  state text NOT NULL DEFAULT 'closed',
+  consecutive_failures integer NOT NULL DEFAULT 0,
+  open_until timestamptz,
+  probe_owner text,
+  probe_until timestamptz,
+  rate_window_start timestamptz,
+  rate_window_count integer NOT NULL DEFAULT 0,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT webhook_destination_circuit_state_allowed
+    CHECK (state IN ('closed', 'open', 'half_open')),
+  CONSTRAINT webhook_destination_circuit_failures_non_negative
+    CHECK (consecutive_failures >= 0),
+  CONSTRAINT webhook_destination_circuit_rate_count_non_negative
+    CHECK (rate_window_count >= 0)
+);
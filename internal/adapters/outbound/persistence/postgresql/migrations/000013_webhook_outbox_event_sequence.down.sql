@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_webhook_outbox_pending_sequence;
+
+DROP INDEX IF EXISTS idx_webhook_outbox_payment_request_sequence;
+
+ALTER TABLE app.webhook_outbox_events
+  DROP COLUMN IF EXISTS sequence;
+
+ALTER TABLE app.payment_requests
+  DROP COLUMN IF EXISTS webhook_event_sequence;
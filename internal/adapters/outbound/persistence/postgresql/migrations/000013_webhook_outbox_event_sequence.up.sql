@@ -0,0 +1,37 @@
+ALTER TABLE app.payment_requests
+  ADD COLUMN IF NOT EXISTS webhook_event_sequence bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE app.webhook_outbox_events
+  ADD COLUMN IF NOT EXISTS sequence bigint;
+
+WITH numbered AS (
+  SELECT
+    id,
+    row_number() OVER (PARTITION BY payment_request_id ORDER BY created_at ASC, id ASC) AS sequence
+  FROM app.webhook_outbox_events
+)
+UPDATE app.webhook_outbox_events AS e
+SET sequence = numbered.sequence
+FROM numbered
+WHERE e.id = numbered.id
+  AND e.sequence IS NULL;
+
+UPDATE app.payment_requests AS pr
+SET webhook_event_sequence = latest.sequence
+FROM (
+  SELECT payment_request_id, MAX(sequence) AS sequence
+  FROM app.webhook_outbox_events
+  GROUP BY payment_request_id
+) AS latest
+WHERE pr.id = latest.payment_request_id
+  AND pr.webhook_event_sequence < latest.sequence;
+
+ALTER TABLE app.webhook_outbox_events
+  ALTER COLUMN sequence SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_outbox_payment_request_sequence
+  ON app.webhook_outbox_events (payment_request_id, sequence);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_pending_sequence
+  ON app.webhook_outbox_events (payment_request_id, sequence)
+  WHERE delivery_status = 'pending';
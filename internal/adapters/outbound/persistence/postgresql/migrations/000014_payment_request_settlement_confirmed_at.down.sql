@@ -0,0 +1,2 @@
+ALTER TABLE app.payment_request_settlements
+  DROP COLUMN IF EXISTS confirmed_at;
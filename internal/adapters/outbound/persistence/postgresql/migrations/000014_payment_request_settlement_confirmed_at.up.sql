@@ -0,0 +1,10 @@
+ALTER TABLE app.payment_request_settlements
+  ADD COLUMN IF NOT EXISTS confirmed_at timestamptz;
+
+UPDATE app.payment_request_settlements AS s
+SET confirmed_at = s.updated_at
+FROM app.payment_requests AS pr
+WHERE pr.id = s.payment_request_id
+  AND pr.status = 'confirmed'
+  AND s.is_canonical = TRUE
+  AND s.confirmed_at IS NULL;
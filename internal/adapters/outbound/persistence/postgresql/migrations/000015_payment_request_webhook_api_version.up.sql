@@ -0,0 +1,2 @@
+ALTER TABLE app.payment_requests
+  ADD COLUMN IF NOT EXISTS webhook_api_version text NOT NULL DEFAULT 'v1';
@@ -0,0 +1,21 @@
+DELETE FROM app.webhook_outbox_events
+WHERE sink <> 'webhook';
+
+DROP INDEX IF EXISTS idx_webhook_outbox_pending_sink_sequence;
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_pending_sequence
+  ON app.webhook_outbox_events (payment_request_id, sequence)
+  WHERE delivery_status = 'pending';
+
+DROP INDEX IF EXISTS idx_webhook_outbox_payment_request_sink_sequence;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_outbox_payment_request_sequence
+  ON app.webhook_outbox_events (payment_request_id, sequence);
+
+DROP INDEX IF EXISTS idx_webhook_outbox_event_sink;
+
+ALTER TABLE app.webhook_outbox_events
+  ADD CONSTRAINT webhook_outbox_events_event_id_key UNIQUE (event_id);
+
+ALTER TABLE app.webhook_outbox_events
+  DROP COLUMN IF EXISTS sink;
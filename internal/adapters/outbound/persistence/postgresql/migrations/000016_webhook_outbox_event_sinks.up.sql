@@ -0,0 +1,19 @@
+ALTER TABLE app.webhook_outbox_events
+  ADD COLUMN IF NOT EXISTS sink text NOT NULL DEFAULT 'webhook';
+
+ALTER TABLE app.webhook_outbox_events
+  DROP CONSTRAINT IF EXISTS webhook_outbox_events_event_id_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_outbox_event_sink
+  ON app.webhook_outbox_events (event_id, sink);
+
+DROP INDEX IF EXISTS idx_webhook_outbox_payment_request_sequence;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_outbox_payment_request_sink_sequence
+  ON app.webhook_outbox_events (payment_request_id, sink, sequence);
+
+DROP INDEX IF EXISTS idx_webhook_outbox_pending_sequence;
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_pending_sink_sequence
+  ON app.webhook_outbox_events (payment_request_id, sink, sequence)
+  WHERE delivery_status = 'pending';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS app.payment_request_events;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS app.payment_request_events (
+  payment_request_id text NOT NULL REFERENCES app.payment_requests (id) ON DELETE CASCADE,
+  sequence bigint NOT NULL,
+  event_id text NOT NULL UNIQUE,
+  event_type text NOT NULL,
+  payload jsonb NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (payment_request_id, sequence)
+);
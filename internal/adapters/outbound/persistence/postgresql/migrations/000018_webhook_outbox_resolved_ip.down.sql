@@ -0,0 +1,2 @@
+ALTER TABLE app.webhook_outbox_events
+  DROP COLUMN IF EXISTS last_resolved_ip;
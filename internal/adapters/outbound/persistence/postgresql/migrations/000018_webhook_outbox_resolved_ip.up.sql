@@ -0,0 +1,2 @@
+ALTER TABLE app.webhook_outbox_events
+  ADD COLUMN IF NOT EXISTS last_resolved_ip text;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_webhook_outbox_retention_cancelled;
+DROP INDEX IF EXISTS idx_webhook_outbox_retention_delivered;
+
+DROP TABLE IF EXISTS app.webhook_outbox_events_archive;
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS app.webhook_outbox_events_archive (
+  id bigint NOT NULL,
+  event_id text NOT NULL,
+  event_type text NOT NULL,
+  payment_request_id text NOT NULL,
+  sink text NOT NULL,
+  sequence bigint NOT NULL,
+  payload jsonb NOT NULL,
+  destination_url text,
+  delivery_status text NOT NULL,
+  attempts integer NOT NULL,
+  max_attempts integer NOT NULL,
+  last_error text,
+  last_resolved_ip text,
+  manual_last_action text,
+  manual_last_actor text,
+  manual_last_at timestamptz,
+  delivered_at timestamptz,
+  created_at timestamptz NOT NULL,
+  updated_at timestamptz NOT NULL,
+  archived_at timestamptz NOT NULL,
+  PRIMARY KEY (archived_at, id)
+) PARTITION BY RANGE (archived_at);
+
+-- Monthly partitions are created by the retention worker before it archives;
+-- the default partition only catches rows written outside that path.
+CREATE TABLE IF NOT EXISTS app.webhook_outbox_events_archive_default
+  PARTITION OF app.webhook_outbox_events_archive DEFAULT;
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_archive_event
+  ON app.webhook_outbox_events_archive (event_id);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_archive_payment_request
+  ON app.webhook_outbox_events_archive (payment_request_id, sequence);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_retention_delivered
+  ON app.webhook_outbox_events (delivered_at, id)
+  WHERE delivery_status = 'delivered';
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_retention_cancelled
+  ON app.webhook_outbox_events (updated_at, id)
+  WHERE delivery_status = 'failed' AND manual_last_action = 'cancel';
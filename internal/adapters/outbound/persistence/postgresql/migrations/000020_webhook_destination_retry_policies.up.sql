@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS app.webhook_destination_retry_policies (
+  destination_host text PRIMARY KEY,
+  max_attempts integer NOT NULL,
+  backoff_curve text NOT NULL DEFAULT 'exponential',
+  initial_backoff_seconds integer NOT NULL,
+  max_backoff_seconds integer NOT NULL,
+  max_age_seconds integer,
+  updated_by text,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT webhook_retry_policy_host_lowercase
+    CHECK (destination_host = lower(destination_host) AND destination_host <> ''),
+  CONSTRAINT webhook_retry_policy_max_attempts_positive
+    CHECK (max_attempts > 0),
+  CONSTRAINT webhook_retry_policy_backoff_curve_allowed
+    CHECK (backoff_curve IN ('exponential', 'linear', 'fixed')),
+  CONSTRAINT webhook_retry_policy_backoff_positive
+    CHECK (initial_backoff_seconds > 0 AND max_backoff_seconds >= initial_backoff_seconds),
+  CONSTRAINT webhook_retry_policy_max_age_positive
+    CHECK (max_age_seconds IS NULL OR max_age_seconds > 0)
+);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS app.idx_payment_requests_principal;
+
+ALTER TABLE app.payment_requests
+  DROP COLUMN IF EXISTS principal_id;
+
+DROP TABLE IF EXISTS app.merchant_api_keys;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS app.merchant_api_keys (
+  id text PRIMARY KEY,
+  principal_id text NOT NULL,
+  key_prefix text NOT NULL,
+  key_hash text NOT NULL,
+  scopes text[] NOT NULL,
+  label text,
+  created_by text,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  expires_at timestamptz,
+  revoked_at timestamptz,
+  last_used_at timestamptz,
+  CONSTRAINT merchant_api_keys_prefix_unique UNIQUE (key_prefix),
+  CONSTRAINT merchant_api_keys_principal_not_blank CHECK (principal_id <> ''),
+  CONSTRAINT merchant_api_keys_scopes_allowed
+    CHECK (cardinality(scopes) > 0 AND scopes <@ ARRAY['create', 'read', 'admin']::text[])
+);
+
+CREATE INDEX IF NOT EXISTS idx_merchant_api_keys_principal
+  ON app.merchant_api_keys (principal_id, created_at DESC);
+
+ALTER TABLE app.payment_requests
+  ADD COLUMN IF NOT EXISTS principal_id text;
+
+UPDATE app.payment_requests pr
+SET principal_id = ir.scope_principal
+FROM app.idempotency_records ir
+WHERE ir.resource_id = pr.id
+  AND pr.principal_id IS NULL;
+
+UPDATE app.payment_requests
+SET principal_id = 'anonymous'
+WHERE principal_id IS NULL;
+
+ALTER TABLE app.payment_requests
+  ALTER COLUMN principal_id SET DEFAULT 'anonymous',
+  ALTER COLUMN principal_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_payment_requests_principal
+  ON app.payment_requests (principal_id, created_at DESC);
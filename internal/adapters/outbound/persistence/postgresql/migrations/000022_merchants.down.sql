@@ -0,0 +1,40 @@
+ALTER TABLE app.wallet_account_sync_events
+  DROP COLUMN IF EXISTS merchant_id;
+
+ALTER TABLE app.merchant_api_keys
+  DROP COLUMN IF EXISTS merchant_id;
+
+DELETE FROM app.idempotency_records
+WHERE scope_merchant <> 'default';
+
+ALTER TABLE app.idempotency_records
+  DROP CONSTRAINT IF EXISTS idempotency_records_pkey;
+ALTER TABLE app.idempotency_records
+  DROP COLUMN IF EXISTS scope_merchant;
+ALTER TABLE app.idempotency_records
+  ADD CONSTRAINT idempotency_records_pkey
+  PRIMARY KEY (scope_principal, scope_method, scope_path, idempotency_key);
+
+DROP INDEX IF EXISTS app.idx_payment_requests_merchant_created;
+
+ALTER TABLE app.payment_requests
+  DROP COLUMN IF EXISTS merchant_id;
+
+DELETE FROM app.asset_catalog
+WHERE merchant_id <> 'default';
+
+ALTER TABLE app.asset_catalog
+  DROP CONSTRAINT IF EXISTS asset_catalog_pkey;
+ALTER TABLE app.asset_catalog
+  DROP COLUMN IF EXISTS merchant_id;
+ALTER TABLE app.asset_catalog
+  ADD CONSTRAINT asset_catalog_pkey PRIMARY KEY (chain, network, asset);
+
+DROP INDEX IF EXISTS app.idx_wallet_accounts_chain_network_keyset_active;
+ALTER TABLE app.wallet_accounts
+  DROP COLUMN IF EXISTS merchant_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wallet_accounts_chain_network_keyset_active
+  ON app.wallet_accounts (chain, network, keyset_id)
+  WHERE is_active = TRUE;
+
+DROP TABLE IF EXISTS app.merchants;
@@ -0,0 +1,54 @@
+CREATE TABLE IF NOT EXISTS app.merchants (
+  id text PRIMARY KEY,
+  display_name text NOT NULL,
+  status text NOT NULL DEFAULT 'active',
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT merchants_id_format CHECK (id ~ '^[a-z0-9][a-z0-9_-]{0,63}$'),
+  CONSTRAINT merchants_status_allowed CHECK (status IN ('active', 'disabled'))
+);
+
+INSERT INTO app.merchants (id, display_name)
+VALUES ('default', 'Default merchant')
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE app.wallet_accounts
+  ADD COLUMN IF NOT EXISTS merchant_id text NOT NULL DEFAULT 'default'
+  REFERENCES app.merchants (id);
+
+DROP INDEX IF EXISTS app.idx_wallet_accounts_chain_network_keyset_active;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wallet_accounts_chain_network_keyset_active
+  ON app.wallet_accounts (merchant_id, chain, network, keyset_id)
+  WHERE is_active = TRUE;
+
+ALTER TABLE app.asset_catalog
+  ADD COLUMN IF NOT EXISTS merchant_id text NOT NULL DEFAULT 'default'
+  REFERENCES app.merchants (id);
+
+ALTER TABLE app.asset_catalog
+  DROP CONSTRAINT IF EXISTS asset_catalog_pkey;
+ALTER TABLE app.asset_catalog
+  ADD CONSTRAINT asset_catalog_pkey PRIMARY KEY (merchant_id, chain, network, asset);
+
+ALTER TABLE app.payment_requests
+  ADD COLUMN IF NOT EXISTS merchant_id text NOT NULL DEFAULT 'default'
+  REFERENCES app.merchants (id);
+
+CREATE INDEX IF NOT EXISTS idx_payment_requests_merchant_created
+  ON app.payment_requests (merchant_id, created_at DESC);
+
+ALTER TABLE app.idempotency_records
+  ADD COLUMN IF NOT EXISTS scope_merchant text NOT NULL DEFAULT 'default';
+
+ALTER TABLE app.idempotency_records
+  DROP CONSTRAINT IF EXISTS idempotency_records_pkey;
+ALTER TABLE app.idempotency_records
+  ADD CONSTRAINT idempotency_records_pkey
+  PRIMARY KEY (scope_merchant, scope_principal, scope_method, scope_path, idempotency_key);
+
+ALTER TABLE app.merchant_api_keys
+  ADD COLUMN IF NOT EXISTS merchant_id text NOT NULL DEFAULT 'default'
+  REFERENCES app.merchants (id);
+
+ALTER TABLE app.wallet_account_sync_events
+  ADD COLUMN IF NOT EXISTS merchant_id text NOT NULL DEFAULT 'default';
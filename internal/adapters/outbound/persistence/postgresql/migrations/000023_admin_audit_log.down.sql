@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_admin_audit_log_append_only ON app.admin_audit_log;
+DROP FUNCTION IF EXISTS app.admin_audit_log_reject_change();
+DROP TABLE IF EXISTS app.admin_audit_log;
@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS app.admin_audit_log (
+  id bigserial PRIMARY KEY,
+  occurred_at timestamptz NOT NULL DEFAULT now(),
+  actor_id text NOT NULL,
+  actor_role text NOT NULL,
+  action text NOT NULL,
+  target text,
+  reason text,
+  source_ip text,
+  outcome text NOT NULL,
+  error_code text,
+  details jsonb NOT NULL DEFAULT '{}'::jsonb,
+  CONSTRAINT admin_audit_log_actor_role_allowed
+    CHECK (actor_role IN ('viewer', 'operator', 'admin')),
+  CONSTRAINT admin_audit_log_outcome_allowed
+    CHECK (outcome IN ('succeeded', 'failed', 'forbidden'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_actor
+  ON app.admin_audit_log (actor_id, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_action
+  ON app.admin_audit_log (action, id DESC);
+
+CREATE OR REPLACE FUNCTION app.admin_audit_log_reject_change()
+RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  RAISE EXCEPTION 'app.admin_audit_log is append only';
+END;
+$$;
+
+DROP TRIGGER IF EXISTS trg_admin_audit_log_append_only ON app.admin_audit_log;
+CREATE TRIGGER trg_admin_audit_log_append_only
+  BEFORE UPDATE OR DELETE ON app.admin_audit_log
+  FOR EACH ROW
+  EXECUTE FUNCTION app.admin_audit_log_reject_change();
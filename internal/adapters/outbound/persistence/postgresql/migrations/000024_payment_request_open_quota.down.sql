@@ -0,0 +1 @@
+DROP INDEX IF EXISTS app.idx_payment_requests_open_by_principal;
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_payment_requests_open_by_principal
+  ON app.payment_requests (merchant_id, principal_id, expires_at)
+  WHERE status IN ('pending', 'detected');
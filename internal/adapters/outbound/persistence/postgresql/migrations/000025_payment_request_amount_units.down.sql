@@ -0,0 +1,6 @@
+ALTER TABLE app.payment_requests
+  DROP CONSTRAINT IF EXISTS payment_requests_decimals_non_negative;
+
+ALTER TABLE app.payment_requests
+  DROP COLUMN IF EXISTS decimals,
+  DROP COLUMN IF EXISTS minor_unit;
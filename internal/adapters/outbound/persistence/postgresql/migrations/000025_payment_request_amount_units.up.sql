@@ -0,0 +1,19 @@
+ALTER TABLE app.payment_requests
+  ADD COLUMN IF NOT EXISTS minor_unit text,
+  ADD COLUMN IF NOT EXISTS decimals integer;
+
+ALTER TABLE app.payment_requests
+  DROP CONSTRAINT IF EXISTS payment_requests_decimals_non_negative;
+ALTER TABLE app.payment_requests
+  ADD CONSTRAINT payment_requests_decimals_non_negative CHECK (decimals IS NULL OR decimals >= 0);
+
+UPDATE app.payment_requests pr
+SET
+  minor_unit = ac.minor_unit,
+  decimals = ac.decimals
+FROM app.asset_catalog ac
+WHERE pr.minor_unit IS NULL
+  AND ac.merchant_id = pr.merchant_id
+  AND ac.chain = pr.chain
+  AND ac.network = pr.network
+  AND ac.asset = pr.asset;
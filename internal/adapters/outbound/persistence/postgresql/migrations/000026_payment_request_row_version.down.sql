@@ -0,0 +1,2 @@
+ALTER TABLE app.payment_requests
+  DROP COLUMN IF EXISTS row_version;
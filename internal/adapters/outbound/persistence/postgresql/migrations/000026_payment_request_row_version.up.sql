@@ -0,0 +1,2 @@
+ALTER TABLE app.payment_requests
+  ADD COLUMN IF NOT EXISTS row_version bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS app.payment_request_status_history (
+  id bigserial PRIMARY KEY,
+  payment_request_id text NOT NULL REFERENCES app.payment_requests (id) ON DELETE CASCADE,
+  from_status text NOT NULL,
+  to_status text NOT NULL,
+  reason text NOT NULL DEFAULT '',
+  details jsonb NOT NULL DEFAULT '{}'::jsonb,
+  recorded_by text NOT NULL DEFAULT '',
+  occurred_at timestamptz NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT payment_request_status_history_changes_status CHECK (from_status <> to_status)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_request_status_history_request
+  ON app.payment_request_status_history (payment_request_id, id);
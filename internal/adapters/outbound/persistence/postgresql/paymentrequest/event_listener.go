@@ -0,0 +1,142 @@
+package paymentrequest
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	portsout "chaintx/internal/application/ports/out"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const defaultEventListenerRetryInterval = 2 * time.Second
+
+// EventListener holds one dedicated LISTEN connection per process and fans
+// PaymentRequestEventsChannel notifications out to in-process subscribers.
+type EventListener struct {
+	databaseURL   string
+	retryInterval time.Duration
+	logger        *log.Logger
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+var _ portsout.PaymentRequestEventNotifier = (*EventListener)(nil)
+
+func NewEventListener(databaseURL string, logger *log.Logger) *EventListener {
+	return &EventListener{
+		databaseURL:   strings.TrimSpace(databaseURL),
+		retryInterval: defaultEventListenerRetryInterval,
+		logger:        logger,
+		subscribers:   map[string]map[chan struct{}]struct{}{},
+	}
+}
+
+// Start listens until ctx is cancelled, reconnecting after connection loss.
+// Every (re)connect signals all subscribers, since notifications sent while
+// disconnected are lost.
+func (l *EventListener) Start(ctx context.Context) {
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		l.logf("payment request event listener disconnected error=%v retry_in=%s", err, l.retryInterval)
+
+		timer := time.NewTimer(l.retryInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (l *EventListener) Subscribe(paymentRequestID string) (<-chan struct{}, func()) {
+	id := strings.TrimSpace(paymentRequestID)
+	signal := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	if l.subscribers[id] == nil {
+		l.subscribers[id] = map[chan struct{}]struct{}{}
+	}
+	l.subscribers[id][signal] = struct{}{}
+	l.mu.Unlock()
+
+	var once sync.Once
+	return signal, func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			delete(l.subscribers[id], signal)
+			if len(l.subscribers[id]) == 0 {
+				delete(l.subscribers, id)
+			}
+		})
+	}
+}
+
+func (l *EventListener) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, l.databaseURL)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = conn.Close(closeCtx)
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{PaymentRequestEventsChannel}.Sanitize()); err != nil {
+		return err
+	}
+	l.logf("payment request event listener connected channel=%s", PaymentRequestEventsChannel)
+	l.signalAll()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		l.signal(notification.Payload)
+	}
+}
+
+func (l *EventListener) signal(paymentRequestID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for subscriber := range l.subscribers[strings.TrimSpace(paymentRequestID)] {
+		notify(subscriber)
+	}
+}
+
+func (l *EventListener) signalAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, subscribers := range l.subscribers {
+		for subscriber := range subscribers {
+			notify(subscriber)
+		}
+	}
+}
+
+// notify never blocks: a pending signal already tells the subscriber to
+// re-read, so extra signals can be dropped.
+func notify(subscriber chan struct{}) {
+	select {
+	case subscriber <- struct{}{}:
+	default:
+	}
+}
+
+func (l *EventListener) logf(format string, args ...any) {
+	if l.logger == nil {
+		return
+	}
+	l.logger.Printf(format, args...)
+}
@@ -0,0 +1,61 @@
+//go:build !integration
+
+package paymentrequest
+
+import "testing"
+
+func TestEventListenerSignalsOnlyMatchingSubscribers(t *testing.T) {
+	listener := NewEventListener("postgresql://unused", nil)
+	first, unsubscribeFirst := listener.Subscribe("pr_1")
+	defer unsubscribeFirst()
+	other, unsubscribeOther := listener.Subscribe("pr_2")
+	defer unsubscribeOther()
+
+	listener.signal("pr_1")
+	listener.signal("pr_1")
+
+	select {
+	case <-first:
+	default:
+		t.Fatalf("expected pr_1 subscriber to be signalled")
+	}
+	select {
+	case <-first:
+		t.Fatalf("expected repeated signals to coalesce")
+	default:
+	}
+	select {
+	case <-other:
+		t.Fatalf("expected pr_2 subscriber not to be signalled")
+	default:
+	}
+}
+
+func TestEventListenerSignalAllAfterReconnect(t *testing.T) {
+	listener := NewEventListener("postgresql://unused", nil)
+	first, unsubscribeFirst := listener.Subscribe("pr_1")
+	defer unsubscribeFirst()
+	second, unsubscribeSecond := listener.Subscribe("pr_2")
+	defer unsubscribeSecond()
+
+	listener.signalAll()
+
+	for _, subscriber := range []<-chan struct{}{first, second} {
+		select {
+		case <-subscriber:
+		default:
+			t.Fatalf("expected every subscriber to be signalled")
+		}
+	}
+}
+
+func TestEventListenerUnsubscribeRemovesSubscriber(t *testing.T) {
+	listener := NewEventListener("postgresql://unused", nil)
+	_, unsubscribe := listener.Subscribe("pr_1")
+	unsubscribe()
+	unsubscribe()
+
+	if len(listener.subscribers) != 0 {
+		t.Fatalf("expected no subscribers, got %d", len(listener.subscribers))
+	}
+}
@@ -23,7 +23,7 @@ func NewReadModel(db *sql.DB) *ReadModel {
 	return &ReadModel{db: db}
 }
 
-func (r *ReadModel) GetByID(ctx context.Context, id string) (dto.PaymentRequestResource, bool, *apperrors.AppError) {
+func (r *ReadModel) GetByID(ctx context.Context, merchantID string, id string) (dto.PaymentRequestResource, bool, *apperrors.AppError) {
 	const query = `
 SELECT
   id,
@@ -39,10 +39,18 @@ SELECT
   token_standard,
   token_contract,
   token_decimals,
+  minor_unit,
+  decimals,
+  webhook_api_version,
+  COALESCE(webhook_url, ''),
+  metadata,
   expires_at,
-  created_at
+  created_at,
+  updated_at,
+  row_version
 FROM app.payment_requests
 WHERE id = $1
+  AND merchant_id = $2
 `
 
 	var (
@@ -53,9 +61,13 @@ WHERE id = $1
 		tokenStandard    sql.NullString
 		tokenContract    sql.NullString
 		tokenDecimals    sql.NullInt64
+		minorUnit        sql.NullString
+		decimals         sql.NullInt64
+		metadataRaw      []byte
+		updatedAt        sql.NullTime
 	)
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id, merchantID).Scan(
 		&resource.ID,
 		&resource.Status,
 		&resource.Chain,
@@ -69,8 +81,15 @@ WHERE id = $1
 		&tokenStandard,
 		&tokenContract,
 		&tokenDecimals,
+		&minorUnit,
+		&decimals,
+		&resource.WebhookAPIVersion,
+		&resource.WebhookURL,
+		&metadataRaw,
 		&resource.ExpiresAt,
 		&resource.CreatedAt,
+		&updatedAt,
+		&resource.Version,
 	)
 	if stderrors.Is(err, sql.ErrNoRows) {
 		return dto.PaymentRequestResource{}, false, nil
@@ -114,6 +133,22 @@ WHERE id = $1
 		value := int(tokenDecimals.Int64)
 		resource.PaymentInstructions.TokenDecimals = &value
 	}
+	resource.PaymentInstructions.MinorUnit = minorUnit.String
+	if decimals.Valid {
+		value := int(decimals.Int64)
+		resource.PaymentInstructions.Decimals = &value
+	}
+
+	if updatedAt.Valid {
+		value := updatedAt.Time.UTC()
+		resource.UpdatedAt = &value
+	}
+	metadata, reconciliation, appErr := decodePaymentRequestMetadata(id, metadataRaw)
+	if appErr != nil {
+		return dto.PaymentRequestResource{}, false, appErr
+	}
+	resource.Metadata = metadata
+	resource.Reconciliation = reconciliation
 
 	addressResponse, appErr := valueobjects.FormatAddressForResponse(resource.Chain, addressCanonical)
 	if appErr != nil {
@@ -124,8 +159,92 @@ WHERE id = $1
 	return resource, true, nil
 }
 
+// decodePaymentRequestMetadata splits stored metadata into the merchant's
+// metadata and what the reconciler keeps under reconciliationMetadataKey.
+func decodePaymentRequestMetadata(
+	id string,
+	raw []byte,
+) (map[string]any, *dto.ReconcileTransitionMetadata, *apperrors.AppError) {
+	if len(raw) == 0 {
+		return nil, nil, nil
+	}
+
+	var (
+		metadata       map[string]any
+		reconciliation *dto.ReconcileTransitionMetadata
+	)
+	err := json.Unmarshal(raw, &metadata)
+	if stored, ok := metadata[reconciliationMetadataKey]; ok && err == nil {
+		delete(metadata, reconciliationMetadataKey)
+		// Round-trip through JSON so the reconciler's own struct decodes it.
+		encoded, _ := json.Marshal(stored)
+		err = json.Unmarshal(encoded, &reconciliation)
+	}
+	if err != nil {
+		return nil, nil, apperrors.NewInternal(
+			"payment_request_query_failed",
+			"failed to decode payment request metadata",
+			map[string]any{"error": err.Error(), "id": id},
+		)
+	}
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+	return metadata, reconciliation, nil
+}
+
+// GetPrincipalID returns the principal that created a payment request, for
+// read authorization.
+func (r *ReadModel) GetPrincipalID(ctx context.Context, merchantID string, id string) (string, bool, *apperrors.AppError) {
+	const query = `
+SELECT principal_id
+FROM app.payment_requests
+WHERE id = $1
+  AND merchant_id = $2
+`
+
+	var principalID string
+	err := r.db.QueryRowContext(ctx, query, id, merchantID).Scan(&principalID)
+	if stderrors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, apperrors.NewInternal(
+			"payment_request_query_failed",
+			"failed to query payment request",
+			map[string]any{"error": err.Error(), "id": id},
+		)
+	}
+	return principalID, true, nil
+}
+
+// GetMerchantID returns the merchant owning a payment request, for pages
+// addressed by payment request id alone.
+func (r *ReadModel) GetMerchantID(ctx context.Context, id string) (string, bool, *apperrors.AppError) {
+	const query = `
+SELECT merchant_id
+FROM app.payment_requests
+WHERE id = $1
+`
+
+	var merchantID string
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&merchantID)
+	if stderrors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, apperrors.NewInternal(
+			"payment_request_query_failed",
+			"failed to query payment request",
+			map[string]any{"error": err.Error(), "id": id},
+		)
+	}
+	return merchantID, true, nil
+}
+
 func (r *ReadModel) ListSettlementsByPaymentRequestID(
 	ctx context.Context,
+	merchantID string,
 	id string,
 ) ([]dto.PaymentRequestSettlementResource, bool, *apperrors.AppError) {
 	const query = `
@@ -133,6 +252,7 @@ WITH target_request AS (
   SELECT id
   FROM app.payment_requests
   WHERE id = $1
+    AND merchant_id = $2
 )
 SELECT
   s.evidence_ref,
@@ -151,7 +271,7 @@ LEFT JOIN app.payment_request_settlements s
 ORDER BY s.first_seen_at ASC NULLS LAST, s.evidence_ref ASC NULLS LAST
 `
 
-	rows, err := r.db.QueryContext(ctx, query, id)
+	rows, err := r.db.QueryContext(ctx, query, id, merchantID)
 	if err != nil {
 		return nil, false, apperrors.NewInternal(
 			"payment_request_query_failed",
@@ -251,3 +371,190 @@ ORDER BY s.first_seen_at ASC NULLS LAST, s.evidence_ref ASC NULLS LAST
 
 	return settlements, requestFound, nil
 }
+
+func (r *ReadModel) ListStatusHistoryByPaymentRequestID(
+	ctx context.Context,
+	merchantID string,
+	id string,
+) ([]dto.PaymentRequestStatusTransition, bool, *apperrors.AppError) {
+	const query = `
+WITH target_request AS (
+  SELECT id
+  FROM app.payment_requests
+  WHERE id = $1
+    AND merchant_id = $2
+)
+SELECT
+  h.from_status,
+  h.to_status,
+  h.reason,
+  h.details,
+  h.recorded_by,
+  h.occurred_at
+FROM target_request tr
+LEFT JOIN app.payment_request_status_history h
+  ON h.payment_request_id = tr.id
+ORDER BY h.id ASC NULLS LAST
+`
+
+	rows, err := r.db.QueryContext(ctx, query, id, merchantID)
+	if err != nil {
+		return nil, false, apperrors.NewInternal(
+			"payment_request_query_failed",
+			"failed to query payment request status history",
+			map[string]any{"error": err.Error(), "id": id},
+		)
+	}
+	defer rows.Close()
+
+	transitions := make([]dto.PaymentRequestStatusTransition, 0)
+	requestFound := false
+	for rows.Next() {
+		requestFound = true
+
+		var (
+			fromStatus sql.NullString
+			toStatus   sql.NullString
+			reason     sql.NullString
+			detailsRaw []byte
+			recordedBy sql.NullString
+			occurredAt sql.NullTime
+		)
+		if scanErr := rows.Scan(
+			&fromStatus,
+			&toStatus,
+			&reason,
+			&detailsRaw,
+			&recordedBy,
+			&occurredAt,
+		); scanErr != nil {
+			return nil, false, apperrors.NewInternal(
+				"payment_request_query_failed",
+				"failed to parse payment request status history row",
+				map[string]any{"error": scanErr.Error(), "id": id},
+			)
+		}
+		if !toStatus.Valid {
+			continue
+		}
+
+		details := map[string]any{}
+		if len(detailsRaw) > 0 {
+			if decodeErr := json.Unmarshal(detailsRaw, &details); decodeErr != nil {
+				return nil, false, apperrors.NewInternal(
+					"payment_request_query_failed",
+					"failed to decode payment request status history details",
+					map[string]any{"error": decodeErr.Error(), "id": id},
+				)
+			}
+		}
+
+		transitions = append(transitions, dto.PaymentRequestStatusTransition{
+			FromStatus: fromStatus.String,
+			ToStatus:   toStatus.String,
+			Reason:     reason.String,
+			Details:    details,
+			RecordedBy: recordedBy.String,
+			OccurredAt: occurredAt.Time.UTC(),
+		})
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, false, apperrors.NewInternal(
+			"payment_request_query_failed",
+			"failed while iterating payment request status history",
+			map[string]any{"error": rowsErr.Error(), "id": id},
+		)
+	}
+
+	return transitions, requestFound, nil
+}
+
+func (r *ReadModel) ListEventsAfterSequence(
+	ctx context.Context,
+	merchantID string,
+	id string,
+	afterSequence int64,
+	limit int,
+) ([]dto.PaymentRequestEvent, bool, *apperrors.AppError) {
+	const query = `
+WITH target_request AS (
+  SELECT id
+  FROM app.payment_requests
+  WHERE id = $1
+    AND merchant_id = $4
+),
+page AS (
+  SELECT
+    e.sequence,
+    e.event_id,
+    e.event_type,
+    e.payload
+  FROM app.payment_request_events e
+  JOIN target_request tr
+    ON e.payment_request_id = tr.id
+  WHERE e.sequence > $2
+  ORDER BY e.sequence ASC
+  LIMIT $3
+)
+SELECT
+  p.sequence,
+  p.event_id,
+  p.event_type,
+  p.payload
+FROM target_request tr
+LEFT JOIN page p
+  ON TRUE
+ORDER BY p.sequence ASC NULLS LAST
+`
+
+	rows, err := r.db.QueryContext(ctx, query, id, afterSequence, limit, merchantID)
+	if err != nil {
+		return nil, false, apperrors.NewInternal(
+			"payment_request_query_failed",
+			"failed to query payment request events",
+			map[string]any{"error": err.Error(), "id": id},
+		)
+	}
+	defer rows.Close()
+
+	events := make([]dto.PaymentRequestEvent, 0)
+	requestFound := false
+	for rows.Next() {
+		requestFound = true
+
+		var (
+			sequence  sql.NullInt64
+			eventID   sql.NullString
+			eventType sql.NullString
+			payload   []byte
+		)
+		if scanErr := rows.Scan(&sequence, &eventID, &eventType, &payload); scanErr != nil {
+			return nil, false, apperrors.NewInternal(
+				"payment_request_query_failed",
+				"failed to parse payment request event row",
+				map[string]any{"error": scanErr.Error(), "id": id},
+			)
+		}
+		if !sequence.Valid {
+			continue
+		}
+
+		events = append(events, dto.PaymentRequestEvent{
+			Sequence:  sequence.Int64,
+			EventID:   eventID.String,
+			EventType: eventType.String,
+			Payload:   payload,
+		})
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, false, apperrors.NewInternal(
+			"payment_request_query_failed",
+			"failed while iterating payment request events",
+			map[string]any{"error": rowsErr.Error(), "id": id},
+		)
+	}
+
+	return events, requestFound, nil
+}
@@ -0,0 +1,140 @@
+package paymentrequest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+var _ portsout.ReconcileHealthReadModel = (*ReadModel)(nil)
+
+// reconcileObservedStatuses are the statuses the reconciler still observes on
+// chain.
+const reconcileObservedStatuses = `('pending', 'detected', 'confirmed', 'reorged')`
+
+func (r *ReadModel) GetReconcileHealthOverview(
+	ctx context.Context,
+	now time.Time,
+	detectedBefore time.Time,
+	windowStart time.Time,
+) (dto.ReconcileHealthOverview, *apperrors.AppError) {
+	// A request enters detected through a journaled status_changed event;
+	// requests created before the journal existed fall back to created_at.
+	const detectedQuery = `
+WITH detected AS (
+  SELECT COALESCE(
+    (
+      SELECT max(e.created_at)
+      FROM app.payment_request_events AS e
+      WHERE e.payment_request_id = pr.id
+        AND e.event_type = 'payment_request.status_changed'
+    ),
+    pr.created_at
+  ) AS detected_at
+  FROM app.payment_requests AS pr
+  WHERE pr.status = 'detected'
+)
+SELECT
+  count(*) FILTER (WHERE detected_at < $1),
+  min(detected_at)
+FROM detected
+`
+	// Every observation rewrites metadata.reconciliation, so the latest
+	// attempt per request is counted once. Policy skips and unsupported
+	// assets are not observations; observer failures are.
+	const observationQuery = `
+SELECT
+  count(*),
+  count(*) FILTER (WHERE metadata #> '{reconciliation,observation_details,observation_error}' IS NOT NULL)
+FROM app.payment_requests
+WHERE status IN ` + reconcileObservedStatuses + `
+  AND updated_at >= $1
+  AND (
+    COALESCE(metadata #>> '{reconciliation,observation_source}', '') NOT IN ('', 'reconcile_policy')
+    OR metadata #> '{reconciliation,observation_details,observation_error}' IS NOT NULL
+  )
+`
+	const reorgQuery = `
+SELECT count(*)
+FROM app.payment_request_settlements
+WHERE is_canonical = FALSE
+  AND updated_at >= $1
+`
+	const chainTipQuery = `
+SELECT chain, network, max(raw_tip::bigint), max(observed_at)
+FROM (
+  SELECT
+    chain,
+    network,
+    COALESCE(
+      metadata #>> '{reconciliation,observation_details,tip_height}',
+      metadata #>> '{reconciliation,observation_details,scan_latest_block}'
+    ) AS raw_tip,
+    updated_at AS observed_at
+  FROM app.payment_requests
+  WHERE status IN ` + reconcileObservedStatuses + `
+) AS tips
+WHERE raw_tip ~ '^[0-9]{1,18}$'
+GROUP BY chain, network
+ORDER BY chain, network
+`
+
+	output := dto.ReconcileHealthOverview{ChainTips: []dto.ReconcileChainTip{}}
+	var oldestDetected sql.NullTime
+	if err := r.db.QueryRowContext(ctx, detectedQuery, detectedBefore.UTC()).Scan(
+		&output.DetectedStuckCount,
+		&oldestDetected,
+	); err != nil {
+		return dto.ReconcileHealthOverview{}, reconcileHealthQueryFailed("failed to query detected payment requests", err)
+	}
+	if oldestDetected.Valid {
+		ageSeconds := now.UTC().Sub(oldestDetected.Time.UTC()).Seconds()
+		if ageSeconds < 0 {
+			ageSeconds = 0
+		}
+		age := int64(ageSeconds)
+		output.OldestDetectedAgeSec = &age
+	}
+
+	if err := r.db.QueryRowContext(ctx, observationQuery, windowStart.UTC()).Scan(
+		&output.ObservedCount,
+		&output.ObservationErrorCount,
+	); err != nil {
+		return dto.ReconcileHealthOverview{}, reconcileHealthQueryFailed("failed to query payment request observations", err)
+	}
+
+	if err := r.db.QueryRowContext(ctx, reorgQuery, windowStart.UTC()).Scan(&output.ReorgCount); err != nil {
+		return dto.ReconcileHealthOverview{}, reconcileHealthQueryFailed("failed to query orphaned settlements", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, chainTipQuery)
+	if err != nil {
+		return dto.ReconcileHealthOverview{}, reconcileHealthQueryFailed("failed to query chain tips", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tip dto.ReconcileChainTip
+		if err := rows.Scan(&tip.Chain, &tip.Network, &tip.TipHeight, &tip.ObservedAt); err != nil {
+			return dto.ReconcileHealthOverview{}, reconcileHealthQueryFailed("failed to parse chain tip", err)
+		}
+		tip.ObservedAt = tip.ObservedAt.UTC()
+		output.ChainTips = append(output.ChainTips, tip)
+	}
+	if err := rows.Err(); err != nil {
+		return dto.ReconcileHealthOverview{}, reconcileHealthQueryFailed("failed to read chain tips", err)
+	}
+
+	return output, nil
+}
+
+func reconcileHealthQueryFailed(message string, err error) *apperrors.AppError {
+	return apperrors.NewInternal(
+		"reconcile_health_query_failed",
+		message,
+		map[string]any{"error": err.Error()},
+	)
+}
@@ -15,6 +15,10 @@ import (
 
 var _ portsout.PaymentRequestReconciliationRepository = (*Repository)(nil)
 
+// reconciliationMetadataKey is the payment request metadata key the
+// reconciler owns.
+const reconciliationMetadataKey = "reconciliation"
+
 func (r *Repository) ClaimOpenForReconciliation(
 	ctx context.Context,
 	now time.Time,
@@ -65,6 +69,7 @@ RETURNING
   pr.token_standard,
   pr.token_contract,
   pr.token_decimals,
+  pr.webhook_api_version,
   pr.metadata
 `
 	observeWindowSeconds := int64(observeWindow / time.Second)
@@ -116,6 +121,7 @@ RETURNING
 			&tokenStandard,
 			&tokenContract,
 			&tokenDecimals,
+			&item.WebhookAPIVersion,
 			&metadata,
 		); err != nil {
 			return nil, apperrors.NewInternal(
@@ -194,6 +200,7 @@ func (r *Repository) SyncObservedSettlements(
 	asset string,
 	observedAt time.Time,
 	settlements []dto.ObservedSettlementEvidence,
+	buildEvents dto.BuildSettlementWebhookEventsFunc,
 ) (dto.ReconcileSettlementSyncResult, *apperrors.AppError) {
 	const selectExistingQuery = `
 SELECT
@@ -203,6 +210,7 @@ SELECT
   block_height,
   block_hash,
   is_canonical,
+  confirmed_at IS NOT NULL,
   metadata
 FROM app.payment_request_settlements
 WHERE payment_request_id = $1
@@ -220,10 +228,12 @@ INSERT INTO app.payment_request_settlements (
   metadata,
   first_seen_at,
   last_seen_at,
-  updated_at
+  updated_at,
+  confirmed_at
 )
 VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8::jsonb, $9, $10, $11
+  $1, $2, $3, $4, $5, $6, $7, $8::jsonb, $9, $10, $11,
+  CASE WHEN $12 THEN $11::timestamptz ELSE NULL END
 )
 `
 	const updateQuery = `
@@ -236,7 +246,8 @@ SET
   is_canonical = $7,
   metadata = $8::jsonb,
   last_seen_at = $9,
-  updated_at = $10
+  updated_at = $10,
+  confirmed_at = CASE WHEN $11 THEN COALESCE(confirmed_at, $10) ELSE NULL END
 WHERE payment_request_id = $1
   AND evidence_ref = $2
 `
@@ -281,6 +292,7 @@ WHERE payment_request_id = $1
 			blockHeight    sql.NullInt64
 			blockHash      sql.NullString
 			isCanonical    bool
+			confirmed      bool
 			metadataRaw    []byte
 			metadataString string
 			err            error
@@ -292,6 +304,7 @@ WHERE payment_request_id = $1
 			&blockHeight,
 			&blockHash,
 			&isCanonical,
+			&confirmed,
 			&metadataRaw,
 		); err != nil {
 			existingRows.Close()
@@ -318,6 +331,7 @@ WHERE payment_request_id = $1
 			blockHeight:   nullableInt64Ptr(blockHeight),
 			blockHash:     nullableStringPtr(blockHash),
 			isCanonical:   isCanonical,
+			confirmed:     confirmed,
 			metadataJSON:  metadataString,
 		}
 	}
@@ -331,6 +345,8 @@ WHERE payment_request_id = $1
 	}
 	existingRows.Close()
 
+	changes := make([]dto.ReconcileSettlementChange, 0)
+	settlementsWritten := 0
 	observedRefs := make([]string, 0, len(settlements))
 	observedRefSet := make(map[string]struct{}, len(settlements))
 	for _, settlement := range settlements {
@@ -365,10 +381,14 @@ WHERE payment_request_id = $1
 			blockHeight:   copyInt64Ptr(settlement.BlockHeight),
 			blockHash:     copyTrimmedStringPtr(settlement.BlockHash),
 			isCanonical:   settlement.IsCanonical,
+			confirmed:     settlement.IsCanonical && settlement.Confirmed,
 			metadataJSON:  metadataString,
 		}
 
 		currentState, exists := existingByRef[evidenceRef]
+		if exists && currentState.isCanonical && nextState.isCanonical && currentState.confirmed {
+			nextState.confirmed = true
+		}
 		var blockHeightValue any
 		if nextState.blockHeight != nil {
 			blockHeightValue = *nextState.blockHeight
@@ -393,6 +413,7 @@ WHERE payment_request_id = $1
 				now,
 				now,
 				now,
+				nextState.confirmed,
 			); execErr != nil {
 				return dto.ReconcileSettlementSyncResult{}, apperrors.NewInternal(
 					"payment_request_update_failed",
@@ -401,6 +422,8 @@ WHERE payment_request_id = $1
 				)
 			}
 			existingByRef[evidenceRef] = nextState
+			settlementsWritten++
+			changes = appendSettlementChanges(changes, evidenceRef, settlementState{}, nextState, false)
 			continue
 		}
 
@@ -421,6 +444,7 @@ WHERE payment_request_id = $1
 			metadataRaw,
 			now,
 			now,
+			nextState.confirmed,
 		); execErr != nil {
 			return dto.ReconcileSettlementSyncResult{}, apperrors.NewInternal(
 				"payment_request_update_failed",
@@ -429,73 +453,81 @@ WHERE payment_request_id = $1
 			)
 		}
 		existingByRef[evidenceRef] = nextState
+		settlementsWritten++
+		changes = appendSettlementChanges(changes, evidenceRef, currentState, nextState, true)
 	}
 
-	orphansUpdated := int64(0)
-	if len(observedRefs) == 0 {
-		result, execErr := tx.ExecContext(
-			ctx,
-			`UPDATE app.payment_request_settlements
-			 SET is_canonical = FALSE, updated_at = $2
-			 WHERE payment_request_id = $1
-			   AND is_canonical = TRUE`,
-			requestID,
-			now,
-		)
-		if execErr != nil {
-			return dto.ReconcileSettlementSyncResult{}, apperrors.NewInternal(
-				"payment_request_update_failed",
-				"failed to mark orphaned settlements",
-				map[string]any{"error": execErr.Error(), "id": requestID},
-			)
-		}
-		rowsAffected, rowsErr := result.RowsAffected()
-		if rowsErr != nil {
-			return dto.ReconcileSettlementSyncResult{}, apperrors.NewInternal(
-				"payment_request_update_failed",
-				"failed to verify orphaned settlement updates",
-				map[string]any{"error": rowsErr.Error(), "id": requestID},
-			)
-		}
-		orphansUpdated = rowsAffected
-	} else {
-		args := make([]any, 0, 2+len(observedRefs))
-		args = append(args, requestID, now)
+	orphanQuery := `
+UPDATE app.payment_request_settlements
+SET is_canonical = FALSE, confirmed_at = NULL, updated_at = $2
+WHERE payment_request_id = $1
+  AND is_canonical = TRUE
+RETURNING evidence_ref, amount_minor::text, confirmations, block_height, block_hash
+`
+	orphanArgs := []any{requestID, now}
+	if len(observedRefs) > 0 {
 		placeholders := make([]string, 0, len(observedRefs))
 		for _, ref := range observedRefs {
-			args = append(args, ref)
-			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+			orphanArgs = append(orphanArgs, ref)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(orphanArgs)))
 		}
 
-		query := fmt.Sprintf(`
+		orphanQuery = fmt.Sprintf(`
 UPDATE app.payment_request_settlements
-SET is_canonical = FALSE, updated_at = $2
+SET is_canonical = FALSE, confirmed_at = NULL, updated_at = $2
 WHERE payment_request_id = $1
   AND is_canonical = TRUE
   AND evidence_ref NOT IN (%s)
+RETURNING evidence_ref, amount_minor::text, confirmations, block_height, block_hash
 `, strings.Join(placeholders, ", "))
+	}
 
-		result, execErr := tx.ExecContext(ctx, query, args...)
-		if execErr != nil {
-			return dto.ReconcileSettlementSyncResult{}, apperrors.NewInternal(
-				"payment_request_update_failed",
-				"failed to mark missing settlements as orphaned",
-				map[string]any{"error": execErr.Error(), "id": requestID},
-			)
-		}
-		rowsAffected, rowsErr := result.RowsAffected()
-		if rowsErr != nil {
+	orphanRows, queryErr := tx.QueryContext(ctx, orphanQuery, orphanArgs...)
+	if queryErr != nil {
+		return dto.ReconcileSettlementSyncResult{}, apperrors.NewInternal(
+			"payment_request_update_failed",
+			"failed to mark missing settlements as orphaned",
+			map[string]any{"error": queryErr.Error(), "id": requestID},
+		)
+	}
+	orphansUpdated := 0
+	for orphanRows.Next() {
+		var (
+			orphan      dto.ObservedSettlementEvidence
+			blockHeight sql.NullInt64
+			blockHash   sql.NullString
+		)
+		if err := orphanRows.Scan(
+			&orphan.EvidenceRef,
+			&orphan.AmountMinor,
+			&orphan.Confirmations,
+			&blockHeight,
+			&blockHash,
+		); err != nil {
+			orphanRows.Close()
 			return dto.ReconcileSettlementSyncResult{}, apperrors.NewInternal(
 				"payment_request_update_failed",
-				"failed to verify orphaned settlement updates",
-				map[string]any{"error": rowsErr.Error(), "id": requestID},
+				"failed to parse orphaned settlement",
+				map[string]any{"error": err.Error(), "id": requestID},
 			)
 		}
-		orphansUpdated = rowsAffected
+		orphan.BlockHeight = nullableInt64Ptr(blockHeight)
+		orphan.BlockHash = nullableStringPtr(blockHash)
+		orphansUpdated++
+		changes = append(changes, dto.ReconcileSettlementChange{Change: "orphaned", Settlement: orphan})
+	}
+	if rowsErr := orphanRows.Err(); rowsErr != nil {
+		orphanRows.Close()
+		return dto.ReconcileSettlementSyncResult{}, apperrors.NewInternal(
+			"payment_request_update_failed",
+			"failed to verify orphaned settlement updates",
+			map[string]any{"error": rowsErr.Error(), "id": requestID},
+		)
 	}
+	orphanRows.Close()
 
 	summary := dto.ReconcileSettlementSyncResult{
-		NewlyOrphanedCount: int(orphansUpdated),
+		NewlyOrphanedCount: orphansUpdated,
 	}
 	countErr := tx.QueryRowContext(
 		ctx,
@@ -516,6 +548,22 @@ WHERE payment_request_id = $1
 		)
 	}
 
+	if settlementsWritten+orphansUpdated > 0 {
+		if appErr := bumpPaymentRequestVersion(ctx, tx, requestID); appErr != nil {
+			return dto.ReconcileSettlementSyncResult{}, appErr
+		}
+	}
+
+	if buildEvents != nil && len(changes) > 0 {
+		events, buildErr := buildEvents(changes)
+		if buildErr != nil {
+			return dto.ReconcileSettlementSyncResult{}, buildErr
+		}
+		if appErr := r.recordPaymentRequestEvents(ctx, tx, requestID, events, now); appErr != nil {
+			return dto.ReconcileSettlementSyncResult{}, appErr
+		}
+	}
+
 	if commitErr := tx.Commit(); commitErr != nil {
 		return dto.ReconcileSettlementSyncResult{}, apperrors.NewInternal(
 			"payment_request_update_failed",
@@ -534,6 +582,7 @@ type settlementState struct {
 	blockHeight   *int64
 	blockHash     *string
 	isCanonical   bool
+	confirmed     bool
 	metadataJSON  string
 }
 
@@ -541,6 +590,7 @@ func (s settlementState) equals(other settlementState) bool {
 	if s.amountMinor != other.amountMinor ||
 		s.confirmations != other.confirmations ||
 		s.isCanonical != other.isCanonical ||
+		s.confirmed != other.confirmed ||
 		s.metadataJSON != other.metadataJSON {
 		return false
 	}
@@ -550,6 +600,38 @@ func (s settlementState) equals(other settlementState) bool {
 	return equalStringPtr(s.blockHash, other.blockHash)
 }
 
+// appendSettlementChanges records the lifecycle steps between two stored
+// states of one settlement: entering the canonical chain, reaching business
+// confirmations, and leaving the canonical chain.
+func appendSettlementChanges(
+	changes []dto.ReconcileSettlementChange,
+	evidenceRef string,
+	current settlementState,
+	next settlementState,
+	existed bool,
+) []dto.ReconcileSettlementChange {
+	evidence := dto.ObservedSettlementEvidence{
+		EvidenceRef:   evidenceRef,
+		AmountMinor:   next.amountMinor,
+		Confirmations: next.confirmations,
+		IsCanonical:   next.isCanonical,
+		Confirmed:     next.confirmed,
+		BlockHeight:   copyInt64Ptr(next.blockHeight),
+		BlockHash:     copyTrimmedStringPtr(next.blockHash),
+	}
+
+	if next.isCanonical && (!existed || !current.isCanonical) {
+		changes = append(changes, dto.ReconcileSettlementChange{Change: "detected", Settlement: evidence})
+	}
+	if next.confirmed && (!existed || !current.confirmed) {
+		changes = append(changes, dto.ReconcileSettlementChange{Change: "confirmed", Settlement: evidence})
+	}
+	if existed && current.isCanonical && !next.isCanonical {
+		changes = append(changes, dto.ReconcileSettlementChange{Change: "orphaned", Settlement: evidence})
+	}
+	return changes
+}
+
 func equalInt64Ptr(left *int64, right *int64) bool {
 	if left == nil && right == nil {
 		return true
@@ -648,107 +730,22 @@ func (r *Repository) TransitionStatusIfCurrent(
 	updatedAt time.Time,
 	leaseOwner string,
 	metadata dto.ReconcileTransitionMetadata,
+	events []dto.WebhookEvent,
 ) (bool, *apperrors.AppError) {
 	const query = `
-WITH updated AS (
-  UPDATE app.payment_requests
-  SET
-    status = $3,
-    metadata = COALESCE(metadata, '{}'::jsonb) || $4::jsonb,
-    updated_at = $5,
-    reconcile_lease_owner = NULL,
-    reconcile_lease_until = NULL
-  WHERE id = $1
-    AND status = $2
-    AND (reconcile_lease_owner IS NULL OR reconcile_lease_owner = $6)
-  RETURNING
-    id,
-    chain,
-    network,
-    asset,
-    expected_amount_minor::text,
-    webhook_url,
-    address_canonical,
-    expires_at
-),
-event_rows AS (
-  SELECT
-    u.id,
-    u.chain,
-    u.network,
-    u.asset,
-    u.expected_amount_minor,
-    u.webhook_url,
-    u.address_canonical,
-    u.expires_at,
-    ('evt_' || md5(random()::text || clock_timestamp()::text || u.id)) AS event_id
-  FROM updated AS u
-  WHERE $7 = TRUE
-    AND $2 <> $3
-    AND $3 IN ('detected', 'confirmed', 'reorged', 'expired')
-    AND NULLIF(btrim(u.webhook_url), '') IS NOT NULL
-),
-inserted_events AS (
-  INSERT INTO app.webhook_outbox_events (
-    event_id,
-    event_type,
-    payment_request_id,
-    destination_url,
-    payload,
-    delivery_status,
-    attempts,
-    max_attempts,
-    next_attempt_at,
-    created_at,
-    updated_at
-  )
-  SELECT
-    e.event_id,
-    'payment_request.status_changed',
-    e.id,
-    e.webhook_url,
-    jsonb_strip_nulls(
-      jsonb_build_object(
-        'event_id', e.event_id,
-        'event_type', 'payment_request.status_changed',
-        'occurred_at', $5,
-        'data',
-        jsonb_build_object(
-          'payment_request',
-          jsonb_strip_nulls(
-            jsonb_build_object(
-              'id', e.id,
-              'chain', e.chain,
-              'network', e.network,
-              'asset', e.asset,
-              'expected_amount_minor', e.expected_amount_minor,
-              'address_canonical', e.address_canonical,
-	              'expires_at', e.expires_at,
-	              'previous_status', $2,
-	              'current_status', $3,
-	              'observed_amount_minor', NULLIF($4::jsonb #>> '{reconciliation,observed_amount_minor}', ''),
-	              'observation_source', NULLIF($4::jsonb #>> '{reconciliation,observation_source}', ''),
-	              'transition_reason', NULLIF($4::jsonb #>> '{reconciliation,transition_reason}', ''),
-	              'finality_reached', $4::jsonb #> '{reconciliation,finality_reached}',
-	              'evidence_summary', $4::jsonb #> '{reconciliation,evidence_summary}'
-	            )
-	          )
-	        )
-      )
-    ),
-    'pending',
-    0,
-    $8,
-    $5,
-    $5,
-    $5
-  FROM event_rows AS e
-)
-SELECT COUNT(*) FROM updated
-	`
+UPDATE app.payment_requests
+SET
+  status = $3,
+  metadata = COALESCE(metadata, '{}'::jsonb) || $4::jsonb,
+  updated_at = $5,
+  reconcile_lease_owner = NULL,
+  reconcile_lease_until = NULL
+WHERE id = $1
+  AND status = $2
+  AND (reconcile_lease_owner IS NULL OR reconcile_lease_owner = $6)
+`
 
-	metadataPayload := map[string]any{}
-	if !metadata.UpdatedAt.IsZero() ||
+	hasMetadata := !metadata.UpdatedAt.IsZero() ||
 		metadata.ObservedAmountMinor != "" ||
 		metadata.ObservationSource != "" ||
 		len(metadata.ObservationDetails) > 0 ||
@@ -759,13 +756,17 @@ SELECT COUNT(*) FROM updated
 		metadata.FinalityReachedAt != nil ||
 		metadata.StabilitySignal != "" ||
 		metadata.StabilityPromoteStreak > 0 ||
-		metadata.StabilityDemoteStreak > 0 {
-		metadataPayload["reconciliation"] = metadata
-	}
+		metadata.StabilityDemoteStreak > 0
 
+	// encodedDetails is what the status history keeps for this transition.
 	encodedMetadata := []byte("{}")
-	if len(metadataPayload) > 0 {
-		encoded, err := json.Marshal(metadataPayload)
+	encodedDetails := []byte("{}")
+	if hasMetadata {
+		details, err := json.Marshal(metadata)
+		if err == nil {
+			encodedDetails = details
+			encodedMetadata, err = json.Marshal(map[string]json.RawMessage{reconciliationMetadataKey: details})
+		}
 		if err != nil {
 			return false, apperrors.NewInternal(
 				"payment_request_update_failed",
@@ -773,22 +774,36 @@ SELECT COUNT(*) FROM updated
 				map[string]any{"error": err.Error(), "id": id},
 			)
 		}
-		encodedMetadata = encoded
 	}
 
-	var updatedCount int
-	err := r.db.QueryRowContext(
+	id = strings.TrimSpace(id)
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return false, apperrors.NewInternal(
+			"payment_request_update_failed",
+			"failed to begin payment request transition transaction",
+			map[string]any{"error": err.Error(), "id": id},
+		)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	fromStatus := strings.ToLower(strings.TrimSpace(currentStatus))
+	toStatus := strings.ToLower(strings.TrimSpace(nextStatus))
+	result, err := tx.ExecContext(
 		ctx,
 		query,
-		strings.TrimSpace(id),
-		strings.ToLower(strings.TrimSpace(currentStatus)),
-		strings.ToLower(strings.TrimSpace(nextStatus)),
+		id,
+		fromStatus,
+		toStatus,
 		encodedMetadata,
 		updatedAt.UTC(),
 		strings.TrimSpace(leaseOwner),
-		r.webhookOutboxEnabled,
-		r.webhookMaxAttempts,
-	).Scan(&updatedCount)
+	)
 	if err != nil {
 		return false, apperrors.NewInternal(
 			"payment_request_update_failed",
@@ -796,6 +811,94 @@ SELECT COUNT(*) FROM updated
 			map[string]any{"error": err.Error(), "id": id},
 		)
 	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, apperrors.NewInternal(
+			"payment_request_update_failed",
+			"failed to verify payment request transition",
+			map[string]any{"error": err.Error(), "id": id},
+		)
+	}
+	if rowsAffected != 1 {
+		return false, nil
+	}
+	// Reconcile passes rewrite metadata without changing status; only real
+	// transitions move the version readers poll on and enter the history.
+	if fromStatus != toStatus {
+		if appErr := bumpPaymentRequestVersion(ctx, tx, id); appErr != nil {
+			return false, appErr
+		}
+		if appErr := recordPaymentRequestStatusTransition(
+			ctx,
+			tx,
+			id,
+			fromStatus,
+			toStatus,
+			metadata.TransitionReason,
+			encodedDetails,
+			strings.TrimSpace(leaseOwner),
+			updatedAt,
+		); appErr != nil {
+			return false, appErr
+		}
+	}
 
-	return updatedCount == 1, nil
+	if appErr := r.recordPaymentRequestEvents(ctx, tx, id, events, updatedAt); appErr != nil {
+		return false, appErr
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, apperrors.NewInternal(
+			"payment_request_update_failed",
+			"failed to commit payment request transition",
+			map[string]any{"error": err.Error(), "id": id},
+		)
+	}
+	committed = true
+
+	return true, nil
+}
+
+func recordPaymentRequestStatusTransition(
+	ctx context.Context,
+	tx *sql.Tx,
+	paymentRequestID string,
+	fromStatus string,
+	toStatus string,
+	reason string,
+	details []byte,
+	recordedBy string,
+	occurredAt time.Time,
+) *apperrors.AppError {
+	const query = `
+INSERT INTO app.payment_request_status_history (
+  payment_request_id,
+  from_status,
+  to_status,
+  reason,
+  details,
+  recorded_by,
+  occurred_at
+)
+VALUES ($1, $2, $3, $4, $5::jsonb, $6, $7)
+`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		paymentRequestID,
+		fromStatus,
+		toStatus,
+		strings.TrimSpace(reason),
+		details,
+		recordedBy,
+		occurredAt.UTC(),
+	); err != nil {
+		return apperrors.NewInternal(
+			"payment_request_update_failed",
+			"failed to record payment request status history",
+			map[string]any{"error": err.Error(), "id": paymentRequestID},
+		)
+	}
+	return nil
 }
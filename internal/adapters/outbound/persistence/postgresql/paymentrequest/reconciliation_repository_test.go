@@ -46,6 +46,51 @@ func TestSettlementStateEquals(t *testing.T) {
 	if base.equals(clearedHash) {
 		t.Fatalf("expected nil block hash difference to break equality")
 	}
+
+	confirmed := base
+	confirmed.confirmed = true
+	if base.equals(confirmed) {
+		t.Fatalf("expected confirmed change to break equality")
+	}
+}
+
+func TestAppendSettlementChanges(t *testing.T) {
+	detected := settlementState{amountMinor: "1000", confirmations: 1, isCanonical: true}
+	confirmed := settlementState{amountMinor: "1000", confirmations: 2, isCanonical: true, confirmed: true}
+	orphaned := settlementState{amountMinor: "1000", confirmations: 2}
+
+	testCases := []struct {
+		name     string
+		current  settlementState
+		next     settlementState
+		existed  bool
+		expected []string
+	}{
+		{name: "new canonical", next: detected, expected: []string{"detected"}},
+		{name: "new confirmed", next: confirmed, expected: []string{"detected", "confirmed"}},
+		{name: "new non canonical", next: orphaned, expected: nil},
+		{name: "reaches confirmations", current: detected, next: confirmed, existed: true, expected: []string{"confirmed"}},
+		{name: "already confirmed", current: confirmed, next: confirmed, existed: true, expected: nil},
+		{name: "leaves canonical chain", current: confirmed, next: orphaned, existed: true, expected: []string{"orphaned"}},
+		{name: "returns to canonical chain", current: orphaned, next: detected, existed: true, expected: []string{"detected"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			changes := appendSettlementChanges(nil, "btc:tx:a", tc.current, tc.next, tc.existed)
+			if len(changes) != len(tc.expected) {
+				t.Fatalf("expected %v, got %+v", tc.expected, changes)
+			}
+			for i, change := range changes {
+				if change.Change != tc.expected[i] {
+					t.Fatalf("expected %v, got %+v", tc.expected, changes)
+				}
+				if change.Settlement.EvidenceRef != "btc:tx:a" {
+					t.Fatalf("expected evidence ref to be propagated, got %+v", change.Settlement)
+				}
+			}
+		})
+	}
 }
 
 func TestCanonicalizeJSON(t *testing.T) {
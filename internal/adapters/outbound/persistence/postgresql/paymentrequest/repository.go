@@ -11,6 +11,7 @@ import (
 
 	"chaintx/internal/application/dto"
 	portsout "chaintx/internal/application/ports/out"
+	valueobjects "chaintx/internal/domain/value_objects"
 	apperrors "chaintx/internal/shared_kernel/errors"
 
 	"github.com/jackc/pgx/v5/pgconn"
@@ -21,6 +22,8 @@ type Repository struct {
 	logger               *log.Logger
 	webhookOutboxEnabled bool
 	webhookMaxAttempts   int
+	eventSinkDriver      valueobjects.EventSinkDriver
+	eventSinkTopic       string
 }
 
 var _ portsout.PaymentRequestRepository = (*Repository)(nil)
@@ -29,9 +32,13 @@ func NewRepository(db *sql.DB, logger *log.Logger) *Repository {
 	return NewRepositoryWithConfig(db, logger, Config{})
 }
 
+// Config controls which outbox channels receive events. EventSinkDriver adds
+// one message bus row per event next to the webhook row.
 type Config struct {
 	WebhookOutboxEnabled bool
 	WebhookMaxAttempts   int
+	EventSinkDriver      valueobjects.EventSinkDriver
+	EventSinkTopic       string
 }
 
 func NewRepositoryWithConfig(db *sql.DB, logger *log.Logger, cfg Config) *Repository {
@@ -45,6 +52,8 @@ func NewRepositoryWithConfig(db *sql.DB, logger *log.Logger, cfg Config) *Reposi
 		logger:               logger,
 		webhookOutboxEnabled: cfg.WebhookOutboxEnabled,
 		webhookMaxAttempts:   maxAttempts,
+		eventSinkDriver:      cfg.EventSinkDriver,
+		eventSinkTopic:       strings.TrimSpace(cfg.EventSinkTopic),
 	}
 }
 
@@ -149,7 +158,19 @@ func (r *Repository) Create(
 		return result, nil
 	}
 
-	wallet, appErr := r.lockWalletAccountForUpdate(ctx, tx, command.AssetCatalogSnapshot.WalletAccountID)
+	if command.CheckOpenQuota != nil {
+		usage, usageErr := r.lockOpenPaymentRequestUsage(ctx, tx, command.IdempotencyScope, command.CreatedAt)
+		if usageErr != nil {
+			appErr = usageErr
+			return result, appErr
+		}
+		if quotaErr := command.CheckOpenQuota(usage); quotaErr != nil {
+			appErr = quotaErr
+			return result, appErr
+		}
+	}
+
+	wallet, appErr := r.lockWalletAccountForUpdate(ctx, tx, command.IdempotencyScope.MerchantID, command.AssetCatalogSnapshot.WalletAccountID)
 	if appErr != nil {
 		return result, appErr
 	}
@@ -196,6 +217,7 @@ func (r *Repository) Create(
 		return result, appErr
 	}
 
+	decimals := command.AssetCatalogSnapshot.Decimals
 	resource := dto.PaymentRequestResource{
 		ID:                  command.ResourceID,
 		Status:              command.Status,
@@ -203,6 +225,7 @@ func (r *Repository) Create(
 		Network:             command.Network,
 		Asset:               command.Asset,
 		ExpectedAmountMinor: command.ExpectedAmountMinor,
+		WebhookAPIVersion:   webhookAPIVersionOrDefault(command.WebhookAPIVersion),
 		ExpiresAt:           command.ExpiresAt,
 		CreatedAt:           command.CreatedAt,
 		PaymentInstructions: dto.PaymentInstructions{
@@ -213,6 +236,8 @@ func (r *Repository) Create(
 			TokenStandard:   command.AssetCatalogSnapshot.TokenStandard,
 			TokenContract:   command.AssetCatalogSnapshot.TokenContract,
 			TokenDecimals:   command.AssetCatalogSnapshot.TokenDecimals,
+			MinorUnit:       command.AssetCatalogSnapshot.MinorUnit,
+			Decimals:        &decimals,
 		},
 	}
 
@@ -250,6 +275,18 @@ func (r *Repository) Create(
 		return result, appErr
 	}
 
+	if command.BuildWebhookEvents != nil {
+		events, buildErr := command.BuildWebhookEvents(resource)
+		if buildErr != nil {
+			appErr = buildErr
+			return result, appErr
+		}
+		if enqueueErr := r.recordPaymentRequestEvents(ctx, tx, command.ResourceID, events, command.CreatedAt); enqueueErr != nil {
+			appErr = enqueueErr
+			return result, appErr
+		}
+	}
+
 	if appErr := r.bumpWalletNextIndex(ctx, tx, wallet.ID, wallet.NextIndex, command.CreatedAt); appErr != nil {
 		return result, appErr
 	}
@@ -285,7 +322,8 @@ func (r *Repository) findIdempotencyRecordForUpdate(
 	const query = `
 SELECT request_hash, resource_id, response_payload
 FROM app.idempotency_records
-WHERE scope_principal = $1
+WHERE scope_merchant = $5
+  AND scope_principal = $1
   AND scope_method = $2
   AND scope_path = $3
   AND idempotency_key = $4
@@ -300,6 +338,7 @@ FOR UPDATE
 		scope.HTTPMethod,
 		scope.HTTPPath,
 		idempotencyKey,
+		scope.MerchantID,
 	).Scan(&record.RequestHash, &record.ResourceID, &record.ResponsePayload)
 	if err == nil {
 		return record, true, nil
@@ -324,14 +363,15 @@ func (r *Repository) loadReplayResource(
 	const query = `
 SELECT request_hash, resource_id, response_payload
 FROM app.idempotency_records
-WHERE scope_principal = $1
+WHERE scope_merchant = $5
+  AND scope_principal = $1
   AND scope_method = $2
   AND scope_path = $3
   AND idempotency_key = $4
 `
 
 	record := idempotencyRecord{}
-	err := r.db.QueryRowContext(ctx, query, scope.PrincipalID, scope.HTTPMethod, scope.HTTPPath, idempotencyKey).Scan(
+	err := r.db.QueryRowContext(ctx, query, scope.PrincipalID, scope.HTTPMethod, scope.HTTPPath, idempotencyKey, scope.MerchantID).Scan(
 		&record.RequestHash,
 		&record.ResourceID,
 		&record.ResponsePayload,
@@ -376,16 +416,19 @@ type walletAccountRow struct {
 	IsActive               bool
 }
 
-func (r *Repository) lockWalletAccountForUpdate(ctx context.Context, tx *sql.Tx, walletAccountID string) (walletAccountRow, *apperrors.AppError) {
+// lockWalletAccountForUpdate only returns wallet accounts of merchantID, so a
+// catalog row can never allocate from another merchant's keyset.
+func (r *Repository) lockWalletAccountForUpdate(ctx context.Context, tx *sql.Tx, merchantID string, walletAccountID string) (walletAccountRow, *apperrors.AppError) {
 	const query = `
 SELECT id, chain, network, keyset_id, derivation_path_template, next_index, is_active
 FROM app.wallet_accounts
 WHERE id = $1
+  AND merchant_id = $2
 FOR UPDATE
 `
 
 	walletAccount := walletAccountRow{}
-	err := tx.QueryRowContext(ctx, query, walletAccountID).Scan(
+	err := tx.QueryRowContext(ctx, query, walletAccountID, merchantID).Scan(
 		&walletAccount.ID,
 		&walletAccount.Chain,
 		&walletAccount.Network,
@@ -398,7 +441,7 @@ FOR UPDATE
 		return walletAccountRow{}, apperrors.NewInternal(
 			"wallet_account_not_found",
 			"wallet account mapping is invalid",
-			map[string]any{"wallet_account_id": walletAccountID},
+			map[string]any{"wallet_account_id": walletAccountID, "merchant_id": merchantID},
 		)
 	}
 	if err != nil {
@@ -414,6 +457,54 @@ FOR UPDATE
 	return walletAccount, nil
 }
 
+// lockOpenPaymentRequestUsage serializes creates of one principal with a
+// transaction advisory lock, so concurrent creates cannot both pass the quota,
+// and counts the principal's unexpired pending and detected requests.
+func (r *Repository) lockOpenPaymentRequestUsage(
+	ctx context.Context,
+	tx *sql.Tx,
+	scope dto.IdempotencyScope,
+	now time.Time,
+) (dto.OpenPaymentRequestUsage, *apperrors.AppError) {
+	const lockQuery = `SELECT pg_advisory_xact_lock(hashtextextended('payment_request_open_quota/' || $1 || '/' || $2, 0))`
+	if _, err := tx.ExecContext(ctx, lockQuery, scope.MerchantID, scope.PrincipalID); err != nil {
+		return dto.OpenPaymentRequestUsage{}, apperrors.NewInternal(
+			"payment_request_quota_lock_failed",
+			"failed to lock payment request quota",
+			map[string]any{"error": err.Error()},
+		)
+	}
+
+	const usageQuery = `
+SELECT
+  COUNT(*),
+  MIN(expires_at) FILTER (WHERE status = 'pending')
+FROM app.payment_requests
+WHERE merchant_id = $1
+  AND principal_id = $2
+  AND status IN ('pending', 'detected')
+  AND expires_at > $3
+`
+	var usage dto.OpenPaymentRequestUsage
+	var earliestExpiresAt sql.NullTime
+	if err := tx.QueryRowContext(ctx, usageQuery, scope.MerchantID, scope.PrincipalID, now).Scan(
+		&usage.OpenCount,
+		&earliestExpiresAt,
+	); err != nil {
+		return dto.OpenPaymentRequestUsage{}, apperrors.NewInternal(
+			"payment_request_quota_query_failed",
+			"failed to count open payment requests",
+			map[string]any{"error": err.Error()},
+		)
+	}
+	if earliestExpiresAt.Valid {
+		expiresAt := earliestExpiresAt.Time.UTC()
+		usage.EarliestExpiresAt = &expiresAt
+	}
+
+	return usage, nil
+}
+
 func (r *Repository) insertPaymentRequest(
 	ctx context.Context,
 	tx *sql.Tx,
@@ -442,11 +533,17 @@ INSERT INTO app.payment_requests (
   metadata,
   expires_at,
   created_at,
-  updated_at
+  updated_at,
+  webhook_api_version,
+  principal_id,
+  merchant_id,
+  minor_unit,
+  decimals
 ) VALUES (
   $1, $2, $3, $4, $5, $6, $7, $8,
   $9, $10, $11, $12, $13, $14, $15,
-  $16, $17, $18, $19
+  $16, $17, $18, $19, $20, $21, $22,
+  $23, $24
 	)
 `
 
@@ -490,6 +587,11 @@ INSERT INTO app.payment_requests (
 		command.ExpiresAt,
 		command.CreatedAt,
 		command.CreatedAt,
+		webhookAPIVersionOrDefault(command.WebhookAPIVersion),
+		command.IdempotencyScope.PrincipalID,
+		command.IdempotencyScope.MerchantID,
+		command.AssetCatalogSnapshot.MinorUnit,
+		command.AssetCatalogSnapshot.Decimals,
 	)
 	if err != nil {
 		if isUniqueViolation(err) {
@@ -528,8 +630,9 @@ INSERT INTO app.idempotency_records (
   resource_id,
   response_payload,
   created_at,
-  expires_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+  expires_at,
+  scope_merchant
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 `
 
 	_, err := tx.ExecContext(
@@ -545,6 +648,7 @@ INSERT INTO app.idempotency_records (
 		responsePayload,
 		command.CreatedAt,
 		expiresAt,
+		command.IdempotencyScope.MerchantID,
 	)
 	if err != nil {
 		if isUniqueViolation(err) {
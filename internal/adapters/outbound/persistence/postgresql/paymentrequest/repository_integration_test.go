@@ -21,6 +21,7 @@ import (
 
 	postgresqlbootstrap "chaintx/internal/adapters/outbound/persistence/postgresql/bootstrap"
 	postgresqlshared "chaintx/internal/adapters/outbound/persistence/postgresql/shared"
+	postgresqlwebhookoutbox "chaintx/internal/adapters/outbound/persistence/postgresql/webhookoutbox"
 	"chaintx/internal/application/dto"
 	apperrors "chaintx/internal/shared_kernel/errors"
 
@@ -130,6 +131,16 @@ func TestPaymentRequestRepositoryCreateIntegrationSmokeByAssetLocalEVM(t *testin
 			if result.Resource.PaymentInstructions.ChainID == nil || *result.Resource.PaymentInstructions.ChainID != 31337 {
 				t.Fatalf("expected local chain id 31337, got %+v", result.Resource.PaymentInstructions.ChainID)
 			}
+
+			stored, found, appErr := NewReadModel(harness.db).GetByID(context.Background(), "default", resourceID)
+			if appErr != nil || !found {
+				t.Fatalf("expected stored payment request, found=%v err=%+v", found, appErr)
+			}
+			for _, instructions := range []dto.PaymentInstructions{result.Resource.PaymentInstructions, stored.PaymentInstructions} {
+				if instructions.MinorUnit != catalog.MinorUnit || instructions.Decimals == nil || *instructions.Decimals != catalog.Decimals {
+					t.Fatalf("expected catalog units %s/%d, got %s/%v", catalog.MinorUnit, catalog.Decimals, instructions.MinorUnit, instructions.Decimals)
+				}
+			}
 		})
 	}
 }
@@ -232,6 +243,51 @@ func TestPaymentRequestRepositoryCreateIntegrationIdempotencyReplay(t *testing.T
 	}
 }
 
+func TestPaymentRequestRepositoryCreateIntegrationOpenQuota(t *testing.T) {
+	harness := newRepositoryIntegrationHarness(t)
+	harness.resetState(t)
+
+	catalog := harness.mustAssetCatalogEntry(t, "ethereum", "sepolia", "ETH")
+	var seen []dto.OpenPaymentRequestUsage
+	quotaOfOne := func(usage dto.OpenPaymentRequestUsage) *apperrors.AppError {
+		seen = append(seen, usage)
+		if usage.OpenCount >= 1 {
+			return apperrors.NewRateLimited("open_payment_request_quota_exceeded", "quota exceeded", nil)
+		}
+		return nil
+	}
+
+	createdAt := time.Now().UTC()
+	firstCommand := newCreatePersistenceCommand(catalog, "pr_quota_001", "idem-quota-1", "hash-quota-1", createdAt)
+	firstCommand.CheckOpenQuota = quotaOfOne
+	if _, appErr := harness.repository.Create(context.Background(), firstCommand, deterministicResolver); appErr != nil {
+		t.Fatalf("expected first create success, got %+v", appErr)
+	}
+
+	secondCommand := newCreatePersistenceCommand(catalog, "pr_quota_002", "idem-quota-2", "hash-quota-2", createdAt.Add(time.Second))
+	secondCommand.CheckOpenQuota = quotaOfOne
+	_, appErr := harness.repository.Create(context.Background(), secondCommand, deterministicResolver)
+	if appErr == nil || appErr.Code != "open_payment_request_quota_exceeded" {
+		t.Fatalf("expected open_payment_request_quota_exceeded, got %+v", appErr)
+	}
+	if len(seen) != 2 || seen[1].OpenCount != 1 || seen[1].EarliestExpiresAt == nil {
+		t.Fatalf("unexpected usage passed to quota check: %+v", seen)
+	}
+	if !seen[1].EarliestExpiresAt.Equal(firstCommand.ExpiresAt.Truncate(time.Microsecond)) {
+		t.Fatalf("expected earliest expiry %s, got %s", firstCommand.ExpiresAt, seen[1].EarliestExpiresAt)
+	}
+	if nextIndex := harness.mustWalletNextIndex(t, catalog.WalletAccountID); nextIndex != 1 {
+		t.Fatalf("expected refused create not to consume an index, got next_index=%d", nextIndex)
+	}
+
+	replayCommand := newCreatePersistenceCommand(catalog, "pr_quota_003", "idem-quota-1", "hash-quota-1", createdAt.Add(2*time.Second))
+	replayCommand.CheckOpenQuota = quotaOfOne
+	replayResult, appErr := harness.repository.Create(context.Background(), replayCommand, deterministicResolver)
+	if appErr != nil || !replayResult.Replayed {
+		t.Fatalf("expected replay to bypass the quota, got %+v err=%+v", replayResult, appErr)
+	}
+}
+
 func TestPaymentRequestRepositoryCreateIntegrationIdempotencyConflict(t *testing.T) {
 	harness := newRepositoryIntegrationHarness(t)
 	harness.resetState(t)
@@ -501,6 +557,240 @@ func TestPaymentRequestRepositoryCreateIntegrationLatencyP95At20RPS(t *testing.T
 	}
 }
 
+func TestPaymentRequestRepositoryRowVersionIntegration(t *testing.T) {
+	harness := newRepositoryIntegrationHarness(t)
+	harness.resetState(t)
+
+	catalog := harness.mustAssetCatalogEntry(t, "bitcoin", "regtest", "BTC")
+	command := newCreatePersistenceCommand(catalog, "pr_row_version_001", "row-version-001", "hash-row-version-001", time.Now().UTC())
+	result, appErr := harness.repository.Create(context.Background(), command, deterministicResolver)
+	if appErr != nil {
+		t.Fatalf("expected create success, got %+v", appErr)
+	}
+	readModel := NewReadModel(harness.db)
+	expectVersion := func(step string, expected int64) {
+		t.Helper()
+		resource, found, appErr := readModel.GetByID(context.Background(), "default", result.Resource.ID)
+		if appErr != nil || !found {
+			t.Fatalf("%s: expected payment request, found=%v err=%+v", step, found, appErr)
+		}
+		if resource.Version != expected {
+			t.Fatalf("%s: expected version %d, got %d", step, expected, resource.Version)
+		}
+	}
+	expectVersion("created", 1)
+
+	settlements := []dto.ObservedSettlementEvidence{
+		{EvidenceRef: "btc:chain_stats", AmountMinor: "50000", Confirmations: 1, IsCanonical: true, Metadata: map[string]any{}},
+	}
+	observedAt := time.Now().UTC()
+	for _, step := range []string{"settlement written", "settlement unchanged"} {
+		if _, appErr := harness.repository.SyncObservedSettlements(
+			context.Background(), result.Resource.ID, "bitcoin", "regtest", "BTC", observedAt, settlements, nil,
+		); appErr != nil {
+			t.Fatalf("%s: expected sync success, got %+v", step, appErr)
+		}
+		expectVersion(step, 2)
+	}
+
+	metadata := dto.ReconcileTransitionMetadata{ObservedAmountMinor: "50000", UpdatedAt: observedAt}
+	if updated, appErr := harness.repository.TransitionStatusIfCurrent(
+		context.Background(), result.Resource.ID, "pending", "pending", observedAt, "", metadata, nil,
+	); appErr != nil || !updated {
+		t.Fatalf("expected metadata-only transition, updated=%v err=%+v", updated, appErr)
+	}
+	expectVersion("metadata only", 2)
+
+	if updated, appErr := harness.repository.TransitionStatusIfCurrent(
+		context.Background(), result.Resource.ID, "pending", "detected", observedAt, "", metadata, nil,
+	); appErr != nil || !updated {
+		t.Fatalf("expected status transition, updated=%v err=%+v", updated, appErr)
+	}
+	expectVersion("status changed", 3)
+}
+
+func TestPaymentRequestRepositoryStatusHistoryIntegration(t *testing.T) {
+	harness := newRepositoryIntegrationHarness(t)
+	harness.resetState(t)
+
+	catalog := harness.mustAssetCatalogEntry(t, "bitcoin", "regtest", "BTC")
+	command := newCreatePersistenceCommand(catalog, "pr_status_history_001", "status-history-001", "hash-status-history-001", time.Now().UTC())
+	result, appErr := harness.repository.Create(context.Background(), command, deterministicResolver)
+	if appErr != nil {
+		t.Fatalf("expected create success, got %+v", appErr)
+	}
+
+	observedAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	transitions := []struct {
+		from     string
+		to       string
+		metadata dto.ReconcileTransitionMetadata
+	}{
+		{from: "pending", to: "pending", metadata: dto.ReconcileTransitionMetadata{ObservedAmountMinor: "0", UpdatedAt: observedAt}},
+		{from: "pending", to: "detected", metadata: dto.ReconcileTransitionMetadata{
+			ObservedAmountMinor: "50000",
+			ObservationSource:   "btc_esplora",
+			TransitionReason:    "payment_detected",
+			UpdatedAt:           observedAt.Add(time.Minute),
+		}},
+	}
+	for _, transition := range transitions {
+		if updated, appErr := harness.repository.TransitionStatusIfCurrent(
+			context.Background(),
+			result.Resource.ID,
+			transition.from,
+			transition.to,
+			transition.metadata.UpdatedAt,
+			"reconciler-a",
+			transition.metadata,
+			nil,
+		); appErr != nil || !updated {
+			t.Fatalf("expected %s->%s transition, updated=%v err=%+v", transition.from, transition.to, updated, appErr)
+		}
+	}
+
+	readModel := NewReadModel(harness.db)
+	history, found, appErr := readModel.ListStatusHistoryByPaymentRequestID(context.Background(), "default", result.Resource.ID)
+	if appErr != nil || !found {
+		t.Fatalf("expected status history, found=%v err=%+v", found, appErr)
+	}
+	if len(history) != 1 {
+		t.Fatalf("expected only the status change in history, got %+v", history)
+	}
+	entry := history[0]
+	if entry.FromStatus != "pending" || entry.ToStatus != "detected" || entry.Reason != "payment_detected" ||
+		entry.RecordedBy != "reconciler-a" || !entry.OccurredAt.Equal(observedAt.Add(time.Minute)) {
+		t.Fatalf("unexpected status history entry %+v", entry)
+	}
+	if entry.Details["observed_amount_minor"] != "50000" || entry.Details["observation_source"] != "btc_esplora" {
+		t.Fatalf("expected reconciliation details in history, got %+v", entry.Details)
+	}
+
+	resource, found, appErr := readModel.GetByID(context.Background(), "default", result.Resource.ID)
+	if appErr != nil || !found {
+		t.Fatalf("expected payment request, found=%v err=%+v", found, appErr)
+	}
+	if resource.WebhookURL != "https://hooks.example.com/integration" || resource.UpdatedAt == nil {
+		t.Fatalf("expected webhook_url and updated_at, got %+v", resource)
+	}
+	if len(resource.Metadata) != 1 || resource.Metadata["test"] != "integration" {
+		t.Fatalf("expected merchant metadata without reconciliation, got %+v", resource.Metadata)
+	}
+	if resource.Reconciliation == nil || resource.Reconciliation.ObservedAmountMinor != "50000" {
+		t.Fatalf("expected reconciliation metadata, got %+v", resource.Reconciliation)
+	}
+
+	if _, found, appErr := readModel.ListStatusHistoryByPaymentRequestID(context.Background(), "other", result.Resource.ID); appErr != nil || found {
+		t.Fatalf("expected another merchant to see no history, found=%v err=%+v", found, appErr)
+	}
+}
+
+func TestWebhookOutboxSingleEventOpsScopedToSinkIntegration(t *testing.T) {
+	harness := newRepositoryIntegrationHarness(t)
+	harness.resetState(t)
+
+	catalog := harness.mustAssetCatalogEntry(t, "bitcoin", "regtest", "BTC")
+	command := newCreatePersistenceCommand(catalog, "pr_outbox_sink_001", "outbox-sink-001", "hash-outbox-sink-001", time.Now().UTC())
+	result, appErr := harness.repository.Create(context.Background(), command, deterministicResolver)
+	if appErr != nil {
+		t.Fatalf("expected create success, got %+v", appErr)
+	}
+	harness.mustInsertOutboxRow(t, result.Resource.ID, "evt_shared", "webhook", "failed", "http_500")
+	harness.mustInsertOutboxRow(t, result.Resource.ID, "evt_shared", "kafka", "pending", "")
+
+	outbox := postgresqlwebhookoutbox.NewRepository(harness.db)
+	now := time.Now().UTC()
+	cancelled, appErr := outbox.CancelByEventID(context.Background(), "evt_shared", "webhook", "ops-user-1", "manual_cancelled", now)
+	if appErr != nil || !cancelled.Found || !cancelled.Updated || cancelled.CurrentStatus != "failed" || cancelled.LastError != "http_500" {
+		t.Fatalf("expected webhook row cancelled, got %+v err=%+v", cancelled, appErr)
+	}
+	if status, _ := harness.mustOutboxRowState(t, "evt_shared", "kafka"); status != "pending" {
+		t.Fatalf("expected kafka row to stay pending, got %s", status)
+	}
+	if _, lastError := harness.mustOutboxRowState(t, "evt_shared", "webhook"); lastError != "http_500" {
+		t.Fatalf("expected cancelled DLQ row to keep its delivery error, got %q", lastError)
+	}
+
+	requeued, appErr := outbox.RequeueFailedByEventID(context.Background(), "evt_shared", "kafka", "ops-user-1", now)
+	if appErr != nil || !requeued.Found || requeued.Updated || requeued.CurrentStatus != "pending" {
+		t.Fatalf("expected pending kafka row to be reported and left alone, got %+v err=%+v", requeued, appErr)
+	}
+	requeued, appErr = outbox.RequeueFailedByEventID(context.Background(), "evt_shared", "webhook", "ops-user-1", now)
+	if appErr != nil || !requeued.Updated {
+		t.Fatalf("expected webhook row requeued, got %+v err=%+v", requeued, appErr)
+	}
+	if status, _ := harness.mustOutboxRowState(t, "evt_shared", "webhook"); status != "pending" {
+		t.Fatalf("expected webhook row pending after requeue, got %s", status)
+	}
+
+	missing, appErr := outbox.CancelByEventID(context.Background(), "evt_shared", "nats", "ops-user-1", "manual_cancelled", now)
+	if appErr != nil || missing.Found {
+		t.Fatalf("expected no nats row, got %+v err=%+v", missing, appErr)
+	}
+}
+
+func TestWebhookOutboxCancelBatchKeepsDLQErrorIntegration(t *testing.T) {
+	harness := newRepositoryIntegrationHarness(t)
+	harness.resetState(t)
+
+	catalog := harness.mustAssetCatalogEntry(t, "bitcoin", "regtest", "BTC")
+	command := newCreatePersistenceCommand(catalog, "pr_outbox_cancel_001", "outbox-cancel-001", "hash-outbox-cancel-001", time.Now().UTC())
+	result, appErr := harness.repository.Create(context.Background(), command, deterministicResolver)
+	if appErr != nil {
+		t.Fatalf("expected create success, got %+v", appErr)
+	}
+	harness.mustInsertOutboxRow(t, result.Resource.ID, "evt_cancel_failed", "webhook", "failed", "http_500")
+	harness.mustInsertOutboxRow(t, result.Resource.ID, "evt_cancel_pending", "webhook", "pending", "")
+
+	outbox := postgresqlwebhookoutbox.NewRepository(harness.db)
+	batch, appErr := outbox.CancelBatch(
+		context.Background(),
+		dto.WebhookOutboxBulkSelection{EventIDs: []string{"evt_cancel_failed", "evt_cancel_pending"}},
+		0,
+		100,
+		"ops-user-1",
+		"manual_cancelled: bulk",
+		time.Now().UTC(),
+	)
+	if appErr != nil || len(batch.EventIDs) != 2 || batch.HasMore {
+		t.Fatalf("expected both events cancelled and nothing left, got %+v err=%+v", batch, appErr)
+	}
+	if status, lastError := harness.mustOutboxRowState(t, "evt_cancel_failed", "webhook"); status != "failed" || lastError != "http_500" {
+		t.Fatalf("expected DLQ row to keep its delivery error, got status=%s last_error=%q", status, lastError)
+	}
+	if status, lastError := harness.mustOutboxRowState(t, "evt_cancel_pending", "webhook"); status != "failed" || lastError != "manual_cancelled: bulk" {
+		t.Fatalf("expected pending row to take the cancel reason, got status=%s last_error=%q", status, lastError)
+	}
+}
+
+func TestWebhookOutboxCancelBatchResumesCappedSelectionIntegration(t *testing.T) {
+	harness := newRepositoryIntegrationHarness(t)
+	harness.resetState(t)
+
+	catalog := harness.mustAssetCatalogEntry(t, "bitcoin", "regtest", "BTC")
+	command := newCreatePersistenceCommand(catalog, "pr_outbox_resume_001", "outbox-resume-001", "hash-outbox-resume-001", time.Now().UTC())
+	result, appErr := harness.repository.Create(context.Background(), command, deterministicResolver)
+	if appErr != nil {
+		t.Fatalf("expected create success, got %+v", appErr)
+	}
+	harness.mustInsertOutboxRow(t, result.Resource.ID, "evt_resume_1", "webhook", "failed", "http_500")
+	harness.mustInsertOutboxRow(t, result.Resource.ID, "evt_resume_2", "webhook", "pending", "")
+	harness.mustInsertOutboxRow(t, result.Resource.ID, "evt_resume_3", "webhook", "pending", "")
+
+	outbox := postgresqlwebhookoutbox.NewRepository(harness.db)
+	selection := dto.WebhookOutboxBulkSelection{EventIDs: []string{"evt_resume_1", "evt_resume_2", "evt_resume_3"}}
+	first, appErr := outbox.CancelBatch(context.Background(), selection, 0, 2, "ops-user-1", "manual_cancelled", time.Now().UTC())
+	if appErr != nil || len(first.EventIDs) != 2 || !first.HasMore {
+		t.Fatalf("expected a capped first batch with more left, got %+v err=%+v", first, appErr)
+	}
+
+	// Repeating the request starts over at id 0 and must skip cancelled rows.
+	second, appErr := outbox.CancelBatch(context.Background(), selection, 0, 2, "ops-user-1", "manual_cancelled", time.Now().UTC())
+	if appErr != nil || len(second.EventIDs) != 1 || second.EventIDs[0] != "evt_resume_3" || second.HasMore {
+		t.Fatalf("expected the repeat to cancel only evt_resume_3, got %+v err=%+v", second, appErr)
+	}
+}
+
 func TestPaymentRequestRepositorySyncObservedSettlementsIntegrationNoWriteOnUnchangedEvidence(t *testing.T) {
 	harness := newRepositoryIntegrationHarness(t)
 	harness.resetState(t)
@@ -544,6 +834,7 @@ func TestPaymentRequestRepositorySyncObservedSettlementsIntegrationNoWriteOnUnch
 		"BTC",
 		observedAt1,
 		settlements,
+		nil,
 	)
 	if appErr != nil {
 		t.Fatalf("expected first settlement sync success, got %+v", appErr)
@@ -563,6 +854,7 @@ func TestPaymentRequestRepositorySyncObservedSettlementsIntegrationNoWriteOnUnch
 		"BTC",
 		observedAt2,
 		settlements,
+		nil,
 	)
 	if appErr != nil {
 		t.Fatalf("expected second settlement sync success, got %+v", appErr)
@@ -592,6 +884,7 @@ func TestPaymentRequestReadModelListSettlementsByPaymentRequestIDNotFound(t *tes
 	readModel := NewReadModel(harness.db)
 	settlements, found, appErr := readModel.ListSettlementsByPaymentRequestID(
 		context.Background(),
+		"default",
 		"pr_missing",
 	)
 	if appErr != nil {
@@ -625,6 +918,7 @@ func TestPaymentRequestReadModelListSettlementsByPaymentRequestIDEmpty(t *testin
 	readModel := NewReadModel(harness.db)
 	settlements, found, appErr := readModel.ListSettlementsByPaymentRequestID(
 		context.Background(),
+		"default",
 		result.Resource.ID,
 	)
 	if appErr != nil {
@@ -638,6 +932,204 @@ func TestPaymentRequestReadModelListSettlementsByPaymentRequestIDEmpty(t *testin
 	}
 }
 
+func TestPaymentRequestReadModelGetPrincipalID(t *testing.T) {
+	harness := newRepositoryIntegrationHarness(t)
+	harness.resetState(t)
+
+	catalog := harness.mustAssetCatalogEntry(t, "bitcoin", "regtest", "BTC")
+	command := newCreatePersistenceCommand(
+		catalog,
+		"pr_read_model_principal_001",
+		"read-model-principal-001",
+		"hash-read-model-principal-001",
+		time.Now().UTC(),
+	)
+	result, appErr := harness.repository.Create(context.Background(), command, deterministicResolver)
+	if appErr != nil {
+		t.Fatalf("expected create success, got %+v", appErr)
+	}
+
+	readModel := NewReadModel(harness.db)
+	principalID, found, appErr := readModel.GetPrincipalID(context.Background(), "default", result.Resource.ID)
+	if appErr != nil || !found {
+		t.Fatalf("expected principal lookup success, got found=%v err=%+v", found, appErr)
+	}
+	if principalID != integrationScopePrincipal {
+		t.Fatalf("expected principal %s, got %s", integrationScopePrincipal, principalID)
+	}
+
+	_, found, appErr = readModel.GetPrincipalID(context.Background(), "default", "pr_missing")
+	if appErr != nil || found {
+		t.Fatalf("expected missing payment request, got found=%v err=%+v", found, appErr)
+	}
+
+	_, found, appErr = readModel.GetPrincipalID(context.Background(), "retail", result.Resource.ID)
+	if appErr != nil || found {
+		t.Fatalf("expected another merchant's request to read as missing, got found=%v err=%+v", found, appErr)
+	}
+}
+
+func TestPaymentRequestReadModelGetMerchantID(t *testing.T) {
+	harness := newRepositoryIntegrationHarness(t)
+	harness.resetState(t)
+
+	catalog := harness.mustAssetCatalogEntry(t, "bitcoin", "regtest", "BTC")
+	command := newCreatePersistenceCommand(
+		catalog,
+		"pr_read_model_merchant_001",
+		"read-model-merchant-001",
+		"hash-read-model-merchant-001",
+		time.Now().UTC(),
+	)
+	result, appErr := harness.repository.Create(context.Background(), command, deterministicResolver)
+	if appErr != nil {
+		t.Fatalf("expected create success, got %+v", appErr)
+	}
+
+	readModel := NewReadModel(harness.db)
+	merchantID, found, appErr := readModel.GetMerchantID(context.Background(), result.Resource.ID)
+	if appErr != nil || !found || merchantID != "default" {
+		t.Fatalf("expected default merchant, got merchant=%q found=%v err=%+v", merchantID, found, appErr)
+	}
+
+	_, found, appErr = readModel.GetMerchantID(context.Background(), "pr_missing")
+	if appErr != nil || found {
+		t.Fatalf("expected missing request to read as missing, got found=%v err=%+v", found, appErr)
+	}
+}
+
+func TestPaymentRequestReadModelListEventsAfterSequence(t *testing.T) {
+	harness := newRepositoryIntegrationHarness(t)
+	harness.resetState(t)
+
+	catalog := harness.mustAssetCatalogEntry(t, "bitcoin", "regtest", "BTC")
+	command := newCreatePersistenceCommand(
+		catalog,
+		"pr_read_model_events_001",
+		"read-model-events-001",
+		"hash-read-model-events-001",
+		time.Now().UTC(),
+	)
+	command.BuildWebhookEvents = func(resource dto.PaymentRequestResource) ([]dto.WebhookEvent, *apperrors.AppError) {
+		return []dto.WebhookEvent{{
+			EventID:    "evt_read_model_events_001",
+			EventType:  "payment_request.created",
+			APIVersion: "v1",
+			Data:       dto.WebhookPaymentRequestCreatedData{PaymentRequest: resource},
+		}}, nil
+	}
+	result, appErr := harness.repository.Create(context.Background(), command, deterministicResolver)
+	if appErr != nil {
+		t.Fatalf("expected create success, got %+v", appErr)
+	}
+
+	readModel := NewReadModel(harness.db)
+	events, found, appErr := readModel.ListEventsAfterSequence(context.Background(), "default", result.Resource.ID, 0, 10)
+	if appErr != nil {
+		t.Fatalf("expected read model success, got %+v", appErr)
+	}
+	if !found || len(events) != 1 {
+		t.Fatalf("expected found=true with one event, got found=%t events=%d", found, len(events))
+	}
+	if events[0].Sequence != 1 || events[0].EventID != "evt_read_model_events_001" {
+		t.Fatalf("unexpected event %+v", events[0])
+	}
+
+	events, found, appErr = readModel.ListEventsAfterSequence(context.Background(), "default", result.Resource.ID, 1, 10)
+	if appErr != nil {
+		t.Fatalf("expected read model success, got %+v", appErr)
+	}
+	if !found || len(events) != 0 {
+		t.Fatalf("expected found=true with no events after sequence 1, got found=%t events=%d", found, len(events))
+	}
+
+	_, found, appErr = readModel.ListEventsAfterSequence(context.Background(), "default", "pr_missing", 0, 10)
+	if appErr != nil {
+		t.Fatalf("expected success for missing id query, got %+v", appErr)
+	}
+	if found {
+		t.Fatalf("expected found=false for missing payment request")
+	}
+}
+
+func TestPaymentRequestReadModelGetReconcileHealthOverview(t *testing.T) {
+	harness := newRepositoryIntegrationHarness(t)
+	harness.resetState(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	catalog := harness.mustAssetCatalogEntry(t, "bitcoin", "regtest", "BTC")
+	stuck, appErr := harness.repository.Create(
+		context.Background(),
+		newCreatePersistenceCommand(catalog, "pr_reconcile_health_001", "reconcile-health-001", "hash-reconcile-health-001", now),
+		deterministicResolver,
+	)
+	if appErr != nil {
+		t.Fatalf("expected create success, got %+v", appErr)
+	}
+	observed, appErr := harness.repository.Create(
+		context.Background(),
+		newCreatePersistenceCommand(catalog, "pr_reconcile_health_002", "reconcile-health-002", "hash-reconcile-health-002", now),
+		deterministicResolver,
+	)
+	if appErr != nil {
+		t.Fatalf("expected create success, got %+v", appErr)
+	}
+
+	if _, err := harness.db.Exec(`
+UPDATE app.payment_requests
+SET status = 'detected',
+    updated_at = $2,
+    metadata = '{"reconciliation":{"observation_source":"reconcile_policy","observation_details":{"observation_error":"btc_esplora_unavailable"}}}'::jsonb
+WHERE id = $1
+`, stuck.Resource.ID, now); err != nil {
+		t.Fatalf("update stuck request: %v", err)
+	}
+	if _, err := harness.db.Exec(`
+INSERT INTO app.payment_request_events (payment_request_id, sequence, event_id, event_type, payload, created_at)
+VALUES ($1, 100, 'evt_reconcile_health_detected', 'payment_request.status_changed', '{}'::jsonb, $2)
+`, stuck.Resource.ID, now.Add(-2*time.Hour)); err != nil {
+		t.Fatalf("insert detected event: %v", err)
+	}
+	if _, err := harness.db.Exec(`
+UPDATE app.payment_requests
+SET updated_at = $2,
+    metadata = '{"reconciliation":{"observation_source":"btc_esplora","observation_details":{"tip_height":205}}}'::jsonb
+WHERE id = $1
+`, observed.Resource.ID, now); err != nil {
+		t.Fatalf("update observed request: %v", err)
+	}
+	if _, err := harness.db.Exec(`
+INSERT INTO app.payment_request_settlements (
+  payment_request_id, evidence_ref, amount_minor, confirmations, is_canonical, first_seen_at, last_seen_at, updated_at
+)
+VALUES ($1, 'btc:utxo:orphan', 1000, 0, FALSE, $2, $2, $2)
+`, observed.Resource.ID, now); err != nil {
+		t.Fatalf("insert orphaned settlement: %v", err)
+	}
+
+	overview, appErr := NewReadModel(harness.db).GetReconcileHealthOverview(
+		context.Background(),
+		now,
+		now.Add(-30*time.Minute),
+		now.Add(-15*time.Minute),
+	)
+	if appErr != nil {
+		t.Fatalf("expected overview success, got %+v", appErr)
+	}
+	if overview.DetectedStuckCount != 1 || overview.OldestDetectedAgeSec == nil || *overview.OldestDetectedAgeSec != 7200 {
+		t.Fatalf("unexpected detected stuck overview %+v", overview)
+	}
+	if overview.ObservedCount != 2 || overview.ObservationErrorCount != 1 {
+		t.Fatalf("expected one error out of two observations, got observed=%d errors=%d", overview.ObservedCount, overview.ObservationErrorCount)
+	}
+	if overview.ReorgCount != 1 {
+		t.Fatalf("expected one orphaned settlement, got %d", overview.ReorgCount)
+	}
+	if len(overview.ChainTips) != 1 || overview.ChainTips[0].TipHeight != 205 || overview.ChainTips[0].Network != "regtest" {
+		t.Fatalf("unexpected chain tips %+v", overview.ChainTips)
+	}
+}
+
 func TestPaymentRequestReadModelListSettlementsByPaymentRequestIDOrdered(t *testing.T) {
 	harness := newRepositoryIntegrationHarness(t)
 	harness.resetState(t)
@@ -679,6 +1171,7 @@ func TestPaymentRequestReadModelListSettlementsByPaymentRequestIDOrdered(t *test
 				Metadata:      map[string]any{"source": "tx_a"},
 			},
 		},
+		nil,
 	)
 	if appErr != nil {
 		t.Fatalf("expected settlement sync success, got %+v", appErr)
@@ -687,6 +1180,7 @@ func TestPaymentRequestReadModelListSettlementsByPaymentRequestIDOrdered(t *test
 	readModel := NewReadModel(harness.db)
 	settlements, found, appErr := readModel.ListSettlementsByPaymentRequestID(
 		context.Background(),
+		"default",
 		result.Resource.ID,
 	)
 	if appErr != nil {
@@ -781,6 +1275,7 @@ func newCreatePersistenceCommand(
 	return dto.CreatePaymentRequestPersistenceCommand{
 		ResourceID: resourceID,
 		IdempotencyScope: dto.IdempotencyScope{
+			MerchantID:  "default",
 			PrincipalID: integrationScopePrincipal,
 			HTTPMethod:  integrationScopeMethod,
 			HTTPPath:    integrationScopePath,
@@ -1101,6 +1596,65 @@ WHERE chain = 'ethereum'
 	)
 }
 
+func (h *repositoryIntegrationHarness) mustInsertOutboxRow(
+	t *testing.T,
+	paymentRequestID string,
+	eventID string,
+	sink string,
+	deliveryStatus string,
+	lastError string,
+) {
+	t.Helper()
+
+	_, err := h.db.ExecContext(context.Background(), `
+INSERT INTO app.webhook_outbox_events (
+  event_id,
+  event_type,
+  payment_request_id,
+  sequence,
+  sink,
+  destination_url,
+  payload,
+  delivery_status,
+  attempts,
+  last_error
+)
+VALUES (
+  $1,
+  'payment_request.status_changed',
+  $2,
+  (SELECT COUNT(*) + 1 FROM app.webhook_outbox_events WHERE payment_request_id = $2 AND sink = $3),
+  $3,
+  'https://hooks.example.com/integration',
+  '{}'::jsonb,
+  $4,
+  CASE WHEN $4 = 'failed' THEN 8 ELSE 0 END,
+  NULLIF($5, '')
+)
+`, eventID, paymentRequestID, sink, deliveryStatus, lastError)
+	if err != nil {
+		t.Fatalf("failed to insert outbox row: %v", err)
+	}
+}
+
+func (h *repositoryIntegrationHarness) mustOutboxRowState(t *testing.T, eventID string, sink string) (string, string) {
+	t.Helper()
+
+	var (
+		status    string
+		lastError sql.NullString
+	)
+	if err := h.db.QueryRowContext(
+		context.Background(),
+		`SELECT delivery_status, last_error FROM app.webhook_outbox_events WHERE event_id = $1 AND sink = $2`,
+		eventID,
+		sink,
+	).Scan(&status, &lastError); err != nil {
+		t.Fatalf("failed to query outbox row: %v", err)
+	}
+	return status, lastError.String
+}
+
 func (h *repositoryIntegrationHarness) mustQueryInt(t *testing.T, query string, args ...any) int {
 	t.Helper()
 
@@ -0,0 +1,228 @@
+package paymentrequest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	valueobjects "chaintx/internal/domain/value_objects"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// PaymentRequestEventsChannel is the Postgres NOTIFY channel signalled with a
+// payment request id whenever events are recorded for it or its row_version
+// changes.
+const PaymentRequestEventsChannel = "payment_request_events"
+
+const notifyPaymentRequestEventsQuery = `SELECT pg_notify($1, $2)`
+
+// recordPaymentRequestEvents writes application-built events to the event
+// journal and the outbox inside the caller's transaction, then signals
+// PaymentRequestEventsChannel (delivered on commit). Sequence numbers are
+// reserved on the payment request row, which also serializes concurrent
+// writers for the same request. Each event gets one outbox row per enabled
+// channel; all rows share event_id and sequence so consumers can de-duplicate
+// across channels.
+func (r *Repository) recordPaymentRequestEvents(
+	ctx context.Context,
+	tx *sql.Tx,
+	paymentRequestID string,
+	events []dto.WebhookEvent,
+	now time.Time,
+) *apperrors.AppError {
+	const reserveQuery = `
+UPDATE app.payment_requests
+SET webhook_event_sequence = webhook_event_sequence + $2
+WHERE id = $1
+RETURNING webhook_event_sequence, COALESCE(webhook_url, '')
+`
+	const journalQuery = `
+INSERT INTO app.payment_request_events (
+  payment_request_id,
+  sequence,
+  event_id,
+  event_type,
+  payload,
+  created_at
+)
+VALUES ($1, $2, $3, $4, $5::jsonb, $6)
+`
+	const insertQuery = `
+INSERT INTO app.webhook_outbox_events (
+  event_id,
+  event_type,
+  payment_request_id,
+  sequence,
+  sink,
+  destination_url,
+  payload,
+  delivery_status,
+  attempts,
+  max_attempts,
+  next_attempt_at,
+  created_at,
+  updated_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7::jsonb, 'pending', 0, $8, $9, $9, $9)
+`
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	var (
+		lastSequence   int64
+		destinationURL string
+	)
+	err := tx.QueryRowContext(ctx, reserveQuery, paymentRequestID, len(events)).Scan(&lastSequence, &destinationURL)
+	if stderrors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return apperrors.NewInternal(
+			"webhook_outbox_enqueue_failed",
+			"failed to reserve webhook event sequence",
+			map[string]any{"error": err.Error(), "id": paymentRequestID},
+		)
+	}
+	channels := r.outboxChannels(destinationURL)
+
+	sequence := lastSequence - int64(len(events))
+	for _, event := range events {
+		sequence++
+		occurredAt := event.OccurredAt.UTC()
+		if event.OccurredAt.IsZero() {
+			occurredAt = now.UTC()
+		}
+		payload, marshalErr := json.Marshal(dto.WebhookEventEnvelope{
+			EventID:    event.EventID,
+			EventType:  event.EventType,
+			APIVersion: event.APIVersion,
+			Sequence:   sequence,
+			OccurredAt: occurredAt,
+			Data:       event.Data,
+		})
+		if marshalErr != nil {
+			return apperrors.NewInternal(
+				"webhook_outbox_enqueue_failed",
+				"failed to encode webhook event payload",
+				map[string]any{"error": marshalErr.Error(), "id": paymentRequestID, "event_type": event.EventType},
+			)
+		}
+
+		if _, execErr := tx.ExecContext(
+			ctx,
+			journalQuery,
+			paymentRequestID,
+			sequence,
+			event.EventID,
+			event.EventType,
+			payload,
+			now.UTC(),
+		); execErr != nil {
+			return apperrors.NewInternal(
+				"payment_request_event_record_failed",
+				"failed to insert payment request event",
+				map[string]any{"error": execErr.Error(), "id": paymentRequestID, "event_type": event.EventType},
+			)
+		}
+
+		for _, channel := range channels {
+			if _, execErr := tx.ExecContext(
+				ctx,
+				insertQuery,
+				event.EventID,
+				event.EventType,
+				paymentRequestID,
+				sequence,
+				channel.sink,
+				channel.destinationURL,
+				payload,
+				r.webhookMaxAttempts,
+				now.UTC(),
+			); execErr != nil {
+				return apperrors.NewInternal(
+					"webhook_outbox_enqueue_failed",
+					"failed to insert webhook outbox event",
+					map[string]any{
+						"error":      execErr.Error(),
+						"id":         paymentRequestID,
+						"event_type": event.EventType,
+						"sink":       channel.sink,
+					},
+				)
+			}
+		}
+	}
+
+	if _, execErr := tx.ExecContext(ctx, notifyPaymentRequestEventsQuery, PaymentRequestEventsChannel, paymentRequestID); execErr != nil {
+		return apperrors.NewInternal(
+			"payment_request_event_record_failed",
+			"failed to notify payment request event listeners",
+			map[string]any{"error": execErr.Error(), "id": paymentRequestID},
+		)
+	}
+
+	return nil
+}
+
+// bumpPaymentRequestVersion increments row_version, the version behind the
+// payment request ETag, and signals PaymentRequestEventsChannel so long-polling
+// readers wake up even when the change records no event. Postgres delivers
+// identical notifications from one transaction once.
+func bumpPaymentRequestVersion(ctx context.Context, tx *sql.Tx, paymentRequestID string) *apperrors.AppError {
+	const bumpQuery = `
+UPDATE app.payment_requests
+SET row_version = row_version + 1
+WHERE id = $1
+`
+	if _, execErr := tx.ExecContext(ctx, bumpQuery, paymentRequestID); execErr != nil {
+		return apperrors.NewInternal(
+			"payment_request_update_failed",
+			"failed to bump payment request version",
+			map[string]any{"error": execErr.Error(), "id": paymentRequestID},
+		)
+	}
+	if _, execErr := tx.ExecContext(ctx, notifyPaymentRequestEventsQuery, PaymentRequestEventsChannel, paymentRequestID); execErr != nil {
+		return apperrors.NewInternal(
+			"payment_request_update_failed",
+			"failed to notify payment request listeners",
+			map[string]any{"error": execErr.Error(), "id": paymentRequestID},
+		)
+	}
+	return nil
+}
+
+type outboxChannel struct {
+	sink           string
+	destinationURL string
+}
+
+func (r *Repository) outboxChannels(webhookURL string) []outboxChannel {
+	channels := make([]outboxChannel, 0, 2)
+	if r.webhookOutboxEnabled && strings.TrimSpace(webhookURL) != "" {
+		channels = append(channels, outboxChannel{
+			sink:           valueobjects.OutboxSinkWebhook,
+			destinationURL: strings.TrimSpace(webhookURL),
+		})
+	}
+	if r.eventSinkDriver.Enabled() && r.eventSinkTopic != "" {
+		channels = append(channels, outboxChannel{
+			sink:           r.eventSinkDriver.String(),
+			destinationURL: valueobjects.EventSinkDestinationURL(r.eventSinkDriver, r.eventSinkTopic),
+		})
+	}
+	return channels
+}
+
+func webhookAPIVersionOrDefault(version string) string {
+	version = strings.TrimSpace(version)
+	if version == "" {
+		return valueobjects.DefaultWebhookAPIVersion.String()
+	}
+	return version
+}
@@ -0,0 +1,221 @@
+package webhookoutbox
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type CircuitRepository struct {
+	db *sql.DB
+}
+
+var _ portsout.WebhookDestinationCircuitRepository = (*CircuitRepository)(nil)
+
+func NewCircuitRepository(db *sql.DB) *CircuitRepository {
+	return &CircuitRepository{db: db}
+}
+
+func (r *CircuitRepository) AcquireDispatchPermit(
+	ctx context.Context,
+	destinationHost string,
+	owner string,
+	policy dto.WebhookDestinationPolicy,
+	probeLease time.Duration,
+	now time.Time,
+) (dto.WebhookDispatchPermit, *apperrors.AppError) {
+	var permit policies.WebhookDispatchPermit
+	appErr := r.updateCircuit(ctx, destinationHost, now, func(circuit policies.WebhookDestinationCircuit) policies.WebhookDestinationCircuit {
+		var next policies.WebhookDestinationCircuit
+		next, permit = policies.AcquireWebhookDispatchPermit(
+			circuit,
+			toCircuitPolicy(policy, probeLease),
+			owner,
+			now.UTC(),
+		)
+		return next
+	})
+	if appErr != nil {
+		return dto.WebhookDispatchPermit{}, appErr
+	}
+
+	return dto.WebhookDispatchPermit{
+		Allowed: permit.Allowed,
+		Probe:   permit.Probe,
+		Reason:  permit.Reason,
+		RetryAt: permit.RetryAt.UTC(),
+	}, nil
+}
+
+func (r *CircuitRepository) RecordDispatchResult(
+	ctx context.Context,
+	destinationHost string,
+	policy dto.WebhookDestinationPolicy,
+	success bool,
+	now time.Time,
+) *apperrors.AppError {
+	return r.updateCircuit(ctx, destinationHost, now, func(circuit policies.WebhookDestinationCircuit) policies.WebhookDestinationCircuit {
+		return policies.RecordWebhookDispatchResult(circuit, toCircuitPolicy(policy, 0), success, now.UTC())
+	})
+}
+
+// updateCircuit serializes circuit transitions for one host across dispatcher
+// replicas by locking its row for the duration of the read-modify-write.
+func (r *CircuitRepository) updateCircuit(
+	ctx context.Context,
+	destinationHost string,
+	now time.Time,
+	transition func(policies.WebhookDestinationCircuit) policies.WebhookDestinationCircuit,
+) *apperrors.AppError {
+	const insertQuery = `
+INSERT INTO app.webhook_destination_circuits (destination_host, created_at, updated_at)
+VALUES ($1, $2, $2)
+ON CONFLICT (destination_host) DO NOTHING
+`
+	const selectQuery = `
+SELECT
+  state,
+  consecutive_failures,
+  open_until,
+  probe_owner,
+  probe_until,
+  rate_window_start,
+  rate_window_count
+FROM app.webhook_destination_circuits
+WHERE destination_host = $1
+FOR UPDATE
+`
+	const updateQuery = `
+UPDATE app.webhook_destination_circuits
+SET
+  state = $2,
+  consecutive_failures = $3,
+  open_until = $4,
+  probe_owner = $5,
+  probe_until = $6,
+  rate_window_start = $7,
+  rate_window_count = $8,
+  updated_at = $9
+WHERE destination_host = $1
+`
+
+	host := strings.ToLower(strings.TrimSpace(destinationHost))
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return apperrors.NewInternal(
+			"webhook_circuit_update_failed",
+			"failed to begin webhook circuit transaction",
+			map[string]any{"error": err.Error(), "destination_host": host},
+		)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err := tx.ExecContext(ctx, insertQuery, host, now.UTC()); err != nil {
+		return apperrors.NewInternal(
+			"webhook_circuit_update_failed",
+			"failed to initialize webhook circuit",
+			map[string]any{"error": err.Error(), "destination_host": host},
+		)
+	}
+
+	var (
+		circuit         policies.WebhookDestinationCircuit
+		openUntil       sql.NullTime
+		probeOwner      sql.NullString
+		probeUntil      sql.NullTime
+		rateWindowStart sql.NullTime
+	)
+	if err := tx.QueryRowContext(ctx, selectQuery, host).Scan(
+		&circuit.State,
+		&circuit.ConsecutiveFailures,
+		&openUntil,
+		&probeOwner,
+		&probeUntil,
+		&rateWindowStart,
+		&circuit.RateWindowCount,
+	); err != nil {
+		return apperrors.NewInternal(
+			"webhook_circuit_query_failed",
+			"failed to load webhook circuit",
+			map[string]any{"error": err.Error(), "destination_host": host},
+		)
+	}
+	circuit.OpenUntil = nullTimeValue(openUntil)
+	circuit.ProbeOwner = probeOwner.String
+	circuit.ProbeUntil = nullTimeValue(probeUntil)
+	circuit.RateWindowStart = nullTimeValue(rateWindowStart)
+
+	next := transition(circuit)
+	if next != circuit {
+		if _, err := tx.ExecContext(
+			ctx,
+			updateQuery,
+			host,
+			next.State,
+			next.ConsecutiveFailures,
+			nullableTime(next.OpenUntil),
+			nullableString(next.ProbeOwner),
+			nullableTime(next.ProbeUntil),
+			nullableTime(next.RateWindowStart),
+			next.RateWindowCount,
+			now.UTC(),
+		); err != nil {
+			return apperrors.NewInternal(
+				"webhook_circuit_update_failed",
+				"failed to update webhook circuit",
+				map[string]any{"error": err.Error(), "destination_host": host},
+			)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewInternal(
+			"webhook_circuit_update_failed",
+			"failed to commit webhook circuit transaction",
+			map[string]any{"error": err.Error(), "destination_host": host},
+		)
+	}
+	committed = true
+	return nil
+}
+
+func toCircuitPolicy(policy dto.WebhookDestinationPolicy, probeLease time.Duration) policies.WebhookDestinationCircuitPolicy {
+	return policies.WebhookDestinationCircuitPolicy{
+		FailureThreshold:     policy.FailureThreshold,
+		OpenDuration:         policy.OpenDuration,
+		ProbeLease:           probeLease,
+		MaxRequestsPerSecond: policy.MaxRequestsPerSecond,
+	}
+}
+
+func nullTimeValue(value sql.NullTime) time.Time {
+	if !value.Valid {
+		return time.Time{}
+	}
+	return value.Time.UTC()
+}
+
+func nullableTime(value time.Time) any {
+	if value.IsZero() {
+		return nil
+	}
+	return value.UTC()
+}
+
+func nullableString(value string) any {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return value
+}
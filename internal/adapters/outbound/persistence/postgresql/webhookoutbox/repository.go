@@ -3,6 +3,7 @@ package webhookoutbox
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"strings"
 	"time"
 
@@ -28,17 +29,31 @@ func (r *Repository) ClaimPendingForDispatch(
 	limit int,
 	leaseOwner string,
 	leaseUntil time.Time,
+	orderedByPaymentRequest bool,
+	sinks []string,
 ) ([]dto.PendingWebhookOutboxEvent, *apperrors.AppError) {
 	const query = `
 WITH candidates AS (
-  SELECT id
-  FROM app.webhook_outbox_events
-  WHERE delivery_status = 'pending'
-    AND destination_url IS NOT NULL
-    AND btrim(destination_url) <> ''
-    AND next_attempt_at <= $1
-    AND (lease_until IS NULL OR lease_until <= $1)
-  ORDER BY created_at ASC, id ASC
+  SELECT c.id
+  FROM app.webhook_outbox_events AS c
+  WHERE c.delivery_status = 'pending'
+    AND c.sink = ANY($6::text[])
+    AND c.destination_url IS NOT NULL
+    AND btrim(c.destination_url) <> ''
+    AND c.next_attempt_at <= $1
+    AND (c.lease_until IS NULL OR c.lease_until <= $1)
+    AND (
+      $5 = FALSE
+      OR NOT EXISTS (
+        SELECT 1
+        FROM app.webhook_outbox_events AS prior
+        WHERE prior.payment_request_id = c.payment_request_id
+          AND prior.sink = c.sink
+          AND prior.delivery_status = 'pending'
+          AND prior.sequence < c.sequence
+      )
+    )
+  ORDER BY c.created_at ASC, c.id ASC
   LIMIT $2
   FOR UPDATE SKIP LOCKED
 )
@@ -53,10 +68,18 @@ RETURNING
   e.id,
   e.event_id,
   e.event_type,
+  e.payment_request_id,
+  COALESCE(
+    (SELECT pr.merchant_id FROM app.payment_requests AS pr WHERE pr.id = e.payment_request_id),
+    'default'
+  ),
+  e.sink,
   e.destination_url,
+  COALESCE(e.destination_host, ''),
   e.payload,
   e.attempts,
-  e.max_attempts
+  e.max_attempts,
+  e.created_at
 `
 
 	rows, err := r.db.QueryContext(
@@ -66,6 +89,8 @@ RETURNING
 		limit,
 		strings.TrimSpace(leaseOwner),
 		leaseUntil.UTC(),
+		orderedByPaymentRequest,
+		sinks,
 	)
 	if err != nil {
 		return nil, apperrors.NewInternal(
@@ -83,10 +108,15 @@ RETURNING
 			&item.ID,
 			&item.EventID,
 			&item.EventType,
+			&item.PaymentRequestID,
+			&item.MerchantID,
+			&item.Sink,
 			&item.DestinationURL,
+			&item.DestinationHost,
 			&item.Payload,
 			&item.Attempts,
 			&item.MaxAttempts,
+			&item.CreatedAt,
 		); err != nil {
 			return nil, apperrors.NewInternal(
 				"webhook_outbox_query_failed",
@@ -111,6 +141,7 @@ func (r *Repository) MarkDelivered(
 	ctx context.Context,
 	id int64,
 	leaseOwner string,
+	resolvedIP string,
 	deliveredAt time.Time,
 ) (bool, *apperrors.AppError) {
 	const query = `
@@ -119,6 +150,7 @@ SET
   delivery_status = 'delivered',
   delivered_at = $3,
   last_error = NULL,
+  last_resolved_ip = COALESCE(NULLIF($4, ''), last_resolved_ip),
   lease_owner = NULL,
   lease_until = NULL,
   updated_at = $3
@@ -126,7 +158,15 @@ WHERE id = $1
   AND delivery_status = 'pending'
   AND (lease_owner IS NULL OR lease_owner = $2)
 `
-	return execRowsAffected(ctx, r.db, query, id, strings.TrimSpace(leaseOwner), deliveredAt.UTC())
+	return execRowsAffected(
+		ctx,
+		r.db,
+		query,
+		id,
+		strings.TrimSpace(leaseOwner),
+		deliveredAt.UTC(),
+		strings.TrimSpace(resolvedIP),
+	)
 }
 
 func (r *Repository) MarkRetry(
@@ -136,6 +176,7 @@ func (r *Repository) MarkRetry(
 	attempts int,
 	nextAttemptAt time.Time,
 	lastError string,
+	resolvedIP string,
 	updatedAt time.Time,
 ) (bool, *apperrors.AppError) {
 	const query = `
@@ -144,6 +185,7 @@ SET
   attempts = $3,
   next_attempt_at = $4,
   last_error = $5,
+  last_resolved_ip = COALESCE(NULLIF($7, ''), last_resolved_ip),
   lease_owner = NULL,
   lease_until = NULL,
   updated_at = $6
@@ -161,6 +203,7 @@ WHERE id = $1
 		nextAttemptAt.UTC(),
 		strings.TrimSpace(lastError),
 		updatedAt.UTC(),
+		strings.TrimSpace(resolvedIP),
 	)
 }
 
@@ -170,6 +213,7 @@ func (r *Repository) MarkFailed(
 	leaseOwner string,
 	attempts int,
 	lastError string,
+	resolvedIP string,
 	updatedAt time.Time,
 ) (bool, *apperrors.AppError) {
 	const query = `
@@ -178,6 +222,7 @@ SET
   delivery_status = 'failed',
   attempts = $3,
   last_error = $4,
+  last_resolved_ip = COALESCE(NULLIF($6, ''), last_resolved_ip),
   lease_owner = NULL,
   lease_until = NULL,
   updated_at = $5
@@ -194,6 +239,36 @@ WHERE id = $1
 		attempts,
 		strings.TrimSpace(lastError),
 		updatedAt.UTC(),
+		strings.TrimSpace(resolvedIP),
+	)
+}
+
+func (r *Repository) DeferDispatch(
+	ctx context.Context,
+	id int64,
+	leaseOwner string,
+	nextAttemptAt time.Time,
+	updatedAt time.Time,
+) (bool, *apperrors.AppError) {
+	const query = `
+UPDATE app.webhook_outbox_events
+SET
+  next_attempt_at = $3,
+  lease_owner = NULL,
+  lease_until = NULL,
+  updated_at = $4
+WHERE id = $1
+  AND delivery_status = 'pending'
+  AND (lease_owner IS NULL OR lease_owner = $2)
+`
+	return execRowsAffected(
+		ctx,
+		r.db,
+		query,
+		id,
+		strings.TrimSpace(leaseOwner),
+		nextAttemptAt.UTC(),
+		updatedAt.UTC(),
 	)
 }
 
@@ -224,9 +299,12 @@ WHERE id = $1
 	)
 }
 
+// RequeueFailedByEventID and CancelByEventID change one sink's row of an
+// event; the event's rows for other sinks are left alone.
 func (r *Repository) RequeueFailedByEventID(
 	ctx context.Context,
 	eventID string,
+	sink string,
 	operatorID string,
 	updatedAt time.Time,
 ) (dto.WebhookOutboxMutationResult, *apperrors.AppError) {
@@ -235,6 +313,7 @@ WITH selected AS (
   SELECT id, delivery_status
   FROM app.webhook_outbox_events
   WHERE event_id = $1
+    AND sink = $4
   FOR UPDATE
 ),
 updated AS (
@@ -254,12 +333,13 @@ updated AS (
   FROM selected AS s
   WHERE e.id = s.id
     AND s.delivery_status = 'failed'
-  RETURNING e.id
+  RETURNING e.id, e.last_error
 )
 SELECT
   EXISTS(SELECT 1 FROM selected) AS found,
   COALESCE((SELECT delivery_status FROM selected LIMIT 1), '') AS current_status,
-  EXISTS(SELECT 1 FROM updated) AS updated
+  EXISTS(SELECT 1 FROM updated) AS updated,
+  COALESCE((SELECT last_error FROM updated LIMIT 1), '') AS last_error
 `
 	return runWebhookOutboxMutationWithStatus(
 		ctx,
@@ -268,12 +348,14 @@ SELECT
 		strings.TrimSpace(eventID),
 		updatedAt.UTC(),
 		strings.TrimSpace(operatorID),
+		strings.TrimSpace(sink),
 	)
 }
 
 func (r *Repository) CancelByEventID(
 	ctx context.Context,
 	eventID string,
+	sink string,
 	operatorID string,
 	lastError string,
 	updatedAt time.Time,
@@ -283,13 +365,14 @@ WITH selected AS (
   SELECT id, delivery_status
   FROM app.webhook_outbox_events
   WHERE event_id = $1
+    AND sink = $5
   FOR UPDATE
 ),
 updated AS (
   UPDATE app.webhook_outbox_events AS e
   SET
     delivery_status = 'failed',
-    last_error = $3,
+    last_error = CASE WHEN e.delivery_status = 'failed' THEN e.last_error ELSE $3 END,
     lease_owner = NULL,
     lease_until = NULL,
     manual_last_action = 'cancel',
@@ -299,12 +382,13 @@ updated AS (
   FROM selected AS s
   WHERE e.id = s.id
     AND s.delivery_status IN ('pending', 'failed')
-  RETURNING e.id
+  RETURNING e.id, e.last_error
 )
 SELECT
   EXISTS(SELECT 1 FROM selected) AS found,
   COALESCE((SELECT delivery_status FROM selected LIMIT 1), '') AS current_status,
-  EXISTS(SELECT 1 FROM updated) AS updated
+  EXISTS(SELECT 1 FROM updated) AS updated,
+  COALESCE((SELECT last_error FROM updated LIMIT 1), '') AS last_error
 `
 	return runWebhookOutboxMutationWithStatus(
 		ctx,
@@ -314,6 +398,7 @@ SELECT
 		strings.TrimSpace(operatorID),
 		strings.TrimSpace(lastError),
 		updatedAt.UTC(),
+		strings.TrimSpace(sink),
 	)
 }
 
@@ -375,29 +460,157 @@ FROM app.webhook_outbox_events
 	return output, nil
 }
 
+func (r *Repository) GetDestinationOverview(
+	ctx context.Context,
+	now time.Time,
+	windowStart time.Time,
+	limit int,
+) ([]dto.WebhookOutboxDestinationOverview, *apperrors.AppError) {
+	const query = `
+SELECT
+  e.sink,
+  COALESCE(e.destination_host, '') AS destination_host,
+  c.state AS circuit_state,
+  COALESCE(SUM(CASE WHEN e.delivery_status = 'pending' THEN 1 ELSE 0 END), 0) AS pending_count,
+  COALESCE(
+    SUM(
+      CASE
+        WHEN e.delivery_status = 'pending'
+         AND e.next_attempt_at <= $1
+         AND (e.lease_until IS NULL OR e.lease_until <= $1)
+        THEN 1
+        ELSE 0
+      END
+    ),
+    0
+  ) AS pending_ready_count,
+  COALESCE(SUM(CASE WHEN e.delivery_status = 'pending' AND e.attempts > 0 THEN 1 ELSE 0 END), 0) AS retrying_count,
+  COALESCE(SUM(CASE WHEN e.delivery_status = 'failed' THEN 1 ELSE 0 END), 0) AS failed_count,
+  MIN(CASE WHEN e.delivery_status = 'pending' THEN e.created_at END) AS oldest_pending_created_at,
+  MAX(e.delivered_at) AS last_delivered_at,
+  COALESCE(
+    SUM(CASE WHEN e.delivery_status = 'delivered' AND e.delivered_at >= $2 THEN 1 ELSE 0 END),
+    0
+  ) AS delivered_in_window,
+  COALESCE(
+    SUM(
+      CASE
+        WHEN e.delivery_status = 'failed'
+         AND e.updated_at >= $2
+         AND e.manual_last_action IS DISTINCT FROM 'cancel'
+        THEN 1
+        ELSE 0
+      END
+    ),
+    0
+  ) AS failed_in_window
+FROM app.webhook_outbox_events AS e
+LEFT JOIN app.webhook_destination_circuits AS c
+  ON c.destination_host = e.destination_host
+GROUP BY e.sink, e.destination_host, c.state
+ORDER BY pending_count DESC, failed_count DESC, e.sink ASC, destination_host ASC
+LIMIT $3
+`
+
+	rows, err := r.db.QueryContext(ctx, query, now.UTC(), windowStart.UTC(), limit)
+	if err != nil {
+		return nil, destinationOverviewQueryFailed(err)
+	}
+	defer rows.Close()
+
+	destinations := []dto.WebhookOutboxDestinationOverview{}
+	for rows.Next() {
+		item := dto.WebhookOutboxDestinationOverview{}
+		var circuitState sql.NullString
+		var oldestPending sql.NullTime
+		var lastDelivered sql.NullTime
+		if err := rows.Scan(
+			&item.Sink,
+			&item.DestinationHost,
+			&circuitState,
+			&item.PendingCount,
+			&item.PendingReadyCount,
+			&item.RetryingCount,
+			&item.FailedCount,
+			&oldestPending,
+			&lastDelivered,
+			&item.DeliveredInWindow,
+			&item.FailedInWindow,
+		); err != nil {
+			return nil, destinationOverviewQueryFailed(err)
+		}
+		if circuitState.Valid {
+			state := circuitState.String
+			item.CircuitState = &state
+		}
+		if oldestPending.Valid {
+			ageSeconds := now.UTC().Sub(oldestPending.Time.UTC()).Seconds()
+			if ageSeconds < 0 {
+				ageSeconds = 0
+			}
+			age := int64(ageSeconds)
+			item.OldestPendingAgeSec = &age
+		}
+		if lastDelivered.Valid {
+			delivered := lastDelivered.Time.UTC()
+			item.LastDeliveredAt = &delivered
+		}
+		destinations = append(destinations, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, destinationOverviewQueryFailed(err)
+	}
+	return destinations, nil
+}
+
+func destinationOverviewQueryFailed(err error) *apperrors.AppError {
+	return apperrors.NewInternal(
+		"webhook_outbox_query_failed",
+		"failed to query webhook outbox destination overview",
+		map[string]any{"error": err.Error()},
+	)
+}
+
 func (r *Repository) ListDLQ(
 	ctx context.Context,
+	filter dto.WebhookOutboxEventFilter,
+	cursor *dto.WebhookDLQCursor,
 	limit int,
 ) ([]dto.WebhookDLQEvent, *apperrors.AppError) {
-	const query = `
+	conditions := []string{"delivery_status = 'failed'"}
+	args := []any{}
+	conditions, args = appendWebhookOutboxFilterConditions(conditions, args, filter)
+	if cursor != nil {
+		args = append(args, cursor.UpdatedAt.UTC(), cursor.ID)
+		conditions = append(
+			conditions,
+			fmt.Sprintf("(updated_at, id) < ($%d, $%d)", len(args)-1, len(args)),
+		)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
 SELECT
+  id,
   event_id,
   event_type,
   payment_request_id,
+  sink,
   destination_url,
   attempts,
   max_attempts,
   last_error,
+  last_resolved_ip,
   created_at,
   updated_at,
   delivered_at
 FROM app.webhook_outbox_events
-WHERE delivery_status = 'failed'
+WHERE %s
 ORDER BY updated_at DESC, id DESC
-LIMIT $1
-`
+LIMIT $%d
+`, strings.Join(conditions, "\n  AND "), len(args))
 
-	rows, err := r.db.QueryContext(ctx, query, limit)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, apperrors.NewInternal(
 			"webhook_outbox_query_failed",
@@ -411,17 +624,21 @@ LIMIT $1
 	for rows.Next() {
 		item := dto.WebhookDLQEvent{}
 		var (
-			lastError   sql.NullString
-			deliveredAt sql.NullTime
+			lastError      sql.NullString
+			lastResolvedIP sql.NullString
+			deliveredAt    sql.NullTime
 		)
 		if err := rows.Scan(
+			&item.ID,
 			&item.EventID,
 			&item.EventType,
 			&item.PaymentRequestID,
+			&item.Sink,
 			&item.DestinationURL,
 			&item.Attempts,
 			&item.MaxAttempts,
 			&lastError,
+			&lastResolvedIP,
 			&item.CreatedAt,
 			&item.UpdatedAt,
 			&deliveredAt,
@@ -432,10 +649,16 @@ LIMIT $1
 				map[string]any{"error": err.Error()},
 			)
 		}
+		item.CreatedAt = item.CreatedAt.UTC()
+		item.UpdatedAt = item.UpdatedAt.UTC()
 		if lastError.Valid {
 			value := lastError.String
 			item.LastError = &value
 		}
+		if lastResolvedIP.Valid {
+			value := lastResolvedIP.String
+			item.LastResolvedIP = &value
+		}
 		if deliveredAt.Valid {
 			value := deliveredAt.Time.UTC()
 			item.DeliveredAt = &value
@@ -453,6 +676,74 @@ LIMIT $1
 	return output, nil
 }
 
+func (r *Repository) RequeueFailedBatch(
+	ctx context.Context,
+	selection dto.WebhookOutboxBulkSelection,
+	afterID int64,
+	limit int,
+	operatorID string,
+	updatedAt time.Time,
+) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError) {
+	args := []any{updatedAt.UTC(), strings.TrimSpace(operatorID)}
+	const setClause = `
+    delivery_status = 'pending',
+    attempts = 0,
+    next_attempt_at = $1,
+    last_error = NULL,
+    delivered_at = NULL,
+    lease_owner = NULL,
+    lease_until = NULL,
+    manual_last_action = 'requeue',
+    manual_last_actor = $2,
+    manual_last_at = $1,
+    updated_at = $1`
+	return runWebhookOutboxBulkMutationBatch(
+		ctx,
+		r.db,
+		"delivery_status = 'failed'",
+		setClause,
+		args,
+		selection,
+		afterID,
+		limit,
+	)
+}
+
+// CancelBatch marks pending and failed events cancelled. Events already in
+// the DLQ keep their last delivery error, so the reason they failed is not
+// lost; only pending events take the cancel reason. Cancelled events leave
+// the selection, so repeating a capped request reaches the remaining rows.
+func (r *Repository) CancelBatch(
+	ctx context.Context,
+	selection dto.WebhookOutboxBulkSelection,
+	afterID int64,
+	limit int,
+	operatorID string,
+	lastError string,
+	updatedAt time.Time,
+) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError) {
+	args := []any{updatedAt.UTC(), strings.TrimSpace(operatorID), strings.TrimSpace(lastError)}
+	const setClause = `
+    delivery_status = 'failed',
+    last_error = CASE WHEN e.delivery_status = 'failed' THEN e.last_error ELSE $3 END,
+    lease_owner = NULL,
+    lease_until = NULL,
+    manual_last_action = 'cancel',
+    manual_last_actor = $2,
+    manual_last_at = $1,
+    updated_at = $1`
+	return runWebhookOutboxBulkMutationBatch(
+		ctx,
+		r.db,
+		"delivery_status IN ('pending', 'failed') AND manual_last_action IS DISTINCT FROM 'cancel'",
+		setClause,
+		args,
+		selection,
+		afterID,
+		limit,
+	)
+}
+
 func execRowsAffected(
 	ctx context.Context,
 	db *sql.DB,
@@ -489,6 +780,7 @@ func runWebhookOutboxMutationWithStatus(
 		&result.Found,
 		&result.CurrentStatus,
 		&result.Updated,
+		&result.LastError,
 	); err != nil {
 		return dto.WebhookOutboxMutationResult{}, apperrors.NewInternal(
 			"webhook_outbox_update_failed",
@@ -499,3 +791,128 @@ func runWebhookOutboxMutationWithStatus(
 	result.CurrentStatus = strings.ToLower(strings.TrimSpace(result.CurrentStatus))
 	return result, nil
 }
+
+func appendWebhookOutboxFilterConditions(
+	conditions []string,
+	args []any,
+	filter dto.WebhookOutboxEventFilter,
+) ([]string, []any) {
+	if host := strings.TrimSpace(filter.DestinationHost); host != "" {
+		args = append(args, strings.ToLower(host))
+		conditions = append(conditions, fmt.Sprintf("destination_host = $%d", len(args)))
+	}
+	if eventType := strings.TrimSpace(filter.EventType); eventType != "" {
+		args = append(args, eventType)
+		conditions = append(conditions, fmt.Sprintf("event_type = $%d", len(args)))
+	}
+	if errorContains := strings.TrimSpace(filter.ErrorContains); errorContains != "" {
+		args = append(args, strings.ToLower(errorContains))
+		conditions = append(
+			conditions,
+			fmt.Sprintf("position($%d IN lower(COALESCE(last_error, ''))) > 0", len(args)),
+		)
+	}
+	if filter.CreatedFrom != nil {
+		args = append(args, filter.CreatedFrom.UTC())
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if filter.CreatedTo != nil {
+		args = append(args, filter.CreatedTo.UTC())
+		conditions = append(conditions, fmt.Sprintf("created_at <= $%d", len(args)))
+	}
+	return conditions, args
+}
+
+func runWebhookOutboxBulkMutationBatch(
+	ctx context.Context,
+	db *sql.DB,
+	statusCondition string,
+	setClause string,
+	args []any,
+	selection dto.WebhookOutboxBulkSelection,
+	afterID int64,
+	limit int,
+) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError) {
+	conditions := []string{statusCondition}
+	args = append(args, afterID)
+	conditions = append(conditions, fmt.Sprintf("id > $%d", len(args)))
+	if len(selection.EventIDs) > 0 {
+		args = append(args, selection.EventIDs)
+		conditions = append(conditions, fmt.Sprintf("event_id = ANY($%d::text[])", len(args)))
+	}
+	conditions, args = appendWebhookOutboxFilterConditions(conditions, args, selection.Filter)
+	args = append(args, limit)
+	where := strings.Join(conditions, "\n    AND ")
+
+	// has_more probes past the batch in the same snapshot, so a selection that
+	// ends exactly at the limit is not reported as unfinished.
+	query := fmt.Sprintf(`
+WITH selected AS (
+  SELECT id
+  FROM app.webhook_outbox_events
+  WHERE %[1]s
+  ORDER BY id ASC
+  LIMIT $%[2]d
+  FOR UPDATE
+),
+updated AS (
+  UPDATE app.webhook_outbox_events AS e
+  SET%[3]s
+  FROM selected AS s
+  WHERE e.id = s.id
+  RETURNING e.id, e.event_id
+)
+SELECT
+  u.id,
+  u.event_id,
+  EXISTS (
+    SELECT 1
+    FROM app.webhook_outbox_events
+    WHERE %[1]s
+      AND id > (SELECT MAX(id) FROM selected)
+  ) AS has_more
+FROM updated AS u
+ORDER BY u.id ASC
+`, where, len(args), setClause)
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return dto.WebhookOutboxBulkMutationBatch{}, apperrors.NewInternal(
+			"webhook_outbox_update_failed",
+			"failed to update webhook outbox event batch",
+			map[string]any{"error": err.Error()},
+		)
+	}
+	defer rows.Close()
+
+	batch := dto.WebhookOutboxBulkMutationBatch{
+		EventIDs: make([]string, 0, limit),
+		LastID:   afterID,
+	}
+	for rows.Next() {
+		var (
+			id      int64
+			eventID string
+		)
+		if err := rows.Scan(&id, &eventID, &batch.HasMore); err != nil {
+			return dto.WebhookOutboxBulkMutationBatch{}, apperrors.NewInternal(
+				"webhook_outbox_update_failed",
+				"failed to parse updated webhook outbox event",
+				map[string]any{"error": err.Error()},
+			)
+		}
+		batch.EventIDs = append(batch.EventIDs, eventID)
+		if id > batch.LastID {
+			batch.LastID = id
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return dto.WebhookOutboxBulkMutationBatch{}, apperrors.NewInternal(
+			"webhook_outbox_update_failed",
+			"failed while iterating updated webhook outbox events",
+			map[string]any{"error": err.Error()},
+		)
+	}
+
+	return batch, nil
+}
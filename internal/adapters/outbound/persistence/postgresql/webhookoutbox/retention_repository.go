@@ -0,0 +1,195 @@
+package webhookoutbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// archivableCondition matches terminal rows nobody acts on anymore: delivered
+// rows and rows an operator cancelled. Rows that failed on their own stay in
+// the outbox so they remain visible and requeueable from the DLQ.
+const archivableCondition = `(
+    (delivery_status = 'delivered' AND delivered_at < $1)
+    OR (delivery_status = 'failed' AND manual_last_action = 'cancel' AND updated_at < $1)
+  )`
+
+const archiveLockKey = "app.webhook_outbox_events_archive"
+
+type RetentionRepository struct {
+	db *sql.DB
+}
+
+var _ portsout.WebhookOutboxRetentionRepository = (*RetentionRepository)(nil)
+
+func NewRetentionRepository(db *sql.DB) *RetentionRepository {
+	return &RetentionRepository{db: db}
+}
+
+func (r *RetentionRepository) CountArchivable(
+	ctx context.Context,
+	cutoff time.Time,
+) (int64, *apperrors.AppError) {
+	query := `
+SELECT count(*)
+FROM app.webhook_outbox_events
+WHERE ` + archivableCondition
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, cutoff.UTC()).Scan(&count); err != nil {
+		return 0, apperrors.NewInternal(
+			"webhook_outbox_query_failed",
+			"failed to count archivable webhook outbox events",
+			map[string]any{"error": err.Error()},
+		)
+	}
+	return count, nil
+}
+
+func (r *RetentionRepository) ArchiveBatch(
+	ctx context.Context,
+	cutoff time.Time,
+	archivedAt time.Time,
+	limit int,
+) (int64, *apperrors.AppError) {
+	archivedAt = archivedAt.UTC()
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return 0, archiveFailed("failed to begin webhook outbox archive transaction", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Serializes concurrent retention workers so partition creation and the
+	// move never race each other.
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, archiveLockKey); err != nil {
+		return 0, archiveFailed("failed to lock webhook outbox archive", err)
+	}
+	if _, err := tx.ExecContext(ctx, archivePartitionDDL(archivedAt)); err != nil {
+		return 0, archiveFailed("failed to create webhook outbox archive partition", err)
+	}
+
+	query := `
+WITH candidates AS (
+  SELECT id
+  FROM app.webhook_outbox_events
+  WHERE ` + archivableCondition + `
+  ORDER BY id
+  LIMIT $3
+  FOR UPDATE SKIP LOCKED
+),
+moved AS (
+  DELETE FROM app.webhook_outbox_events AS e
+  USING candidates AS c
+  WHERE e.id = c.id
+  RETURNING
+    e.id,
+    e.event_id,
+    e.event_type,
+    e.payment_request_id,
+    e.sink,
+    e.sequence,
+    e.payload,
+    e.destination_url,
+    e.delivery_status,
+    e.attempts,
+    e.max_attempts,
+    e.last_error,
+    e.last_resolved_ip,
+    e.manual_last_action,
+    e.manual_last_actor,
+    e.manual_last_at,
+    e.delivered_at,
+    e.created_at,
+    e.updated_at
+)
+INSERT INTO app.webhook_outbox_events_archive (
+  id,
+  event_id,
+  event_type,
+  payment_request_id,
+  sink,
+  sequence,
+  payload,
+  destination_url,
+  delivery_status,
+  attempts,
+  max_attempts,
+  last_error,
+  last_resolved_ip,
+  manual_last_action,
+  manual_last_actor,
+  manual_last_at,
+  delivered_at,
+  created_at,
+  updated_at,
+  archived_at
+)
+SELECT
+  id,
+  event_id,
+  event_type,
+  payment_request_id,
+  sink,
+  sequence,
+  payload,
+  destination_url,
+  delivery_status,
+  attempts,
+  max_attempts,
+  last_error,
+  last_resolved_ip,
+  manual_last_action,
+  manual_last_actor,
+  manual_last_at,
+  delivered_at,
+  created_at,
+  updated_at,
+  $2
+FROM moved
+`
+	result, err := tx.ExecContext(ctx, query, cutoff.UTC(), archivedAt, limit)
+	if err != nil {
+		return 0, archiveFailed("failed to archive webhook outbox events", err)
+	}
+	archived, err := result.RowsAffected()
+	if err != nil {
+		return 0, archiveFailed("failed to read archived webhook outbox row count", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, archiveFailed("failed to commit webhook outbox archive transaction", err)
+	}
+	committed = true
+	return archived, nil
+}
+
+// archivePartitionDDL returns the statement creating the monthly archive
+// partition that holds archivedAt.
+func archivePartitionDDL(archivedAt time.Time) string {
+	from := time.Date(archivedAt.Year(), archivedAt.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	return fmt.Sprintf(
+		`CREATE TABLE IF NOT EXISTS app.webhook_outbox_events_archive_%s
+  PARTITION OF app.webhook_outbox_events_archive
+  FOR VALUES FROM ('%s') TO ('%s')`,
+		from.Format("200601"),
+		from.Format(time.RFC3339),
+		to.Format(time.RFC3339),
+	)
+}
+
+func archiveFailed(message string, err error) *apperrors.AppError {
+	return apperrors.NewInternal(
+		"webhook_outbox_archive_failed",
+		message,
+		map[string]any{"error": err.Error()},
+	)
+}
@@ -0,0 +1,205 @@
+package webhookoutbox
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const retryPolicyColumns = `
+  destination_host,
+  max_attempts,
+  backoff_curve,
+  initial_backoff_seconds,
+  max_backoff_seconds,
+  max_age_seconds,
+  updated_by,
+  created_at,
+  updated_at`
+
+type RetryPolicyRepository struct {
+	db *sql.DB
+}
+
+var _ portsout.WebhookRetryPolicyRepository = (*RetryPolicyRepository)(nil)
+
+func NewRetryPolicyRepository(db *sql.DB) *RetryPolicyRepository {
+	return &RetryPolicyRepository{db: db}
+}
+
+func (r *RetryPolicyRepository) ListByDestinationHosts(
+	ctx context.Context,
+	destinationHosts []string,
+) (map[string]dto.WebhookRetryPolicy, *apperrors.AppError) {
+	hosts := make([]string, 0, len(destinationHosts))
+	for _, host := range destinationHosts {
+		if normalized := strings.ToLower(strings.TrimSpace(host)); normalized != "" {
+			hosts = append(hosts, normalized)
+		}
+	}
+	items := map[string]dto.WebhookRetryPolicy{}
+	if len(hosts) == 0 {
+		return items, nil
+	}
+
+	query := `
+SELECT` + retryPolicyColumns + `
+FROM app.webhook_destination_retry_policies
+WHERE destination_host = ANY($1::text[])
+`
+	rows, err := r.db.QueryContext(ctx, query, hosts)
+	if err != nil {
+		return nil, retryPolicyQueryFailed("failed to query webhook retry policies", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := scanRetryPolicy(rows)
+		if err != nil {
+			return nil, retryPolicyQueryFailed("failed to parse webhook retry policy", err)
+		}
+		items[item.DestinationHost] = item
+	}
+	if err := rows.Err(); err != nil {
+		return nil, retryPolicyQueryFailed("failed while iterating webhook retry policies", err)
+	}
+	return items, nil
+}
+
+func (r *RetryPolicyRepository) Get(
+	ctx context.Context,
+	destinationHost string,
+) (dto.WebhookRetryPolicy, bool, *apperrors.AppError) {
+	query := `
+SELECT` + retryPolicyColumns + `
+FROM app.webhook_destination_retry_policies
+WHERE destination_host = $1
+`
+	item, err := scanRetryPolicy(r.db.QueryRowContext(ctx, query, strings.ToLower(strings.TrimSpace(destinationHost))))
+	if errors.Is(err, sql.ErrNoRows) {
+		return dto.WebhookRetryPolicy{}, false, nil
+	}
+	if err != nil {
+		return dto.WebhookRetryPolicy{}, false, retryPolicyQueryFailed("failed to query webhook retry policy", err)
+	}
+	return item, true, nil
+}
+
+func (r *RetryPolicyRepository) Upsert(
+	ctx context.Context,
+	policy dto.WebhookRetryPolicy,
+) (dto.WebhookRetryPolicy, *apperrors.AppError) {
+	query := `
+INSERT INTO app.webhook_destination_retry_policies (
+  destination_host,
+  max_attempts,
+  backoff_curve,
+  initial_backoff_seconds,
+  max_backoff_seconds,
+  max_age_seconds,
+  updated_by,
+  created_at,
+  updated_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (destination_host) DO UPDATE
+SET
+  max_attempts = EXCLUDED.max_attempts,
+  backoff_curve = EXCLUDED.backoff_curve,
+  initial_backoff_seconds = EXCLUDED.initial_backoff_seconds,
+  max_backoff_seconds = EXCLUDED.max_backoff_seconds,
+  max_age_seconds = EXCLUDED.max_age_seconds,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = EXCLUDED.updated_at
+RETURNING` + retryPolicyColumns
+
+	var maxAge sql.NullInt64
+	if policy.MaxAgeSeconds != nil {
+		maxAge = sql.NullInt64{Int64: int64(*policy.MaxAgeSeconds), Valid: true}
+	}
+	var updatedBy sql.NullString
+	if policy.UpdatedBy != nil {
+		updatedBy = sql.NullString{String: *policy.UpdatedBy, Valid: true}
+	}
+
+	item, err := scanRetryPolicy(r.db.QueryRowContext(
+		ctx,
+		query,
+		strings.ToLower(strings.TrimSpace(policy.DestinationHost)),
+		policy.MaxAttempts,
+		policy.BackoffCurve,
+		policy.InitialBackoffSeconds,
+		policy.MaxBackoffSeconds,
+		maxAge,
+		updatedBy,
+		policy.CreatedAt.UTC(),
+		policy.UpdatedAt.UTC(),
+	))
+	if err != nil {
+		return dto.WebhookRetryPolicy{}, retryPolicyQueryFailed("failed to upsert webhook retry policy", err)
+	}
+	return item, nil
+}
+
+func (r *RetryPolicyRepository) Delete(ctx context.Context, destinationHost string) (bool, *apperrors.AppError) {
+	const query = `
+DELETE FROM app.webhook_destination_retry_policies
+WHERE destination_host = $1
+`
+	result, err := r.db.ExecContext(ctx, query, strings.ToLower(strings.TrimSpace(destinationHost)))
+	if err != nil {
+		return false, retryPolicyQueryFailed("failed to delete webhook retry policy", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, retryPolicyQueryFailed("failed to read deleted webhook retry policy count", err)
+	}
+	return deleted > 0, nil
+}
+
+type retryPolicyScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRetryPolicy(scanner retryPolicyScanner) (dto.WebhookRetryPolicy, error) {
+	item := dto.WebhookRetryPolicy{}
+	var maxAge sql.NullInt64
+	var updatedBy sql.NullString
+	if err := scanner.Scan(
+		&item.DestinationHost,
+		&item.MaxAttempts,
+		&item.BackoffCurve,
+		&item.InitialBackoffSeconds,
+		&item.MaxBackoffSeconds,
+		&maxAge,
+		&updatedBy,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	); err != nil {
+		return dto.WebhookRetryPolicy{}, err
+	}
+	if maxAge.Valid {
+		value := int(maxAge.Int64)
+		item.MaxAgeSeconds = &value
+	}
+	if updatedBy.Valid {
+		value := updatedBy.String
+		item.UpdatedBy = &value
+	}
+	item.CreatedAt = item.CreatedAt.UTC()
+	item.UpdatedAt = item.UpdatedAt.UTC()
+	return item, nil
+}
+
+func retryPolicyQueryFailed(message string, err error) *apperrors.AppError {
+	return apperrors.NewInternal(
+		"webhook_retry_policy_query_failed",
+		message,
+		map[string]any{"error": err.Error()},
+	)
+}
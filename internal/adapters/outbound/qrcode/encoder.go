@@ -0,0 +1,118 @@
+package qrcode
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	// quietZoneModules is the light border the spec requires around a symbol.
+	quietZoneModules = 4
+	pngModulePixels  = 8
+)
+
+type Encoder struct{}
+
+var _ portsout.QRCodeEncoder = (*Encoder)(nil)
+
+func NewEncoder() *Encoder {
+	return &Encoder{}
+}
+
+func (e *Encoder) Encode(content string, format string) ([]byte, *apperrors.AppError) {
+	s, err := encodeSymbol([]byte(content))
+	if err != nil {
+		return nil, apperrors.NewInternal(
+			"qr_code_encode_failed",
+			"failed to encode QR code",
+			map[string]any{"error": err.Error(), "length": len(content)},
+		)
+	}
+
+	switch format {
+	case dto.QRCodeFormatPNG:
+		out, err := renderPNG(s)
+		if err != nil {
+			return nil, apperrors.NewInternal(
+				"qr_code_encode_failed",
+				"failed to encode QR code",
+				map[string]any{"error": err.Error(), "format": format},
+			)
+		}
+		return out, nil
+	case dto.QRCodeFormatSVG:
+		return renderSVG(s), nil
+	default:
+		return nil, apperrors.NewInternal(
+			"qr_code_format_unsupported",
+			"QR code format is not supported",
+			map[string]any{"format": format},
+		)
+	}
+}
+
+func renderPNG(s *symbol) ([]byte, error) {
+	side := (s.size + 2*quietZoneModules) * pngModulePixels
+	img := image.NewPaletted(image.Rect(0, 0, side, side), color.Palette{color.White, color.Black})
+	for y := 0; y < s.size; y++ {
+		for x := 0; x < s.size; x++ {
+			if !s.modules[y][x] {
+				continue
+			}
+			top := (y + quietZoneModules) * pngModulePixels
+			left := (x + quietZoneModules) * pngModulePixels
+			for dy := 0; dy < pngModulePixels; dy++ {
+				for dx := 0; dx < pngModulePixels; dx++ {
+					img.SetColorIndex(left+dx, top+dy, 1)
+				}
+			}
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// renderSVG draws one module per user unit, merging horizontal runs of dark
+// modules into a single path segment.
+func renderSVG(s *symbol) []byte {
+	side := s.size + 2*quietZoneModules
+
+	var path strings.Builder
+	for y := 0; y < s.size; y++ {
+		for x := 0; x < s.size; {
+			if !s.modules[y][x] {
+				x++
+				continue
+			}
+			run := 1
+			for x+run < s.size && s.modules[y][x+run] {
+				run++
+			}
+			fmt.Fprintf(&path, "M%d %dh%dv1h-%dz", x+quietZoneModules, y+quietZoneModules, run, run)
+			x += run
+		}
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(
+		&out,
+		`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" width="%d" height="%d" shape-rendering="crispEdges">`,
+		side, side, side*pngModulePixels, side*pngModulePixels,
+	)
+	out.WriteString(`<rect width="100%" height="100%" fill="#fff"/>`)
+	fmt.Fprintf(&out, `<path fill="#000" d="%s"/>`, path.String())
+	out.WriteString(`</svg>`)
+	return []byte(out.String())
+}
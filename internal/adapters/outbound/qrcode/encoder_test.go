@@ -0,0 +1,113 @@
+//go:build !integration
+
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"chaintx/internal/application/dto"
+)
+
+func TestReedSolomonRemainder(t *testing.T) {
+	// Version 1-M "HELLO WORLD" from the spec's worked example.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	got := reedSolomonRemainder(data, reedSolomonGenerator(len(want)))
+	if !bytes.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestFormatAndVersionBits(t *testing.T) {
+	if got := formatBits(0); got != 0b101010000010010 {
+		t.Fatalf("expected level M mask 0 format bits, got %015b", got)
+	}
+	if got := formatBits(7); got != 0b100101010100000 {
+		t.Fatalf("expected level M mask 7 format bits, got %015b", got)
+	}
+	if got := versionBits(7); got != 0b000111110010010100 {
+		t.Fatalf("expected version 7 bits, got %018b", got)
+	}
+	if got := versionBits(40); got != 0b101000110001101001 {
+		t.Fatalf("expected version 40 bits, got %018b", got)
+	}
+}
+
+func TestEncodeSymbolPicksSmallestVersion(t *testing.T) {
+	cases := []struct {
+		length  int
+		version int
+	}{
+		{length: 0, version: 1},
+		{length: 14, version: 1},
+		{length: 15, version: 2},
+		{length: 106, version: 6},
+		{length: 2331, version: 40},
+	}
+	for _, tc := range cases {
+		s, err := encodeSymbol(bytes.Repeat([]byte("a"), tc.length))
+		if err != nil {
+			t.Fatalf("length %d: unexpected error %v", tc.length, err)
+		}
+		if s.size != tc.version*4+17 {
+			t.Fatalf("length %d: expected version %d, got size %d", tc.length, tc.version, s.size)
+		}
+		assertFinderPattern(t, s, 0, 0)
+		assertFinderPattern(t, s, s.size-7, 0)
+		assertFinderPattern(t, s, 0, s.size-7)
+	}
+
+	if _, err := encodeSymbol(bytes.Repeat([]byte("a"), 2332)); err == nil {
+		t.Fatalf("expected content over version 40 capacity to fail")
+	}
+}
+
+func TestEncoderFormats(t *testing.T) {
+	encoder := NewEncoder()
+	content := "bitcoin:bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq?amount=0.0015"
+
+	pngBytes, appErr := encoder.Encode(content, dto.QRCodeFormatPNG)
+	if appErr != nil {
+		t.Fatalf("unexpected png error: %+v", appErr)
+	}
+	img, err := png.Decode(bytes.NewReader(pngBytes))
+	if err != nil {
+		t.Fatalf("expected a valid png, got %v", err)
+	}
+	// 64 bytes need version 5 (37 modules), plus a 4 module quiet zone each side.
+	if side := (37 + 8) * pngModulePixels; img.Bounds().Dx() != side || img.Bounds().Dy() != side {
+		t.Fatalf("expected %dx%d png, got %v", side, side, img.Bounds())
+	}
+
+	svg, appErr := encoder.Encode(content, dto.QRCodeFormatSVG)
+	if appErr != nil {
+		t.Fatalf("unexpected svg error: %+v", appErr)
+	}
+	if !strings.HasPrefix(string(svg), `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 45 45"`) ||
+		!strings.Contains(string(svg), `<path fill="#000" d="M4 4h7v1h-7z`) {
+		t.Fatalf("unexpected svg: %s", svg)
+	}
+
+	if _, appErr := encoder.Encode(content, "gif"); appErr == nil || appErr.Code != "qr_code_format_unsupported" {
+		t.Fatalf("expected qr_code_format_unsupported, got %+v", appErr)
+	}
+	if _, appErr := encoder.Encode(strings.Repeat("a", 2332), dto.QRCodeFormatSVG); appErr == nil || appErr.Code != "qr_code_encode_failed" {
+		t.Fatalf("expected qr_code_encode_failed, got %+v", appErr)
+	}
+}
+
+func assertFinderPattern(t *testing.T, s *symbol, left, top int) {
+	t.Helper()
+	for dy := 0; dy < 7; dy++ {
+		for dx := 0; dx < 7; dx++ {
+			ring := max(abs(dx-3), abs(dy-3))
+			if want := ring != 2; s.modules[top+dy][left+dx] != want {
+				t.Fatalf("finder pattern at (%d,%d) broken at (%d,%d)", left, top, left+dx, top+dy)
+			}
+		}
+	}
+}
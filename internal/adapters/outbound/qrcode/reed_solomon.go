@@ -0,0 +1,59 @@
+package qrcode
+
+// GF(256) arithmetic over the QR code polynomial x^8 + x^4 + x^3 + x^2 + 1.
+var (
+	gfExp [512]byte
+	gfLog [256]byte
+)
+
+func init() {
+	value := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(value)
+		gfLog[value] = byte(i)
+		value <<= 1
+		if value&0x100 != 0 {
+			value ^= 0x11d
+		}
+	}
+	for i := 255; i < len(gfExp); i++ {
+		gfExp[i] = gfExp[i-255]
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+// reedSolomonGenerator returns the monic generator polynomial of the given
+// degree, highest coefficient first.
+func reedSolomonGenerator(degree int) []byte {
+	generator := []byte{1}
+	for i := 0; i < degree; i++ {
+		next := make([]byte, len(generator)+1)
+		for j, coefficient := range generator {
+			next[j] ^= coefficient
+			next[j+1] ^= gfMul(coefficient, gfExp[i])
+		}
+		generator = next
+	}
+	return generator
+}
+
+// reedSolomonRemainder returns the error correction codewords for data.
+func reedSolomonRemainder(data []byte, generator []byte) []byte {
+	degree := len(generator) - 1
+	remainder := make([]byte, degree)
+	for _, value := range data {
+		factor := value ^ remainder[0]
+		copy(remainder, remainder[1:])
+		remainder[degree-1] = 0
+		for i := 0; i < degree; i++ {
+			remainder[i] ^= gfMul(generator[i+1], factor)
+		}
+	}
+	return remainder
+}
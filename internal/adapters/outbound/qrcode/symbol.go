@@ -0,0 +1,440 @@
+package qrcode
+
+import "errors"
+
+// Symbols are always byte mode at error correction level M, which keeps
+// wallet URIs readable from a phone screen while staying small.
+const (
+	minVersion = 1
+	maxVersion = 40
+
+	formatBitsLevelM = 0
+)
+
+var errContentTooLong = errors.New("content does not fit in a QR code")
+
+// Level M error correction codewords per block and block count, by version.
+var (
+	eccCodewordsPerBlock = [maxVersion + 1]int{
+		-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26,
+		26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
+	}
+	eccBlockCount = [maxVersion + 1]int{
+		-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16,
+		17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49,
+	}
+)
+
+// symbol is a square grid of modules; true is dark.
+type symbol struct {
+	size       int
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+// encodeSymbol builds the smallest level M symbol holding content in byte
+// mode, with the mask that scores the lowest penalty.
+func encodeSymbol(content []byte) (*symbol, error) {
+	version, ok := pickVersion(len(content))
+	if !ok {
+		return nil, errContentTooLong
+	}
+
+	codewords := addErrorCorrection(dataCodewords(content, version), version)
+
+	s := newSymbol(version)
+	s.drawFunctionPatterns(version)
+	s.drawCodewords(codewords)
+
+	bestMask, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		s.applyMask(mask)
+		s.drawFormatBits(mask)
+		if penalty := s.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask, bestPenalty = mask, penalty
+		}
+		s.applyMask(mask)
+	}
+	s.applyMask(bestMask)
+	s.drawFormatBits(bestMask)
+
+	return s, nil
+}
+
+func pickVersion(length int) (int, bool) {
+	for version := minVersion; version <= maxVersion; version++ {
+		if 4+characterCountBits(version)+8*length <= 8*numDataCodewords(version) {
+			return version, true
+		}
+	}
+	return 0, false
+}
+
+func characterCountBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+func numRawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		numAlign := version/7 + 2
+		result -= (25*numAlign-10)*numAlign - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+func numDataCodewords(version int) int {
+	return numRawDataModules(version)/8 - eccCodewordsPerBlock[version]*eccBlockCount[version]
+}
+
+func alignmentPatternPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	numAlign := version/7 + 2
+	step := (version*8 + numAlign*3 + 5) / (numAlign*4 - 4) * 2
+	positions := make([]int, numAlign)
+	positions[0] = 6
+	for i, position := numAlign-1, version*4+10; i >= 1; i, position = i-1, position-step {
+		positions[i] = position
+	}
+	return positions
+}
+
+type bitBuffer struct {
+	bits []bool
+}
+
+func (b *bitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		b.bits = append(b.bits, (value>>i)&1 != 0)
+	}
+}
+
+// dataCodewords lays out the byte mode segment, terminator and padding.
+func dataCodewords(content []byte, version int) []byte {
+	capacityBits := 8 * numDataCodewords(version)
+
+	buffer := &bitBuffer{}
+	buffer.append(0b0100, 4)
+	buffer.append(len(content), characterCountBits(version))
+	for _, value := range content {
+		buffer.append(int(value), 8)
+	}
+	buffer.append(0, min(4, capacityBits-len(buffer.bits)))
+	buffer.append(0, (8-len(buffer.bits)%8)%8)
+	for pad := 0xec; len(buffer.bits) < capacityBits; pad ^= 0xec ^ 0x11 {
+		buffer.append(pad, 8)
+	}
+
+	out := make([]byte, len(buffer.bits)/8)
+	for i, bit := range buffer.bits {
+		if bit {
+			out[i/8] |= 1 << (7 - i%8)
+		}
+	}
+	return out
+}
+
+// addErrorCorrection splits data into blocks, appends each block's error
+// correction codewords and interleaves the result.
+func addErrorCorrection(data []byte, version int) []byte {
+	numBlocks := eccBlockCount[version]
+	eccLength := eccCodewordsPerBlock[version]
+	rawCodewords := numRawDataModules(version) / 8
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortDataLength := rawCodewords/numBlocks - eccLength
+	generator := reedSolomonGenerator(eccLength)
+
+	dataBlocks := make([][]byte, 0, numBlocks)
+	eccBlocks := make([][]byte, 0, numBlocks)
+	offset := 0
+	for i := 0; i < numBlocks; i++ {
+		length := shortDataLength
+		if i >= numShortBlocks {
+			length++
+		}
+		block := data[offset : offset+length]
+		offset += length
+		dataBlocks = append(dataBlocks, block)
+		eccBlocks = append(eccBlocks, reedSolomonRemainder(block, generator))
+	}
+
+	out := make([]byte, 0, rawCodewords)
+	for i := 0; i <= shortDataLength; i++ {
+		for _, block := range dataBlocks {
+			if i < len(block) {
+				out = append(out, block[i])
+			}
+		}
+	}
+	for i := 0; i < eccLength; i++ {
+		for _, block := range eccBlocks {
+			out = append(out, block[i])
+		}
+	}
+	return out
+}
+
+func newSymbol(version int) *symbol {
+	size := version*4 + 17
+	s := &symbol{
+		size:       size,
+		modules:    make([][]bool, size),
+		isFunction: make([][]bool, size),
+	}
+	for y := 0; y < size; y++ {
+		s.modules[y] = make([]bool, size)
+		s.isFunction[y] = make([]bool, size)
+	}
+	return s
+}
+
+func (s *symbol) setFunction(x, y int, dark bool) {
+	s.modules[y][x] = dark
+	s.isFunction[y][x] = true
+}
+
+func (s *symbol) drawFunctionPatterns(version int) {
+	for i := 0; i < s.size; i++ {
+		s.setFunction(6, i, i%2 == 0)
+		s.setFunction(i, 6, i%2 == 0)
+	}
+
+	s.drawFinderPattern(3, 3)
+	s.drawFinderPattern(s.size-4, 3)
+	s.drawFinderPattern(3, s.size-4)
+
+	positions := alignmentPatternPositions(version)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			s.drawAlignmentPattern(x, y)
+		}
+	}
+
+	// Reserve the format areas; the real bits are drawn once a mask is chosen.
+	s.drawFormatBits(0)
+	s.drawVersionBits(version)
+}
+
+func (s *symbol) drawFinderPattern(centerX, centerY int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			x, y := centerX+dx, centerY+dy
+			if x < 0 || x >= s.size || y < 0 || y >= s.size {
+				continue
+			}
+			distance := max(abs(dx), abs(dy))
+			s.setFunction(x, y, distance != 2 && distance != 4)
+		}
+	}
+}
+
+func (s *symbol) drawAlignmentPattern(centerX, centerY int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			s.setFunction(centerX+dx, centerY+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// formatBits is the 15-bit BCH protected error correction level and mask.
+func formatBits(mask int) int {
+	data := formatBitsLevelM<<3 | mask
+	remainder := data
+	for i := 0; i < 10; i++ {
+		remainder = remainder<<1 ^ (remainder>>9)*0x537
+	}
+	return (data<<10 | remainder) ^ 0x5412
+}
+
+// versionBits is the 18-bit BCH protected version number.
+func versionBits(version int) int {
+	remainder := version
+	for i := 0; i < 12; i++ {
+		remainder = remainder<<1 ^ (remainder>>11)*0x1f25
+	}
+	return version<<12 | remainder
+}
+
+func (s *symbol) drawFormatBits(mask int) {
+	bits := formatBits(mask)
+	bit := func(i int) bool { return (bits>>i)&1 != 0 }
+
+	for i := 0; i <= 5; i++ {
+		s.setFunction(8, i, bit(i))
+	}
+	s.setFunction(8, 7, bit(6))
+	s.setFunction(8, 8, bit(7))
+	s.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		s.setFunction(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		s.setFunction(s.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		s.setFunction(8, s.size-15+i, bit(i))
+	}
+	s.setFunction(8, s.size-8, true)
+}
+
+func (s *symbol) drawVersionBits(version int) {
+	if version < 7 {
+		return
+	}
+	bits := versionBits(version)
+	for i := 0; i < 18; i++ {
+		dark := (bits>>i)&1 != 0
+		a, b := s.size-11+i%3, i/3
+		s.setFunction(a, b, dark)
+		s.setFunction(b, a, dark)
+	}
+}
+
+// drawCodewords fills the non-function modules in the zigzag order of the
+// spec, two columns at a time from the bottom right.
+func (s *symbol) drawCodewords(codewords []byte) {
+	i := 0
+	for right := s.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vertical := 0; vertical < s.size; vertical++ {
+			y := vertical
+			if upward {
+				y = s.size - 1 - vertical
+			}
+			for j := 0; j < 2; j++ {
+				x := right - j
+				if s.isFunction[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+				s.modules[y][x] = (codewords[i/8]>>(7-i%8))&1 != 0
+				i++
+			}
+		}
+	}
+}
+
+// applyMask flips the data modules selected by mask; applying it twice
+// restores the symbol.
+func (s *symbol) applyMask(mask int) {
+	for y := 0; y < s.size; y++ {
+		for x := 0; x < s.size; x++ {
+			if s.isFunction[y][x] {
+				continue
+			}
+			var flip bool
+			switch mask {
+			case 0:
+				flip = (x+y)%2 == 0
+			case 1:
+				flip = y%2 == 0
+			case 2:
+				flip = x%3 == 0
+			case 3:
+				flip = (x+y)%3 == 0
+			case 4:
+				flip = (x/3+y/2)%2 == 0
+			case 5:
+				flip = x*y%2+x*y%3 == 0
+			case 6:
+				flip = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				flip = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if flip {
+				s.modules[y][x] = !s.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores the symbol with the four mask evaluation rules of the spec.
+func (s *symbol) penalty() int {
+	result := 0
+	dark := 0
+	row := make([]bool, s.size)
+	column := make([]bool, s.size)
+	for i := 0; i < s.size; i++ {
+		for j := 0; j < s.size; j++ {
+			row[j] = s.modules[i][j]
+			column[j] = s.modules[j][i]
+			if s.modules[i][j] {
+				dark++
+			}
+		}
+		result += linePenalty(row) + linePenalty(column)
+	}
+
+	for y := 0; y < s.size-1; y++ {
+		for x := 0; x < s.size-1; x++ {
+			color := s.modules[y][x]
+			if color == s.modules[y][x+1] && color == s.modules[y+1][x] && color == s.modules[y+1][x+1] {
+				result += 3
+			}
+		}
+	}
+
+	total := s.size * s.size
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	return result + k*10
+}
+
+var (
+	finderLikeBefore = []bool{false, false, false, false, true, false, true, true, true, false, true}
+	finderLikeAfter  = []bool{true, false, true, true, true, false, true, false, false, false, false}
+)
+
+// linePenalty covers runs of five or more same-colored modules and
+// finder-like 1:1:3:1:1 patterns next to four light modules.
+func linePenalty(line []bool) int {
+	result := 0
+	run := 1
+	for i := 1; i <= len(line); i++ {
+		if i < len(line) && line[i] == line[i-1] {
+			run++
+			continue
+		}
+		if run >= 5 {
+			result += run - 2
+		}
+		run = 1
+	}
+
+	for i := 0; i+len(finderLikeBefore) <= len(line); i++ {
+		if matchesAt(line, i, finderLikeBefore) || matchesAt(line, i, finderLikeAfter) {
+			result += 40
+		}
+	}
+	return result
+}
+
+func matchesAt(line []bool, offset int, pattern []bool) bool {
+	for i, value := range pattern {
+		if line[offset+i] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// sweepInterval spaces the scans that drop idle buckets.
+const sweepInterval = time.Minute
+
+// BucketStore keeps token buckets in process memory, so each server replica
+// enforces its own limit. Buckets idle long enough to have refilled are
+// dropped, which keeps memory proportional to recently active callers.
+type BucketStore struct {
+	mu        sync.Mutex
+	buckets   map[string]policies.RateLimitBucket
+	lastSweep time.Time
+}
+
+var _ portsout.RateLimitBucketStore = (*BucketStore)(nil)
+
+func NewBucketStore() *BucketStore {
+	return &BucketStore{buckets: map[string]policies.RateLimitBucket{}}
+}
+
+func (s *BucketStore) Take(
+	_ context.Context,
+	key string,
+	policy dto.RateLimitPolicy,
+	now time.Time,
+) (dto.RateLimitPermit, *apperrors.AppError) {
+	limit := policies.RateLimitPolicy{RatePerSecond: policy.RatePerSecond, Burst: policy.Burst}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweepLocked(limit, now)
+	bucket, permit := policies.TakeRateLimitToken(s.buckets[key], limit, now)
+	s.buckets[key] = bucket
+
+	return dto.RateLimitPermit{
+		Allowed:    permit.Allowed,
+		RetryAfter: permit.RetryAfter,
+	}, nil
+}
+
+func (s *BucketStore) sweepLocked(limit policies.RateLimitPolicy, now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	refill := limit.RefillDuration()
+	for key, bucket := range s.buckets {
+		if now.Sub(bucket.UpdatedAt) >= refill {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func (s *BucketStore) size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.buckets)
+}
@@ -0,0 +1,61 @@
+//go:build !integration
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+)
+
+func TestBucketStoreLimitsPerKey(t *testing.T) {
+	store := NewBucketStore()
+	policy := dto.RateLimitPolicy{RatePerSecond: 1, Burst: 2}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		if permit, _ := store.Take(context.Background(), "default/a", policy, now); !permit.Allowed {
+			t.Fatalf("expected burst token %d to be allowed", i+1)
+		}
+	}
+	permit, appErr := store.Take(context.Background(), "default/a", policy, now)
+	if appErr != nil || permit.Allowed || permit.RetryAfter != time.Second {
+		t.Fatalf("expected denial with 1s retry, got %+v err=%+v", permit, appErr)
+	}
+	if permit, _ := store.Take(context.Background(), "default/b", policy, now); !permit.Allowed {
+		t.Fatalf("expected another key to have its own bucket")
+	}
+}
+
+func TestBucketStoreSweepsIdleBuckets(t *testing.T) {
+	store := NewBucketStore()
+	policy := dto.RateLimitPolicy{RatePerSecond: 1, Burst: 2}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	_, _ = store.Take(context.Background(), "default/a", policy, now)
+	_, _ = store.Take(context.Background(), "default/b", policy, now.Add(30*time.Second))
+	if store.size() != 2 {
+		t.Fatalf("expected two buckets, got %d", store.size())
+	}
+
+	_, _ = store.Take(context.Background(), "default/c", policy, now.Add(2*time.Minute))
+	if store.size() != 1 {
+		t.Fatalf("expected idle buckets to be swept, got %d", store.size())
+	}
+}
+
+func TestCountersRecordRefusals(t *testing.T) {
+	since := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	counters := NewCounters(since)
+	counters.Record(dto.PaymentRequestLimitOutcomeRateLimited)
+	counters.Record(dto.PaymentRequestLimitOutcomeRateLimited)
+	counters.Record(dto.PaymentRequestLimitOutcomeQuotaExceeded)
+	counters.Record("unknown")
+
+	snapshot := counters.Snapshot()
+	if snapshot.RateLimited != 2 || snapshot.QuotaExceeded != 1 || !snapshot.Since.Equal(since) {
+		t.Fatalf("unexpected snapshot: %+v", snapshot)
+	}
+}
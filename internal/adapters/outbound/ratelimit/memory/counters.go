@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"sync/atomic"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+)
+
+// Counters counts refused payment request creates since the process started.
+type Counters struct {
+	since         time.Time
+	rateLimited   atomic.Int64
+	quotaExceeded atomic.Int64
+}
+
+var _ portsout.PaymentRequestLimitCounters = (*Counters)(nil)
+
+func NewCounters(now time.Time) *Counters {
+	return &Counters{since: now.UTC()}
+}
+
+func (c *Counters) Record(outcome string) {
+	switch outcome {
+	case dto.PaymentRequestLimitOutcomeRateLimited:
+		c.rateLimited.Add(1)
+	case dto.PaymentRequestLimitOutcomeQuotaExceeded:
+		c.quotaExceeded.Add(1)
+	}
+}
+
+func (c *Counters) Snapshot() dto.PaymentRequestLimitCounters {
+	return dto.PaymentRequestLimitCounters{
+		RateLimited:   c.rateLimited.Load(),
+		QuotaExceeded: c.quotaExceeded.Load(),
+		Since:         c.since,
+	}
+}
@@ -0,0 +1,149 @@
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	nethttp "net/http"
+	"net/http/httptrace"
+	"net/netip"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// ErrDestinationAddressBlocked is returned by the delivery dialer when a
+// destination resolves to an address outside the public internet.
+var ErrDestinationAddressBlocked = errors.New("webhook destination address is not allowed")
+
+// nonPublicPrefixes covers ranges that net/netip does not classify as
+// private, loopback or link-local but that still never belong to a merchant
+// receiver.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// IsPublicAddress reports whether addr is a routable public unicast address.
+func IsPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsUnspecified() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// dialGuard checks the address a connection is actually opened to, after DNS
+// resolution, so a record that changes between validation and delivery can
+// not point a webhook at an internal service.
+type dialGuard struct {
+	allowlist []netip.Prefix
+}
+
+func (g dialGuard) allowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if IsPublicAddress(addr) {
+		return true
+	}
+	for _, prefix := range g.allowlist {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g dialGuard) control(_ string, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrDestinationAddressBlocked, address)
+	}
+	if !g.allowed(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrDestinationAddressBlocked, addrPort.Addr().Unmap())
+	}
+	return nil
+}
+
+// newDeliveryClient builds the client used for one destination profile. It
+// never follows redirects and ignores proxy environment variables, since both
+// would move the connection to an address the guard has not seen.
+func newDeliveryClient(
+	timeout time.Duration,
+	tlsConfig *tls.Config,
+	privateNetworkAllowList []netip.Prefix,
+) *nethttp.Client {
+	guard := dialGuard{allowlist: privateNetworkAllowList}
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   guard.control,
+	}
+	transport := nethttp.DefaultTransport.(*nethttp.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return &nethttp.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*nethttp.Request, []*nethttp.Request) error {
+			return nethttp.ErrUseLastResponse
+		},
+	}
+}
+
+// resolvedAddressTrace records the last address a request connected, or
+// tried to connect, to.
+type resolvedAddressTrace struct {
+	mu      sync.Mutex
+	address string
+}
+
+func (t *resolvedAddressTrace) withContext(ctx context.Context) context.Context {
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		ConnectStart: func(_ string, address string) {
+			t.record(address)
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			if info.Conn != nil {
+				t.record(info.Conn.RemoteAddr().String())
+			}
+		},
+	})
+}
+
+func (t *resolvedAddressTrace) record(address string) {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return
+	}
+	t.mu.Lock()
+	t.address = addrPort.Addr().Unmap().String()
+	t.mu.Unlock()
+}
+
+func (t *resolvedAddressTrace) ip() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.address
+}
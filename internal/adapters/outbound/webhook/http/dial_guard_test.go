@@ -0,0 +1,128 @@
+//go:build !integration
+
+package http
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"chaintx/internal/application/dto"
+)
+
+var loopbackAllowList = []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+
+func TestIsPublicAddress(t *testing.T) {
+	expected := map[string]bool{
+		"203.0.113.24":           true,
+		"8.8.8.8":                true,
+		"2606:4700:4700::1111":   true,
+		"127.0.0.1":              false,
+		"10.1.2.3":               false,
+		"172.16.0.9":             false,
+		"192.168.1.1":            false,
+		"169.254.169.254":        false,
+		"100.64.0.1":             false,
+		"0.0.0.0":                false,
+		"::1":                    false,
+		"fd00::1":                false,
+		"fe80::1":                false,
+		"::ffff:10.0.0.1":        false,
+		"::ffff:169.254.169.254": false,
+		"64:ff9b::a00:1":         false,
+		"224.0.0.1":              false,
+	}
+	for raw, public := range expected {
+		if got := IsPublicAddress(netip.MustParseAddr(raw)); got != public {
+			t.Fatalf("expected IsPublicAddress(%s)=%t, got %t", raw, public, got)
+		}
+	}
+}
+
+func TestSendWebhookEventBlocksNonPublicDestination(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		hits++
+		w.WriteHeader(nethttp.StatusNoContent)
+	}))
+	defer server.Close()
+
+	output, appErr := NewGateway(Config{HMACSecret: "webhook-secret"}).SendWebhookEvent(
+		context.Background(),
+		dto.SendWebhookEventInput{
+			EventID:        "evt_ssrf",
+			EventType:      "payment_request.status_changed",
+			DestinationURL: server.URL,
+			Payload:        []byte(`{"event_id":"evt_ssrf"}`),
+		},
+	)
+	if appErr == nil {
+		t.Fatalf("expected loopback destination to be blocked")
+	}
+	if appErr.Code != "webhook_destination_blocked" {
+		t.Fatalf("expected webhook_destination_blocked, got %s", appErr.Code)
+	}
+	if output.ResolvedIP != "127.0.0.1" {
+		t.Fatalf("expected resolved ip 127.0.0.1, got %q", output.ResolvedIP)
+	}
+	if hits != 0 {
+		t.Fatalf("expected no request to reach the receiver, got %d", hits)
+	}
+}
+
+func TestSendWebhookEventDoesNotFollowRedirects(t *testing.T) {
+	internalHits := 0
+	internal := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		internalHits++
+		w.WriteHeader(nethttp.StatusNoContent)
+	}))
+	defer internal.Close()
+	redirecting := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		nethttp.Redirect(w, r, internal.URL, nethttp.StatusTemporaryRedirect)
+	}))
+	defer redirecting.Close()
+
+	output, appErr := NewGateway(Config{
+		HMACSecret:              "webhook-secret",
+		PrivateNetworkAllowList: loopbackAllowList,
+	}).SendWebhookEvent(context.Background(), dto.SendWebhookEventInput{
+		EventID:        "evt_redirect",
+		EventType:      "payment_request.status_changed",
+		DestinationURL: redirecting.URL,
+		Payload:        []byte(`{"event_id":"evt_redirect"}`),
+	})
+	if appErr == nil || appErr.Code != "webhook_delivery_failed" {
+		t.Fatalf("expected redirect to fail delivery, got %+v", appErr)
+	}
+	if output.StatusCode != nethttp.StatusTemporaryRedirect {
+		t.Fatalf("expected status 307, got %d", output.StatusCode)
+	}
+	if internalHits != 0 {
+		t.Fatalf("expected redirect target not to be called, got %d", internalHits)
+	}
+}
+
+func TestSendWebhookEventReportsResolvedIP(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusNoContent)
+	}))
+	defer server.Close()
+
+	output, appErr := NewGateway(Config{
+		HMACSecret:              "webhook-secret",
+		PrivateNetworkAllowList: loopbackAllowList,
+	}).SendWebhookEvent(context.Background(), dto.SendWebhookEventInput{
+		EventID:        "evt_ip",
+		EventType:      "payment_request.status_changed",
+		DestinationURL: server.URL,
+		Payload:        []byte(`{"event_id":"evt_ip"}`),
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.ResolvedIP != "127.0.0.1" {
+		t.Fatalf("expected resolved ip 127.0.0.1, got %q", output.ResolvedIP)
+	}
+}
@@ -0,0 +1,348 @@
+package http
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	nethttp "net/http"
+	"net/netip"
+	"net/textproto"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	valueobjects "chaintx/internal/domain/value_objects"
+)
+
+const defaultEndpointReloadInterval = 5 * time.Second
+
+// reservedHeaders are owned by the gateway; an endpoint entry can never
+// override signing, idempotency or framing headers.
+var reservedHeaders = map[string]struct{}{
+	"Connection":        {},
+	"Content-Length":    {},
+	"Content-Type":      {},
+	"Host":              {},
+	"Idempotency-Key":   {},
+	"Transfer-Encoding": {},
+}
+
+// IsReservedHeader reports whether a header name is set by the gateway itself.
+func IsReservedHeader(name string) bool {
+	canonical := textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(name))
+	if strings.HasPrefix(canonical, "X-Chaintx-") {
+		return true
+	}
+	_, reserved := reservedHeaders[canonical]
+	return reserved
+}
+
+type endpointsFile struct {
+	Endpoints []endpointFileEntry `json:"endpoints"`
+}
+
+type endpointFileEntry struct {
+	Host    string            `json:"host"`
+	TLS     *endpointFileTLS  `json:"tls,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type endpointFileTLS struct {
+	ClientCertFile string `json:"client_cert_file"`
+	ClientKeyFile  string `json:"client_key_file"`
+	CAFile         string `json:"ca_file"`
+}
+
+type endpointProfile struct {
+	client  *nethttp.Client
+	headers map[string]string
+}
+
+type endpointSnapshot struct {
+	profiles    map[string]endpointProfile
+	fingerprint map[string]time.Time
+}
+
+type EndpointRegistryConfig struct {
+	Path            string
+	HeaderAllowList []string
+	Timeout         time.Duration
+	ReloadInterval  time.Duration
+	Logger          *log.Logger
+	// PrivateNetworkAllowList must match the gateway setting so endpoint
+	// clients apply the same destination address guard.
+	PrivateNetworkAllowList []netip.Prefix
+}
+
+// EndpointRegistry serves per-destination delivery settings from a JSON file.
+// The file and every certificate it references are re-checked at most once
+// per reload interval; a broken edit keeps the last good snapshot in place.
+type EndpointRegistry struct {
+	path           string
+	allowlist      map[string]struct{}
+	timeout        time.Duration
+	reloadInterval time.Duration
+	privateNetwork []netip.Prefix
+	logger         *log.Logger
+	now            func() time.Time
+
+	mu        sync.Mutex
+	snapshot  endpointSnapshot
+	checkedAt time.Time
+}
+
+// NewEndpointRegistry loads the endpoints file once so a broken file fails
+// startup instead of the first delivery.
+func NewEndpointRegistry(cfg EndpointRegistryConfig) (*EndpointRegistry, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultHTTPTimeout
+	}
+	reloadInterval := cfg.ReloadInterval
+	if reloadInterval <= 0 {
+		reloadInterval = defaultEndpointReloadInterval
+	}
+	allowlist := make(map[string]struct{}, len(cfg.HeaderAllowList))
+	for _, name := range cfg.HeaderAllowList {
+		if IsReservedHeader(name) {
+			return nil, fmt.Errorf("custom header allowlist must not contain reserved header %s", name)
+		}
+		allowlist[textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(name))] = struct{}{}
+	}
+
+	registry := &EndpointRegistry{
+		path:           strings.TrimSpace(cfg.Path),
+		allowlist:      allowlist,
+		timeout:        timeout,
+		reloadInterval: reloadInterval,
+		privateNetwork: cfg.PrivateNetworkAllowList,
+		logger:         cfg.Logger,
+		now:            time.Now,
+	}
+	if registry.path == "" {
+		return nil, errors.New("webhook endpoints file path is required")
+	}
+
+	snapshot, err := registry.load()
+	if err != nil {
+		return nil, err
+	}
+	registry.snapshot = snapshot
+	registry.checkedAt = registry.now()
+	return registry, nil
+}
+
+// resolve returns the profile for a destination host, preferring an exact
+// host entry over the longest matching wildcard entry.
+func (r *EndpointRegistry) resolve(host string) (endpointProfile, bool) {
+	if r == nil {
+		return endpointProfile{}, false
+	}
+	snapshot := r.current()
+
+	normalizedHost := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+	if normalizedHost == "" {
+		return endpointProfile{}, false
+	}
+	if profile, exists := snapshot.profiles[normalizedHost]; exists {
+		return profile, true
+	}
+
+	bestPattern := ""
+	for pattern := range snapshot.profiles {
+		if !strings.HasPrefix(pattern, "*.") || len(pattern) <= len(bestPattern) {
+			continue
+		}
+		if valueobjects.IsWebhookHostAllowed(normalizedHost, []string{pattern}) {
+			bestPattern = pattern
+		}
+	}
+	if bestPattern == "" {
+		return endpointProfile{}, false
+	}
+	return snapshot.profiles[bestPattern], true
+}
+
+func (r *EndpointRegistry) current() endpointSnapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if now.Sub(r.checkedAt) < r.reloadInterval {
+		return r.snapshot
+	}
+	r.checkedAt = now
+
+	if !r.changed() {
+		return r.snapshot
+	}
+
+	next, err := r.load()
+	if err != nil {
+		r.logf("webhook endpoints reload failed path=%s error=%v", r.path, err)
+		return r.snapshot
+	}
+	previous := r.snapshot
+	r.snapshot = next
+	closeIdleConnections(previous)
+	r.logf("webhook endpoints reloaded path=%s endpoints=%d", r.path, len(next.profiles))
+	return r.snapshot
+}
+
+func (r *EndpointRegistry) changed() bool {
+	for path, modTime := range r.snapshot.fingerprint {
+		info, err := os.Stat(path)
+		if err != nil || !info.ModTime().Equal(modTime) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *EndpointRegistry) load() (endpointSnapshot, error) {
+	fingerprint := map[string]time.Time{}
+	raw, err := readTrackedFile(r.path, fingerprint)
+	if err != nil {
+		return endpointSnapshot{}, err
+	}
+
+	decoded := endpointsFile{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&decoded); err != nil {
+		return endpointSnapshot{}, fmt.Errorf("parse webhook endpoints file: %w", err)
+	}
+
+	profiles := make(map[string]endpointProfile, len(decoded.Endpoints))
+	for index, entry := range decoded.Endpoints {
+		pattern, appErr := valueobjects.NormalizeWebhookHostPattern(entry.Host)
+		if appErr != nil {
+			return endpointSnapshot{}, fmt.Errorf("webhook endpoints[%d]: %s", index, appErr.Message)
+		}
+		if _, exists := profiles[pattern]; exists {
+			return endpointSnapshot{}, fmt.Errorf("webhook endpoints[%d]: duplicate host %s", index, pattern)
+		}
+
+		headers, err := r.validateHeaders(entry.Headers)
+		if err != nil {
+			return endpointSnapshot{}, fmt.Errorf("webhook endpoints[%d]: %w", index, err)
+		}
+		tlsConfig, err := buildEndpointTLSConfig(entry.TLS, fingerprint)
+		if err != nil {
+			return endpointSnapshot{}, fmt.Errorf("webhook endpoints[%d]: %w", index, err)
+		}
+
+		profiles[pattern] = endpointProfile{
+			client:  newDeliveryClient(r.timeout, tlsConfig, r.privateNetwork),
+			headers: headers,
+		}
+	}
+
+	return endpointSnapshot{profiles: profiles, fingerprint: fingerprint}, nil
+}
+
+func (r *EndpointRegistry) validateHeaders(raw map[string]string) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(raw))
+	for name := range raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	headers := make(map[string]string, len(raw))
+	for _, name := range names {
+		canonical := textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(name))
+		if IsReservedHeader(canonical) {
+			return nil, fmt.Errorf("header %s is reserved", canonical)
+		}
+		if _, allowed := r.allowlist[canonical]; !allowed {
+			return nil, fmt.Errorf("header %s is not in the custom header allowlist", canonical)
+		}
+		value := strings.TrimSpace(raw[name])
+		if value == "" || strings.ContainsAny(value, "\r\n") {
+			return nil, fmt.Errorf("header %s value is invalid", canonical)
+		}
+		headers[canonical] = value
+	}
+	return headers, nil
+}
+
+func buildEndpointTLSConfig(raw *endpointFileTLS, fingerprint map[string]time.Time) (*tls.Config, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	certFile := strings.TrimSpace(raw.ClientCertFile)
+	keyFile := strings.TrimSpace(raw.ClientKeyFile)
+	caFile := strings.TrimSpace(raw.CAFile)
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("tls client_cert_file and client_key_file must be set together")
+	}
+	if certFile == "" && caFile == "" {
+		return nil, errors.New("tls requires a client certificate or ca_file")
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if certFile != "" {
+		certPEM, err := readTrackedFile(certFile, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		keyPEM, err := readTrackedFile(keyFile, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("load tls client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	if caFile != "" {
+		caPEM, err := readTrackedFile(caFile, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("tls ca_file %s contains no certificates", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+func readTrackedFile(path string, fingerprint map[string]time.Time) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("stat %s: %w", path, err)
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	fingerprint[path] = info.ModTime()
+	return raw, nil
+}
+
+func closeIdleConnections(snapshot endpointSnapshot) {
+	for _, profile := range snapshot.profiles {
+		profile.client.CloseIdleConnections()
+	}
+}
+
+func (r *EndpointRegistry) logf(format string, args ...any) {
+	if r.logger == nil {
+		return
+	}
+	r.logger.Printf(format, args...)
+}
@@ -0,0 +1,240 @@
+//go:build !integration
+
+package http
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+)
+
+func TestSendWebhookEventUsesEndpointClientCertificateAndHeaders(t *testing.T) {
+	dir := t.TempDir()
+	clientCertPEM, clientKeyPEM, clientCert := generateClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server := httptest.NewUnstartedServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer receiver-token" {
+			t.Fatalf("expected custom authorization header, got %q", got)
+		}
+		if got := r.Header.Get("X-ChainTx-Event-Id"); got != "evt_mtls" {
+			t.Fatalf("expected event id header evt_mtls, got %q", got)
+		}
+		w.WriteHeader(nethttp.StatusNoContent)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := writeTestFile(t, dir, "ca.pem", pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}))
+	certFile := writeTestFile(t, dir, "client.pem", clientCertPEM)
+	keyFile := writeTestFile(t, dir, "client-key.pem", clientKeyPEM)
+	endpointsFile := writeTestFile(t, dir, "endpoints.json", []byte(`{"endpoints":[{
+		"host":"127.0.0.1",
+		"tls":{"client_cert_file":"`+certFile+`","client_key_file":"`+keyFile+`","ca_file":"`+caFile+`"},
+		"headers":{"authorization":"Bearer receiver-token"}
+	}]}`))
+
+	input := dto.SendWebhookEventInput{
+		EventID:        "evt_mtls",
+		EventType:      "payment_request.status_changed",
+		DestinationURL: server.URL,
+		Payload:        []byte(`{"event_id":"evt_mtls"}`),
+	}
+	_, appErr := NewGateway(Config{
+		HMACSecret:              "webhook-secret",
+		PrivateNetworkAllowList: loopbackAllowList,
+	}).SendWebhookEvent(context.Background(), input)
+	if appErr == nil {
+		t.Fatalf("expected delivery without endpoint settings to fail tls verification")
+	}
+
+	registry, err := NewEndpointRegistry(EndpointRegistryConfig{
+		Path:                    endpointsFile,
+		HeaderAllowList:         []string{"Authorization"},
+		PrivateNetworkAllowList: loopbackAllowList,
+	})
+	if err != nil {
+		t.Fatalf("expected registry to load, got %v", err)
+	}
+	output, appErr := NewGateway(Config{
+		HMACSecret:              "webhook-secret",
+		Endpoints:               registry,
+		PrivateNetworkAllowList: loopbackAllowList,
+	}).SendWebhookEvent(context.Background(), input)
+	if appErr != nil {
+		t.Fatalf("expected delivery to succeed, got %+v", appErr)
+	}
+	if output.StatusCode != nethttp.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", output.StatusCode)
+	}
+}
+
+func TestNewEndpointRegistryRejectsInvalidEntries(t *testing.T) {
+	dir := t.TempDir()
+	cases := map[string]string{
+		"reserved header":    `{"endpoints":[{"host":"hooks.example.com","headers":{"X-ChainTx-Signature":"forged"}}]}`,
+		"header not allowed": `{"endpoints":[{"host":"hooks.example.com","headers":{"Cookie":"session=1"}}]}`,
+		"header newline":     `{"endpoints":[{"host":"hooks.example.com","headers":{"Authorization":"a\r\nb"}}]}`,
+		"duplicate host":     `{"endpoints":[{"host":"hooks.example.com"},{"host":"HOOKS.example.com."}]}`,
+		"invalid host":       `{"endpoints":[{"host":"https://hooks.example.com"}]}`,
+		"cert without key":   `{"endpoints":[{"host":"hooks.example.com","tls":{"client_cert_file":"/tmp/cert.pem"}}]}`,
+		"missing ca file":    `{"endpoints":[{"host":"hooks.example.com","tls":{"ca_file":"` + filepath.Join(dir, "missing.pem") + `"}}]}`,
+		"unknown field":      `{"endpoints":[{"host":"hooks.example.com","timeout":3}]}`,
+	}
+	for name, raw := range cases {
+		path := writeTestFile(t, dir, strings.ReplaceAll(name, " ", "_")+".json", []byte(raw))
+		if _, err := NewEndpointRegistry(EndpointRegistryConfig{
+			Path:            path,
+			HeaderAllowList: []string{"Authorization", "X-Api-Key"},
+		}); err == nil {
+			t.Fatalf("%s: expected registry load error", name)
+		}
+	}
+
+	path := writeTestFile(t, dir, "valid.json", []byte(`{"endpoints":[]}`))
+	if _, err := NewEndpointRegistry(EndpointRegistryConfig{
+		Path:            path,
+		HeaderAllowList: []string{"Idempotency-Key"},
+	}); err == nil {
+		t.Fatalf("expected reserved allowlist entry to be rejected")
+	}
+}
+
+func TestEndpointRegistryResolvesExactHostBeforeLongestWildcard(t *testing.T) {
+	path := writeTestFile(t, t.TempDir(), "endpoints.json", []byte(`{"endpoints":[
+		{"host":"*.example.com","headers":{"X-Api-Key":"broad"}},
+		{"host":"*.hooks.example.com","headers":{"X-Api-Key":"narrow"}},
+		{"host":"a.hooks.example.com","headers":{"X-Api-Key":"exact"}}
+	]}`))
+	registry, err := NewEndpointRegistry(EndpointRegistryConfig{
+		Path:            path,
+		HeaderAllowList: []string{"X-Api-Key"},
+	})
+	if err != nil {
+		t.Fatalf("expected registry to load, got %v", err)
+	}
+
+	expected := map[string]string{
+		"A.hooks.example.com.": "exact",
+		"b.hooks.example.com":  "narrow",
+		"api.example.com":      "broad",
+	}
+	for host, value := range expected {
+		profile, exists := registry.resolve(host)
+		if !exists || profile.headers["X-Api-Key"] != value {
+			t.Fatalf("expected %s to resolve to %s, got %+v", host, value, profile.headers)
+		}
+	}
+	if _, exists := registry.resolve("example.com"); exists {
+		t.Fatalf("expected wildcard not to match apex host")
+	}
+}
+
+func TestEndpointRegistryReloadsChangedFileAndKeepsLastGoodOnError(t *testing.T) {
+	path := writeTestFile(t, t.TempDir(), "endpoints.json", []byte(
+		`{"endpoints":[{"host":"hooks.example.com","headers":{"X-Api-Key":"first"}}]}`,
+	))
+	registry, err := NewEndpointRegistry(EndpointRegistryConfig{
+		Path:            path,
+		HeaderAllowList: []string{"X-Api-Key"},
+		ReloadInterval:  time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("expected registry to load, got %v", err)
+	}
+	now := time.Now()
+	registry.now = func() time.Time { return now }
+	registry.checkedAt = now
+
+	rewriteTestFile(t, path, `{"endpoints":[{"host":"hooks.example.com","headers":{"X-Api-Key":"second"}}]}`, 1)
+	if profile, _ := registry.resolve("hooks.example.com"); profile.headers["X-Api-Key"] != "first" {
+		t.Fatalf("expected reload to wait for the interval, got %s", profile.headers["X-Api-Key"])
+	}
+
+	now = now.Add(time.Minute)
+	if profile, _ := registry.resolve("hooks.example.com"); profile.headers["X-Api-Key"] != "second" {
+		t.Fatalf("expected reloaded header, got %s", profile.headers["X-Api-Key"])
+	}
+
+	rewriteTestFile(t, path, `{"endpoints":[`, 2)
+	now = now.Add(time.Minute)
+	if profile, _ := registry.resolve("hooks.example.com"); profile.headers["X-Api-Key"] != "second" {
+		t.Fatalf("expected last good snapshot after broken edit, got %s", profile.headers["X-Api-Key"])
+	}
+}
+
+func writeTestFile(t *testing.T, dir string, name string, raw []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+	return path
+}
+
+func rewriteTestFile(t *testing.T, path string, raw string, generation int) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(raw), 0o600); err != nil {
+		t.Fatalf("rewrite %s: %v", path, err)
+	}
+	modTime := time.Now().Add(time.Duration(generation) * time.Hour)
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("touch %s: %v", path, err)
+	}
+}
+
+func generateClientCertificate(t *testing.T) ([]byte, []byte, *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "chaintx-webhook-client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(cryptorand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		cert
+}
@@ -7,9 +7,11 @@ import (
 	cryptorand "crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	nethttp "net/http"
+	"net/netip"
 	"strconv"
 	"strings"
 	"time"
@@ -20,20 +22,29 @@ import (
 )
 
 const (
-	defaultHTTPTimeout = 5 * time.Second
-	maxErrorBodyBytes  = 1024
-	signatureVersionV1 = "v1"
-	nonceByteLength    = 16
+	defaultHTTPTimeout  = 5 * time.Second
+	maxBodyPreviewBytes = 1024
+	signatureVersionV1  = "v1"
+	nonceByteLength     = 16
 )
 
 type Config struct {
 	HMACSecret string
-	Timeout    time.Duration
+	// MerchantHMACSecrets signs deliveries of the listed merchants with their
+	// own secret; other merchants use HMACSecret.
+	MerchantHMACSecrets map[string]string
+	Timeout             time.Duration
+	Endpoints           *EndpointRegistry
+	// PrivateNetworkAllowList lists non-public ranges deliveries may still
+	// connect to, such as a local receiver in development.
+	PrivateNetworkAllowList []netip.Prefix
 }
 
 type Gateway struct {
-	hmacSecret string
-	client     *nethttp.Client
+	hmacSecret      string
+	merchantSecrets map[string]string
+	client          *nethttp.Client
+	endpoints       *EndpointRegistry
 }
 
 var _ portsout.WebhookEventGateway = (*Gateway)(nil)
@@ -44,14 +55,28 @@ func NewGateway(cfg Config) *Gateway {
 		timeout = defaultHTTPTimeout
 	}
 
+	merchantSecrets := map[string]string{}
+	for merchantID, secret := range cfg.MerchantHMACSecrets {
+		if trimmed := strings.TrimSpace(secret); trimmed != "" {
+			merchantSecrets[strings.TrimSpace(merchantID)] = trimmed
+		}
+	}
+
 	return &Gateway{
-		hmacSecret: strings.TrimSpace(cfg.HMACSecret),
-		client: &nethttp.Client{
-			Timeout: timeout,
-		},
+		hmacSecret:      strings.TrimSpace(cfg.HMACSecret),
+		merchantSecrets: merchantSecrets,
+		client:          newDeliveryClient(timeout, nil, cfg.PrivateNetworkAllowList),
+		endpoints:       cfg.Endpoints,
 	}
 }
 
+func (g *Gateway) signingSecret(merchantID string) string {
+	if secret, exists := g.merchantSecrets[strings.TrimSpace(merchantID)]; exists {
+		return secret
+	}
+	return g.hmacSecret
+}
+
 func (g *Gateway) SendWebhookEvent(
 	ctx context.Context,
 	input dto.SendWebhookEventInput,
@@ -63,7 +88,8 @@ func (g *Gateway) SendWebhookEvent(
 			nil,
 		)
 	}
-	if g.hmacSecret == "" {
+	hmacSecret := g.signingSecret(input.MerchantID)
+	if hmacSecret == "" {
 		return dto.SendWebhookEventOutput{}, apperrors.NewInternal(
 			"webhook_hmac_secret_missing",
 			"webhook hmac secret is missing",
@@ -113,14 +139,20 @@ func (g *Gateway) SendWebhookEvent(
 			map[string]any{"error": nonceErr.Error()},
 		)
 	}
-	legacySignature := webhookLegacySignature(g.hmacSecret, timestamp, body)
-	signatureV1 := webhookV1Signature(g.hmacSecret, timestamp, nonce, eventID, eventType, body)
+	legacySignature := webhookLegacySignature(hmacSecret, timestamp, body)
+	signatureV1 := webhookV1Signature(hmacSecret, timestamp, nonce, eventID, eventType, body)
 	deliveryAttempt := input.DeliveryAttempt
 	if deliveryAttempt <= 0 {
 		deliveryAttempt = 1
 	}
 
-	request, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, destinationURL, bytes.NewReader(body))
+	trace := &resolvedAddressTrace{}
+	request, err := nethttp.NewRequestWithContext(
+		trace.withContext(ctx),
+		nethttp.MethodPost,
+		destinationURL,
+		bytes.NewReader(body),
+	)
 	if err != nil {
 		return dto.SendWebhookEventOutput{}, apperrors.NewInternal(
 			"webhook_request_build_failed",
@@ -128,6 +160,13 @@ func (g *Gateway) SendWebhookEvent(
 			map[string]any{"error": err.Error()},
 		)
 	}
+	client := g.client
+	if profile, exists := g.endpoints.resolve(request.URL.Hostname()); exists {
+		client = profile.client
+		for name, value := range profile.headers {
+			request.Header.Set(name, value)
+		}
+	}
 	request.Header.Set("Content-Type", "application/json")
 	request.Header.Set("X-ChainTx-Event-Id", eventID)
 	request.Header.Set("Idempotency-Key", eventID)
@@ -139,33 +178,50 @@ func (g *Gateway) SendWebhookEvent(
 	request.Header.Set("X-ChainTx-Signature-V1", "sha256="+signatureV1)
 	request.Header.Set("X-ChainTx-Signature", "sha256="+legacySignature)
 
-	response, err := g.client.Do(request)
+	response, err := client.Do(request)
 	if err != nil {
-		return dto.SendWebhookEventOutput{}, apperrors.NewInternal(
+		output := dto.SendWebhookEventOutput{ResolvedIP: trace.ip()}
+		if errors.Is(err, ErrDestinationAddressBlocked) {
+			return output, apperrors.NewInternal(
+				"webhook_destination_blocked",
+				"webhook destination resolves to a non-public address",
+				map[string]any{"resolved_ip": output.ResolvedIP},
+			)
+		}
+		return output, apperrors.NewInternal(
 			"webhook_delivery_failed",
 			"failed to send webhook request",
-			map[string]any{"error": err.Error()},
+			map[string]any{"error": err.Error(), "resolved_ip": output.ResolvedIP},
 		)
 	}
 	defer response.Body.Close()
 
+	output := dto.SendWebhookEventOutput{
+		StatusCode:          response.StatusCode,
+		ResponseBodyPreview: readBodyPreview(response.Body),
+		ResolvedIP:          trace.ip(),
+	}
 	if response.StatusCode < 200 || response.StatusCode > 299 {
-		bodyPreview := ""
-		raw, readErr := io.ReadAll(io.LimitReader(response.Body, maxErrorBodyBytes))
-		if readErr == nil {
-			bodyPreview = strings.TrimSpace(string(raw))
-		}
-		return dto.SendWebhookEventOutput{StatusCode: response.StatusCode}, apperrors.NewInternal(
+		return output, apperrors.NewInternal(
 			"webhook_delivery_failed",
 			"webhook endpoint returned non-2xx status",
 			map[string]any{
 				"status_code": response.StatusCode,
-				"body":        bodyPreview,
+				"body":        output.ResponseBodyPreview,
+				"resolved_ip": output.ResolvedIP,
 			},
 		)
 	}
 
-	return dto.SendWebhookEventOutput{StatusCode: response.StatusCode}, nil
+	return output, nil
+}
+
+func readBodyPreview(body io.Reader) string {
+	raw, err := io.ReadAll(io.LimitReader(body, maxBodyPreviewBytes))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(raw))
 }
 
 func webhookNonce() (string, error) {
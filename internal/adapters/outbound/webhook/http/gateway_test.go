@@ -68,7 +68,8 @@ func TestSendWebhookEventSuccess(t *testing.T) {
 	defer server.Close()
 
 	gateway := NewGateway(Config{
-		HMACSecret: secret,
+		HMACSecret:              secret,
+		PrivateNetworkAllowList: loopbackAllowList,
 	})
 	output, appErr := gateway.SendWebhookEvent(context.Background(), dto.SendWebhookEventInput{
 		EventID:         "evt_1",
@@ -85,6 +86,45 @@ func TestSendWebhookEventSuccess(t *testing.T) {
 	}
 }
 
+func TestSendWebhookEventSignsWithMerchantSecret(t *testing.T) {
+	payload := []byte(`{"event_id":"evt_m","event_type":"payment_request.created"}`)
+	expectedSecret := ""
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("failed to read request body: %v", err)
+		}
+		timestamp := r.Header.Get("X-ChainTx-Timestamp")
+		if got := r.Header.Get("X-ChainTx-Signature"); got != BuildExpectedSignatureHeader(expectedSecret, timestamp, body) {
+			t.Fatalf("expected delivery signed with %q, got %s", expectedSecret, got)
+		}
+		w.WriteHeader(nethttp.StatusNoContent)
+	}))
+	defer server.Close()
+
+	gateway := NewGateway(Config{
+		HMACSecret:              "global-secret",
+		MerchantHMACSecrets:     map[string]string{"retail": "retail-secret"},
+		PrivateNetworkAllowList: loopbackAllowList,
+	})
+	for merchantID, secret := range map[string]string{
+		"retail":    "retail-secret",
+		"default":   "global-secret",
+		"wholesale": "global-secret",
+	} {
+		expectedSecret = secret
+		if _, appErr := gateway.SendWebhookEvent(context.Background(), dto.SendWebhookEventInput{
+			MerchantID:     merchantID,
+			EventID:        "evt_m",
+			EventType:      "payment_request.created",
+			DestinationURL: server.URL,
+			Payload:        payload,
+		}); appErr != nil {
+			t.Fatalf("merchant %s: expected no error, got %+v", merchantID, appErr)
+		}
+	}
+}
+
 func TestSendWebhookEventNon2xxReturnsError(t *testing.T) {
 	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
 		w.WriteHeader(nethttp.StatusBadGateway)
@@ -93,7 +133,8 @@ func TestSendWebhookEventNon2xxReturnsError(t *testing.T) {
 	defer server.Close()
 
 	gateway := NewGateway(Config{
-		HMACSecret: "webhook-secret",
+		HMACSecret:              "webhook-secret",
+		PrivateNetworkAllowList: loopbackAllowList,
 	})
 	output, appErr := gateway.SendWebhookEvent(context.Background(), dto.SendWebhookEventInput{
 		EventID:        "evt_2",
@@ -110,6 +151,34 @@ func TestSendWebhookEventNon2xxReturnsError(t *testing.T) {
 	if output.StatusCode != nethttp.StatusBadGateway {
 		t.Fatalf("expected status %d, got %d", nethttp.StatusBadGateway, output.StatusCode)
 	}
+	if output.ResponseBodyPreview != "upstream unavailable" {
+		t.Fatalf("expected response preview, got %q", output.ResponseBodyPreview)
+	}
+}
+
+func TestSendWebhookEventTruncatesResponsePreview(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusOK)
+		_, _ = w.Write([]byte(strings.Repeat("a", maxBodyPreviewBytes+100)))
+	}))
+	defer server.Close()
+
+	gateway := NewGateway(Config{
+		HMACSecret:              "webhook-secret",
+		PrivateNetworkAllowList: loopbackAllowList,
+	})
+	output, appErr := gateway.SendWebhookEvent(context.Background(), dto.SendWebhookEventInput{
+		EventID:        "evt_3",
+		EventType:      "ping",
+		DestinationURL: server.URL,
+		Payload:        []byte(`{"event_id":"evt_3"}`),
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if len(output.ResponseBodyPreview) != maxBodyPreviewBytes {
+		t.Fatalf("expected preview of %d bytes, got %d", maxBodyPreviewBytes, len(output.ResponseBodyPreview))
+	}
 }
 
 func TestSendWebhookEventRequiresDestinationURL(t *testing.T) {
@@ -139,7 +208,8 @@ func TestSendWebhookEventUsesDefaultAttemptHeader(t *testing.T) {
 	defer server.Close()
 
 	gateway := NewGateway(Config{
-		HMACSecret: "webhook-secret",
+		HMACSecret:              "webhook-secret",
+		PrivateNetworkAllowList: loopbackAllowList,
 	})
 	_, appErr := gateway.SendWebhookEvent(context.Background(), dto.SendWebhookEventInput{
 		EventID:        "evt_4",
@@ -0,0 +1,35 @@
+package dto
+
+import "time"
+
+// AccessTokenClaims are the claims of a bearer JWT whose signature, expiry,
+// issuer and audience have been verified.
+type AccessTokenClaims struct {
+	Subject string
+	Claims  map[string]any
+}
+
+// AccessTokenClaimMapping names the claims that carry the principal, the
+// merchant (tenant), the merchant API scopes and the ops role.
+type AccessTokenClaimMapping struct {
+	Principal string
+	Merchant  string
+	Scopes    string
+	OpsRole   string
+}
+
+type AuthenticateAccessTokenCommand struct {
+	Token string
+	Now   time.Time
+}
+
+// AccessTokenPrincipal is a verified token mapped onto the service's own
+// identities. Scopes hold only merchant API scopes and OpsRole is empty when
+// the token carries no ops role. MerchantID is empty only for an ops-only
+// token, which then has no merchant scopes.
+type AccessTokenPrincipal struct {
+	PrincipalID string
+	MerchantID  string
+	Scopes      []string
+	OpsRole     string
+}
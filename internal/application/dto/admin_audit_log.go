@@ -0,0 +1,52 @@
+package dto
+
+import "time"
+
+const (
+	AdminAuditOutcomeSucceeded = "succeeded"
+	AdminAuditOutcomeFailed    = "failed"
+	AdminAuditOutcomeForbidden = "forbidden"
+)
+
+// AdminAuditEntry records one ops action: who performed it with which role,
+// what it targeted, why, and from which address.
+type AdminAuditEntry struct {
+	ID         int64          `json:"id"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	ActorID    string         `json:"actor_id"`
+	ActorRole  string         `json:"actor_role"`
+	Action     string         `json:"action"`
+	Target     *string        `json:"target,omitempty"`
+	Reason     *string        `json:"reason,omitempty"`
+	SourceIP   *string        `json:"source_ip,omitempty"`
+	Outcome    string         `json:"outcome"`
+	ErrorCode  *string        `json:"error_code,omitempty"`
+	Details    map[string]any `json:"details"`
+}
+
+type RecordAdminAuditEntryCommand struct {
+	ActorID   string
+	ActorRole string
+	Action    string
+	Target    string
+	Reason    string
+	SourceIP  string
+	Outcome   string
+	ErrorCode string
+	Details   map[string]any
+	Now       time.Time
+}
+
+// ListAdminAuditEntriesQuery pages newest first; BeforeID is the id of the
+// last entry of the previous page.
+type ListAdminAuditEntriesQuery struct {
+	ActorID  string
+	Action   string
+	BeforeID int64
+	Limit    int
+}
+
+type ListAdminAuditEntriesOutput struct {
+	Entries      []AdminAuditEntry `json:"entries"`
+	NextBeforeID *int64            `json:"next_before_id,omitempty"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+// AlertNotification is one triggered, ongoing or resolved alert event routed
+// to a notification channel. Source names the monitor that raised it; Scope,
+// when set, narrows the signal to one entity such as a chain network.
+type AlertNotification struct {
+	Source     string
+	Signal     string
+	Scope      string
+	State      string
+	Severity   string
+	Current    int64
+	Threshold  int64
+	OccurredAt time.Time
+}
@@ -1,6 +1,8 @@
 package dto
 
-type ListAssetsQuery struct{}
+type ListAssetsQuery struct {
+	MerchantID string
+}
 
 type ListAssetsOutput struct {
 	Assets []AssetCatalogEntry `json:"assets"`
@@ -0,0 +1,70 @@
+package dto
+
+import "time"
+
+// MerchantPrincipal is the caller resolved from a merchant API key. The zero
+// value is an unauthenticated caller, which only legacy deployments accept and
+// which acts on behalf of the default merchant.
+type MerchantPrincipal struct {
+	MerchantID  string
+	PrincipalID string
+	KeyID       string
+	Scopes      []string
+}
+
+type MerchantAPIKey struct {
+	ID          string     `json:"id"`
+	MerchantID  string     `json:"merchant_id"`
+	PrincipalID string     `json:"principal_id"`
+	KeyPrefix   string     `json:"key_prefix"`
+	Scopes      []string   `json:"scopes"`
+	Label       *string    `json:"label,omitempty"`
+	CreatedBy   *string    `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+}
+
+// MerchantAPIKeyCredential pairs a stored key with its SHA-256 digest. The
+// plaintext key is never persisted.
+type MerchantAPIKeyCredential struct {
+	Key     MerchantAPIKey
+	KeyHash string
+}
+
+type AuthenticateMerchantAPIKeyCommand struct {
+	Token string
+	Now   time.Time
+}
+
+type CreateMerchantAPIKeyCommand struct {
+	MerchantID       string
+	PrincipalID      string
+	Scopes           []string
+	Label            string
+	ExpiresInSeconds *int64
+	OperatorID       string
+	Now              time.Time
+}
+
+// CreateMerchantAPIKeyOutput carries the plaintext key, which is returned
+// exactly once.
+type CreateMerchantAPIKeyOutput struct {
+	Key    MerchantAPIKey `json:"key"`
+	APIKey string         `json:"api_key"`
+}
+
+type ListMerchantAPIKeysQuery struct {
+	MerchantID  string
+	PrincipalID string
+}
+
+type ListMerchantAPIKeysOutput struct {
+	Keys []MerchantAPIKey `json:"keys"`
+}
+
+type RevokeMerchantAPIKeyCommand struct {
+	KeyID string
+	Now   time.Time
+}
@@ -0,0 +1,35 @@
+package dto
+
+const (
+	QRCodeFormatPNG = "png"
+	QRCodeFormatSVG = "svg"
+)
+
+type GetPaymentRequestQRCodeQuery struct {
+	ID     string
+	Caller MerchantPrincipal
+	Format string
+}
+
+type PaymentRequestQRCode struct {
+	PaymentURI  string
+	ContentType string
+	Content     []byte
+}
+
+// GetPaymentRequestCheckoutQuery has no caller: the hosted checkout page is
+// public and the unguessable payment request id is the only credential.
+type GetPaymentRequestCheckoutQuery struct {
+	ID string
+}
+
+// PaymentRequestCheckout leaves PaymentURI and QRCodeSVG, and the resource's
+// payment URI, empty once the request is no longer pending, so nobody pays
+// into it afterwards. Open is true while the request awaits payment or
+// confirmation.
+type PaymentRequestCheckout struct {
+	Resource   PaymentRequestResource
+	PaymentURI string
+	QRCodeSVG  string
+	Open       bool
+}
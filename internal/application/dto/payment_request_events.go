@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// PaymentRequestEvent is one journaled event of a payment request. Payload is
+// the same JSON envelope the webhook outbox delivers.
+type PaymentRequestEvent struct {
+	Sequence  int64
+	EventID   string
+	EventType string
+	Payload   []byte
+}
+
+// StreamPaymentRequestEventsCommand drives one stream subscription. Open is
+// called once the payment request is known to exist and before any event, so
+// transports can commit their response headers; Emit and Heartbeat errors end
+// the stream.
+type StreamPaymentRequestEventsCommand struct {
+	ID                string
+	Caller            MerchantPrincipal
+	LastEventID       string
+	HeartbeatInterval time.Duration
+	PollInterval      time.Duration
+	Open              func() error
+	Emit              func(event PaymentRequestEvent) error
+	Heartbeat         func() error
+}
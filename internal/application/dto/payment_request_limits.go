@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	PaymentRequestLimitOutcomeRateLimited   = "rate_limited"
+	PaymentRequestLimitOutcomeQuotaExceeded = "quota_exceeded"
+)
+
+type RateLimitPolicy struct {
+	RatePerSecond float64
+	Burst         int
+}
+
+type RateLimitPermit struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+type CheckPaymentRequestRateLimitCommand struct {
+	MerchantID  string
+	PrincipalID string
+	Now         time.Time
+}
+
+// OpenPaymentRequestUsage is what a principal holds open when it creates a
+// payment request: pending or detected requests that have not expired yet.
+type OpenPaymentRequestUsage struct {
+	OpenCount         int64
+	EarliestExpiresAt *time.Time
+}
+
+// CheckOpenPaymentRequestQuotaFunc runs inside the create transaction after
+// idempotent replays are answered, so a replay is never refused.
+type CheckOpenPaymentRequestQuotaFunc func(usage OpenPaymentRequestUsage) *apperrors.AppError
+
+type GetPaymentRequestLimitsOverviewQuery struct{}
+
+type PaymentRequestLimitCounters struct {
+	RateLimited   int64     `json:"rate_limited"`
+	QuotaExceeded int64     `json:"quota_exceeded"`
+	Since         time.Time `json:"since"`
+}
+
+type PaymentRequestRateLimitSettings struct {
+	Enabled           bool    `json:"enabled"`
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	Burst             int     `json:"burst"`
+}
+
+// PaymentRequestLimitsOverview reports the create limits and the counters of
+// the replica that served the request; counters reset on restart.
+type PaymentRequestLimitsOverview struct {
+	RateLimit                   PaymentRequestRateLimitSettings `json:"rate_limit"`
+	MaxOpenRequestsPerPrincipal int                             `json:"max_open_requests_per_principal"`
+	Counters                    PaymentRequestLimitCounters     `json:"counters"`
+}
@@ -36,6 +36,7 @@ type OpenPaymentRequestForReconciliation struct {
 	TokenStandard       *string
 	TokenContract       *string
 	TokenDecimals       *int
+	WebhookAPIVersion   string
 	Metadata            map[string]any
 }
 
@@ -68,11 +69,19 @@ type ObservedSettlementEvidence struct {
 	AmountMinor   string
 	Confirmations int
 	IsCanonical   bool
+	Confirmed     bool
 	BlockHeight   *int64
 	BlockHash     *string
 	Metadata      map[string]any
 }
 
+// ReconcileSettlementChange reports one settlement lifecycle step observed
+// during sync: "detected", "confirmed", or "orphaned".
+type ReconcileSettlementChange struct {
+	Change     string
+	Settlement ObservedSettlementEvidence
+}
+
 type ReconcileSettlementSyncResult struct {
 	CanonicalCount     int
 	NonCanonicalCount  int
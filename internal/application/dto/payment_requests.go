@@ -8,6 +8,7 @@ import (
 )
 
 type IdempotencyScope struct {
+	MerchantID  string
 	PrincipalID string
 	HTTPMethod  string
 	HTTPPath    string
@@ -20,6 +21,7 @@ type CreatePaymentRequestCommand struct {
 	Network             string
 	Asset               string
 	WebhookURL          string
+	WebhookAPIVersion   string
 	ExpectedAmountMinor *string
 	ExpiresInSeconds    *int64
 	Metadata            map[string]any
@@ -41,6 +43,7 @@ type CreatePaymentRequestPersistenceCommand struct {
 	Network              string
 	Asset                string
 	WebhookURL           string
+	WebhookAPIVersion    string
 	ExpectedAmountMinor  *string
 	Metadata             map[string]any
 	ExpiresAt            time.Time
@@ -48,6 +51,8 @@ type CreatePaymentRequestPersistenceCommand struct {
 	CreatedAt            time.Time
 	AssetCatalogSnapshot AssetCatalogEntry
 	AllocationMode       string
+	BuildWebhookEvents   BuildPaymentRequestWebhookEventsFunc
+	CheckOpenQuota       CheckOpenPaymentRequestQuotaFunc
 }
 
 type ResolvePaymentAddressInput struct {
@@ -72,24 +77,55 @@ type CreatePaymentRequestPersistenceResult struct {
 	Replayed bool
 }
 
+// Expansions accepted by GetPaymentRequestQuery.Expand.
+const (
+	PaymentRequestExpandStatusHistory  = "status_history"
+	PaymentRequestExpandReconciliation = "reconciliation"
+	PaymentRequestExpandSettlements    = "settlements"
+)
+
+// GetPaymentRequestQuery long-polls when WaitForChange is set (a duration such
+// as "30s"): while the stored version still equals KnownVersion, the read
+// waits up to that long for a status or settlement change. Expand is a
+// comma-separated list of the PaymentRequestExpand values.
 type GetPaymentRequestQuery struct {
-	ID string
+	ID            string
+	Caller        MerchantPrincipal
+	KnownVersion  int64
+	WaitForChange string
+	Expand        string
 }
 
 type GetPaymentRequestSettlementsQuery struct {
-	ID string
+	ID     string
+	Caller MerchantPrincipal
 }
 
 type PaymentRequestResource struct {
-	ID                  string              `json:"id"`
-	Status              string              `json:"status"`
-	Chain               string              `json:"chain"`
-	Network             string              `json:"network"`
-	Asset               string              `json:"asset"`
-	ExpectedAmountMinor *string             `json:"expected_amount_minor,omitempty"`
-	ExpiresAt           time.Time           `json:"expires_at"`
-	CreatedAt           time.Time           `json:"created_at"`
+	ID                  string    `json:"id"`
+	Status              string    `json:"status"`
+	Chain               string    `json:"chain"`
+	Network             string    `json:"network"`
+	Asset               string    `json:"asset"`
+	ExpectedAmountMinor *string   `json:"expected_amount_minor,omitempty"`
+	WebhookAPIVersion   string    `json:"webhook_api_version,omitempty"`
+	ExpiresAt           time.Time `json:"expires_at"`
+	CreatedAt           time.Time `json:"created_at"`
+	// UpdatedAt, WebhookURL and Metadata are set by reads only, so create
+	// responses and payment_request.created payloads keep their shape.
+	// Metadata is the merchant's metadata without the reconciliation key.
+	UpdatedAt           *time.Time          `json:"updated_at,omitempty"`
+	WebhookURL          string              `json:"webhook_url,omitempty"`
+	Metadata            map[string]any      `json:"metadata,omitempty"`
 	PaymentInstructions PaymentInstructions `json:"payment_instructions"`
+	// StatusHistory, Reconciliation and Settlements are only present when
+	// expanded. Reconciliation is absent until the first reconcile pass.
+	StatusHistory  []PaymentRequestStatusTransition   `json:"status_history,omitzero"`
+	Reconciliation *ReconcileTransitionMetadata       `json:"reconciliation,omitempty"`
+	Settlements    []PaymentRequestSettlementResource `json:"settlements,omitzero"`
+	// Version increases with every status or settlement change. Reads set it;
+	// it backs the HTTP ETag and is not part of the body.
+	Version int64 `json:"-"`
 }
 
 type PaymentInstructions struct {
@@ -100,6 +136,26 @@ type PaymentInstructions struct {
 	TokenStandard   *string `json:"token_standard,omitempty"`
 	TokenContract   *string `json:"token_contract,omitempty"`
 	TokenDecimals   *int    `json:"token_decimals,omitempty"`
+	// MinorUnit and Decimals are copied from the asset catalog when the
+	// request is created; expected_amount_minor is in MinorUnit.
+	MinorUnit string `json:"minor_unit,omitempty"`
+	Decimals  *int   `json:"decimals,omitempty"`
+	// DisplayAmount and PaymentURI are derived by the application layer and
+	// never stored.
+	DisplayAmount *string `json:"display_amount,omitempty"`
+	PaymentURI    *string `json:"payment_uri,omitempty"`
+}
+
+// PaymentRequestStatusTransition records one status change. Details is the
+// reconciliation metadata the transition was made with; RecordedBy is the
+// reconciler that made it.
+type PaymentRequestStatusTransition struct {
+	FromStatus string         `json:"from_status"`
+	ToStatus   string         `json:"to_status"`
+	Reason     string         `json:"reason,omitempty"`
+	Details    map[string]any `json:"details"`
+	RecordedBy string         `json:"recorded_by,omitempty"`
+	OccurredAt time.Time      `json:"occurred_at"`
 }
 
 type PaymentRequestSettlementResource struct {
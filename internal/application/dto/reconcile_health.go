@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// GetReconcileHealthOverviewQuery counts requests that stayed detected longer
+// than DetectedStuckAfter, and observation errors and orphaned settlements
+// within the trailing Window.
+type GetReconcileHealthOverviewQuery struct {
+	Now                time.Time
+	DetectedStuckAfter time.Duration
+	Window             time.Duration
+}
+
+type ReconcileHealthOverview struct {
+	DetectedStuckCount      int64               `json:"detected_stuck_count"`
+	OldestDetectedAgeSec    *int64              `json:"oldest_detected_age_seconds,omitempty"`
+	ObservedCount           int64               `json:"observed_count"`
+	ObservationErrorCount   int64               `json:"observation_error_count"`
+	ObservationErrorRateBPS int64               `json:"observation_error_rate_bps"`
+	ReorgCount              int64               `json:"reorg_count"`
+	ChainTips               []ReconcileChainTip `json:"chain_tips"`
+}
+
+// ReconcileChainTip is the highest tip height the observer reported for one
+// chain network across open payment requests, and when it was last reported.
+type ReconcileChainTip struct {
+	Chain      string    `json:"chain"`
+	Network    string    `json:"network"`
+	TipHeight  int64     `json:"tip_height"`
+	ObservedAt time.Time `json:"observed_at"`
+}
@@ -3,14 +3,29 @@ package dto
 import "time"
 
 type DispatchWebhookEventsCommand struct {
-	Now            time.Time
-	BatchSize      int
-	WorkerID       string
-	LeaseDuration  time.Duration
-	InitialBackoff time.Duration
-	MaxBackoff     time.Duration
-	RetryJitterBPS int
-	RetryBudget    int
+	Now             time.Time
+	BatchSize       int
+	WorkerID        string
+	LeaseDuration   time.Duration
+	InitialBackoff  time.Duration
+	MaxBackoff      time.Duration
+	RetryJitterBPS  int
+	RetryBudget     int
+	Destination     WebhookDestinationPolicy
+	OrderedDelivery bool
+}
+
+type WebhookDestinationPolicy struct {
+	FailureThreshold     int
+	OpenDuration         time.Duration
+	MaxRequestsPerSecond int
+}
+
+type WebhookDispatchPermit struct {
+	Allowed bool
+	Probe   bool
+	Reason  string
+	RetryAt time.Time
 }
 
 type DispatchWebhookEventsOutput struct {
@@ -24,20 +39,34 @@ type DispatchWebhookEventsOutput struct {
 	HTTP4xxCount      int
 	HTTP5xxCount      int
 	NetworkErrorCount int
+	Deferred          int
+	CircuitOpenCount  int
+	RateLimitedCount  int
+	SinkPublished     int
+	SinkErrorCount    int
+	RetryExpiredCount int
 	LatencyMS         int64
 }
 
 type PendingWebhookOutboxEvent struct {
-	ID             int64
-	EventID        string
-	EventType      string
-	DestinationURL string
-	Payload        []byte
-	Attempts       int
-	MaxAttempts    int
+	ID               int64
+	EventID          string
+	EventType        string
+	PaymentRequestID string
+	MerchantID       string
+	Sink             string
+	DestinationURL   string
+	DestinationHost  string
+	Payload          []byte
+	Attempts         int
+	MaxAttempts      int
+	CreatedAt        time.Time
 }
 
+// SendWebhookEventInput is signed with the secret of MerchantID, falling back
+// to the global secret for the default merchant or an unconfigured one.
 type SendWebhookEventInput struct {
+	MerchantID      string
 	EventID         string
 	EventType       string
 	DeliveryAttempt int
@@ -46,5 +75,16 @@ type SendWebhookEventInput struct {
 }
 
 type SendWebhookEventOutput struct {
-	StatusCode int
+	StatusCode          int
+	ResponseBodyPreview string
+	ResolvedIP          string
+}
+
+type PublishEventInput struct {
+	EventID          string
+	EventType        string
+	PaymentRequestID string
+	Topic            string
+	DeliveryAttempt  int
+	Payload          []byte
 }
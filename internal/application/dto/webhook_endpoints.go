@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+const (
+	WebhookEndpointTestScopeAdmin    = "admin"
+	WebhookEndpointTestScopeMerchant = "merchant"
+)
+
+type TestWebhookEndpointCommand struct {
+	WebhookURL        string
+	WebhookAPIVersion string
+	Scope             string
+	MerchantID        string
+	PrincipalID       string
+}
+
+// TestWebhookEndpointOutput reports one synchronous ping delivery. Receiver
+// failures are part of the result, not request errors.
+type TestWebhookEndpointOutput struct {
+	EventID         string    `json:"event_id"`
+	WebhookURL      string    `json:"webhook_url"`
+	APIVersion      string    `json:"api_version"`
+	Delivered       bool      `json:"delivered"`
+	StatusCode      int       `json:"status_code,omitempty"`
+	LatencyMS       int64     `json:"latency_ms"`
+	ResponsePreview string    `json:"response_preview"`
+	ResolvedIP      string    `json:"resolved_ip,omitempty"`
+	Error           string    `json:"error,omitempty"`
+	TestedAt        time.Time `json:"tested_at"`
+}
+
+type WebhookPingData struct {
+	Message     string `json:"message"`
+	Scope       string `json:"scope"`
+	PrincipalID string `json:"principal_id,omitempty"`
+}
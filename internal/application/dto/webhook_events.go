@@ -0,0 +1,122 @@
+package dto
+
+import (
+	"time"
+
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type WebhookEvent struct {
+	EventID    string
+	EventType  string
+	APIVersion string
+	OccurredAt time.Time
+	Data       any
+}
+
+// WebhookEventEnvelope is the JSON body delivered to receivers. Sequence is
+// assigned by persistence when the event is enqueued; APIVersion names the
+// shape of Data.
+type WebhookEventEnvelope struct {
+	EventID    string    `json:"event_id"`
+	EventType  string    `json:"event_type"`
+	APIVersion string    `json:"api_version"`
+	Sequence   int64     `json:"sequence"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+type BuildPaymentRequestWebhookEventsFunc func(resource PaymentRequestResource) ([]WebhookEvent, *apperrors.AppError)
+
+type BuildSettlementWebhookEventsFunc func(changes []ReconcileSettlementChange) ([]WebhookEvent, *apperrors.AppError)
+
+type WebhookPaymentRequestCreatedData struct {
+	PaymentRequest PaymentRequestResource `json:"payment_request"`
+}
+
+type WebhookPaymentRequestStatusChangedData struct {
+	PaymentRequest WebhookPaymentRequestStatusPayload `json:"payment_request"`
+}
+
+type WebhookPaymentRequestStatusPayload struct {
+	ID                  string                    `json:"id"`
+	Chain               string                    `json:"chain"`
+	Network             string                    `json:"network"`
+	Asset               string                    `json:"asset"`
+	ExpectedAmountMinor *string                   `json:"expected_amount_minor,omitempty"`
+	AddressCanonical    string                    `json:"address_canonical"`
+	ExpiresAt           time.Time                 `json:"expires_at"`
+	PreviousStatus      string                    `json:"previous_status"`
+	CurrentStatus       string                    `json:"current_status"`
+	ObservedAmountMinor string                    `json:"observed_amount_minor,omitempty"`
+	ObservationSource   string                    `json:"observation_source,omitempty"`
+	TransitionReason    string                    `json:"transition_reason,omitempty"`
+	FinalityReached     *bool                     `json:"finality_reached,omitempty"`
+	EvidenceSummary     *ReconcileEvidenceSummary `json:"evidence_summary,omitempty"`
+}
+
+type WebhookPaymentRequestFinalityReachedData struct {
+	PaymentRequest WebhookPaymentRequestFinalityPayload `json:"payment_request"`
+}
+
+type WebhookPaymentRequestFinalityPayload struct {
+	ID                  string     `json:"id"`
+	Chain               string     `json:"chain"`
+	Network             string     `json:"network"`
+	Asset               string     `json:"asset"`
+	Status              string     `json:"status"`
+	ObservedAmountMinor string     `json:"observed_amount_minor,omitempty"`
+	FirstConfirmedAt    *time.Time `json:"first_confirmed_at,omitempty"`
+	FinalityReachedAt   time.Time  `json:"finality_reached_at"`
+}
+
+type WebhookSettlementEventData struct {
+	PaymentRequestID string                   `json:"payment_request_id"`
+	Chain            string                   `json:"chain"`
+	Network          string                   `json:"network"`
+	Asset            string                   `json:"asset"`
+	Settlement       WebhookSettlementPayload `json:"settlement"`
+}
+
+type WebhookSettlementPayload struct {
+	EvidenceRef   string  `json:"evidence_ref"`
+	AmountMinor   string  `json:"amount_minor"`
+	Confirmations int     `json:"confirmations"`
+	BlockHeight   *int64  `json:"block_height,omitempty"`
+	BlockHash     *string `json:"block_hash,omitempty"`
+	IsCanonical   bool    `json:"is_canonical"`
+}
+
+type WebhookPaymentRequestStatusChangedDataV2 struct {
+	PaymentRequest  WebhookPaymentRequestSnapshotV2 `json:"payment_request"`
+	Transition      WebhookStatusTransitionV2       `json:"transition"`
+	EvidenceSummary *ReconcileEvidenceSummary       `json:"evidence_summary,omitempty"`
+	Settlements     []WebhookSettlementPayload      `json:"settlements"`
+}
+
+type WebhookPaymentRequestFinalityReachedDataV2 struct {
+	PaymentRequest    WebhookPaymentRequestSnapshotV2 `json:"payment_request"`
+	FirstConfirmedAt  *time.Time                      `json:"first_confirmed_at,omitempty"`
+	FinalityReachedAt time.Time                       `json:"finality_reached_at"`
+	Settlements       []WebhookSettlementPayload      `json:"settlements"`
+}
+
+type WebhookPaymentRequestSnapshotV2 struct {
+	ID                  string    `json:"id"`
+	Status              string    `json:"status"`
+	Chain               string    `json:"chain"`
+	Network             string    `json:"network"`
+	Asset               string    `json:"asset"`
+	ExpectedAmountMinor *string   `json:"expected_amount_minor,omitempty"`
+	ObservedAmountMinor string    `json:"observed_amount_minor,omitempty"`
+	AddressCanonical    string    `json:"address_canonical"`
+	ExpiresAt           time.Time `json:"expires_at"`
+}
+
+type WebhookStatusTransitionV2 struct {
+	PreviousStatus    string `json:"previous_status"`
+	CurrentStatus     string `json:"current_status"`
+	Reason            string `json:"reason,omitempty"`
+	ObservationSource string `json:"observation_source,omitempty"`
+	FinalityReached   *bool  `json:"finality_reached,omitempty"`
+}
@@ -2,8 +2,13 @@ package dto
 
 import "time"
 
+const WebhookOutboxOverviewGroupByDestination = "destination"
+
 type GetWebhookOutboxOverviewQuery struct {
-	Now time.Time
+	Now     time.Time
+	GroupBy string
+	Window  time.Duration
+	Limit   int
 }
 
 type WebhookOutboxOverview struct {
@@ -14,43 +19,89 @@ type WebhookOutboxOverview struct {
 	DeliveredCount         int64      `json:"delivered_count"`
 	OldestPendingCreatedAt *time.Time `json:"oldest_pending_created_at,omitempty"`
 	OldestPendingAgeSec    *int64     `json:"oldest_pending_age_seconds,omitempty"`
+
+	WindowSeconds *int64                             `json:"window_seconds,omitempty"`
+	Destinations  []WebhookOutboxDestinationOverview `json:"destinations,omitempty"`
+}
+
+// WebhookOutboxDestinationOverview is one (sink, destination host) group of
+// the overview. Window counters cover rows that reached a terminal state
+// within the requested window; operator cancellations are not counted as
+// destination failures.
+type WebhookOutboxDestinationOverview struct {
+	Sink                string     `json:"sink"`
+	DestinationHost     string     `json:"destination_host"`
+	CircuitState        *string    `json:"circuit_state,omitempty"`
+	PendingCount        int64      `json:"pending_count"`
+	PendingReadyCount   int64      `json:"pending_ready_count"`
+	RetryingCount       int64      `json:"retrying_count"`
+	FailedCount         int64      `json:"failed_count"`
+	OldestPendingAgeSec *int64     `json:"oldest_pending_age_seconds,omitempty"`
+	LastDeliveredAt     *time.Time `json:"last_delivered_at,omitempty"`
+	DeliveredInWindow   int64      `json:"delivered_in_window"`
+	FailedInWindow      int64      `json:"failed_in_window"`
+	SuccessRateBPS      *int64     `json:"success_rate_bps,omitempty"`
 }
 
 type ListWebhookDLQEventsQuery struct {
-	Limit int
+	Limit  int
+	Cursor string
+	Filter WebhookOutboxEventFilter
+}
+
+type WebhookOutboxEventFilter struct {
+	DestinationHost string
+	EventType       string
+	ErrorContains   string
+	CreatedFrom     *time.Time
+	CreatedTo       *time.Time
+}
+
+type WebhookDLQCursor struct {
+	UpdatedAt time.Time
+	ID        int64
 }
 
 type WebhookDLQEvent struct {
+	ID               int64      `json:"-"`
 	EventID          string     `json:"event_id"`
 	EventType        string     `json:"event_type"`
 	PaymentRequestID string     `json:"payment_request_id"`
+	Sink             string     `json:"sink"`
 	DestinationURL   string     `json:"destination_url"`
 	Attempts         int        `json:"attempts"`
 	MaxAttempts      int        `json:"max_attempts"`
 	LastError        *string    `json:"last_error,omitempty"`
+	LastResolvedIP   *string    `json:"last_resolved_ip,omitempty"`
 	CreatedAt        time.Time  `json:"created_at"`
 	UpdatedAt        time.Time  `json:"updated_at"`
 	DeliveredAt      *time.Time `json:"delivered_at,omitempty"`
 }
 
 type ListWebhookDLQEventsOutput struct {
-	Events []WebhookDLQEvent `json:"events"`
+	Events     []WebhookDLQEvent `json:"events"`
+	NextCursor *string           `json:"next_cursor,omitempty"`
 }
 
+// RequeueWebhookDLQEventCommand and CancelWebhookOutboxEventCommand address
+// one outbox row: an event has a row per sink. Sink defaults to webhook.
 type RequeueWebhookDLQEventCommand struct {
 	EventID    string
+	Sink       string
 	OperatorID string
 	Now        time.Time
 }
 
 type RequeueWebhookDLQEventOutput struct {
 	EventID        string    `json:"event_id"`
+	Sink           string    `json:"sink"`
 	DeliveryStatus string    `json:"delivery_status"`
 	UpdatedAt      time.Time `json:"updated_at"`
 }
 
 type CancelWebhookOutboxEventCommand struct {
 	EventID    string
+	Sink       string
 	OperatorID string
 	Reason     string
 	Now        time.Time
@@ -58,13 +109,56 @@ type CancelWebhookOutboxEventCommand struct {
 
 type CancelWebhookOutboxEventOutput struct {
 	EventID        string    `json:"event_id"`
+	Sink           string    `json:"sink"`
 	DeliveryStatus string    `json:"delivery_status"`
 	LastError      string    `json:"last_error"`
 	UpdatedAt      time.Time `json:"updated_at"`
 }
 
+// WebhookOutboxMutationResult reports a single-event mutation. CurrentStatus
+// is the status before it and LastError the stored value after it.
 type WebhookOutboxMutationResult struct {
 	Found         bool
 	Updated       bool
 	CurrentStatus string
+	LastError     string
+}
+
+type WebhookOutboxBulkSelection struct {
+	EventIDs []string
+	Filter   WebhookOutboxEventFilter
+}
+
+type BulkRequeueWebhookDLQEventsCommand struct {
+	Selection  WebhookOutboxBulkSelection
+	MaxEvents  int
+	OperatorID string
+	Now        time.Time
+}
+
+type BulkCancelWebhookOutboxEventsCommand struct {
+	Selection  WebhookOutboxBulkSelection
+	MaxEvents  int
+	OperatorID string
+	Reason     string
+	Now        time.Time
+}
+
+type WebhookOutboxBulkMutationOutput struct {
+	Action         string    `json:"action"`
+	UpdatedCount   int       `json:"updated_count"`
+	BatchCount     int       `json:"batch_count"`
+	HasMore        bool      `json:"has_more"`
+	EventIDs       []string  `json:"event_ids"`
+	DeliveryStatus string    `json:"delivery_status"`
+	LastError      string    `json:"last_error,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// WebhookOutboxBulkMutationBatch is one committed bulk batch. HasMore reports
+// whether another matching row exists past LastID.
+type WebhookOutboxBulkMutationBatch struct {
+	EventIDs []string
+	LastID   int64
+	HasMore  bool
 }
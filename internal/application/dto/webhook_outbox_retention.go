@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// PurgeWebhookOutboxCommand archives terminal outbox rows (delivered, or
+// failed by an operator cancel) older than RetentionAge, at most MaxBatches
+// batches of BatchSize rows per run. DryRun only counts eligible rows.
+type PurgeWebhookOutboxCommand struct {
+	Now          time.Time
+	RetentionAge time.Duration
+	BatchSize    int
+	MaxBatches   int
+	DryRun       bool
+}
+
+type PurgeWebhookOutboxOutput struct {
+	Cutoff    time.Time
+	DryRun    bool
+	Eligible  int64
+	Archived  int64
+	Batches   int
+	Drained   bool
+	LatencyMS int64
+}
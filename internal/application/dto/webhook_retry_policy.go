@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+type WebhookRetryPolicy struct {
+	DestinationHost       string    `json:"destination_host"`
+	MaxAttempts           int       `json:"max_attempts"`
+	BackoffCurve          string    `json:"backoff_curve"`
+	InitialBackoffSeconds int       `json:"initial_backoff_seconds"`
+	MaxBackoffSeconds     int       `json:"max_backoff_seconds"`
+	MaxAgeSeconds         *int      `json:"max_age_seconds,omitempty"`
+	UpdatedBy             *string   `json:"updated_by,omitempty"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+type PutWebhookRetryPolicyCommand struct {
+	DestinationHost       string
+	MaxAttempts           int
+	BackoffCurve          string
+	InitialBackoffSeconds int
+	MaxBackoffSeconds     int
+	MaxAgeSeconds         *int
+	OperatorID            string
+	Now                   time.Time
+}
+
+type GetWebhookRetryPolicyQuery struct {
+	DestinationHost string
+}
+
+type DeleteWebhookRetryPolicyCommand struct {
+	DestinationHost string
+}
+
+type DeleteWebhookRetryPolicyOutput struct {
+	DestinationHost string `json:"destination_host"`
+	Deleted         bool   `json:"deleted"`
+}
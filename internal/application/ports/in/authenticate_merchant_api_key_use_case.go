@@ -0,0 +1,12 @@
+package in
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type AuthenticateMerchantAPIKeyUseCase interface {
+	Execute(ctx context.Context, command dto.AuthenticateMerchantAPIKeyCommand) (dto.MerchantPrincipal, *apperrors.AppError)
+}
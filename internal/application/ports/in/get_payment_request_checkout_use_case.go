@@ -0,0 +1,12 @@
+package in
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type GetPaymentRequestCheckoutUseCase interface {
+	Execute(ctx context.Context, query dto.GetPaymentRequestCheckoutQuery) (dto.PaymentRequestCheckout, *apperrors.AppError)
+}
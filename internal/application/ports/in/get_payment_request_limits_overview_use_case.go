@@ -0,0 +1,12 @@
+package in
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type GetPaymentRequestLimitsOverviewUseCase interface {
+	Execute(ctx context.Context, query dto.GetPaymentRequestLimitsOverviewQuery) (dto.PaymentRequestLimitsOverview, *apperrors.AppError)
+}
@@ -0,0 +1,12 @@
+package in
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type GetPaymentRequestQRCodeUseCase interface {
+	Execute(ctx context.Context, query dto.GetPaymentRequestQRCodeQuery) (dto.PaymentRequestQRCode, *apperrors.AppError)
+}
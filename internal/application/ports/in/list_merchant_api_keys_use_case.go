@@ -0,0 +1,12 @@
+package in
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type ListMerchantAPIKeysUseCase interface {
+	Execute(ctx context.Context, query dto.ListMerchantAPIKeysQuery) (dto.ListMerchantAPIKeysOutput, *apperrors.AppError)
+}
@@ -0,0 +1,12 @@
+package in
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type PutWebhookRetryPolicyUseCase interface {
+	Execute(ctx context.Context, command dto.PutWebhookRetryPolicyCommand) (dto.WebhookRetryPolicy, *apperrors.AppError)
+}
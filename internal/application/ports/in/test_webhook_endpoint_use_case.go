@@ -0,0 +1,12 @@
+package in
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type TestWebhookEndpointUseCase interface {
+	Execute(ctx context.Context, command dto.TestWebhookEndpointCommand) (dto.TestWebhookEndpointOutput, *apperrors.AppError)
+}
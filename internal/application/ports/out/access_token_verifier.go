@@ -0,0 +1,15 @@
+package out
+
+import (
+	"context"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// AccessTokenVerifier checks a bearer JWT against the configured signing
+// keys, issuer and audience.
+type AccessTokenVerifier interface {
+	Verify(ctx context.Context, token string, now time.Time) (dto.AccessTokenClaims, *apperrors.AppError)
+}
@@ -0,0 +1,14 @@
+package out
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// AdminAuditLogRepository is append only.
+type AdminAuditLogRepository interface {
+	Append(ctx context.Context, entry dto.AdminAuditEntry) (dto.AdminAuditEntry, *apperrors.AppError)
+	List(ctx context.Context, query dto.ListAdminAuditEntriesQuery) ([]dto.AdminAuditEntry, *apperrors.AppError)
+}
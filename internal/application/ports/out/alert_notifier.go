@@ -0,0 +1,14 @@
+package out
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// AlertNotifier delivers alert events to an on-call channel. Failures are
+// not retried; an ongoing alert is sent again after the monitor cooldown.
+type AlertNotifier interface {
+	NotifyAlert(ctx context.Context, notification dto.AlertNotification) *apperrors.AppError
+}
@@ -8,5 +8,5 @@ import (
 )
 
 type AssetCatalogReadModel interface {
-	ListEnabled(ctx context.Context) ([]dto.AssetCatalogEntry, *apperrors.AppError)
+	ListEnabled(ctx context.Context, merchantID string) ([]dto.AssetCatalogEntry, *apperrors.AppError)
 }
@@ -0,0 +1,14 @@
+package out
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// EventSink publishes outbox events to a message bus. A nil error means the
+// broker acknowledged the message; the outbox row is then marked delivered.
+type EventSink interface {
+	PublishEvent(ctx context.Context, input dto.PublishEventInput) *apperrors.AppError
+}
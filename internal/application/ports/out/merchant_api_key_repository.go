@@ -0,0 +1,17 @@
+package out
+
+import (
+	"context"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type MerchantAPIKeyRepository interface {
+	Create(ctx context.Context, credential dto.MerchantAPIKeyCredential) (dto.MerchantAPIKey, *apperrors.AppError)
+	FindByPrefix(ctx context.Context, keyPrefix string) (dto.MerchantAPIKeyCredential, bool, *apperrors.AppError)
+	List(ctx context.Context, merchantID string, principalID string) ([]dto.MerchantAPIKey, *apperrors.AppError)
+	Revoke(ctx context.Context, keyID string, revokedAt time.Time) (dto.MerchantAPIKey, bool, *apperrors.AppError)
+	TouchLastUsed(ctx context.Context, keyID string, usedAt time.Time) *apperrors.AppError
+}
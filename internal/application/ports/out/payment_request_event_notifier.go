@@ -0,0 +1,8 @@
+package out
+
+// PaymentRequestEventNotifier signals that new events were recorded for a
+// payment request. Signals carry no data and may be coalesced or, after a
+// reconnect, spurious; subscribers re-read the journal on every signal.
+type PaymentRequestEventNotifier interface {
+	Subscribe(paymentRequestID string) (<-chan struct{}, func())
+}
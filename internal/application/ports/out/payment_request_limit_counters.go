@@ -0,0 +1,8 @@
+package out
+
+import "chaintx/internal/application/dto"
+
+type PaymentRequestLimitCounters interface {
+	Record(outcome string)
+	Snapshot() dto.PaymentRequestLimitCounters
+}
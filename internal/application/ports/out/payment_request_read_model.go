@@ -7,10 +7,28 @@ import (
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
+// PaymentRequestReadModel only sees requests owned by merchantID; another
+// merchant's request reads as not found. GetMerchantID is the one lookup by id
+// alone, for the public hosted checkout page.
 type PaymentRequestReadModel interface {
-	GetByID(ctx context.Context, id string) (dto.PaymentRequestResource, bool, *apperrors.AppError)
+	GetByID(ctx context.Context, merchantID string, id string) (dto.PaymentRequestResource, bool, *apperrors.AppError)
+	GetPrincipalID(ctx context.Context, merchantID string, id string) (string, bool, *apperrors.AppError)
+	GetMerchantID(ctx context.Context, id string) (string, bool, *apperrors.AppError)
 	ListSettlementsByPaymentRequestID(
 		ctx context.Context,
+		merchantID string,
 		id string,
 	) ([]dto.PaymentRequestSettlementResource, bool, *apperrors.AppError)
+	ListStatusHistoryByPaymentRequestID(
+		ctx context.Context,
+		merchantID string,
+		id string,
+	) ([]dto.PaymentRequestStatusTransition, bool, *apperrors.AppError)
+	ListEventsAfterSequence(
+		ctx context.Context,
+		merchantID string,
+		id string,
+		afterSequence int64,
+		limit int,
+	) ([]dto.PaymentRequestEvent, bool, *apperrors.AppError)
 }
@@ -25,6 +25,7 @@ type PaymentRequestReconciliationRepository interface {
 		asset string,
 		observedAt time.Time,
 		settlements []dto.ObservedSettlementEvidence,
+		buildEvents dto.BuildSettlementWebhookEventsFunc,
 	) (dto.ReconcileSettlementSyncResult, *apperrors.AppError)
 	TransitionStatusIfCurrent(
 		ctx context.Context,
@@ -34,5 +35,6 @@ type PaymentRequestReconciliationRepository interface {
 		updatedAt time.Time,
 		leaseOwner string,
 		metadata dto.ReconcileTransitionMetadata,
+		events []dto.WebhookEvent,
 	) (bool, *apperrors.AppError)
 }
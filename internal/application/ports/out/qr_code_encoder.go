@@ -0,0 +1,11 @@
+package out
+
+import (
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// QRCodeEncoder renders content as a QR code image in one of the
+// dto.QRCodeFormat values.
+type QRCodeEncoder interface {
+	Encode(content string, format string) ([]byte, *apperrors.AppError)
+}
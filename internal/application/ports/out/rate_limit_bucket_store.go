@@ -0,0 +1,13 @@
+package out
+
+import (
+	"context"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type RateLimitBucketStore interface {
+	Take(ctx context.Context, key string, policy dto.RateLimitPolicy, now time.Time) (dto.RateLimitPermit, *apperrors.AppError)
+}
@@ -0,0 +1,21 @@
+package out
+
+import (
+	"context"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// ReconcileHealthReadModel reads reconciler outcomes persisted on payment
+// requests and settlements. detectedBefore and windowStart are absolute cutoffs
+// derived from the query by the use case.
+type ReconcileHealthReadModel interface {
+	GetReconcileHealthOverview(
+		ctx context.Context,
+		now time.Time,
+		detectedBefore time.Time,
+		windowStart time.Time,
+	) (dto.ReconcileHealthOverview, *apperrors.AppError)
+}
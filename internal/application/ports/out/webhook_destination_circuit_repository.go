@@ -0,0 +1,27 @@
+package out
+
+import (
+	"context"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type WebhookDestinationCircuitRepository interface {
+	AcquireDispatchPermit(
+		ctx context.Context,
+		destinationHost string,
+		owner string,
+		policy dto.WebhookDestinationPolicy,
+		probeLease time.Duration,
+		now time.Time,
+	) (dto.WebhookDispatchPermit, *apperrors.AppError)
+	RecordDispatchResult(
+		ctx context.Context,
+		destinationHost string,
+		policy dto.WebhookDestinationPolicy,
+		success bool,
+		now time.Time,
+	) *apperrors.AppError
+}
@@ -10,5 +10,16 @@ import (
 
 type WebhookOutboxReadModel interface {
 	GetOverview(ctx context.Context, now time.Time) (dto.WebhookOutboxOverview, *apperrors.AppError)
-	ListDLQ(ctx context.Context, limit int) ([]dto.WebhookDLQEvent, *apperrors.AppError)
+	GetDestinationOverview(
+		ctx context.Context,
+		now time.Time,
+		windowStart time.Time,
+		limit int,
+	) ([]dto.WebhookOutboxDestinationOverview, *apperrors.AppError)
+	ListDLQ(
+		ctx context.Context,
+		filter dto.WebhookOutboxEventFilter,
+		cursor *dto.WebhookDLQCursor,
+		limit int,
+	) ([]dto.WebhookDLQEvent, *apperrors.AppError)
 }
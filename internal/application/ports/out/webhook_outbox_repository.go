@@ -15,11 +15,14 @@ type WebhookOutboxRepository interface {
 		limit int,
 		leaseOwner string,
 		leaseUntil time.Time,
+		orderedByPaymentRequest bool,
+		sinks []string,
 	) ([]dto.PendingWebhookOutboxEvent, *apperrors.AppError)
 	MarkDelivered(
 		ctx context.Context,
 		id int64,
 		leaseOwner string,
+		resolvedIP string,
 		deliveredAt time.Time,
 	) (bool, *apperrors.AppError)
 	MarkRetry(
@@ -29,6 +32,7 @@ type WebhookOutboxRepository interface {
 		attempts int,
 		nextAttemptAt time.Time,
 		lastError string,
+		resolvedIP string,
 		updatedAt time.Time,
 	) (bool, *apperrors.AppError)
 	MarkFailed(
@@ -37,6 +41,14 @@ type WebhookOutboxRepository interface {
 		leaseOwner string,
 		attempts int,
 		lastError string,
+		resolvedIP string,
+		updatedAt time.Time,
+	) (bool, *apperrors.AppError)
+	DeferDispatch(
+		ctx context.Context,
+		id int64,
+		leaseOwner string,
+		nextAttemptAt time.Time,
 		updatedAt time.Time,
 	) (bool, *apperrors.AppError)
 	RenewLease(
@@ -49,14 +61,33 @@ type WebhookOutboxRepository interface {
 	RequeueFailedByEventID(
 		ctx context.Context,
 		eventID string,
+		sink string,
 		operatorID string,
 		updatedAt time.Time,
 	) (dto.WebhookOutboxMutationResult, *apperrors.AppError)
 	CancelByEventID(
 		ctx context.Context,
 		eventID string,
+		sink string,
 		operatorID string,
 		lastError string,
 		updatedAt time.Time,
 	) (dto.WebhookOutboxMutationResult, *apperrors.AppError)
+	RequeueFailedBatch(
+		ctx context.Context,
+		selection dto.WebhookOutboxBulkSelection,
+		afterID int64,
+		limit int,
+		operatorID string,
+		updatedAt time.Time,
+	) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError)
+	CancelBatch(
+		ctx context.Context,
+		selection dto.WebhookOutboxBulkSelection,
+		afterID int64,
+		limit int,
+		operatorID string,
+		lastError string,
+		updatedAt time.Time,
+	) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError)
 }
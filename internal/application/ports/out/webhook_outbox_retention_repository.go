@@ -0,0 +1,15 @@
+package out
+
+import (
+	"context"
+	"time"
+
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type WebhookOutboxRetentionRepository interface {
+	CountArchivable(ctx context.Context, cutoff time.Time) (int64, *apperrors.AppError)
+	// ArchiveBatch moves up to limit archivable rows into the archive and
+	// deletes them from the outbox atomically, returning the rows moved.
+	ArchiveBatch(ctx context.Context, cutoff time.Time, archivedAt time.Time, limit int) (int64, *apperrors.AppError)
+}
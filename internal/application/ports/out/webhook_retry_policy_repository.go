@@ -0,0 +1,18 @@
+package out
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type WebhookRetryPolicyRepository interface {
+	ListByDestinationHosts(
+		ctx context.Context,
+		destinationHosts []string,
+	) (map[string]dto.WebhookRetryPolicy, *apperrors.AppError)
+	Get(ctx context.Context, destinationHost string) (dto.WebhookRetryPolicy, bool, *apperrors.AppError)
+	Upsert(ctx context.Context, policy dto.WebhookRetryPolicy) (dto.WebhookRetryPolicy, *apperrors.AppError)
+	Delete(ctx context.Context, destinationHost string) (bool, *apperrors.AppError)
+}
@@ -0,0 +1,154 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+var testAccessTokenClaimMapping = dto.AccessTokenClaimMapping{
+	Principal: "sub",
+	Merchant:  "merchant_id",
+	Scopes:    "scope",
+	OpsRole:   "groups",
+}
+
+func TestAuthenticateAccessTokenUseCaseMapsClaims(t *testing.T) {
+	verifier := &fakeAccessTokenVerifier{claims: map[string]any{
+		"sub":         "user-1",
+		"merchant_id": "Retail",
+		"scope":       "read create openid",
+		"groups":      []any{"billing", "viewer", "operator"},
+	}}
+	useCase := NewAuthenticateAccessTokenUseCase(verifier, testAccessTokenClaimMapping, false)
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	principal, appErr := useCase.Execute(context.Background(), dto.AuthenticateAccessTokenCommand{
+		Token: " header.payload.signature ",
+		Now:   now,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if verifier.lastToken != "header.payload.signature" || !verifier.lastNow.Equal(now) {
+		t.Fatalf("unexpected verifier input token=%q now=%s", verifier.lastToken, verifier.lastNow)
+	}
+	if principal.PrincipalID != "user-1" || principal.MerchantID != "retail" {
+		t.Fatalf("unexpected principal: %+v", principal)
+	}
+	if len(principal.Scopes) != 2 || principal.Scopes[0] != "read" || principal.Scopes[1] != "create" {
+		t.Fatalf("expected merchant scopes only, got %+v", principal.Scopes)
+	}
+	if principal.OpsRole != "operator" {
+		t.Fatalf("expected highest ops role operator, got %q", principal.OpsRole)
+	}
+}
+
+func TestAuthenticateAccessTokenUseCaseAcceptsOpsOnlyTokenWithoutMerchant(t *testing.T) {
+	useCase := NewAuthenticateAccessTokenUseCase(
+		&fakeAccessTokenVerifier{claims: map[string]any{"sub": "user-1", "scope": "read create", "groups": "Admin"}},
+		testAccessTokenClaimMapping,
+		false,
+	)
+
+	principal, appErr := useCase.Execute(context.Background(), dto.AuthenticateAccessTokenCommand{Token: "t"})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if principal.MerchantID != "" || len(principal.Scopes) != 0 || principal.OpsRole != "admin" {
+		t.Fatalf("expected an ops-only principal without merchant scopes, got %+v", principal)
+	}
+}
+
+func TestAuthenticateAccessTokenUseCaseDefaultMerchantFallback(t *testing.T) {
+	claims := map[string]any{"sub": "user-1", "scope": "read"}
+
+	_, appErr := NewAuthenticateAccessTokenUseCase(
+		&fakeAccessTokenVerifier{claims: claims},
+		testAccessTokenClaimMapping,
+		false,
+	).Execute(context.Background(), dto.AuthenticateAccessTokenCommand{Token: "t"})
+	if appErr == nil || appErr.Code != "unauthorized" {
+		t.Fatalf("expected unauthorized without fallback, got %+v", appErr)
+	}
+
+	principal, appErr := NewAuthenticateAccessTokenUseCase(
+		&fakeAccessTokenVerifier{claims: claims},
+		testAccessTokenClaimMapping,
+		true,
+	).Execute(context.Background(), dto.AuthenticateAccessTokenCommand{Token: "t"})
+	if appErr != nil {
+		t.Fatalf("expected no error with fallback, got %+v", appErr)
+	}
+	if principal.MerchantID != "default" || len(principal.Scopes) != 1 || principal.Scopes[0] != "read" {
+		t.Fatalf("expected default merchant with read scope, got %+v", principal)
+	}
+}
+
+func TestAuthenticateAccessTokenUseCaseRejectsInvalidClaims(t *testing.T) {
+	testCases := []struct {
+		name   string
+		claims map[string]any
+	}{
+		{name: "missing principal", claims: map[string]any{"merchant_id": "retail"}},
+		{name: "blank principal", claims: map[string]any{"sub": "  "}},
+		{name: "non-string principal", claims: map[string]any{"sub": 42}},
+		{name: "invalid merchant", claims: map[string]any{"sub": "user-1", "merchant_id": "retail eu"}},
+		{name: "merchant list", claims: map[string]any{"sub": "user-1", "merchant_id": []any{"retail"}}},
+		{name: "missing merchant", claims: map[string]any{"sub": "user-1", "scope": "read create"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			useCase := NewAuthenticateAccessTokenUseCase(
+				&fakeAccessTokenVerifier{claims: testCase.claims},
+				testAccessTokenClaimMapping,
+				false,
+			)
+			_, appErr := useCase.Execute(context.Background(), dto.AuthenticateAccessTokenCommand{Token: "t"})
+			if appErr == nil || appErr.Code != "unauthorized" {
+				t.Fatalf("expected unauthorized, got %+v", appErr)
+			}
+		})
+	}
+}
+
+func TestAuthenticateAccessTokenUseCasePassesVerifierErrors(t *testing.T) {
+	verifierErr := apperrors.NewInternal("access_token_jwks_unavailable", "unavailable", nil)
+	useCase := NewAuthenticateAccessTokenUseCase(&fakeAccessTokenVerifier{err: verifierErr}, testAccessTokenClaimMapping, false)
+
+	_, appErr := useCase.Execute(context.Background(), dto.AuthenticateAccessTokenCommand{Token: "t"})
+	if appErr != verifierErr {
+		t.Fatalf("expected verifier error, got %+v", appErr)
+	}
+
+	_, appErr = NewAuthenticateAccessTokenUseCase(nil, testAccessTokenClaimMapping, false).Execute(
+		context.Background(),
+		dto.AuthenticateAccessTokenCommand{Token: "t"},
+	)
+	if appErr == nil || appErr.Code != "access_token_verifier_missing" {
+		t.Fatalf("expected access_token_verifier_missing, got %+v", appErr)
+	}
+}
+
+type fakeAccessTokenVerifier struct {
+	claims    map[string]any
+	err       *apperrors.AppError
+	lastToken string
+	lastNow   time.Time
+}
+
+func (f *fakeAccessTokenVerifier) Verify(_ context.Context, token string, now time.Time) (dto.AccessTokenClaims, *apperrors.AppError) {
+	f.lastToken = token
+	f.lastNow = now
+	if f.err != nil {
+		return dto.AccessTokenClaims{}, f.err
+	}
+	subject, _ := f.claims["sub"].(string)
+	return dto.AccessTokenClaims{Subject: subject, Claims: f.claims}, nil
+}
@@ -0,0 +1,139 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestRecordAdminAuditEntryUseCaseAppendsNormalizedEntry(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	repository := &fakeAdminAuditLogRepository{}
+	useCase := NewRecordAdminAuditEntryUseCase(repository)
+
+	entry, appErr := useCase.Execute(context.Background(), dto.RecordAdminAuditEntryCommand{
+		ActorID:   " alice ",
+		ActorRole: "operator",
+		Action:    "webhook_outbox.cancel",
+		Target:    "evt_1",
+		Reason:    strings.Repeat("r", maxAdminAuditReasonLength+10),
+		SourceIP:  "203.0.113.7",
+		Outcome:   dto.AdminAuditOutcomeSucceeded,
+		Now:       now,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if entry.ActorID != "alice" || entry.OccurredAt != now || entry.Details == nil {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+	if entry.Target == nil || *entry.Target != "evt_1" || entry.ErrorCode != nil {
+		t.Fatalf("expected target and no error code, got %+v", entry)
+	}
+	if entry.Reason == nil || len(*entry.Reason) != maxAdminAuditReasonLength {
+		t.Fatalf("expected reason to be truncated, got %v", entry.Reason)
+	}
+}
+
+func TestRecordAdminAuditEntryUseCaseValidation(t *testing.T) {
+	valid := dto.RecordAdminAuditEntryCommand{
+		ActorID:   "alice",
+		ActorRole: "admin",
+		Action:    "merchant_api_key.create",
+		Outcome:   dto.AdminAuditOutcomeFailed,
+	}
+	testCases := []struct {
+		name   string
+		mutate func(*dto.RecordAdminAuditEntryCommand)
+		field  string
+	}{
+		{name: "actor", mutate: func(c *dto.RecordAdminAuditEntryCommand) { c.ActorID = " " }, field: "actor_id"},
+		{name: "role", mutate: func(c *dto.RecordAdminAuditEntryCommand) { c.ActorRole = "root" }, field: "actor_role"},
+		{name: "action", mutate: func(c *dto.RecordAdminAuditEntryCommand) { c.Action = "" }, field: "action"},
+		{name: "outcome", mutate: func(c *dto.RecordAdminAuditEntryCommand) { c.Outcome = "ok" }, field: "outcome"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repository := &fakeAdminAuditLogRepository{}
+			command := valid
+			testCase.mutate(&command)
+
+			_, appErr := NewRecordAdminAuditEntryUseCase(repository).Execute(context.Background(), command)
+			if appErr == nil || appErr.Details["field"] != testCase.field {
+				t.Fatalf("expected validation error on %s, got %+v", testCase.field, appErr)
+			}
+			if len(repository.entries) != 0 {
+				t.Fatalf("expected nothing appended")
+			}
+		})
+	}
+}
+
+func TestListAdminAuditEntriesUseCasePaginates(t *testing.T) {
+	repository := &fakeAdminAuditLogRepository{}
+	for index := 0; index < 3; index++ {
+		_, _ = repository.Append(context.Background(), dto.AdminAuditEntry{ActorID: "alice", Action: "webhook_outbox.requeue"})
+	}
+	useCase := NewListAdminAuditEntriesUseCase(repository)
+
+	page, appErr := useCase.Execute(context.Background(), dto.ListAdminAuditEntriesQuery{Limit: 2})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if len(page.Entries) != 2 || page.Entries[0].ID != 3 || page.NextBeforeID == nil || *page.NextBeforeID != 2 {
+		t.Fatalf("unexpected first page %+v", page)
+	}
+
+	page, appErr = useCase.Execute(context.Background(), dto.ListAdminAuditEntriesQuery{Limit: 2, BeforeID: *page.NextBeforeID})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if len(page.Entries) != 1 || page.Entries[0].ID != 1 || page.NextBeforeID != nil {
+		t.Fatalf("unexpected last page %+v", page)
+	}
+
+	if _, appErr := useCase.Execute(context.Background(), dto.ListAdminAuditEntriesQuery{Limit: 500}); appErr == nil {
+		t.Fatalf("expected limit validation error")
+	}
+}
+
+type fakeAdminAuditLogRepository struct {
+	entries []dto.AdminAuditEntry
+}
+
+func (r *fakeAdminAuditLogRepository) Append(
+	_ context.Context,
+	entry dto.AdminAuditEntry,
+) (dto.AdminAuditEntry, *apperrors.AppError) {
+	entry.ID = int64(len(r.entries) + 1)
+	r.entries = append(r.entries, entry)
+	return entry, nil
+}
+
+func (r *fakeAdminAuditLogRepository) List(
+	_ context.Context,
+	query dto.ListAdminAuditEntriesQuery,
+) ([]dto.AdminAuditEntry, *apperrors.AppError) {
+	out := []dto.AdminAuditEntry{}
+	for index := len(r.entries) - 1; index >= 0; index-- {
+		entry := r.entries[index]
+		if query.BeforeID > 0 && entry.ID >= query.BeforeID {
+			continue
+		}
+		if query.ActorID != "" && entry.ActorID != query.ActorID {
+			continue
+		}
+		out = append(out, entry)
+		if len(out) == query.Limit {
+			break
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,135 @@
+package use_cases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type authenticateAccessTokenUseCase struct {
+	verifier                portsout.AccessTokenVerifier
+	mapping                 dto.AccessTokenClaimMapping
+	defaultMerchantFallback bool
+}
+
+// NewAuthenticateAccessTokenUseCase builds the JWT authenticator. With
+// defaultMerchantFallback a token without the merchant claim acts for the
+// default merchant; otherwise such a token keeps only its ops role.
+func NewAuthenticateAccessTokenUseCase(
+	verifier portsout.AccessTokenVerifier,
+	mapping dto.AccessTokenClaimMapping,
+	defaultMerchantFallback bool,
+) portsin.AuthenticateAccessTokenUseCase {
+	return &authenticateAccessTokenUseCase{
+		verifier:                verifier,
+		mapping:                 mapping,
+		defaultMerchantFallback: defaultMerchantFallback,
+	}
+}
+
+// Execute verifies a bearer JWT and maps its claims onto a principal, a
+// merchant, merchant API scopes and an ops role. Unknown scopes and roles are
+// dropped so an identity provider can share one claim with other services.
+func (u *authenticateAccessTokenUseCase) Execute(
+	ctx context.Context,
+	command dto.AuthenticateAccessTokenCommand,
+) (dto.AccessTokenPrincipal, *apperrors.AppError) {
+	if u.verifier == nil {
+		return dto.AccessTokenPrincipal{}, apperrors.NewInternal(
+			"access_token_verifier_missing",
+			"access token verifier is required",
+			nil,
+		)
+	}
+
+	now := command.Now.UTC()
+	if command.Now.IsZero() {
+		now = time.Now().UTC()
+	}
+	claims, appErr := u.verifier.Verify(ctx, strings.TrimSpace(command.Token), now)
+	if appErr != nil {
+		return dto.AccessTokenPrincipal{}, appErr
+	}
+
+	principalID, _ := claims.Claims[u.mapping.Principal].(string)
+	principalID = strings.TrimSpace(principalID)
+	if principalID == "" {
+		return dto.AccessTokenPrincipal{}, accessTokenClaimInvalid(u.mapping.Principal)
+	}
+
+	merchantID := ""
+	rawMerchantID, hasMerchant := claims.Claims[u.mapping.Merchant]
+	if hasMerchant {
+		value, ok := rawMerchantID.(string)
+		merchantID = policies.NormalizeMerchantID(value)
+		if !ok || !policies.IsValidMerchantID(merchantID) {
+			return dto.AccessTokenPrincipal{}, accessTokenClaimInvalid(u.mapping.Merchant)
+		}
+	} else if u.defaultMerchantFallback {
+		merchantID = policies.DefaultMerchantID
+		hasMerchant = true
+	}
+
+	scopes := []string{}
+	for _, scope := range accessTokenClaimValues(claims.Claims, u.mapping.Scopes) {
+		if policies.IsMerchantAPIKeyScope(scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	roles := accessTokenClaimValues(claims.Claims, u.mapping.OpsRole)
+	for index := range roles {
+		roles[index] = strings.ToLower(roles[index])
+	}
+	opsRole := policies.HighestOpsRole(roles)
+
+	// Without a merchant claim the token names no tenant, so it must not act
+	// for one: it is accepted only for its ops role, with no merchant scopes.
+	if !hasMerchant {
+		if opsRole == "" {
+			return dto.AccessTokenPrincipal{}, accessTokenClaimInvalid(u.mapping.Merchant)
+		}
+		scopes = []string{}
+	}
+
+	return dto.AccessTokenPrincipal{
+		PrincipalID: principalID,
+		MerchantID:  merchantID,
+		Scopes:      scopes,
+		OpsRole:     opsRole,
+	}, nil
+}
+
+// accessTokenClaimValues reads a string claim or an array of strings. A
+// string is split on spaces, which is how OAuth encodes the scope claim.
+func accessTokenClaimValues(claims map[string]any, name string) []string {
+	if name == "" {
+		return nil
+	}
+	values := []string{}
+	switch raw := claims[name].(type) {
+	case string:
+		values = append(values, strings.Fields(raw)...)
+	case []any:
+		for _, item := range raw {
+			if value, ok := item.(string); ok && strings.TrimSpace(value) != "" {
+				values = append(values, strings.TrimSpace(value))
+			}
+		}
+	}
+	return values
+}
+
+func accessTokenClaimInvalid(claim string) *apperrors.AppError {
+	return apperrors.NewValidation(
+		"unauthorized",
+		"access token "+claim+" claim is missing or invalid",
+		nil,
+	)
+}
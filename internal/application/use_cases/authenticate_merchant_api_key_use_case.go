@@ -0,0 +1,98 @@
+package use_cases
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// merchantAPIKeyTouchInterval throttles last_used_at writes so a busy key
+// does not turn every authenticated request into an UPDATE.
+const merchantAPIKeyTouchInterval = time.Minute
+
+type authenticateMerchantAPIKeyUseCase struct {
+	repository portsout.MerchantAPIKeyRepository
+}
+
+func NewAuthenticateMerchantAPIKeyUseCase(
+	repository portsout.MerchantAPIKeyRepository,
+) portsin.AuthenticateMerchantAPIKeyUseCase {
+	return &authenticateMerchantAPIKeyUseCase{repository: repository}
+}
+
+// Execute resolves a bearer token to its principal. Every rejection returns
+// the same validation error so callers cannot probe which part failed.
+func (u *authenticateMerchantAPIKeyUseCase) Execute(
+	ctx context.Context,
+	command dto.AuthenticateMerchantAPIKeyCommand,
+) (dto.MerchantPrincipal, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.MerchantPrincipal{}, merchantAPIKeyRepositoryMissing()
+	}
+
+	token := strings.TrimSpace(command.Token)
+	keyPrefix, ok := parseMerchantAPIKeyPrefix(token)
+	if !ok {
+		return dto.MerchantPrincipal{}, merchantAPIKeyInvalid()
+	}
+
+	credential, found, appErr := u.repository.FindByPrefix(ctx, keyPrefix)
+	if appErr != nil {
+		return dto.MerchantPrincipal{}, appErr
+	}
+	if !found {
+		return dto.MerchantPrincipal{}, merchantAPIKeyInvalid()
+	}
+	if subtle.ConstantTimeCompare([]byte(hashMerchantAPIKey(token)), []byte(credential.KeyHash)) != 1 {
+		return dto.MerchantPrincipal{}, merchantAPIKeyInvalid()
+	}
+
+	now := command.Now.UTC()
+	if command.Now.IsZero() {
+		now = time.Now().UTC()
+	}
+	key := credential.Key
+	if key.RevokedAt != nil || (key.ExpiresAt != nil && !now.Before(*key.ExpiresAt)) {
+		return dto.MerchantPrincipal{}, merchantAPIKeyInvalid()
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= merchantAPIKeyTouchInterval {
+		// Usage tracking is best effort and must not reject a valid key.
+		_ = u.repository.TouchLastUsed(ctx, key.ID, now)
+	}
+
+	return dto.MerchantPrincipal{
+		MerchantID:  key.MerchantID,
+		PrincipalID: key.PrincipalID,
+		KeyID:       key.ID,
+		Scopes:      append([]string(nil), key.Scopes...),
+	}, nil
+}
+
+// parseMerchantAPIKeyPrefix extracts the lookup prefix from
+// ctxk_<prefix>_<secret>.
+func parseMerchantAPIKeyPrefix(token string) (string, bool) {
+	if !strings.HasPrefix(token, policies.MerchantAPIKeyTokenPrefix) {
+		return "", false
+	}
+	keyPrefix, secret, found := strings.Cut(strings.TrimPrefix(token, policies.MerchantAPIKeyTokenPrefix), "_")
+	if !found || len(keyPrefix) != merchantAPIKeyPrefixBytes*2 || secret == "" {
+		return "", false
+	}
+	return keyPrefix, true
+}
+
+func merchantAPIKeyInvalid() *apperrors.AppError {
+	return apperrors.NewValidation(
+		"unauthorized",
+		"merchant api key is invalid, expired or revoked",
+		nil,
+	)
+}
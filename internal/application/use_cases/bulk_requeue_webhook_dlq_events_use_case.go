@@ -0,0 +1,78 @@
+package use_cases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type bulkRequeueWebhookDLQEventsUseCase struct {
+	repository portsout.WebhookOutboxRepository
+}
+
+func NewBulkRequeueWebhookDLQEventsUseCase(
+	repository portsout.WebhookOutboxRepository,
+) portsin.BulkRequeueWebhookDLQEventsUseCase {
+	return &bulkRequeueWebhookDLQEventsUseCase{repository: repository}
+}
+
+func (u *bulkRequeueWebhookDLQEventsUseCase) Execute(
+	ctx context.Context,
+	command dto.BulkRequeueWebhookDLQEventsCommand,
+) (dto.WebhookOutboxBulkMutationOutput, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.WebhookOutboxBulkMutationOutput{}, apperrors.NewInternal(
+			"webhook_outbox_repository_missing",
+			"webhook outbox repository is required",
+			nil,
+		)
+	}
+
+	operatorID := strings.TrimSpace(command.OperatorID)
+	if operatorID == "" {
+		return dto.WebhookOutboxBulkMutationOutput{}, apperrors.NewValidation(
+			"invalid_request",
+			"x_principal_id is required",
+			map[string]any{"field": "x_principal_id"},
+		)
+	}
+	selection, appErr := normalizeWebhookOutboxBulkSelection(command.Selection)
+	if appErr != nil {
+		return dto.WebhookOutboxBulkMutationOutput{}, appErr
+	}
+	maxEvents, appErr := resolveWebhookOutboxBulkMaxEvents(command.MaxEvents)
+	if appErr != nil {
+		return dto.WebhookOutboxBulkMutationOutput{}, appErr
+	}
+
+	now := command.Now.UTC()
+	if command.Now.IsZero() {
+		now = time.Now().UTC()
+	}
+
+	result, appErr := runWebhookOutboxBulkMutation(
+		ctx,
+		maxEvents,
+		func(ctx context.Context, afterID int64, limit int) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError) {
+			return u.repository.RequeueFailedBatch(ctx, selection, afterID, limit, operatorID, now)
+		},
+	)
+	if appErr != nil {
+		return dto.WebhookOutboxBulkMutationOutput{}, appErr
+	}
+
+	return dto.WebhookOutboxBulkMutationOutput{
+		Action:         "requeue",
+		UpdatedCount:   len(result.eventIDs),
+		BatchCount:     result.batchCount,
+		HasMore:        result.hasMore,
+		EventIDs:       result.eventIDs,
+		DeliveryStatus: "pending",
+		UpdatedAt:      now,
+	}, nil
+}
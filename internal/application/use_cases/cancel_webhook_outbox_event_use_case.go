@@ -8,6 +8,7 @@ import (
 	"chaintx/internal/application/dto"
 	portsin "chaintx/internal/application/ports/in"
 	portsout "chaintx/internal/application/ports/out"
+	valueobjects "chaintx/internal/domain/value_objects"
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
@@ -39,6 +40,10 @@ func (u *cancelWebhookOutboxEventUseCase) Execute(
 			map[string]any{"field": "event_id"},
 		)
 	}
+	sink, appErr := valueobjects.NormalizeOutboxSink(command.Sink)
+	if appErr != nil {
+		return dto.CancelWebhookOutboxEventOutput{}, appErr
+	}
 	operatorID := strings.TrimSpace(command.OperatorID)
 	if operatorID == "" {
 		return dto.CancelWebhookOutboxEventOutput{}, apperrors.NewValidation(
@@ -54,7 +59,7 @@ func (u *cancelWebhookOutboxEventUseCase) Execute(
 	}
 
 	lastError := normalizeWebhookManualCancelReason(command.Reason)
-	result, appErr := u.repository.CancelByEventID(ctx, eventID, operatorID, lastError, now)
+	result, appErr := u.repository.CancelByEventID(ctx, eventID, sink, operatorID, lastError, now)
 	if appErr != nil {
 		return dto.CancelWebhookOutboxEventOutput{}, appErr
 	}
@@ -62,7 +67,7 @@ func (u *cancelWebhookOutboxEventUseCase) Execute(
 		return dto.CancelWebhookOutboxEventOutput{}, apperrors.NewNotFound(
 			"webhook_outbox_event_not_found",
 			"webhook outbox event was not found",
-			map[string]any{"event_id": eventID},
+			map[string]any{"event_id": eventID, "sink": sink},
 		)
 	}
 	if !result.Updated {
@@ -71,6 +76,7 @@ func (u *cancelWebhookOutboxEventUseCase) Execute(
 			"webhook outbox event is not cancellable",
 			map[string]any{
 				"event_id":        eventID,
+				"sink":            sink,
 				"delivery_status": result.CurrentStatus,
 			},
 		)
@@ -78,8 +84,9 @@ func (u *cancelWebhookOutboxEventUseCase) Execute(
 
 	return dto.CancelWebhookOutboxEventOutput{
 		EventID:        eventID,
+		Sink:           sink,
 		DeliveryStatus: "failed",
-		LastError:      lastError,
+		LastError:      result.LastError,
 		UpdatedAt:      now,
 	}, nil
 }
@@ -0,0 +1,81 @@
+package use_cases
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type checkPaymentRequestRateLimitUseCase struct {
+	store    portsout.RateLimitBucketStore
+	policy   dto.RateLimitPolicy
+	counters portsout.PaymentRequestLimitCounters
+}
+
+func NewCheckPaymentRequestRateLimitUseCase(
+	store portsout.RateLimitBucketStore,
+	policy dto.RateLimitPolicy,
+	counters portsout.PaymentRequestLimitCounters,
+) portsin.CheckPaymentRequestRateLimitUseCase {
+	return &checkPaymentRequestRateLimitUseCase{
+		store:    store,
+		policy:   policy,
+		counters: counters,
+	}
+}
+
+// Execute takes one token from the caller's bucket. Buckets are keyed by
+// merchant and principal, so a principal holding several API keys shares one
+// budget across them.
+func (u *checkPaymentRequestRateLimitUseCase) Execute(
+	ctx context.Context,
+	command dto.CheckPaymentRequestRateLimitCommand,
+) *apperrors.AppError {
+	if !toRateLimitPolicy(u.policy).Enabled() {
+		return nil
+	}
+	if u.store == nil {
+		return apperrors.NewInternal(
+			"rate_limit_store_missing",
+			"rate limit bucket store is required",
+			nil,
+		)
+	}
+
+	principalID := strings.TrimSpace(command.PrincipalID)
+	if principalID == "" {
+		principalID = defaultPrincipalID
+	}
+	key := policies.NormalizeMerchantID(command.MerchantID) + "/" + principalID
+
+	permit, appErr := u.store.Take(ctx, key, u.policy, command.Now)
+	if appErr != nil {
+		return appErr
+	}
+	if permit.Allowed {
+		return nil
+	}
+
+	if u.counters != nil {
+		u.counters.Record(dto.PaymentRequestLimitOutcomeRateLimited)
+	}
+	return apperrors.NewRateLimited(
+		"rate_limited",
+		"payment request rate limit exceeded",
+		map[string]any{
+			"retry_after_seconds": int64(math.Ceil(permit.RetryAfter.Seconds())),
+			"requests_per_second": u.policy.RatePerSecond,
+			"burst":               u.policy.Burst,
+		},
+	)
+}
+
+func toRateLimitPolicy(policy dto.RateLimitPolicy) policies.RateLimitPolicy {
+	return policies.RateLimitPolicy{RatePerSecond: policy.RatePerSecond, Burst: policy.Burst}
+}
@@ -0,0 +1,196 @@
+package use_cases
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	merchantAPIKeyPrefixBytes      = 8
+	merchantAPIKeySecretBytes      = 32
+	maxMerchantAPIKeyPrincipalLen  = 128
+	maxMerchantAPIKeyLabelLen      = 200
+	maxMerchantAPIKeyExpirySeconds = 5 * 365 * 86400
+)
+
+type createMerchantAPIKeyUseCase struct {
+	repository portsout.MerchantAPIKeyRepository
+}
+
+func NewCreateMerchantAPIKeyUseCase(repository portsout.MerchantAPIKeyRepository) portsin.CreateMerchantAPIKeyUseCase {
+	return &createMerchantAPIKeyUseCase{repository: repository}
+}
+
+func (u *createMerchantAPIKeyUseCase) Execute(
+	ctx context.Context,
+	command dto.CreateMerchantAPIKeyCommand,
+) (dto.CreateMerchantAPIKeyOutput, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.CreateMerchantAPIKeyOutput{}, merchantAPIKeyRepositoryMissing()
+	}
+
+	merchantID := policies.NormalizeMerchantID(command.MerchantID)
+	if !policies.IsValidMerchantID(merchantID) {
+		return dto.CreateMerchantAPIKeyOutput{}, apperrors.NewValidation(
+			"invalid_request",
+			"merchant_id must be a lowercase slug of at most 64 characters",
+			map[string]any{"field": "merchant_id"},
+		)
+	}
+	principalID := strings.TrimSpace(command.PrincipalID)
+	if principalID == "" || len(principalID) > maxMerchantAPIKeyPrincipalLen {
+		return dto.CreateMerchantAPIKeyOutput{}, apperrors.NewValidation(
+			"invalid_request",
+			"principal_id is required and must be at most 128 characters",
+			map[string]any{"field": "principal_id"},
+		)
+	}
+	scopes, appErr := normalizeMerchantAPIKeyScopes(command.Scopes)
+	if appErr != nil {
+		return dto.CreateMerchantAPIKeyOutput{}, appErr
+	}
+	label := strings.TrimSpace(command.Label)
+	if len(label) > maxMerchantAPIKeyLabelLen {
+		return dto.CreateMerchantAPIKeyOutput{}, apperrors.NewValidation(
+			"invalid_request",
+			"label must be at most 200 characters",
+			map[string]any{"field": "label"},
+		)
+	}
+	operatorID := strings.TrimSpace(command.OperatorID)
+	if operatorID == "" {
+		return dto.CreateMerchantAPIKeyOutput{}, apperrors.NewValidation(
+			"invalid_request",
+			"x_principal_id is required",
+			map[string]any{"field": "x_principal_id"},
+		)
+	}
+
+	now := command.Now.UTC()
+	if command.Now.IsZero() {
+		now = time.Now().UTC()
+	}
+	var expiresAt *time.Time
+	if command.ExpiresInSeconds != nil {
+		if *command.ExpiresInSeconds < 1 || *command.ExpiresInSeconds > maxMerchantAPIKeyExpirySeconds {
+			return dto.CreateMerchantAPIKeyOutput{}, apperrors.NewValidation(
+				"invalid_request",
+				"expires_in_seconds must be between 1 and 157680000",
+				map[string]any{"field": "expires_in_seconds"},
+			)
+		}
+		value := now.Add(time.Duration(*command.ExpiresInSeconds) * time.Second)
+		expiresAt = &value
+	}
+
+	keyID, appErr := generateID("key_")
+	if appErr != nil {
+		return dto.CreateMerchantAPIKeyOutput{}, appErr
+	}
+	keyPrefix, appErr := randomHex(merchantAPIKeyPrefixBytes)
+	if appErr != nil {
+		return dto.CreateMerchantAPIKeyOutput{}, appErr
+	}
+	secret, appErr := randomHex(merchantAPIKeySecretBytes)
+	if appErr != nil {
+		return dto.CreateMerchantAPIKeyOutput{}, appErr
+	}
+	token := policies.MerchantAPIKeyTokenPrefix + keyPrefix + "_" + secret
+
+	key := dto.MerchantAPIKey{
+		ID:          keyID,
+		MerchantID:  merchantID,
+		PrincipalID: principalID,
+		KeyPrefix:   keyPrefix,
+		Scopes:      scopes,
+		CreatedBy:   &operatorID,
+		CreatedAt:   now,
+		ExpiresAt:   expiresAt,
+	}
+	if label != "" {
+		key.Label = &label
+	}
+
+	created, appErr := u.repository.Create(ctx, dto.MerchantAPIKeyCredential{
+		Key:     key,
+		KeyHash: hashMerchantAPIKey(token),
+	})
+	if appErr != nil {
+		return dto.CreateMerchantAPIKeyOutput{}, appErr
+	}
+
+	return dto.CreateMerchantAPIKeyOutput{Key: created, APIKey: token}, nil
+}
+
+// normalizeMerchantAPIKeyScopes de-duplicates scopes into a stable order so
+// stored rows compare equal regardless of request ordering.
+func normalizeMerchantAPIKeyScopes(raw []string) ([]string, *apperrors.AppError) {
+	requested := map[string]bool{}
+	for _, value := range raw {
+		scope := strings.ToLower(strings.TrimSpace(value))
+		if !policies.IsMerchantAPIKeyScope(scope) {
+			return nil, apperrors.NewValidation(
+				"invalid_request",
+				"scopes must contain only create, read or admin",
+				map[string]any{"field": "scopes", "scope": value},
+			)
+		}
+		requested[scope] = true
+	}
+	if len(requested) == 0 {
+		return nil, apperrors.NewValidation(
+			"invalid_request",
+			"scopes must not be empty",
+			map[string]any{"field": "scopes"},
+		)
+	}
+
+	scopes := make([]string, 0, len(requested))
+	for _, scope := range []string{
+		policies.MerchantAPIKeyScopeCreate,
+		policies.MerchantAPIKeyScopeRead,
+		policies.MerchantAPIKeyScopeAdmin,
+	} {
+		if requested[scope] {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+// hashMerchantAPIKey digests the full token. Keys carry 256 bits of entropy,
+// so an unsalted SHA-256 is sufficient and keeps lookups cheap.
+func hashMerchantAPIKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(size int) (string, *apperrors.AppError) {
+	randomBytes := make([]byte, size)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", apperrors.NewInternal(
+			"id_generation_failed",
+			"failed to generate random identifier",
+			map[string]any{"error": err.Error()},
+		)
+	}
+	return hex.EncodeToString(randomBytes), nil
+}
+
+func merchantAPIKeyRepositoryMissing() *apperrors.AppError {
+	return apperrors.NewInternal(
+		"merchant_api_key_repository_missing",
+		"merchant api key repository is required",
+		nil,
+	)
+}
@@ -18,6 +18,7 @@ type createRequestHashInput struct {
 	Network             string
 	Asset               string
 	WebhookURL          string
+	WebhookAPIVersion   string
 	ExpectedAmountMinor *string
 	ExpiresInSeconds    int64
 	Metadata            map[string]any
@@ -34,6 +35,9 @@ func hashCreateRequest(input createRequestHashInput) (string, *apperrors.AppErro
 	if input.ExpectedAmountMinor != nil {
 		payload["expected_amount_minor"] = *input.ExpectedAmountMinor
 	}
+	if input.WebhookAPIVersion != "" {
+		payload["webhook_api_version"] = input.WebhookAPIVersion
+	}
 	if len(input.Metadata) > 0 {
 		payload["metadata"] = input.Metadata
 	}
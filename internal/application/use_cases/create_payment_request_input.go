@@ -29,6 +29,8 @@ type normalizedCreatePaymentRequestInput struct {
 	Network             string
 	Asset               string
 	WebhookURL          string
+	WebhookAPIVersion   valueobjects.WebhookAPIVersion
+	RequestedAPIVersion valueobjects.WebhookAPIVersion
 	ExpectedAmountMinor *string
 	ExpiresInSeconds    *int64
 	Metadata            map[string]any
@@ -97,6 +99,19 @@ func (u *createPaymentRequestUseCase) normalizeCommand(command dto.CreatePayment
 			map[string]any{"field": "webhook_url"},
 		)
 	}
+	var requestedAPIVersion valueobjects.WebhookAPIVersion
+	if strings.TrimSpace(command.WebhookAPIVersion) != "" {
+		requestedAPIVersion, appErr = valueobjects.ParseWebhookAPIVersion(command.WebhookAPIVersion)
+		if appErr != nil {
+			return normalizedCreatePaymentRequestInput{}, appErr
+		}
+	}
+	webhookAPIVersion := policies.ResolveWebhookAPIVersion(
+		requestedAPIVersion,
+		webhookHost,
+		u.webhookEndpointPins,
+		u.webhookAPIVersion,
+	)
 
 	var expectedAmountMinor *string
 	if command.ExpectedAmountMinor != nil {
@@ -126,6 +141,8 @@ func (u *createPaymentRequestUseCase) normalizeCommand(command dto.CreatePayment
 		Network:             network,
 		Asset:               asset,
 		WebhookURL:          webhookURL,
+		WebhookAPIVersion:   webhookAPIVersion,
+		RequestedAPIVersion: requestedAPIVersion,
 		ExpectedAmountMinor: expectedAmountMinor,
 		ExpiresInSeconds:    command.ExpiresInSeconds,
 		Metadata:            metadata,
@@ -134,8 +151,8 @@ func (u *createPaymentRequestUseCase) normalizeCommand(command dto.CreatePayment
 	}, nil
 }
 
-func (u *createPaymentRequestUseCase) loadAssetCatalogEntry(ctx context.Context, chain, network, asset string) (dto.AssetCatalogEntry, *apperrors.AppError) {
-	assetEntries, appErr := u.assetCatalogReadModel.ListEnabled(ctx)
+func (u *createPaymentRequestUseCase) loadAssetCatalogEntry(ctx context.Context, merchantID, chain, network, asset string) (dto.AssetCatalogEntry, *apperrors.AppError) {
+	assetEntries, appErr := u.assetCatalogReadModel.ListEnabled(ctx, merchantID)
 	if appErr != nil {
 		return dto.AssetCatalogEntry{}, appErr
 	}
@@ -166,6 +183,7 @@ func (u *createPaymentRequestUseCase) buildPersistenceCommand(
 		Network:             input.Network,
 		Asset:               input.Asset,
 		WebhookURL:          input.WebhookURL,
+		WebhookAPIVersion:   input.RequestedAPIVersion.String(),
 		ExpectedAmountMinor: input.ExpectedAmountMinor,
 		ExpiresInSeconds:    resolvedExpiresInSeconds,
 		Metadata:            input.Metadata,
@@ -191,6 +209,7 @@ func (u *createPaymentRequestUseCase) buildPersistenceCommand(
 		Network:              input.Network,
 		Asset:                input.Asset,
 		WebhookURL:           input.WebhookURL,
+		WebhookAPIVersion:    input.WebhookAPIVersion.String(),
 		ExpectedAmountMinor:  input.ExpectedAmountMinor,
 		Metadata:             input.Metadata,
 		ExpiresAt:            expiresAt,
@@ -198,6 +217,8 @@ func (u *createPaymentRequestUseCase) buildPersistenceCommand(
 		CreatedAt:            createdAt,
 		AssetCatalogSnapshot: assetEntry,
 		AllocationMode:       u.allocationMode,
+		BuildWebhookEvents:   buildPaymentRequestCreatedEvents,
+		CheckOpenQuota:       u.openQuotaCheck(createdAt),
 	}, nil
 }
 
@@ -263,6 +284,7 @@ func normalizeIdempotencyScope(scope dto.IdempotencyScope) dto.IdempotencyScope
 	httpPath = normalizePath(httpPath)
 
 	return dto.IdempotencyScope{
+		MerchantID:  policies.NormalizeMerchantID(scope.MerchantID),
 		PrincipalID: principalID,
 		HTTPMethod:  httpMethod,
 		HTTPPath:    httpPath,
@@ -2,7 +2,9 @@ package use_cases
 
 import (
 	"context"
+	"math"
 	"strings"
+	"time"
 
 	"chaintx/internal/application/dto"
 	portsin "chaintx/internal/application/ports/in"
@@ -11,13 +13,21 @@ import (
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
+// openQuotaDefaultRetryAfter is advertised when no open request has an
+// expiry to wait for, e.g. when every open request is already detected.
+const openQuotaDefaultRetryAfter = 60 * time.Second
+
 type createPaymentRequestUseCase struct {
 	assetCatalogReadModel portsout.AssetCatalogReadModel
 	repository            portsout.PaymentRequestRepository
 	walletGateway         portsout.WalletAllocationGateway
 	allocationMode        string
 	webhookURLAllowList   []string
+	webhookAPIVersion     valueobjects.WebhookAPIVersion
+	webhookEndpointPins   map[string]valueobjects.WebhookAPIVersion
 	clock                 Clock
+	maxOpenRequests       int
+	limitCounters         portsout.PaymentRequestLimitCounters
 }
 
 func NewCreatePaymentRequestUseCase(
@@ -26,6 +36,10 @@ func NewCreatePaymentRequestUseCase(
 	walletGateway portsout.WalletAllocationGateway,
 	clock Clock,
 	webhookURLAllowList []string,
+	webhookAPIVersion valueobjects.WebhookAPIVersion,
+	webhookEndpointPins map[string]valueobjects.WebhookAPIVersion,
+	maxOpenRequests int,
+	limitCounters portsout.PaymentRequestLimitCounters,
 ) portsin.CreatePaymentRequestUseCase {
 	if clock == nil {
 		clock = NewSystemClock()
@@ -37,7 +51,11 @@ func NewCreatePaymentRequestUseCase(
 		walletGateway:         walletGateway,
 		allocationMode:        detectAllocationMode(walletGateway),
 		webhookURLAllowList:   webhookURLAllowList,
+		webhookAPIVersion:     webhookAPIVersion,
+		webhookEndpointPins:   webhookEndpointPins,
 		clock:                 clock,
+		maxOpenRequests:       maxOpenRequests,
+		limitCounters:         limitCounters,
 	}
 }
 
@@ -51,7 +69,7 @@ func (u *createPaymentRequestUseCase) Execute(ctx context.Context, command dto.C
 		return dto.CreatePaymentRequestOutput{}, appErr
 	}
 
-	assetEntry, appErr := u.loadAssetCatalogEntry(ctx, normalizedInput.Chain, normalizedInput.Network, normalizedInput.Asset)
+	assetEntry, appErr := u.loadAssetCatalogEntry(ctx, normalizedInput.IdempotencyScope.MerchantID, normalizedInput.Chain, normalizedInput.Network, normalizedInput.Asset)
 	if appErr != nil {
 		return dto.CreatePaymentRequestOutput{}, appErr
 	}
@@ -65,6 +83,7 @@ func (u *createPaymentRequestUseCase) Execute(ctx context.Context, command dto.C
 	if appErr != nil {
 		return dto.CreatePaymentRequestOutput{}, appErr
 	}
+	result.Resource = withDerivedPaymentInstructions(result.Resource)
 
 	return dto.CreatePaymentRequestOutput(result), nil
 }
@@ -124,6 +143,38 @@ func (u *createPaymentRequestUseCase) resolvePaymentAddress(
 	}, nil
 }
 
+// openQuotaCheck caps the payment requests a principal holds open, since each
+// one permanently consumes a derivation index and stays in the reconciler's
+// open set until it expires. It returns nil when no quota is configured.
+func (u *createPaymentRequestUseCase) openQuotaCheck(now time.Time) dto.CheckOpenPaymentRequestQuotaFunc {
+	if u.maxOpenRequests <= 0 {
+		return nil
+	}
+
+	return func(usage dto.OpenPaymentRequestUsage) *apperrors.AppError {
+		if usage.OpenCount < int64(u.maxOpenRequests) {
+			return nil
+		}
+		if u.limitCounters != nil {
+			u.limitCounters.Record(dto.PaymentRequestLimitOutcomeQuotaExceeded)
+		}
+
+		retryAfter := openQuotaDefaultRetryAfter
+		if usage.EarliestExpiresAt != nil && usage.EarliestExpiresAt.After(now) {
+			retryAfter = usage.EarliestExpiresAt.Sub(now)
+		}
+		return apperrors.NewRateLimited(
+			"open_payment_request_quota_exceeded",
+			"principal has too many open payment requests",
+			map[string]any{
+				"open_requests":       usage.OpenCount,
+				"max_open_requests":   u.maxOpenRequests,
+				"retry_after_seconds": int64(math.Ceil(retryAfter.Seconds())),
+			},
+		)
+	}
+}
+
 type allocationModeProvider interface {
 	Mode() string
 }
@@ -10,6 +10,7 @@ import (
 
 	"chaintx/internal/application/dto"
 	portsout "chaintx/internal/application/ports/out"
+	valueobjects "chaintx/internal/domain/value_objects"
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
@@ -58,6 +59,29 @@ func TestCreatePaymentRequestUseCaseExecuteSuccessWithDefaults(t *testing.T) {
 			if command.IdempotencyExpiresAt.Before(command.ExpiresAt) {
 				t.Fatalf("expected idempotency expiry >= request expiry")
 			}
+			if command.BuildWebhookEvents == nil {
+				t.Fatalf("expected webhook event builder")
+			}
+			events, buildErr := command.BuildWebhookEvents(dto.PaymentRequestResource{
+				ID:                  command.ResourceID,
+				Chain:               command.Chain,
+				CreatedAt:           command.CreatedAt,
+				PaymentInstructions: dto.PaymentInstructions{Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"},
+			})
+			if buildErr != nil {
+				t.Fatalf("expected event build success, got %+v", buildErr)
+			}
+			if len(events) != 1 || events[0].EventType != "payment_request.created" || !events[0].OccurredAt.Equal(command.CreatedAt) {
+				t.Fatalf("unexpected created events: %+v", events)
+			}
+			data, ok := events[0].Data.(dto.WebhookPaymentRequestCreatedData)
+			if !ok || data.PaymentRequest.ID != command.ResourceID {
+				t.Fatalf("unexpected created event data: %+v", events[0].Data)
+			}
+			if paymentURI := data.PaymentRequest.PaymentInstructions.PaymentURI; paymentURI == nil ||
+				*paymentURI != "bitcoin:bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh" {
+				t.Fatalf("expected created event payment uri, got %v", paymentURI)
+			}
 		},
 		result: dto.CreatePaymentRequestPersistenceResult{
 			Resource: dto.PaymentRequestResource{ID: "pr_test", Status: "pending"},
@@ -70,7 +94,7 @@ func TestCreatePaymentRequestUseCaseExecuteSuccessWithDefaults(t *testing.T) {
 		},
 	}
 
-	useCase := NewCreatePaymentRequestUseCase(readModel, repository, walletGateway, clock, testWebhookAllowList)
+	useCase := NewCreatePaymentRequestUseCase(readModel, repository, walletGateway, clock, testWebhookAllowList, "", nil, 0, nil)
 	output, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
 		Chain:      "Bitcoin",
 		Network:    "Mainnet",
@@ -92,12 +116,61 @@ func TestCreatePaymentRequestUseCaseExecuteSuccessWithDefaults(t *testing.T) {
 	}
 }
 
+func TestCreatePaymentRequestUseCaseExecuteUsesMerchantCatalog(t *testing.T) {
+	readModel := fakeAssetCatalogReadModel{
+		entriesByMerchant: map[string][]dto.AssetCatalogEntry{
+			"default": {
+				{Chain: "bitcoin", Network: "mainnet", Asset: "BTC", AddressScheme: "bip84_p2wpkh", DefaultExpiresInSeconds: 3600, WalletAccountID: "wa_default"},
+			},
+			"retail": {
+				{Chain: "bitcoin", Network: "mainnet", Asset: "BTC", AddressScheme: "bip84_p2wpkh", DefaultExpiresInSeconds: 3600, WalletAccountID: "wa_retail"},
+			},
+		},
+	}
+	repository := &fakePaymentRequestRepository{
+		onCreate: func(command dto.CreatePaymentRequestPersistenceCommand) {
+			if command.IdempotencyScope.MerchantID != "retail" {
+				t.Fatalf("expected retail merchant scope, got %q", command.IdempotencyScope.MerchantID)
+			}
+			if command.AssetCatalogSnapshot.WalletAccountID != "wa_retail" {
+				t.Fatalf("expected retail wallet account, got %q", command.AssetCatalogSnapshot.WalletAccountID)
+			}
+		},
+		result: dto.CreatePaymentRequestPersistenceResult{Resource: dto.PaymentRequestResource{ID: "pr_test"}},
+	}
+	walletGateway := &fakeWalletAllocationGateway{
+		result: portsout.DerivedAddress{AddressRaw: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"},
+	}
+
+	useCase := NewCreatePaymentRequestUseCase(readModel, repository, walletGateway, fixedClock{now: time.Now().UTC()}, testWebhookAllowList, "", nil, 0, nil)
+	if _, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
+		IdempotencyScope: dto.IdempotencyScope{MerchantID: "retail", PrincipalID: "merchant_a"},
+		Chain:            "bitcoin",
+		Network:          "mainnet",
+		Asset:            "BTC",
+		WebhookURL:       "https://hooks.example.com/evt",
+	}); appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+
+	_, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
+		IdempotencyScope: dto.IdempotencyScope{MerchantID: "wholesale", PrincipalID: "merchant_b"},
+		Chain:            "bitcoin",
+		Network:          "mainnet",
+		Asset:            "BTC",
+		WebhookURL:       "https://hooks.example.com/evt",
+	})
+	if appErr == nil || appErr.Code != "unsupported_network" {
+		t.Fatalf("expected merchant without catalog rows to be rejected, got %+v", appErr)
+	}
+}
+
 func TestCreatePaymentRequestUseCaseExecuteUnsupportedAsset(t *testing.T) {
 	useCase := NewCreatePaymentRequestUseCase(fakeAssetCatalogReadModel{
 		entries: []dto.AssetCatalogEntry{
 			{Chain: "bitcoin", Network: "mainnet", Asset: "BTC", DefaultExpiresInSeconds: 3600},
 		},
-	}, &fakePaymentRequestRepository{}, &fakeWalletAllocationGateway{}, fixedClock{now: time.Now().UTC()}, testWebhookAllowList)
+	}, &fakePaymentRequestRepository{}, &fakeWalletAllocationGateway{}, fixedClock{now: time.Now().UTC()}, testWebhookAllowList, "", nil, 0, nil)
 
 	_, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
 		Chain:      "bitcoin",
@@ -115,7 +188,7 @@ func TestCreatePaymentRequestUseCaseExecuteUnsupportedAsset(t *testing.T) {
 
 func TestCreatePaymentRequestUseCaseExecuteExpectedAmountValidation(t *testing.T) {
 	amount := "1.25"
-	useCase := NewCreatePaymentRequestUseCase(fakeAssetCatalogReadModel{}, &fakePaymentRequestRepository{}, &fakeWalletAllocationGateway{}, fixedClock{now: time.Now().UTC()}, testWebhookAllowList)
+	useCase := NewCreatePaymentRequestUseCase(fakeAssetCatalogReadModel{}, &fakePaymentRequestRepository{}, &fakeWalletAllocationGateway{}, fixedClock{now: time.Now().UTC()}, testWebhookAllowList, "", nil, 0, nil)
 
 	_, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
 		Chain:               "bitcoin",
@@ -143,6 +216,10 @@ func TestCreatePaymentRequestUseCaseExecuteRequiresWebhookURL(t *testing.T) {
 		&fakeWalletAllocationGateway{},
 		fixedClock{now: time.Now().UTC()},
 		testWebhookAllowList,
+		"",
+		nil,
+		0,
+		nil,
 	)
 
 	_, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
@@ -169,6 +246,10 @@ func TestCreatePaymentRequestUseCaseExecuteRejectsNonAllowlistedWebhookURL(t *te
 		&fakeWalletAllocationGateway{},
 		fixedClock{now: time.Now().UTC()},
 		testWebhookAllowList,
+		"",
+		nil,
+		0,
+		nil,
 	)
 
 	_, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
@@ -186,7 +267,7 @@ func TestCreatePaymentRequestUseCaseExecuteRejectsNonAllowlistedWebhookURL(t *te
 }
 
 func TestCreatePaymentRequestUseCaseExecuteMetadataTooLarge(t *testing.T) {
-	useCase := NewCreatePaymentRequestUseCase(fakeAssetCatalogReadModel{}, &fakePaymentRequestRepository{}, &fakeWalletAllocationGateway{}, fixedClock{now: time.Now().UTC()}, testWebhookAllowList)
+	useCase := NewCreatePaymentRequestUseCase(fakeAssetCatalogReadModel{}, &fakePaymentRequestRepository{}, &fakeWalletAllocationGateway{}, fixedClock{now: time.Now().UTC()}, testWebhookAllowList, "", nil, 0, nil)
 
 	metadata := map[string]any{
 		"blob": strings.Repeat("a", 5000),
@@ -230,7 +311,7 @@ func TestCreatePaymentRequestUseCaseExecuteRejectsGatewayMetadataMismatch(t *tes
 			ChainID:       int64Ptr(1),
 		},
 	}
-	useCase := NewCreatePaymentRequestUseCase(readModel, repository, walletGateway, fixedClock{now: time.Now().UTC()}, testWebhookAllowList)
+	useCase := NewCreatePaymentRequestUseCase(readModel, repository, walletGateway, fixedClock{now: time.Now().UTC()}, testWebhookAllowList, "", nil, 0, nil)
 
 	_, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
 		Chain:      "ethereum",
@@ -276,7 +357,7 @@ func TestCreatePaymentRequestUseCasePassesCatalogChainIDToGateway(t *testing.T)
 		},
 	}
 
-	useCase := NewCreatePaymentRequestUseCase(readModel, repository, walletGateway, fixedClock{now: time.Now().UTC()}, testWebhookAllowList)
+	useCase := NewCreatePaymentRequestUseCase(readModel, repository, walletGateway, fixedClock{now: time.Now().UTC()}, testWebhookAllowList, "", nil, 0, nil)
 	_, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
 		Chain:      "ethereum",
 		Network:    "local",
@@ -291,6 +372,98 @@ func TestCreatePaymentRequestUseCasePassesCatalogChainIDToGateway(t *testing.T)
 	}
 }
 
+func TestCreatePaymentRequestUseCaseResolvesWebhookAPIVersion(t *testing.T) {
+	readModel := fakeAssetCatalogReadModel{
+		entries: []dto.AssetCatalogEntry{
+			{Chain: "bitcoin", Network: "mainnet", Asset: "BTC", AddressScheme: "bip84_p2wpkh", DefaultExpiresInSeconds: 3600},
+		},
+	}
+	pins := map[string]valueobjects.WebhookAPIVersion{
+		"*.partners.example.com": valueobjects.WebhookAPIVersionV2,
+	}
+
+	testCases := []struct {
+		name          string
+		webhookURL    string
+		requested     string
+		expected      string
+		expectHashKey bool
+	}{
+		{name: "service default", webhookURL: "https://hooks.example.com/evt", expected: "v1"},
+		{name: "endpoint pin", webhookURL: "https://a.partners.example.com/evt", expected: "v2"},
+		{name: "explicit request", webhookURL: "https://a.partners.example.com/evt", requested: "V1", expected: "v1", expectHashKey: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var captured dto.CreatePaymentRequestPersistenceCommand
+			repository := &fakePaymentRequestRepository{
+				onCreate: func(command dto.CreatePaymentRequestPersistenceCommand) {
+					captured = command
+				},
+			}
+			useCase := NewCreatePaymentRequestUseCase(
+				readModel,
+				repository,
+				&fakeWalletAllocationGateway{},
+				fixedClock{now: time.Now().UTC()},
+				testWebhookAllowList,
+				valueobjects.WebhookAPIVersionV1,
+				pins,
+				0,
+				nil,
+			)
+
+			_, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
+				Chain:             "bitcoin",
+				Network:           "mainnet",
+				Asset:             "BTC",
+				WebhookURL:        tc.webhookURL,
+				WebhookAPIVersion: tc.requested,
+			})
+			if appErr != nil {
+				t.Fatalf("expected success, got %+v", appErr)
+			}
+			if captured.WebhookAPIVersion != tc.expected {
+				t.Fatalf("expected webhook api version %s, got %s", tc.expected, captured.WebhookAPIVersion)
+			}
+
+			unpinnedHash, hashErr := hashCreateRequest(createRequestHashInput{
+				Chain:            "bitcoin",
+				Network:          "mainnet",
+				Asset:            "BTC",
+				WebhookURL:       tc.webhookURL,
+				ExpiresInSeconds: 3600,
+				Metadata:         map[string]any{},
+			})
+			if hashErr != nil {
+				t.Fatalf("expected no hash error, got %+v", hashErr)
+			}
+			if (captured.RequestHash != unpinnedHash) != tc.expectHashKey {
+				t.Fatalf("expected request hash to include version=%t", tc.expectHashKey)
+			}
+		})
+	}
+}
+
+func TestCreatePaymentRequestUseCaseRejectsUnsupportedWebhookAPIVersion(t *testing.T) {
+	useCase := NewCreatePaymentRequestUseCase(fakeAssetCatalogReadModel{}, &fakePaymentRequestRepository{}, &fakeWalletAllocationGateway{}, fixedClock{now: time.Now().UTC()}, testWebhookAllowList, "", nil, 0, nil)
+
+	_, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
+		Chain:             "bitcoin",
+		Network:           "mainnet",
+		Asset:             "BTC",
+		WebhookURL:        "https://hooks.example.com/evt",
+		WebhookAPIVersion: "v9",
+	})
+	if appErr == nil {
+		t.Fatalf("expected validation error")
+	}
+	if appErr.Code != "invalid_request" {
+		t.Fatalf("expected invalid_request, got %s", appErr.Code)
+	}
+}
+
 func TestHashCreateRequestDeterministicForEquivalentJSON(t *testing.T) {
 	first, appErr := hashCreateRequest(createRequestHashInput{
 		Chain:            "bitcoin",
@@ -328,14 +501,18 @@ func TestHashCreateRequestDeterministicForEquivalentJSON(t *testing.T) {
 }
 
 type fakeAssetCatalogReadModel struct {
-	entries []dto.AssetCatalogEntry
-	appErr  *apperrors.AppError
+	entries           []dto.AssetCatalogEntry
+	entriesByMerchant map[string][]dto.AssetCatalogEntry
+	appErr            *apperrors.AppError
 }
 
-func (f fakeAssetCatalogReadModel) ListEnabled(_ context.Context) ([]dto.AssetCatalogEntry, *apperrors.AppError) {
+func (f fakeAssetCatalogReadModel) ListEnabled(_ context.Context, merchantID string) ([]dto.AssetCatalogEntry, *apperrors.AppError) {
 	if f.appErr != nil {
 		return nil, f.appErr
 	}
+	if f.entriesByMerchant != nil {
+		return f.entriesByMerchant[merchantID], nil
+	}
 
 	return f.entries, nil
 }
@@ -0,0 +1,45 @@
+package use_cases
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type deleteWebhookRetryPolicyUseCase struct {
+	repository portsout.WebhookRetryPolicyRepository
+}
+
+func NewDeleteWebhookRetryPolicyUseCase(repository portsout.WebhookRetryPolicyRepository) portsin.DeleteWebhookRetryPolicyUseCase {
+	return &deleteWebhookRetryPolicyUseCase{repository: repository}
+}
+
+func (u *deleteWebhookRetryPolicyUseCase) Execute(
+	ctx context.Context,
+	command dto.DeleteWebhookRetryPolicyCommand,
+) (dto.DeleteWebhookRetryPolicyOutput, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.DeleteWebhookRetryPolicyOutput{}, webhookRetryPolicyRepositoryMissing()
+	}
+
+	host, appErr := normalizeWebhookRetryPolicyHost(command.DestinationHost)
+	if appErr != nil {
+		return dto.DeleteWebhookRetryPolicyOutput{}, appErr
+	}
+
+	deleted, appErr := u.repository.Delete(ctx, host)
+	if appErr != nil {
+		return dto.DeleteWebhookRetryPolicyOutput{}, appErr
+	}
+	if !deleted {
+		return dto.DeleteWebhookRetryPolicyOutput{}, apperrors.NewNotFound(
+			"webhook_retry_policy_not_found",
+			"webhook retry policy was not found",
+			map[string]any{"destination_host": host},
+		)
+	}
+	return dto.DeleteWebhookRetryPolicyOutput{DestinationHost: host, Deleted: true}, nil
+}
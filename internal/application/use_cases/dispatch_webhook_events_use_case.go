@@ -4,27 +4,58 @@ import (
 	"context"
 	"fmt"
 	"hash/fnv"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
 	"chaintx/internal/application/dto"
 	portsin "chaintx/internal/application/ports/in"
 	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	valueobjects "chaintx/internal/domain/value_objects"
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
 type dispatchWebhookEventsUseCase struct {
-	repository portsout.WebhookOutboxRepository
-	gateway    portsout.WebhookEventGateway
+	repository        portsout.WebhookOutboxRepository
+	gateway           portsout.WebhookEventGateway
+	circuitRepository portsout.WebhookDestinationCircuitRepository
+	retryPolicies     portsout.WebhookRetryPolicyRepository
+	eventSinks        map[string]portsout.EventSink
+	sinks             []string
 }
 
+// NewDispatchWebhookEventsUseCase drains the webhook channel through gateway
+// and each eventSinks channel (keyed by outbox sink name) through its sink.
+// Rows for sinks this dispatcher does not know are left for another worker.
+// retryPolicies, when set, overrides the command's retry settings for hosts
+// that have a stored policy.
 func NewDispatchWebhookEventsUseCase(
 	repository portsout.WebhookOutboxRepository,
 	gateway portsout.WebhookEventGateway,
+	circuitRepository portsout.WebhookDestinationCircuitRepository,
+	retryPolicies portsout.WebhookRetryPolicyRepository,
+	eventSinks map[string]portsout.EventSink,
 ) portsin.DispatchWebhookEventsUseCase {
+	sinks := []string{valueobjects.OutboxSinkWebhook}
+	configuredSinks := make(map[string]portsout.EventSink, len(eventSinks))
+	for name, sink := range eventSinks {
+		if sink == nil || name == valueobjects.OutboxSinkWebhook {
+			continue
+		}
+		configuredSinks[name] = sink
+		sinks = append(sinks, name)
+	}
+	sort.Strings(sinks[1:])
+
 	return &dispatchWebhookEventsUseCase{
-		repository: repository,
-		gateway:    gateway,
+		repository:        repository,
+		gateway:           gateway,
+		circuitRepository: circuitRepository,
+		retryPolicies:     retryPolicies,
+		eventSinks:        configuredSinks,
+		sinks:             sinks,
 	}
 }
 
@@ -99,6 +130,19 @@ func (u *dispatchWebhookEventsUseCase) Execute(
 			map[string]any{"retry_budget": command.RetryBudget},
 		)
 	}
+	if command.Destination.FailureThreshold < 0 ||
+		command.Destination.OpenDuration < 0 ||
+		command.Destination.MaxRequestsPerSecond < 0 {
+		return dto.DispatchWebhookEventsOutput{}, apperrors.NewValidation(
+			"dispatch_webhook_destination_policy_invalid",
+			"dispatch webhook destination policy values must be non-negative",
+			map[string]any{
+				"failure_threshold":       command.Destination.FailureThreshold,
+				"open_duration":           command.Destination.OpenDuration.String(),
+				"max_requests_per_second": command.Destination.MaxRequestsPerSecond,
+			},
+		)
+	}
 	heartbeatInterval, heartbeatIntervalErr := webhookLeaseHeartbeatInterval(command.LeaseDuration)
 	if heartbeatIntervalErr != nil {
 		return dto.DispatchWebhookEventsOutput{}, heartbeatIntervalErr
@@ -117,6 +161,8 @@ func (u *dispatchWebhookEventsUseCase) Execute(
 		command.BatchSize,
 		workerID,
 		leaseUntil,
+		command.OrderedDelivery,
+		u.sinks,
 	)
 	if appErr != nil {
 		return dto.DispatchWebhookEventsOutput{}, appErr
@@ -125,6 +171,12 @@ func (u *dispatchWebhookEventsUseCase) Execute(
 	output := dto.DispatchWebhookEventsOutput{
 		Claimed: len(rows),
 	}
+	retryPolicies, appErr := u.loadRetryPolicies(ctx, rows)
+	if appErr != nil {
+		return output, appErr
+	}
+	guardDestinations := u.circuitRepository != nil && webhookDestinationPolicyEnabled(command.Destination)
+	openDestinations := map[string]time.Time{}
 	for _, row := range rows {
 		destinationURL := strings.TrimSpace(row.DestinationURL)
 		if destinationURL == "" {
@@ -132,6 +184,25 @@ func (u *dispatchWebhookEventsUseCase) Execute(
 			output.Skipped++
 			continue
 		}
+		destinationHost := webhookDestinationHost(row)
+		if guardDestinations && destinationHost != "" {
+			permit, permitErr := u.acquireDestinationPermit(
+				ctx,
+				destinationHost,
+				workerID,
+				command,
+				openDestinations,
+			)
+			if permitErr != nil {
+				return output, permitErr
+			}
+			if !permit.Allowed {
+				if deferErr := u.deferWebhookEvent(ctx, row, workerID, permit, now, &output); deferErr != nil {
+					return output, deferErr
+				}
+				continue
+			}
+		}
 		deliveryAttempt := row.Attempts + 1
 		if deliveryAttempt <= 0 {
 			deliveryAttempt = 1
@@ -153,19 +224,30 @@ func (u *dispatchWebhookEventsUseCase) Execute(
 			)
 		}(row.EventID, row.ID)
 
-		sendOutput, sendErr := u.gateway.SendWebhookEvent(ctx, dto.SendWebhookEventInput{
-			EventID:         row.EventID,
-			EventType:       row.EventType,
-			DeliveryAttempt: deliveryAttempt,
-			DestinationURL:  destinationURL,
-			Payload:         row.Payload,
-		})
+		delivered, healthy, sendOutput, sendErr := u.deliver(ctx, row, destinationURL, deliveryAttempt, &output)
 		stopHeartbeat()
 		<-heartbeatDoneCh
-		heartbeatErr := drainWebhookHeartbeatError(heartbeatErrCh)
-		recordWebhookDeliveryBucket(&output, sendOutput.StatusCode, sendErr)
-		if sendErr == nil && sendOutput.StatusCode >= 200 && sendOutput.StatusCode <= 299 {
-			updated, deliveredErr := u.repository.MarkDelivered(ctx, row.ID, workerID, now)
+		postSendErr := drainWebhookHeartbeatError(heartbeatErrCh)
+		if guardDestinations && destinationHost != "" {
+			circuitErr := u.circuitRepository.RecordDispatchResult(
+				ctx,
+				destinationHost,
+				command.Destination,
+				healthy,
+				time.Now().UTC(),
+			)
+			if postSendErr == nil {
+				postSendErr = circuitErr
+			}
+		}
+		if delivered {
+			updated, deliveredErr := u.repository.MarkDelivered(
+				ctx,
+				row.ID,
+				workerID,
+				sendOutput.ResolvedIP,
+				now,
+			)
 			if deliveredErr != nil {
 				return output, deliveredErr
 			}
@@ -174,23 +256,39 @@ func (u *dispatchWebhookEventsUseCase) Execute(
 			} else {
 				output.Skipped++
 			}
-			if heartbeatErr != nil {
-				return output, heartbeatErr
+			if postSendErr != nil {
+				return output, postSendErr
 			}
 			continue
 		}
 
 		output.Errors++
 		nextAttempts := row.Attempts + 1
-		effectiveMaxAttempts := webhookEffectiveMaxAttempts(row.MaxAttempts, command.RetryBudget)
+		retryPolicy := webhookRetryPolicyForRow(row, command, retryPolicies[destinationHost])
+		effectiveMaxAttempts := webhookEffectiveMaxAttempts(retryPolicy.MaxAttempts, command.RetryBudget)
 		errorMessage := webhookDispatchErrorMessage(sendErr, sendOutput.StatusCode)
-		if nextAttempts >= effectiveMaxAttempts {
+		backoff := webhookRetryBackoffWithJitter(
+			retryPolicy.BackoffCurve,
+			nextAttempts,
+			retryPolicy.InitialBackoff,
+			retryPolicy.MaxBackoff,
+			command.RetryJitterBPS,
+			row.EventID,
+			row.ID,
+		)
+		nextAttemptAt := now.Add(backoff)
+		expired := retryPolicy.Expired(row.CreatedAt, nextAttemptAt)
+		if expired {
+			errorMessage += " (retry max age exceeded)"
+		}
+		if nextAttempts >= effectiveMaxAttempts || expired {
 			updated, markErr := u.repository.MarkFailed(
 				ctx,
 				row.ID,
 				workerID,
 				nextAttempts,
 				errorMessage,
+				sendOutput.ResolvedIP,
 				now,
 			)
 			if markErr != nil {
@@ -198,24 +296,18 @@ func (u *dispatchWebhookEventsUseCase) Execute(
 			}
 			if updated {
 				output.Failed++
+				if expired {
+					output.RetryExpiredCount++
+				}
 			} else {
 				output.Skipped++
 			}
-			if heartbeatErr != nil {
-				return output, heartbeatErr
+			if postSendErr != nil {
+				return output, postSendErr
 			}
 			continue
 		}
 
-		backoff := webhookRetryBackoffWithJitter(
-			nextAttempts,
-			command.InitialBackoff,
-			command.MaxBackoff,
-			command.RetryJitterBPS,
-			row.EventID,
-			row.ID,
-		)
-		nextAttemptAt := now.Add(backoff)
 		updated, markErr := u.repository.MarkRetry(
 			ctx,
 			row.ID,
@@ -223,6 +315,7 @@ func (u *dispatchWebhookEventsUseCase) Execute(
 			nextAttempts,
 			nextAttemptAt,
 			errorMessage,
+			sendOutput.ResolvedIP,
 			now,
 		)
 		if markErr != nil {
@@ -233,8 +326,8 @@ func (u *dispatchWebhookEventsUseCase) Execute(
 		} else {
 			output.Skipped++
 		}
-		if heartbeatErr != nil {
-			return output, heartbeatErr
+		if postSendErr != nil {
+			return output, postSendErr
 		}
 	}
 
@@ -242,6 +335,148 @@ func (u *dispatchWebhookEventsUseCase) Execute(
 	return output, nil
 }
 
+// loadRetryPolicies fetches the stored retry policies for the hosts in one
+// claimed batch, so a batch costs at most one extra query.
+func (u *dispatchWebhookEventsUseCase) loadRetryPolicies(
+	ctx context.Context,
+	rows []dto.PendingWebhookOutboxEvent,
+) (map[string]dto.WebhookRetryPolicy, *apperrors.AppError) {
+	if u.retryPolicies == nil || len(rows) == 0 {
+		return nil, nil
+	}
+	seen := map[string]struct{}{}
+	hosts := make([]string, 0, len(rows))
+	for _, row := range rows {
+		host := webhookDestinationHost(row)
+		if host == "" {
+			continue
+		}
+		if _, exists := seen[host]; exists {
+			continue
+		}
+		seen[host] = struct{}{}
+		hosts = append(hosts, host)
+	}
+	if len(hosts) == 0 {
+		return nil, nil
+	}
+	return u.retryPolicies.ListByDestinationHosts(ctx, hosts)
+}
+
+// deliver sends one claimed row through its channel and reports whether it
+// was accepted and whether the destination should count as healthy.
+func (u *dispatchWebhookEventsUseCase) deliver(
+	ctx context.Context,
+	row dto.PendingWebhookOutboxEvent,
+	destinationURL string,
+	deliveryAttempt int,
+	output *dto.DispatchWebhookEventsOutput,
+) (bool, bool, dto.SendWebhookEventOutput, *apperrors.AppError) {
+	sinkName := strings.TrimSpace(row.Sink)
+	if sinkName == "" || sinkName == valueobjects.OutboxSinkWebhook {
+		sendOutput, sendErr := u.gateway.SendWebhookEvent(ctx, dto.SendWebhookEventInput{
+			MerchantID:      row.MerchantID,
+			EventID:         row.EventID,
+			EventType:       row.EventType,
+			DeliveryAttempt: deliveryAttempt,
+			DestinationURL:  destinationURL,
+			Payload:         row.Payload,
+		})
+		recordWebhookDeliveryBucket(output, sendOutput.StatusCode, sendErr)
+		delivered := sendErr == nil && sendOutput.StatusCode >= 200 && sendOutput.StatusCode <= 299
+		return delivered, webhookDestinationHealthy(sendOutput.StatusCode, sendErr), sendOutput, sendErr
+	}
+
+	sink, exists := u.eventSinks[sinkName]
+	var publishErr *apperrors.AppError
+	if !exists {
+		publishErr = apperrors.NewInternal(
+			"event_sink_not_configured",
+			"event sink is not configured",
+			map[string]any{"sink": sinkName},
+		)
+	} else {
+		publishErr = sink.PublishEvent(ctx, dto.PublishEventInput{
+			EventID:          row.EventID,
+			EventType:        row.EventType,
+			PaymentRequestID: row.PaymentRequestID,
+			Topic:            valueobjects.EventSinkTopicFromDestinationURL(destinationURL),
+			DeliveryAttempt:  deliveryAttempt,
+			Payload:          row.Payload,
+		})
+	}
+	if publishErr != nil {
+		output.SinkErrorCount++
+		return false, false, dto.SendWebhookEventOutput{}, publishErr
+	}
+	output.SinkPublished++
+	return true, true, dto.SendWebhookEventOutput{}, nil
+}
+
+func (u *dispatchWebhookEventsUseCase) acquireDestinationPermit(
+	ctx context.Context,
+	destinationHost string,
+	workerID string,
+	command dto.DispatchWebhookEventsCommand,
+	openDestinations map[string]time.Time,
+) (dto.WebhookDispatchPermit, *apperrors.AppError) {
+	if retryAt, open := openDestinations[destinationHost]; open {
+		return dto.WebhookDispatchPermit{
+			Reason:  policies.WebhookDispatchDeniedCircuitOpen,
+			RetryAt: retryAt,
+		}, nil
+	}
+
+	permit, appErr := u.circuitRepository.AcquireDispatchPermit(
+		ctx,
+		destinationHost,
+		workerID,
+		command.Destination,
+		command.LeaseDuration,
+		time.Now().UTC(),
+	)
+	if appErr != nil {
+		return dto.WebhookDispatchPermit{}, appErr
+	}
+	if !permit.Allowed && permit.Reason == policies.WebhookDispatchDeniedCircuitOpen {
+		openDestinations[destinationHost] = permit.RetryAt
+	}
+	return permit, nil
+}
+
+// deferWebhookEvent releases a claimed event whose destination is not
+// accepting traffic, keeping its attempts count untouched.
+func (u *dispatchWebhookEventsUseCase) deferWebhookEvent(
+	ctx context.Context,
+	row dto.PendingWebhookOutboxEvent,
+	workerID string,
+	permit dto.WebhookDispatchPermit,
+	now time.Time,
+	output *dto.DispatchWebhookEventsOutput,
+) *apperrors.AppError {
+	nextAttemptAt := permit.RetryAt.UTC()
+	if nextAttemptAt.Before(now) {
+		nextAttemptAt = now
+	}
+	updated, appErr := u.repository.DeferDispatch(ctx, row.ID, workerID, nextAttemptAt, now)
+	if appErr != nil {
+		return appErr
+	}
+	if !updated {
+		output.Skipped++
+		return nil
+	}
+
+	output.Deferred++
+	switch permit.Reason {
+	case policies.WebhookDispatchDeniedCircuitOpen:
+		output.CircuitOpenCount++
+	case policies.WebhookDispatchDeniedRateLimited:
+		output.RateLimitedCount++
+	}
+	return nil
+}
+
 func (u *dispatchWebhookEventsUseCase) runLeaseHeartbeat(
 	ctx context.Context,
 	eventID string,
@@ -400,23 +635,66 @@ func recordWebhookDeliveryBucket(
 	}
 }
 
-func webhookRetryBackoff(attempts int, initial time.Duration, max time.Duration) time.Duration {
-	if attempts <= 1 {
-		return initial
+func webhookDestinationPolicyEnabled(policy dto.WebhookDestinationPolicy) bool {
+	return (policy.FailureThreshold > 0 && policy.OpenDuration > 0) || policy.MaxRequestsPerSecond > 0
+}
+
+func webhookDestinationHost(row dto.PendingWebhookOutboxEvent) string {
+	host := strings.ToLower(strings.TrimSpace(row.DestinationHost))
+	if host != "" {
+		return host
 	}
+	parsed, err := url.Parse(strings.TrimSpace(row.DestinationURL))
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Hostname())
+}
 
-	backoff := initial
-	for i := 1; i < attempts; i++ {
-		if backoff >= max {
-			return max
-		}
-		backoff *= 2
-		if backoff > max {
-			return max
+// webhookDestinationHealthy treats any response below 500 (except 429) as a
+// reachable receiver; only transport errors, throttling and 5xx count against
+// the destination circuit.
+func webhookDestinationHealthy(statusCode int, sendErr *apperrors.AppError) bool {
+	if sendErr != nil || statusCode <= 0 {
+		return false
+	}
+	if statusCode == 429 {
+		return false
+	}
+	return statusCode < 500
+}
+
+func webhookRetryBackoff(attempts int, initial time.Duration, max time.Duration) time.Duration {
+	return policies.WebhookRetryBackoff(policies.WebhookBackoffCurveExponential, attempts, initial, max)
+}
+
+// webhookRetryPolicyForRow resolves the retry settings for one failed row: the
+// destination's stored policy when present, otherwise the row's max_attempts
+// with the dispatcher-wide exponential backoff.
+func webhookRetryPolicyForRow(
+	row dto.PendingWebhookOutboxEvent,
+	command dto.DispatchWebhookEventsCommand,
+	stored dto.WebhookRetryPolicy,
+) policies.WebhookRetryPolicy {
+	if stored.MaxAttempts <= 0 {
+		return policies.WebhookRetryPolicy{
+			MaxAttempts:    row.MaxAttempts,
+			BackoffCurve:   policies.WebhookBackoffCurveExponential,
+			InitialBackoff: command.InitialBackoff,
+			MaxBackoff:     command.MaxBackoff,
 		}
 	}
 
-	return backoff
+	policy := policies.WebhookRetryPolicy{
+		MaxAttempts:    stored.MaxAttempts,
+		BackoffCurve:   stored.BackoffCurve,
+		InitialBackoff: time.Duration(stored.InitialBackoffSeconds) * time.Second,
+		MaxBackoff:     time.Duration(stored.MaxBackoffSeconds) * time.Second,
+	}
+	if stored.MaxAgeSeconds != nil {
+		policy.MaxAge = time.Duration(*stored.MaxAgeSeconds) * time.Second
+	}
+	return policy
 }
 
 func webhookEffectiveMaxAttempts(rowMaxAttempts int, retryBudget int) int {
@@ -432,6 +710,7 @@ func webhookEffectiveMaxAttempts(rowMaxAttempts int, retryBudget int) int {
 }
 
 func webhookRetryBackoffWithJitter(
+	curve string,
 	attempts int,
 	initial time.Duration,
 	max time.Duration,
@@ -439,7 +718,7 @@ func webhookRetryBackoffWithJitter(
 	eventID string,
 	rowID int64,
 ) time.Duration {
-	base := webhookRetryBackoff(attempts, initial, max)
+	base := policies.WebhookRetryBackoff(curve, attempts, initial, max)
 	if jitterBPS <= 0 || base <= 0 {
 		return base
 	}
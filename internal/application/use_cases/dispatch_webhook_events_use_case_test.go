@@ -4,11 +4,14 @@ package use_cases
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
@@ -16,6 +19,9 @@ func TestDispatchWebhookEventsUseCaseValidatesInput(t *testing.T) {
 	useCase := NewDispatchWebhookEventsUseCase(
 		&fakeWebhookOutboxRepository{},
 		&fakeWebhookEventGateway{},
+		nil,
+		nil,
+		nil,
 	)
 
 	_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
@@ -30,6 +36,9 @@ func TestDispatchWebhookEventsUseCaseRejectsInvalidRetryJitterBPS(t *testing.T)
 	useCase := NewDispatchWebhookEventsUseCase(
 		&fakeWebhookOutboxRepository{},
 		&fakeWebhookEventGateway{},
+		nil,
+		nil,
+		nil,
 	)
 
 	_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
@@ -53,6 +62,9 @@ func TestDispatchWebhookEventsUseCaseRejectsNegativeRetryBudget(t *testing.T) {
 	useCase := NewDispatchWebhookEventsUseCase(
 		&fakeWebhookOutboxRepository{},
 		&fakeWebhookEventGateway{},
+		nil,
+		nil,
+		nil,
 	)
 
 	_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
@@ -92,7 +104,7 @@ func TestDispatchWebhookEventsUseCaseMarksDeliveredOnSuccess(t *testing.T) {
 			"evt_1": {StatusCode: 204},
 		},
 	}
-	useCase := NewDispatchWebhookEventsUseCase(repo, gateway)
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, nil)
 
 	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
 		Now:            now,
@@ -136,7 +148,7 @@ func TestDispatchWebhookEventsUseCasePassesDeliveryAttempt(t *testing.T) {
 			"evt_10": {StatusCode: 204},
 		},
 	}
-	useCase := NewDispatchWebhookEventsUseCase(repo, gateway)
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, nil)
 
 	_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
 		Now:            now,
@@ -178,7 +190,7 @@ func TestDispatchWebhookEventsUseCaseRetriesOnFailure(t *testing.T) {
 			"evt_2": apperrors.NewInternal("webhook_http_failed", "endpoint timeout", nil),
 		},
 	}
-	useCase := NewDispatchWebhookEventsUseCase(repo, gateway)
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, nil)
 
 	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
 		Now:            now,
@@ -205,6 +217,55 @@ func TestDispatchWebhookEventsUseCaseRetriesOnFailure(t *testing.T) {
 	}
 }
 
+func TestDispatchWebhookEventsUseCaseRecordsResolvedIP(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	repo := &fakeWebhookOutboxRepository{
+		claimed: []dto.PendingWebhookOutboxEvent{
+			{
+				ID:             30,
+				EventID:        "evt_30",
+				EventType:      "payment_request.status_changed",
+				DestinationURL: "https://hooks.example.com/evt_30",
+				Payload:        []byte(`{"event_id":"evt_30"}`),
+				MaxAttempts:    8,
+			},
+			{
+				ID:             31,
+				EventID:        "evt_31",
+				EventType:      "payment_request.status_changed",
+				DestinationURL: "https://hooks.example.com/evt_31",
+				Payload:        []byte(`{"event_id":"evt_31"}`),
+				MaxAttempts:    8,
+			},
+		},
+	}
+	gateway := &fakeWebhookEventGateway{
+		results: map[string]dto.SendWebhookEventOutput{
+			"evt_30": {StatusCode: 204, ResolvedIP: "203.0.113.24"},
+			"evt_31": {StatusCode: 503, ResolvedIP: "203.0.113.25"},
+		},
+	}
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, nil)
+
+	_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
+		Now:            now,
+		BatchSize:      10,
+		WorkerID:       "webhook-worker-a",
+		LeaseDuration:  30 * time.Second,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     60 * time.Second,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if len(repo.delivered) != 1 || repo.delivered[0].resolvedIP != "203.0.113.24" {
+		t.Fatalf("expected delivered resolved ip, got %+v", repo.delivered)
+	}
+	if len(repo.retried) != 1 || repo.retried[0].resolvedIP != "203.0.113.25" {
+		t.Fatalf("expected retried resolved ip, got %+v", repo.retried)
+	}
+}
+
 func TestDispatchWebhookEventsUseCaseFailsWhenRetryBudgetReached(t *testing.T) {
 	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
 	repo := &fakeWebhookOutboxRepository{
@@ -225,7 +286,7 @@ func TestDispatchWebhookEventsUseCaseFailsWhenRetryBudgetReached(t *testing.T) {
 			"evt_20": {StatusCode: 500},
 		},
 	}
-	useCase := NewDispatchWebhookEventsUseCase(repo, gateway)
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, nil)
 
 	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
 		Now:            now,
@@ -267,7 +328,7 @@ func TestDispatchWebhookEventsUseCaseMarksFailedAtMaxAttempts(t *testing.T) {
 			"evt_3": {StatusCode: 500},
 		},
 	}
-	useCase := NewDispatchWebhookEventsUseCase(repo, gateway)
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, nil)
 
 	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
 		Now:            now,
@@ -311,7 +372,7 @@ func TestDispatchWebhookEventsUseCaseCounts4xxBucket(t *testing.T) {
 			"evt_31": {StatusCode: 429},
 		},
 	}
-	useCase := NewDispatchWebhookEventsUseCase(repo, gateway)
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, nil)
 
 	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
 		Now:            now,
@@ -353,7 +414,7 @@ func TestDispatchWebhookEventsUseCaseRenewsLeaseDuringSlowSend(t *testing.T) {
 		},
 		sendDelay: 220 * time.Millisecond,
 	}
-	useCase := NewDispatchWebhookEventsUseCase(repo, gateway)
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, nil)
 
 	_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
 		Now:            now,
@@ -392,7 +453,7 @@ func TestDispatchWebhookEventsUseCaseReturnsRenewLeaseError(t *testing.T) {
 			"evt_5": {StatusCode: 204},
 		},
 	}
-	useCase := NewDispatchWebhookEventsUseCase(repo, gateway)
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, nil)
 
 	_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
 		Now:            now,
@@ -433,7 +494,7 @@ func TestDispatchWebhookEventsUseCaseReturnsLeaseLostWhenRenewReturnsFalse(t *te
 			"evt_6": {StatusCode: 204},
 		},
 	}
-	useCase := NewDispatchWebhookEventsUseCase(repo, gateway)
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, nil)
 
 	_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
 		Now:            now,
@@ -455,6 +516,9 @@ func TestDispatchWebhookEventsUseCaseRejectsLeaseTooSmallForHeartbeat(t *testing
 	useCase := NewDispatchWebhookEventsUseCase(
 		&fakeWebhookOutboxRepository{},
 		&fakeWebhookEventGateway{},
+		nil,
+		nil,
+		nil,
 	)
 
 	_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
@@ -473,9 +537,101 @@ func TestDispatchWebhookEventsUseCaseRejectsLeaseTooSmallForHeartbeat(t *testing
 	}
 }
 
+func TestDispatchWebhookEventsUseCaseAppliesDestinationRetryPolicy(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	maxAge := 3600
+	repo := &fakeWebhookOutboxRepository{
+		claimed: []dto.PendingWebhookOutboxEvent{
+			{
+				ID:             1,
+				EventID:        "evt_linear",
+				DestinationURL: "https://slow.example.com/hooks",
+				Attempts:       2,
+				MaxAttempts:    3,
+				CreatedAt:      now.Add(-10 * time.Minute),
+			},
+			{
+				ID:             2,
+				EventID:        "evt_aged",
+				DestinationURL: "https://slow.example.com/hooks",
+				Attempts:       0,
+				MaxAttempts:    3,
+				CreatedAt:      now.Add(-59*time.Minute - 30*time.Second),
+			},
+			{
+				ID:             3,
+				EventID:        "evt_default",
+				DestinationURL: "https://other.example.com/hooks",
+				Attempts:       2,
+				MaxAttempts:    3,
+				CreatedAt:      now.Add(-10 * time.Minute),
+			},
+		},
+	}
+	gateway := &fakeWebhookEventGateway{
+		results: map[string]dto.SendWebhookEventOutput{
+			"evt_linear":  {StatusCode: 503},
+			"evt_aged":    {StatusCode: 503},
+			"evt_default": {StatusCode: 503},
+		},
+	}
+	retryPolicies := &fakeWebhookRetryPolicyRepository{
+		policies: map[string]dto.WebhookRetryPolicy{
+			"slow.example.com": {
+				DestinationHost:       "slow.example.com",
+				MaxAttempts:           20,
+				BackoffCurve:          policies.WebhookBackoffCurveLinear,
+				InitialBackoffSeconds: 60,
+				MaxBackoffSeconds:     600,
+				MaxAgeSeconds:         &maxAge,
+			},
+		},
+	}
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, retryPolicies, nil)
+
+	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
+		Now:            now,
+		BatchSize:      10,
+		WorkerID:       "webhook-worker-a",
+		LeaseDuration:  30 * time.Second,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     60 * time.Second,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if len(retryPolicies.lookups) != 1 || len(retryPolicies.lookups[0]) != 2 {
+		t.Fatalf("expected one lookup for two distinct hosts, got %+v", retryPolicies.lookups)
+	}
+	if len(repo.retried) != 1 || repo.retried[0].id != 1 {
+		t.Fatalf("expected only evt_linear retried past row max_attempts, got %+v", repo.retried)
+	}
+	if !repo.retried[0].nextAttemptAt.Equal(now.Add(3 * time.Minute)) {
+		t.Fatalf("expected linear backoff of 3m, got %s", repo.retried[0].nextAttemptAt.Sub(now))
+	}
+	if len(repo.failed) != 2 || output.Failed != 2 || output.RetryExpiredCount != 1 {
+		t.Fatalf("expected aged and default rows failed, got failed=%+v output=%+v", repo.failed, output)
+	}
+	for _, failed := range repo.failed {
+		switch failed.id {
+		case 2:
+			if !strings.Contains(failed.lastError, "retry max age exceeded") {
+				t.Fatalf("expected max age error, got %q", failed.lastError)
+			}
+		case 3:
+			if failed.attempts != 3 || strings.Contains(failed.lastError, "max age") {
+				t.Fatalf("expected default policy exhaustion, got %+v", failed)
+			}
+		default:
+			t.Fatalf("unexpected failed row %+v", failed)
+		}
+	}
+}
+
 func TestWebhookRetryBackoffWithJitterDisabledMatchesBase(t *testing.T) {
 	base := webhookRetryBackoff(3, 5*time.Second, 60*time.Second)
 	jittered := webhookRetryBackoffWithJitter(
+		policies.WebhookBackoffCurveExponential,
 		3,
 		5*time.Second,
 		60*time.Second,
@@ -491,6 +647,7 @@ func TestWebhookRetryBackoffWithJitterDisabledMatchesBase(t *testing.T) {
 func TestWebhookRetryBackoffWithJitterWithinBounds(t *testing.T) {
 	base := webhookRetryBackoff(3, 5*time.Second, 60*time.Second)
 	jittered := webhookRetryBackoffWithJitter(
+		policies.WebhookBackoffCurveExponential,
 		3,
 		5*time.Second,
 		60*time.Second,
@@ -505,45 +662,369 @@ func TestWebhookRetryBackoffWithJitterWithinBounds(t *testing.T) {
 	}
 }
 
+func TestDispatchWebhookEventsUseCasePassesOrderedDeliveryToClaim(t *testing.T) {
+	repo := &fakeWebhookOutboxRepository{}
+	useCase := NewDispatchWebhookEventsUseCase(repo, &fakeWebhookEventGateway{}, nil, nil, nil)
+
+	for _, ordered := range []bool{false, true} {
+		_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
+			Now:             time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+			BatchSize:       10,
+			WorkerID:        "webhook-worker-a",
+			LeaseDuration:   30 * time.Second,
+			InitialBackoff:  5 * time.Second,
+			MaxBackoff:      60 * time.Second,
+			OrderedDelivery: ordered,
+		})
+		if appErr != nil {
+			t.Fatalf("expected no error, got %+v", appErr)
+		}
+	}
+
+	if len(repo.claimOrdered) != 2 || repo.claimOrdered[0] || !repo.claimOrdered[1] {
+		t.Fatalf("expected claim ordered flags [false true], got %+v", repo.claimOrdered)
+	}
+}
+
+func TestDispatchWebhookEventsUseCaseDefersOpenCircuitWithoutBurningAttempts(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	openUntil := now.Add(time.Minute)
+	repo := &fakeWebhookOutboxRepository{
+		claimed: []dto.PendingWebhookOutboxEvent{
+			{ID: 1, EventID: "evt_1", DestinationURL: "https://down.example.com/hook", DestinationHost: "down.example.com", Attempts: 2, MaxAttempts: 5},
+			{ID: 2, EventID: "evt_2", DestinationURL: "https://down.example.com/hook", DestinationHost: "down.example.com", Attempts: 0, MaxAttempts: 5},
+			{ID: 3, EventID: "evt_3", DestinationURL: "https://up.example.com/hook", DestinationHost: "up.example.com", Attempts: 0, MaxAttempts: 5},
+		},
+	}
+	gateway := &fakeWebhookEventGateway{}
+	circuits := &fakeWebhookDestinationCircuitRepository{
+		permits: map[string]dto.WebhookDispatchPermit{
+			"down.example.com": {Reason: "circuit_open", RetryAt: openUntil},
+		},
+	}
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, circuits, nil, nil)
+
+	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
+		Now:            now,
+		BatchSize:      10,
+		WorkerID:       "webhook-worker-a",
+		LeaseDuration:  30 * time.Second,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     60 * time.Second,
+		Destination: dto.WebhookDestinationPolicy{
+			FailureThreshold: 3,
+			OpenDuration:     time.Minute,
+		},
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.Sent != 1 || output.Deferred != 2 || output.CircuitOpenCount != 2 || output.RateLimitedCount != 0 {
+		t.Fatalf("expected sent=1 deferred=2 circuit_open=2, got %+v", output)
+	}
+	if len(repo.retried) != 0 || len(repo.failed) != 0 {
+		t.Fatalf("expected no attempts to be recorded for deferred events, got retried=%+v failed=%+v", repo.retried, repo.failed)
+	}
+	if len(repo.deferred) != 2 || !repo.deferred[0].nextAttemptAt.Equal(openUntil) {
+		t.Fatalf("expected two deferrals until %s, got %+v", openUntil, repo.deferred)
+	}
+	if acquired := circuits.acquiredFor("down.example.com"); acquired != 1 {
+		t.Fatalf("expected open circuit to be checked once per cycle, got %d", acquired)
+	}
+	sent := gateway.sentInputs()
+	if len(sent) != 1 || sent[0].EventID != "evt_3" {
+		t.Fatalf("expected only evt_3 to be sent, got %+v", sent)
+	}
+}
+
+func TestDispatchWebhookEventsUseCaseDefersRateLimitedDestination(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	repo := &fakeWebhookOutboxRepository{
+		claimed: []dto.PendingWebhookOutboxEvent{
+			{ID: 1, EventID: "evt_1", DestinationURL: "https://busy.example.com/hook", Attempts: 0, MaxAttempts: 5},
+		},
+	}
+	circuits := &fakeWebhookDestinationCircuitRepository{
+		permits: map[string]dto.WebhookDispatchPermit{
+			"busy.example.com": {Reason: "rate_limited", RetryAt: now.Add(-time.Second)},
+		},
+	}
+	useCase := NewDispatchWebhookEventsUseCase(repo, &fakeWebhookEventGateway{}, circuits, nil, nil)
+
+	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
+		Now:            now,
+		BatchSize:      10,
+		WorkerID:       "webhook-worker-a",
+		LeaseDuration:  30 * time.Second,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     60 * time.Second,
+		Destination:    dto.WebhookDestinationPolicy{MaxRequestsPerSecond: 5},
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.Deferred != 1 || output.RateLimitedCount != 1 || output.Sent != 0 {
+		t.Fatalf("expected deferred=1 rate_limited=1 sent=0, got %+v", output)
+	}
+	if len(repo.deferred) != 1 || !repo.deferred[0].nextAttemptAt.Equal(now) {
+		t.Fatalf("expected deferral to be clamped to now, got %+v", repo.deferred)
+	}
+}
+
+func TestDispatchWebhookEventsUseCaseRecordsDestinationHealth(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	repo := &fakeWebhookOutboxRepository{
+		claimed: []dto.PendingWebhookOutboxEvent{
+			{ID: 1, EventID: "evt_ok", DestinationURL: "https://a.example.com/hook", Attempts: 0, MaxAttempts: 5},
+			{ID: 2, EventID: "evt_4xx", DestinationURL: "https://b.example.com/hook", Attempts: 0, MaxAttempts: 5},
+			{ID: 3, EventID: "evt_429", DestinationURL: "https://c.example.com/hook", Attempts: 0, MaxAttempts: 5},
+			{ID: 4, EventID: "evt_5xx", DestinationURL: "https://d.example.com/hook", Attempts: 0, MaxAttempts: 5},
+			{ID: 5, EventID: "evt_net", DestinationURL: "https://e.example.com/hook", Attempts: 0, MaxAttempts: 5},
+		},
+	}
+	gateway := &fakeWebhookEventGateway{
+		results: map[string]dto.SendWebhookEventOutput{
+			"evt_4xx": {StatusCode: 400},
+			"evt_429": {StatusCode: 429},
+			"evt_5xx": {StatusCode: 503},
+		},
+		errors: map[string]*apperrors.AppError{
+			"evt_net": apperrors.NewInternal("webhook_send_failed", "connection refused", nil),
+		},
+	}
+	circuits := &fakeWebhookDestinationCircuitRepository{}
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, circuits, nil, nil)
+
+	_, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
+		Now:            now,
+		BatchSize:      10,
+		WorkerID:       "webhook-worker-a",
+		LeaseDuration:  30 * time.Second,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     60 * time.Second,
+		Destination:    dto.WebhookDestinationPolicy{FailureThreshold: 3, OpenDuration: time.Minute},
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+
+	expected := map[string]bool{
+		"a.example.com": true,
+		"b.example.com": true,
+		"c.example.com": false,
+		"d.example.com": false,
+		"e.example.com": false,
+	}
+	results := circuits.recordedResults()
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d recorded results, got %+v", len(expected), results)
+	}
+	for host, success := range expected {
+		if results[host] != success {
+			t.Fatalf("expected %s success=%t, got %+v", host, success, results)
+		}
+	}
+}
+
+func TestDispatchWebhookEventsUseCaseSkipsCircuitWhenPolicyDisabled(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	repo := &fakeWebhookOutboxRepository{
+		claimed: []dto.PendingWebhookOutboxEvent{
+			{ID: 1, EventID: "evt_1", DestinationURL: "https://a.example.com/hook", Attempts: 0, MaxAttempts: 5},
+		},
+	}
+	circuits := &fakeWebhookDestinationCircuitRepository{}
+	useCase := NewDispatchWebhookEventsUseCase(repo, &fakeWebhookEventGateway{}, circuits, nil, nil)
+
+	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
+		Now:            now,
+		BatchSize:      10,
+		WorkerID:       "webhook-worker-a",
+		LeaseDuration:  30 * time.Second,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     60 * time.Second,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.Sent != 1 {
+		t.Fatalf("expected sent=1, got %+v", output)
+	}
+	if circuits.acquiredFor("a.example.com") != 0 || len(circuits.recordedResults()) != 0 {
+		t.Fatalf("expected circuit repository to stay untouched")
+	}
+}
+
+func TestDispatchWebhookEventsUseCasePublishesBusRowsToEventSink(t *testing.T) {
+	repo := &fakeWebhookOutboxRepository{
+		claimed: []dto.PendingWebhookOutboxEvent{
+			{
+				ID:               1,
+				EventID:          "evt_1",
+				EventType:        "payment_request.status_changed",
+				PaymentRequestID: "pr_1",
+				Sink:             "webhook",
+				DestinationURL:   "https://hooks.example.com/evt_1",
+				Payload:          []byte(`{"event_id":"evt_1"}`),
+				MaxAttempts:      3,
+			},
+			{
+				ID:               2,
+				EventID:          "evt_1",
+				EventType:        "payment_request.status_changed",
+				PaymentRequestID: "pr_1",
+				Sink:             "kafka",
+				DestinationURL:   "kafka://chaintx.payment_requests",
+				Payload:          []byte(`{"event_id":"evt_1"}`),
+				Attempts:         1,
+				MaxAttempts:      3,
+			},
+		},
+	}
+	gateway := &fakeWebhookEventGateway{
+		results: map[string]dto.SendWebhookEventOutput{
+			"evt_1": {StatusCode: 204},
+		},
+	}
+	sink := &fakeEventSink{}
+	useCase := NewDispatchWebhookEventsUseCase(repo, gateway, nil, nil, map[string]portsout.EventSink{"kafka": sink})
+
+	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
+		Now:            time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC),
+		BatchSize:      10,
+		WorkerID:       "webhook-worker-a",
+		LeaseDuration:  30 * time.Second,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     60 * time.Second,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.Sent != 2 || output.SinkPublished != 1 || output.HTTP2xxCount != 1 {
+		t.Fatalf("expected sent=2 sink_published=1 http_2xx=1, got %+v", output)
+	}
+	if len(gateway.sentInputs()) != 1 {
+		t.Fatalf("expected one webhook send, got %d", len(gateway.sentInputs()))
+	}
+	if len(sink.published) != 1 {
+		t.Fatalf("expected one sink publish, got %d", len(sink.published))
+	}
+	published := sink.published[0]
+	if published.Topic != "chaintx.payment_requests" || published.PaymentRequestID != "pr_1" || published.DeliveryAttempt != 2 {
+		t.Fatalf("unexpected publish input %+v", published)
+	}
+	if len(repo.delivered) != 2 {
+		t.Fatalf("expected both rows delivered, got %+v", repo.delivered)
+	}
+	if len(repo.claimSinks) != 1 || strings.Join(repo.claimSinks[0], ",") != "webhook,kafka" {
+		t.Fatalf("expected claim sinks [webhook kafka], got %+v", repo.claimSinks)
+	}
+}
+
+func TestDispatchWebhookEventsUseCaseRetriesFailedSinkPublish(t *testing.T) {
+	repo := &fakeWebhookOutboxRepository{
+		claimed: []dto.PendingWebhookOutboxEvent{
+			{
+				ID:             1,
+				EventID:        "evt_1",
+				EventType:      "payment_request.status_changed",
+				Sink:           "nats",
+				DestinationURL: "nats://chaintx.payment_requests",
+				Payload:        []byte(`{"event_id":"evt_1"}`),
+				MaxAttempts:    3,
+			},
+			{
+				ID:             2,
+				EventID:        "evt_2",
+				EventType:      "payment_request.status_changed",
+				Sink:           "amqp",
+				DestinationURL: "amqp://chaintx.payment_requests",
+				Payload:        []byte(`{"event_id":"evt_2"}`),
+				MaxAttempts:    3,
+			},
+		},
+	}
+	sink := &fakeEventSink{
+		err: apperrors.NewInternal("event_sink_publish_failed", "event sink publish failed", nil),
+	}
+	useCase := NewDispatchWebhookEventsUseCase(repo, &fakeWebhookEventGateway{}, nil, nil, map[string]portsout.EventSink{"nats": sink})
+
+	output, appErr := useCase.Execute(context.Background(), dto.DispatchWebhookEventsCommand{
+		Now:            time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC),
+		BatchSize:      10,
+		WorkerID:       "webhook-worker-a",
+		LeaseDuration:  30 * time.Second,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     60 * time.Second,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.Retried != 2 || output.SinkErrorCount != 2 || output.SinkPublished != 0 {
+		t.Fatalf("expected retried=2 sink_error=2, got %+v", output)
+	}
+	if len(repo.retried) != 2 || repo.retried[0].attempts != 1 || repo.retried[1].attempts != 1 {
+		t.Fatalf("expected both rows retried with attempts=1, got %+v", repo.retried)
+	}
+}
+
 type fakeWebhookOutboxRepository struct {
 	mu sync.Mutex
 
-	claimed []dto.PendingWebhookOutboxEvent
+	claimed      []dto.PendingWebhookOutboxEvent
+	claimOrdered []bool
+	claimSinks   [][]string
 
 	delivered []fakeWebhookDelivered
 	retried   []fakeWebhookRetried
 	failed    []fakeWebhookFailed
 	renewed   []fakeWebhookRenewal
+	deferred  []fakeWebhookDeferred
 
 	renewErr      *apperrors.AppError
 	renewByIDPass map[int64]bool
 }
 
 type fakeWebhookDelivered struct {
-	id int64
+	id         int64
+	resolvedIP string
 }
 
 type fakeWebhookRetried struct {
-	id       int64
-	attempts int
+	id            int64
+	attempts      int
+	nextAttemptAt time.Time
+	resolvedIP    string
 }
 
 type fakeWebhookFailed struct {
-	id       int64
-	attempts int
+	id        int64
+	attempts  int
+	lastError string
 }
 
 type fakeWebhookRenewal struct {
 	id int64
 }
 
+type fakeWebhookDeferred struct {
+	id            int64
+	nextAttemptAt time.Time
+}
+
 func (f *fakeWebhookOutboxRepository) ClaimPendingForDispatch(
 	_ context.Context,
 	_ time.Time,
 	_ int,
 	_ string,
 	_ time.Time,
+	orderedByPaymentRequest bool,
+	sinks []string,
 ) ([]dto.PendingWebhookOutboxEvent, *apperrors.AppError) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.claimOrdered = append(f.claimOrdered, orderedByPaymentRequest)
+	f.claimSinks = append(f.claimSinks, append([]string(nil), sinks...))
 	return f.claimed, nil
 }
 
@@ -551,11 +1032,12 @@ func (f *fakeWebhookOutboxRepository) MarkDelivered(
 	_ context.Context,
 	id int64,
 	_ string,
+	resolvedIP string,
 	_ time.Time,
 ) (bool, *apperrors.AppError) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	f.delivered = append(f.delivered, fakeWebhookDelivered{id: id})
+	f.delivered = append(f.delivered, fakeWebhookDelivered{id: id, resolvedIP: resolvedIP})
 	return true, nil
 }
 
@@ -564,13 +1046,19 @@ func (f *fakeWebhookOutboxRepository) MarkRetry(
 	id int64,
 	_ string,
 	attempts int,
-	_ time.Time,
+	nextAttemptAt time.Time,
 	_ string,
+	resolvedIP string,
 	_ time.Time,
 ) (bool, *apperrors.AppError) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	f.retried = append(f.retried, fakeWebhookRetried{id: id, attempts: attempts})
+	f.retried = append(f.retried, fakeWebhookRetried{
+		id:            id,
+		attempts:      attempts,
+		nextAttemptAt: nextAttemptAt,
+		resolvedIP:    resolvedIP,
+	})
 	return true, nil
 }
 
@@ -579,12 +1067,13 @@ func (f *fakeWebhookOutboxRepository) MarkFailed(
 	id int64,
 	_ string,
 	attempts int,
+	lastError string,
 	_ string,
 	_ time.Time,
 ) (bool, *apperrors.AppError) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	f.failed = append(f.failed, fakeWebhookFailed{id: id, attempts: attempts})
+	f.failed = append(f.failed, fakeWebhookFailed{id: id, attempts: attempts, lastError: lastError})
 	return true, nil
 }
 
@@ -611,10 +1100,24 @@ func (f *fakeWebhookOutboxRepository) RenewLease(
 	return true, nil
 }
 
+func (f *fakeWebhookOutboxRepository) DeferDispatch(
+	_ context.Context,
+	id int64,
+	_ string,
+	nextAttemptAt time.Time,
+	_ time.Time,
+) (bool, *apperrors.AppError) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deferred = append(f.deferred, fakeWebhookDeferred{id: id, nextAttemptAt: nextAttemptAt})
+	return true, nil
+}
+
 func (f *fakeWebhookOutboxRepository) RequeueFailedByEventID(
 	_ context.Context,
 	_ string,
 	_ string,
+	_ string,
 	_ time.Time,
 ) (dto.WebhookOutboxMutationResult, *apperrors.AppError) {
 	return dto.WebhookOutboxMutationResult{}, nil
@@ -625,11 +1128,35 @@ func (f *fakeWebhookOutboxRepository) CancelByEventID(
 	_ string,
 	_ string,
 	_ string,
+	_ string,
 	_ time.Time,
 ) (dto.WebhookOutboxMutationResult, *apperrors.AppError) {
 	return dto.WebhookOutboxMutationResult{}, nil
 }
 
+func (f *fakeWebhookOutboxRepository) RequeueFailedBatch(
+	_ context.Context,
+	_ dto.WebhookOutboxBulkSelection,
+	_ int64,
+	_ int,
+	_ string,
+	_ time.Time,
+) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError) {
+	return dto.WebhookOutboxBulkMutationBatch{}, nil
+}
+
+func (f *fakeWebhookOutboxRepository) CancelBatch(
+	_ context.Context,
+	_ dto.WebhookOutboxBulkSelection,
+	_ int64,
+	_ int,
+	_ string,
+	_ string,
+	_ time.Time,
+) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError) {
+	return dto.WebhookOutboxBulkMutationBatch{}, nil
+}
+
 func (f *fakeWebhookOutboxRepository) renewCount() int {
 	f.mu.Lock()
 	defer f.mu.Unlock()
@@ -675,3 +1202,124 @@ func (f *fakeWebhookEventGateway) sentInputs() []dto.SendWebhookEventInput {
 	copy(out, f.inputs)
 	return out
 }
+
+type fakeWebhookDestinationCircuitRepository struct {
+	mu       sync.Mutex
+	permits  map[string]dto.WebhookDispatchPermit
+	acquired map[string]int
+	results  map[string]bool
+}
+
+func (f *fakeWebhookDestinationCircuitRepository) AcquireDispatchPermit(
+	_ context.Context,
+	destinationHost string,
+	_ string,
+	_ dto.WebhookDestinationPolicy,
+	_ time.Duration,
+	_ time.Time,
+) (dto.WebhookDispatchPermit, *apperrors.AppError) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.acquired == nil {
+		f.acquired = map[string]int{}
+	}
+	f.acquired[destinationHost]++
+	if permit, exists := f.permits[destinationHost]; exists {
+		return permit, nil
+	}
+	return dto.WebhookDispatchPermit{Allowed: true}, nil
+}
+
+func (f *fakeWebhookDestinationCircuitRepository) RecordDispatchResult(
+	_ context.Context,
+	destinationHost string,
+	_ dto.WebhookDestinationPolicy,
+	success bool,
+	_ time.Time,
+) *apperrors.AppError {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.results == nil {
+		f.results = map[string]bool{}
+	}
+	f.results[destinationHost] = success
+	return nil
+}
+
+func (f *fakeWebhookDestinationCircuitRepository) acquiredFor(destinationHost string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.acquired[destinationHost]
+}
+
+func (f *fakeWebhookDestinationCircuitRepository) recordedResults() map[string]bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make(map[string]bool, len(f.results))
+	for host, success := range f.results {
+		out[host] = success
+	}
+	return out
+}
+
+type fakeEventSink struct {
+	mu        sync.Mutex
+	published []dto.PublishEventInput
+	err       *apperrors.AppError
+}
+
+func (f *fakeEventSink) PublishEvent(_ context.Context, input dto.PublishEventInput) *apperrors.AppError {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, input)
+	return nil
+}
+
+type fakeWebhookRetryPolicyRepository struct {
+	policies map[string]dto.WebhookRetryPolicy
+	lookups  [][]string
+}
+
+func (f *fakeWebhookRetryPolicyRepository) ListByDestinationHosts(
+	_ context.Context,
+	destinationHosts []string,
+) (map[string]dto.WebhookRetryPolicy, *apperrors.AppError) {
+	f.lookups = append(f.lookups, append([]string(nil), destinationHosts...))
+	items := map[string]dto.WebhookRetryPolicy{}
+	for _, host := range destinationHosts {
+		if policy, exists := f.policies[host]; exists {
+			items[host] = policy
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeWebhookRetryPolicyRepository) Get(
+	_ context.Context,
+	destinationHost string,
+) (dto.WebhookRetryPolicy, bool, *apperrors.AppError) {
+	policy, exists := f.policies[destinationHost]
+	return policy, exists, nil
+}
+
+func (f *fakeWebhookRetryPolicyRepository) Upsert(
+	_ context.Context,
+	policy dto.WebhookRetryPolicy,
+) (dto.WebhookRetryPolicy, *apperrors.AppError) {
+	if f.policies == nil {
+		f.policies = map[string]dto.WebhookRetryPolicy{}
+	}
+	f.policies[policy.DestinationHost] = policy
+	return policy, nil
+}
+
+func (f *fakeWebhookRetryPolicyRepository) Delete(_ context.Context, destinationHost string) (bool, *apperrors.AppError) {
+	if _, exists := f.policies[destinationHost]; !exists {
+		return false, nil
+	}
+	delete(f.policies, destinationHost)
+	return true, nil
+}
@@ -0,0 +1,95 @@
+package use_cases
+
+import (
+	"context"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	valueobjects "chaintx/internal/domain/value_objects"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type getPaymentRequestCheckoutUseCase struct {
+	readModel portsout.PaymentRequestReadModel
+	encoder   portsout.QRCodeEncoder
+}
+
+func NewGetPaymentRequestCheckoutUseCase(
+	readModel portsout.PaymentRequestReadModel,
+	encoder portsout.QRCodeEncoder,
+) portsin.GetPaymentRequestCheckoutUseCase {
+	return &getPaymentRequestCheckoutUseCase{readModel: readModel, encoder: encoder}
+}
+
+// Execute resolves the owning merchant from the id, since the payer has no
+// credentials, and then reads the request within that merchant.
+func (u *getPaymentRequestCheckoutUseCase) Execute(
+	ctx context.Context,
+	query dto.GetPaymentRequestCheckoutQuery,
+) (dto.PaymentRequestCheckout, *apperrors.AppError) {
+	if u.readModel == nil {
+		return dto.PaymentRequestCheckout{}, apperrors.NewInternal(
+			"payment_request_read_model_missing",
+			"payment request read model is required",
+			nil,
+		)
+	}
+	if u.encoder == nil {
+		return dto.PaymentRequestCheckout{}, apperrors.NewInternal(
+			"qr_code_encoder_missing",
+			"QR code encoder is required",
+			nil,
+		)
+	}
+
+	id := strings.TrimSpace(query.ID)
+	if id == "" {
+		return dto.PaymentRequestCheckout{}, apperrors.NewValidation(
+			"invalid_request",
+			"payment request id is required",
+			map[string]any{"field": "id"},
+		)
+	}
+	notFound := apperrors.NewNotFound(
+		"payment_request_not_found",
+		"payment request was not found",
+		map[string]any{"id": id},
+	)
+
+	merchantID, found, appErr := u.readModel.GetMerchantID(ctx, id)
+	if appErr != nil {
+		return dto.PaymentRequestCheckout{}, appErr
+	}
+	if !found {
+		return dto.PaymentRequestCheckout{}, notFound
+	}
+	resource, found, appErr := u.readModel.GetByID(ctx, merchantID, id)
+	if appErr != nil {
+		return dto.PaymentRequestCheckout{}, appErr
+	}
+	if !found {
+		return dto.PaymentRequestCheckout{}, notFound
+	}
+
+	resource = withDerivedPaymentInstructions(resource)
+	status := valueobjects.PaymentRequestStatus(resource.Status)
+	checkout := dto.PaymentRequestCheckout{
+		Resource: resource,
+		Open:     status.IsOpen(),
+	}
+	if status != valueobjects.PaymentRequestStatusPending || resource.PaymentInstructions.PaymentURI == nil {
+		checkout.Resource.PaymentInstructions.PaymentURI = nil
+		return checkout, nil
+	}
+
+	checkout.PaymentURI = *resource.PaymentInstructions.PaymentURI
+	svg, appErr := u.encoder.Encode(checkout.PaymentURI, dto.QRCodeFormatSVG)
+	if appErr != nil {
+		return dto.PaymentRequestCheckout{}, appErr
+	}
+	checkout.QRCodeSVG = string(svg)
+
+	return checkout, nil
+}
@@ -0,0 +1,51 @@
+package use_cases
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type getPaymentRequestLimitsOverviewUseCase struct {
+	rateLimit       dto.RateLimitPolicy
+	maxOpenRequests int
+	counters        portsout.PaymentRequestLimitCounters
+}
+
+func NewGetPaymentRequestLimitsOverviewUseCase(
+	rateLimit dto.RateLimitPolicy,
+	maxOpenRequests int,
+	counters portsout.PaymentRequestLimitCounters,
+) portsin.GetPaymentRequestLimitsOverviewUseCase {
+	return &getPaymentRequestLimitsOverviewUseCase{
+		rateLimit:       rateLimit,
+		maxOpenRequests: maxOpenRequests,
+		counters:        counters,
+	}
+}
+
+func (u *getPaymentRequestLimitsOverviewUseCase) Execute(
+	_ context.Context,
+	_ dto.GetPaymentRequestLimitsOverviewQuery,
+) (dto.PaymentRequestLimitsOverview, *apperrors.AppError) {
+	if u.counters == nil {
+		return dto.PaymentRequestLimitsOverview{}, apperrors.NewInternal(
+			"payment_request_limit_counters_missing",
+			"payment request limit counters are required",
+			nil,
+		)
+	}
+
+	return dto.PaymentRequestLimitsOverview{
+		RateLimit: dto.PaymentRequestRateLimitSettings{
+			Enabled:           toRateLimitPolicy(u.rateLimit).Enabled(),
+			RequestsPerSecond: u.rateLimit.RatePerSecond,
+			Burst:             u.rateLimit.Burst,
+		},
+		MaxOpenRequestsPerPrincipal: u.maxOpenRequests,
+		Counters:                    u.counters.Snapshot(),
+	}, nil
+}
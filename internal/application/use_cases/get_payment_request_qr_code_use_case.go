@@ -0,0 +1,101 @@
+package use_cases
+
+import (
+	"context"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+var qrCodeContentTypes = map[string]string{
+	dto.QRCodeFormatPNG: "image/png",
+	dto.QRCodeFormatSVG: "image/svg+xml",
+}
+
+type getPaymentRequestQRCodeUseCase struct {
+	readModel portsout.PaymentRequestReadModel
+	encoder   portsout.QRCodeEncoder
+}
+
+func NewGetPaymentRequestQRCodeUseCase(
+	readModel portsout.PaymentRequestReadModel,
+	encoder portsout.QRCodeEncoder,
+) portsin.GetPaymentRequestQRCodeUseCase {
+	return &getPaymentRequestQRCodeUseCase{readModel: readModel, encoder: encoder}
+}
+
+func (u *getPaymentRequestQRCodeUseCase) Execute(
+	ctx context.Context,
+	query dto.GetPaymentRequestQRCodeQuery,
+) (dto.PaymentRequestQRCode, *apperrors.AppError) {
+	if u.readModel == nil {
+		return dto.PaymentRequestQRCode{}, apperrors.NewInternal(
+			"payment_request_read_model_missing",
+			"payment request read model is required",
+			nil,
+		)
+	}
+	if u.encoder == nil {
+		return dto.PaymentRequestQRCode{}, apperrors.NewInternal(
+			"qr_code_encoder_missing",
+			"QR code encoder is required",
+			nil,
+		)
+	}
+
+	id := strings.TrimSpace(query.ID)
+	if id == "" {
+		return dto.PaymentRequestQRCode{}, apperrors.NewValidation(
+			"invalid_request",
+			"payment request id is required",
+			map[string]any{"field": "id"},
+		)
+	}
+	contentType, ok := qrCodeContentTypes[query.Format]
+	if !ok {
+		return dto.PaymentRequestQRCode{}, apperrors.NewValidation(
+			"invalid_request",
+			"QR code format must be png or svg",
+			map[string]any{"field": "format"},
+		)
+	}
+
+	if appErr := authorizePaymentRequestRead(ctx, u.readModel, query.Caller, id); appErr != nil {
+		return dto.PaymentRequestQRCode{}, appErr
+	}
+
+	resource, found, appErr := u.readModel.GetByID(ctx, callerMerchantID(query.Caller), id)
+	if appErr != nil {
+		return dto.PaymentRequestQRCode{}, appErr
+	}
+	if !found {
+		return dto.PaymentRequestQRCode{}, apperrors.NewNotFound(
+			"payment_request_not_found",
+			"payment request was not found",
+			map[string]any{"id": id},
+		)
+	}
+
+	resource = withDerivedPaymentInstructions(resource)
+	paymentURI := resource.PaymentInstructions.PaymentURI
+	if paymentURI == nil {
+		return dto.PaymentRequestQRCode{}, apperrors.NewNotFound(
+			"payment_uri_not_available",
+			"payment request has no payment URI for its chain",
+			map[string]any{"id": id, "chain": resource.Chain},
+		)
+	}
+	content, appErr := u.encoder.Encode(*paymentURI, query.Format)
+	if appErr != nil {
+		return dto.PaymentRequestQRCode{}, appErr
+	}
+
+	return dto.PaymentRequestQRCode{
+		PaymentURI:  *paymentURI,
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
@@ -41,7 +41,11 @@ func (u *getPaymentRequestSettlementsUseCase) Execute(
 		)
 	}
 
-	settlements, found, appErr := u.readModel.ListSettlementsByPaymentRequestID(ctx, id)
+	if appErr := authorizePaymentRequestRead(ctx, u.readModel, query.Caller, id); appErr != nil {
+		return dto.PaymentRequestSettlementsResource{}, appErr
+	}
+
+	settlements, found, appErr := u.readModel.ListSettlementsByPaymentRequestID(ctx, callerMerchantID(query.Caller), id)
 	if appErr != nil {
 		return dto.PaymentRequestSettlementsResource{}, appErr
 	}
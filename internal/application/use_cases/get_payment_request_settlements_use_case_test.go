@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"chaintx/internal/application/dto"
+	"chaintx/internal/domain/policies"
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
@@ -102,25 +103,121 @@ func TestGetPaymentRequestSettlementsUseCaseExecuteReadModelError(t *testing.T)
 	}
 }
 
+func TestGetPaymentRequestSettlementsUseCaseExecuteScopesToCallerMerchant(t *testing.T) {
+	readModel := stubPaymentRequestReadModelForSettlements{found: true, merchantID: "retail", principalID: "merchant_a"}
+	useCase := NewGetPaymentRequestSettlementsUseCase(readModel)
+
+	for _, caller := range []dto.MerchantPrincipal{
+		{MerchantID: "wholesale", PrincipalID: "ops", KeyID: "key_ops", Scopes: []string{"admin"}},
+		{},
+	} {
+		_, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestSettlementsQuery{
+			ID:     "pr_test",
+			Caller: caller,
+		})
+		if appErr == nil || appErr.Code != "payment_request_not_found" {
+			t.Fatalf("expected payment_request_not_found for caller %+v, got %+v", caller, appErr)
+		}
+	}
+
+	if _, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestSettlementsQuery{
+		ID:     "pr_test",
+		Caller: dto.MerchantPrincipal{MerchantID: "retail", PrincipalID: "merchant_a", KeyID: "key_a", Scopes: []string{"read"}},
+	}); appErr != nil {
+		t.Fatalf("expected owning merchant to be allowed, got %+v", appErr)
+	}
+}
+
+func TestGetPaymentRequestSettlementsUseCaseExecuteScopesToCallerPrincipal(t *testing.T) {
+	readModel := stubPaymentRequestReadModelForSettlements{found: true, principalID: "merchant_a"}
+	useCase := NewGetPaymentRequestSettlementsUseCase(readModel)
+
+	_, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestSettlementsQuery{
+		ID:     "pr_test",
+		Caller: dto.MerchantPrincipal{PrincipalID: "merchant_b", KeyID: "key_b", Scopes: []string{"read"}},
+	})
+	if appErr == nil || appErr.Code != "payment_request_not_found" {
+		t.Fatalf("expected payment_request_not_found for another principal, got %+v", appErr)
+	}
+
+	for _, caller := range []dto.MerchantPrincipal{
+		{PrincipalID: "merchant_a", KeyID: "key_a", Scopes: []string{"read"}},
+		{PrincipalID: "ops", KeyID: "key_ops", Scopes: []string{"admin"}},
+		{},
+	} {
+		if _, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestSettlementsQuery{
+			ID:     "pr_test",
+			Caller: caller,
+		}); appErr != nil {
+			t.Fatalf("expected caller %+v to be allowed, got %+v", caller, appErr)
+		}
+	}
+}
+
 type stubPaymentRequestReadModelForSettlements struct {
-	found       bool
-	settlements []dto.PaymentRequestSettlementResource
-	listErr     *apperrors.AppError
+	found         bool
+	merchantID    string
+	principalID   string
+	settlements   []dto.PaymentRequestSettlementResource
+	statusHistory []dto.PaymentRequestStatusTransition
+	listErr       *apperrors.AppError
 }
 
 func (s stubPaymentRequestReadModelForSettlements) GetByID(
 	_ context.Context,
 	_ string,
+	_ string,
 ) (dto.PaymentRequestResource, bool, *apperrors.AppError) {
 	return dto.PaymentRequestResource{}, false, nil
 }
 
+func (s stubPaymentRequestReadModelForSettlements) GetPrincipalID(
+	_ context.Context,
+	merchantID string,
+	_ string,
+) (string, bool, *apperrors.AppError) {
+	return s.principalID, s.found && s.ownedBy(merchantID), nil
+}
+
+func (s stubPaymentRequestReadModelForSettlements) GetMerchantID(
+	_ context.Context,
+	_ string,
+) (string, bool, *apperrors.AppError) {
+	return policies.NormalizeMerchantID(s.merchantID), s.found, nil
+}
+
 func (s stubPaymentRequestReadModelForSettlements) ListSettlementsByPaymentRequestID(
 	_ context.Context,
+	merchantID string,
 	_ string,
 ) ([]dto.PaymentRequestSettlementResource, bool, *apperrors.AppError) {
 	if s.listErr != nil {
 		return nil, false, s.listErr
 	}
-	return s.settlements, s.found, nil
+	return s.settlements, s.found && s.ownedBy(merchantID), nil
+}
+
+func (s stubPaymentRequestReadModelForSettlements) ListStatusHistoryByPaymentRequestID(
+	_ context.Context,
+	merchantID string,
+	_ string,
+) ([]dto.PaymentRequestStatusTransition, bool, *apperrors.AppError) {
+	if s.listErr != nil {
+		return nil, false, s.listErr
+	}
+	return s.statusHistory, s.found && s.ownedBy(merchantID), nil
+}
+
+func (s stubPaymentRequestReadModelForSettlements) ListEventsAfterSequence(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ int64,
+	_ int,
+) ([]dto.PaymentRequestEvent, bool, *apperrors.AppError) {
+	return nil, s.found, nil
+}
+
+func (s stubPaymentRequestReadModelForSettlements) ownedBy(merchantID string) bool {
+	return policies.NormalizeMerchantID(s.merchantID) == merchantID
 }
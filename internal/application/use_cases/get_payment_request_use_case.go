@@ -2,7 +2,9 @@ package use_cases
 
 import (
 	"context"
+	"strconv"
 	"strings"
+	"time"
 
 	"chaintx/internal/application/dto"
 	portsin "chaintx/internal/application/ports/in"
@@ -10,12 +12,28 @@ import (
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
+const (
+	maxWaitForChange = 60 * time.Second
+	// defaultWaitForChangePollInterval bounds latency when a notification is
+	// missed or no notifier is wired.
+	defaultWaitForChangePollInterval = 5 * time.Second
+)
+
 type getPaymentRequestUseCase struct {
-	readModel portsout.PaymentRequestReadModel
+	readModel    portsout.PaymentRequestReadModel
+	notifier     portsout.PaymentRequestEventNotifier
+	pollInterval time.Duration
 }
 
-func NewGetPaymentRequestUseCase(readModel portsout.PaymentRequestReadModel) portsin.GetPaymentRequestUseCase {
-	return &getPaymentRequestUseCase{readModel: readModel}
+func NewGetPaymentRequestUseCase(
+	readModel portsout.PaymentRequestReadModel,
+	notifier portsout.PaymentRequestEventNotifier,
+) portsin.GetPaymentRequestUseCase {
+	return &getPaymentRequestUseCase{
+		readModel:    readModel,
+		notifier:     notifier,
+		pollInterval: defaultWaitForChangePollInterval,
+	}
 }
 
 func (u *getPaymentRequestUseCase) Execute(ctx context.Context, query dto.GetPaymentRequestQuery) (dto.PaymentRequestResource, *apperrors.AppError) {
@@ -35,18 +53,175 @@ func (u *getPaymentRequestUseCase) Execute(ctx context.Context, query dto.GetPay
 			map[string]any{"field": "id"},
 		)
 	}
+	wait, appErr := parseWaitForChange(query.WaitForChange)
+	if appErr != nil {
+		return dto.PaymentRequestResource{}, appErr
+	}
+	expansion, appErr := parsePaymentRequestExpand(query.Expand)
+	if appErr != nil {
+		return dto.PaymentRequestResource{}, appErr
+	}
+
+	if appErr := authorizePaymentRequestRead(ctx, u.readModel, query.Caller, id); appErr != nil {
+		return dto.PaymentRequestResource{}, appErr
+	}
+	merchantID := callerMerchantID(query.Caller)
 
-	resource, found, appErr := u.readModel.GetByID(ctx, id)
+	// Subscribe before the first read so a change committed in between still
+	// produces a signal.
+	var signals <-chan struct{}
+	if wait > 0 && query.KnownVersion > 0 && u.notifier != nil {
+		subscription, unsubscribe := u.notifier.Subscribe(id)
+		defer unsubscribe()
+		signals = subscription
+	}
+
+	resource, appErr := u.load(ctx, merchantID, id)
 	if appErr != nil {
 		return dto.PaymentRequestResource{}, appErr
 	}
-	if !found {
-		return dto.PaymentRequestResource{}, apperrors.NewNotFound(
-			"payment_request_not_found",
-			"payment request was not found",
-			map[string]any{"id": id},
-		)
+	if wait <= 0 || query.KnownVersion <= 0 || resource.Version != query.KnownVersion {
+		return u.expand(ctx, merchantID, id, resource, expansion)
+	}
+
+	deadline := time.NewTimer(wait)
+	defer deadline.Stop()
+	poll := time.NewTicker(positiveDurationOrDefault(u.pollInterval, defaultWaitForChangePollInterval))
+	defer poll.Stop()
+
+	for resource.Version == query.KnownVersion {
+		select {
+		case <-ctx.Done():
+			return u.expand(ctx, merchantID, id, resource, expansion)
+		case <-deadline.C:
+			return u.expand(ctx, merchantID, id, resource, expansion)
+		case <-signals:
+		case <-poll.C:
+		}
+
+		resource, appErr = u.load(ctx, merchantID, id)
+		if appErr != nil {
+			return dto.PaymentRequestResource{}, appErr
+		}
 	}
 
+	return u.expand(ctx, merchantID, id, resource, expansion)
+}
+
+// expand finishes a read: it derives payment instructions and attaches the
+// requested expansions. The read model always loads reconciliation
+// metadata; it is dropped here unless asked for.
+func (u *getPaymentRequestUseCase) expand(
+	ctx context.Context,
+	merchantID string,
+	id string,
+	resource dto.PaymentRequestResource,
+	expansion paymentRequestExpansion,
+) (dto.PaymentRequestResource, *apperrors.AppError) {
+	if !expansion.reconciliation {
+		resource.Reconciliation = nil
+	}
+	if expansion.statusHistory {
+		history, found, appErr := u.readModel.ListStatusHistoryByPaymentRequestID(ctx, merchantID, id)
+		if appErr != nil {
+			return dto.PaymentRequestResource{}, appErr
+		}
+		if !found {
+			return dto.PaymentRequestResource{}, paymentRequestNotFound(id)
+		}
+		resource.StatusHistory = history
+		if resource.StatusHistory == nil {
+			resource.StatusHistory = []dto.PaymentRequestStatusTransition{}
+		}
+	}
+	if expansion.settlements {
+		settlements, found, appErr := u.readModel.ListSettlementsByPaymentRequestID(ctx, merchantID, id)
+		if appErr != nil {
+			return dto.PaymentRequestResource{}, appErr
+		}
+		if !found {
+			return dto.PaymentRequestResource{}, paymentRequestNotFound(id)
+		}
+		resource.Settlements = settlements
+		if resource.Settlements == nil {
+			resource.Settlements = []dto.PaymentRequestSettlementResource{}
+		}
+	}
+	return withDerivedPaymentInstructions(resource), nil
+}
+
+func (u *getPaymentRequestUseCase) load(ctx context.Context, merchantID string, id string) (dto.PaymentRequestResource, *apperrors.AppError) {
+	resource, found, appErr := u.readModel.GetByID(ctx, merchantID, id)
+	if appErr != nil {
+		return dto.PaymentRequestResource{}, appErr
+	}
+	if !found {
+		return dto.PaymentRequestResource{}, paymentRequestNotFound(id)
+	}
 	return resource, nil
 }
+
+func paymentRequestNotFound(id string) *apperrors.AppError {
+	return apperrors.NewNotFound(
+		"payment_request_not_found",
+		"payment request was not found",
+		map[string]any{"id": id},
+	)
+}
+
+type paymentRequestExpansion struct {
+	statusHistory  bool
+	reconciliation bool
+	settlements    bool
+}
+
+// parsePaymentRequestExpand accepts a comma-separated list of
+// dto.PaymentRequestExpand values; repeats and blank entries are ignored.
+func parsePaymentRequestExpand(raw string) (paymentRequestExpansion, *apperrors.AppError) {
+	expansion := paymentRequestExpansion{}
+	for _, item := range strings.Split(raw, ",") {
+		switch strings.ToLower(strings.TrimSpace(item)) {
+		case "":
+		case dto.PaymentRequestExpandStatusHistory:
+			expansion.statusHistory = true
+		case dto.PaymentRequestExpandReconciliation:
+			expansion.reconciliation = true
+		case dto.PaymentRequestExpandSettlements:
+			expansion.settlements = true
+		default:
+			return paymentRequestExpansion{}, apperrors.NewValidation(
+				"invalid_request",
+				"expand must list status_history, reconciliation or settlements",
+				map[string]any{"field": "expand"},
+			)
+		}
+	}
+	return expansion, nil
+}
+
+// parseWaitForChange accepts a Go duration ("30s") or whole seconds ("30"),
+// up to maxWaitForChange. Empty means no waiting.
+func parseWaitForChange(raw string) (time.Duration, *apperrors.AppError) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return 0, nil
+	}
+
+	wait, err := time.ParseDuration(trimmed)
+	if err != nil {
+		seconds, atoiErr := strconv.Atoi(trimmed)
+		if atoiErr != nil {
+			wait = -1
+		} else {
+			wait = time.Duration(seconds) * time.Second
+		}
+	}
+	if wait < 0 || wait > maxWaitForChange {
+		return 0, apperrors.NewValidation(
+			"invalid_request",
+			"wait_for_change must be a duration between 0s and 60s",
+			map[string]any{"field": "wait_for_change"},
+		)
+	}
+	return wait, nil
+}
@@ -0,0 +1,257 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestGetPaymentRequestUseCaseReturnsWithoutWaiting(t *testing.T) {
+	readModel := newVersionedReadModel(3)
+	notifier := &fakeEventNotifier{signals: make(chan struct{}, 1)}
+	useCase := NewGetPaymentRequestUseCase(readModel, notifier)
+
+	for _, query := range []dto.GetPaymentRequestQuery{
+		{ID: "pr_test", Caller: versionedReadModelCaller},
+		{ID: "pr_test", Caller: versionedReadModelCaller, KnownVersion: 2, WaitForChange: "30s"},
+		{ID: "pr_test", Caller: versionedReadModelCaller, WaitForChange: "30s"},
+	} {
+		resource, appErr := useCase.Execute(context.Background(), query)
+		if appErr != nil {
+			t.Fatalf("expected success for %+v, got %+v", query, appErr)
+		}
+		if resource.Version != 3 || resource.PaymentInstructions.PaymentURI == nil {
+			t.Fatalf("expected version 3 with derived instructions, got %+v", resource)
+		}
+	}
+	if notifier.subscribedID != "" && !notifier.unsubscribed {
+		t.Fatalf("expected the subscription to be released")
+	}
+}
+
+func TestGetPaymentRequestUseCaseOmitsUnsupportedPaymentURI(t *testing.T) {
+	standard := "ERC1155"
+	contract := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	readModel := newVersionedReadModel(1)
+	readModel.resource.Chain = "ethereum"
+	readModel.resource.PaymentInstructions.Address = "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"
+	readModel.resource.PaymentInstructions.TokenStandard = &standard
+	readModel.resource.PaymentInstructions.TokenContract = &contract
+	useCase := NewGetPaymentRequestUseCase(readModel, nil)
+
+	resource, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestQuery{
+		ID:     "pr_test",
+		Caller: versionedReadModelCaller,
+	})
+	if appErr != nil {
+		t.Fatalf("expected the read to succeed, got %+v", appErr)
+	}
+	if resource.PaymentInstructions.PaymentURI != nil {
+		t.Fatalf("expected no payment uri, got %q", *resource.PaymentInstructions.PaymentURI)
+	}
+}
+
+func TestGetPaymentRequestUseCaseRejectsInvalidWait(t *testing.T) {
+	useCase := NewGetPaymentRequestUseCase(newVersionedReadModel(1), nil)
+
+	for _, wait := range []string{"soon", "-1s", "61s", "2m"} {
+		_, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestQuery{
+			ID:            "pr_test",
+			Caller:        versionedReadModelCaller,
+			WaitForChange: wait,
+		})
+		if appErr == nil || appErr.Code != "invalid_request" || appErr.Details["field"] != "wait_for_change" {
+			t.Fatalf("expected invalid wait_for_change for %q, got %+v", wait, appErr)
+		}
+	}
+	if wait, appErr := parseWaitForChange("45"); appErr != nil || wait != 45*time.Second {
+		t.Fatalf("expected whole seconds to parse, got %s %+v", wait, appErr)
+	}
+}
+
+func TestGetPaymentRequestUseCaseWaitsForNotification(t *testing.T) {
+	readModel := newVersionedReadModel(3)
+	notifier := &fakeEventNotifier{signals: make(chan struct{}, 1)}
+	useCase := NewGetPaymentRequestUseCase(readModel, notifier)
+	useCase.(*getPaymentRequestUseCase).pollInterval = time.Hour
+
+	done := make(chan dto.PaymentRequestResource, 1)
+	go func() {
+		resource, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestQuery{
+			ID:            "pr_test",
+			Caller:        versionedReadModelCaller,
+			KnownVersion:  3,
+			WaitForChange: "30s",
+		})
+		if appErr != nil {
+			t.Errorf("expected success, got %+v", appErr)
+		}
+		done <- resource
+	}()
+
+	readModel.waitForReads(t, 1)
+	// A spurious signal re-reads without returning.
+	notifier.signals <- struct{}{}
+	readModel.waitForReads(t, 2)
+	readModel.setVersion(4)
+	notifier.signals <- struct{}{}
+
+	select {
+	case resource := <-done:
+		if resource.Version != 4 {
+			t.Fatalf("expected version 4, got %d", resource.Version)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected notification to end the wait")
+	}
+	if notifier.subscribedID != "pr_test" || !notifier.unsubscribed {
+		t.Fatalf("expected subscribe/unsubscribe for pr_test, got %+v", notifier)
+	}
+}
+
+func TestGetPaymentRequestUseCaseWaitTimesOut(t *testing.T) {
+	readModel := newVersionedReadModel(3)
+	useCase := NewGetPaymentRequestUseCase(readModel, nil)
+
+	started := time.Now()
+	resource, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestQuery{
+		ID:            "pr_test",
+		Caller:        versionedReadModelCaller,
+		KnownVersion:  3,
+		WaitForChange: "50ms",
+	})
+	if appErr != nil {
+		t.Fatalf("expected success, got %+v", appErr)
+	}
+	if resource.Version != 3 || time.Since(started) < 50*time.Millisecond {
+		t.Fatalf("expected unchanged version after the full wait, got %d after %s", resource.Version, time.Since(started))
+	}
+}
+
+func TestGetPaymentRequestUseCasePollsWithoutNotifier(t *testing.T) {
+	readModel := newVersionedReadModel(3)
+	useCase := NewGetPaymentRequestUseCase(readModel, nil)
+	useCase.(*getPaymentRequestUseCase).pollInterval = 10 * time.Millisecond
+
+	go func() {
+		readModel.waitForReads(t, 1)
+		readModel.setVersion(5)
+	}()
+	resource, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestQuery{
+		ID:            "pr_test",
+		Caller:        versionedReadModelCaller,
+		KnownVersion:  3,
+		WaitForChange: "30s",
+	})
+	if appErr != nil {
+		t.Fatalf("expected success, got %+v", appErr)
+	}
+	if resource.Version != 5 {
+		t.Fatalf("expected polled version 5, got %d", resource.Version)
+	}
+}
+
+func TestGetPaymentRequestUseCaseExpands(t *testing.T) {
+	readModel := newCheckoutReadModel("detected")
+	readModel.resource.Reconciliation = &dto.ReconcileTransitionMetadata{ObservedAmountMinor: "150000"}
+	readModel.statusHistory = []dto.PaymentRequestStatusTransition{
+		{FromStatus: "pending", ToStatus: "detected", Reason: "payment_detected"},
+	}
+	useCase := NewGetPaymentRequestUseCase(readModel, nil)
+
+	resource, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestQuery{ID: "pr_test", Caller: versionedReadModelCaller})
+	if appErr != nil {
+		t.Fatalf("expected success, got %+v", appErr)
+	}
+	if resource.Reconciliation != nil || resource.StatusHistory != nil || resource.Settlements != nil {
+		t.Fatalf("expected no expansions by default, got %+v", resource)
+	}
+
+	resource, appErr = useCase.Execute(context.Background(), dto.GetPaymentRequestQuery{
+		ID:     "pr_test",
+		Caller: versionedReadModelCaller,
+		Expand: " status_history,Reconciliation,settlements,,settlements",
+	})
+	if appErr != nil {
+		t.Fatalf("expected success, got %+v", appErr)
+	}
+	if len(resource.StatusHistory) != 1 || resource.StatusHistory[0].Reason != "payment_detected" {
+		t.Fatalf("expected status history, got %+v", resource.StatusHistory)
+	}
+	if resource.Reconciliation == nil || resource.Reconciliation.ObservedAmountMinor != "150000" {
+		t.Fatalf("expected reconciliation, got %+v", resource.Reconciliation)
+	}
+	if resource.Settlements == nil || len(resource.Settlements) != 0 {
+		t.Fatalf("expected empty settlements list, got %+v", resource.Settlements)
+	}
+	if resource.PaymentInstructions.DisplayAmount == nil {
+		t.Fatalf("expected derived instructions on expanded reads")
+	}
+
+	_, appErr = useCase.Execute(context.Background(), dto.GetPaymentRequestQuery{
+		ID:     "pr_test",
+		Caller: versionedReadModelCaller,
+		Expand: "events",
+	})
+	if appErr == nil || appErr.Code != "invalid_request" || appErr.Details["field"] != "expand" {
+		t.Fatalf("expected invalid expand, got %+v", appErr)
+	}
+}
+
+var versionedReadModelCaller = dto.MerchantPrincipal{
+	MerchantID:  "acme",
+	PrincipalID: "merchant_a",
+	KeyID:       "key_a",
+	Scopes:      []string{"read"},
+}
+
+type versionedReadModel struct {
+	checkoutReadModel
+
+	mu      sync.Mutex
+	version int64
+	reads   int
+}
+
+func newVersionedReadModel(version int64) *versionedReadModel {
+	return &versionedReadModel{checkoutReadModel: newCheckoutReadModel("pending"), version: version}
+}
+
+func (m *versionedReadModel) GetByID(
+	ctx context.Context,
+	merchantID string,
+	id string,
+) (dto.PaymentRequestResource, bool, *apperrors.AppError) {
+	resource, found, appErr := m.checkoutReadModel.GetByID(ctx, merchantID, id)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reads++
+	resource.Version = m.version
+	return resource, found, appErr
+}
+
+func (m *versionedReadModel) setVersion(version int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.version = version
+}
+
+func (m *versionedReadModel) waitForReads(t *testing.T, reads int) {
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		m.mu.Lock()
+		current := m.reads
+		m.mu.Unlock()
+		if current >= reads {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Errorf("expected %d reads", reads)
+}
@@ -0,0 +1,71 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type getReconcileHealthOverviewUseCase struct {
+	readModel portsout.ReconcileHealthReadModel
+}
+
+func NewGetReconcileHealthOverviewUseCase(
+	readModel portsout.ReconcileHealthReadModel,
+) portsin.GetReconcileHealthOverviewUseCase {
+	return &getReconcileHealthOverviewUseCase{readModel: readModel}
+}
+
+func (u *getReconcileHealthOverviewUseCase) Execute(
+	ctx context.Context,
+	query dto.GetReconcileHealthOverviewQuery,
+) (dto.ReconcileHealthOverview, *apperrors.AppError) {
+	if u.readModel == nil {
+		return dto.ReconcileHealthOverview{}, apperrors.NewInternal(
+			"reconcile_health_read_model_missing",
+			"reconcile health read model is required",
+			nil,
+		)
+	}
+	if query.DetectedStuckAfter <= 0 {
+		return dto.ReconcileHealthOverview{}, apperrors.NewValidation(
+			"reconcile_health_detected_stuck_after_invalid",
+			"detected stuck duration must be greater than zero",
+			map[string]any{"detected_stuck_after": query.DetectedStuckAfter.String()},
+		)
+	}
+	if query.Window <= 0 {
+		return dto.ReconcileHealthOverview{}, apperrors.NewValidation(
+			"reconcile_health_window_invalid",
+			"reconcile health window must be greater than zero",
+			map[string]any{"window": query.Window.String()},
+		)
+	}
+
+	now := query.Now.UTC()
+	if query.Now.IsZero() {
+		now = time.Now().UTC()
+	}
+
+	overview, appErr := u.readModel.GetReconcileHealthOverview(
+		ctx,
+		now,
+		now.Add(-query.DetectedStuckAfter),
+		now.Add(-query.Window),
+	)
+	if appErr != nil {
+		return dto.ReconcileHealthOverview{}, appErr
+	}
+	overview.ObservationErrorRateBPS = 0
+	if overview.ObservedCount > 0 {
+		overview.ObservationErrorRateBPS = overview.ObservationErrorCount * 10000 / overview.ObservedCount
+	}
+	if overview.ChainTips == nil {
+		overview.ChainTips = []dto.ReconcileChainTip{}
+	}
+	return overview, nil
+}
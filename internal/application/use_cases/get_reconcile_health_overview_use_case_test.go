@@ -0,0 +1,104 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestGetReconcileHealthOverviewUseCaseDerivesCutoffsAndErrorRate(t *testing.T) {
+	now := time.Date(2026, 10, 19, 3, 0, 0, 0, time.UTC)
+	readModel := &fakeReconcileHealthReadModel{
+		overview: dto.ReconcileHealthOverview{
+			DetectedStuckCount:    2,
+			ObservedCount:         8,
+			ObservationErrorCount: 3,
+			ReorgCount:            1,
+		},
+	}
+	useCase := NewGetReconcileHealthOverviewUseCase(readModel)
+
+	overview, appErr := useCase.Execute(context.Background(), dto.GetReconcileHealthOverviewQuery{
+		Now:                now,
+		DetectedStuckAfter: 30 * time.Minute,
+		Window:             15 * time.Minute,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if !readModel.detectedBefore.Equal(now.Add(-30*time.Minute)) || !readModel.windowStart.Equal(now.Add(-15*time.Minute)) {
+		t.Fatalf("unexpected cutoffs detected_before=%s window_start=%s", readModel.detectedBefore, readModel.windowStart)
+	}
+	if overview.ObservationErrorRateBPS != 3750 {
+		t.Fatalf("expected 3750 bps error rate, got %d", overview.ObservationErrorRateBPS)
+	}
+	if overview.ChainTips == nil {
+		t.Fatalf("expected empty chain tips slice, got nil")
+	}
+}
+
+func TestGetReconcileHealthOverviewUseCaseWithoutObservationsHasZeroRate(t *testing.T) {
+	useCase := NewGetReconcileHealthOverviewUseCase(&fakeReconcileHealthReadModel{})
+
+	overview, appErr := useCase.Execute(context.Background(), dto.GetReconcileHealthOverviewQuery{
+		DetectedStuckAfter: time.Minute,
+		Window:             time.Minute,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if overview.ObservationErrorRateBPS != 0 {
+		t.Fatalf("expected zero error rate, got %d", overview.ObservationErrorRateBPS)
+	}
+}
+
+func TestGetReconcileHealthOverviewUseCaseRejectsInvalidDurations(t *testing.T) {
+	useCase := NewGetReconcileHealthOverviewUseCase(&fakeReconcileHealthReadModel{})
+
+	testCases := []struct {
+		name     string
+		query    dto.GetReconcileHealthOverviewQuery
+		expected string
+	}{
+		{
+			name:     "detected stuck after",
+			query:    dto.GetReconcileHealthOverviewQuery{Window: time.Minute},
+			expected: "reconcile_health_detected_stuck_after_invalid",
+		},
+		{
+			name:     "window",
+			query:    dto.GetReconcileHealthOverviewQuery{DetectedStuckAfter: time.Minute},
+			expected: "reconcile_health_window_invalid",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, appErr := useCase.Execute(context.Background(), tc.query)
+			if appErr == nil || appErr.Code != tc.expected {
+				t.Fatalf("expected %s, got %+v", tc.expected, appErr)
+			}
+		})
+	}
+}
+
+type fakeReconcileHealthReadModel struct {
+	overview       dto.ReconcileHealthOverview
+	detectedBefore time.Time
+	windowStart    time.Time
+}
+
+func (f *fakeReconcileHealthReadModel) GetReconcileHealthOverview(
+	_ context.Context,
+	_ time.Time,
+	detectedBefore time.Time,
+	windowStart time.Time,
+) (dto.ReconcileHealthOverview, *apperrors.AppError) {
+	f.detectedBefore = detectedBefore
+	f.windowStart = windowStart
+	return f.overview, nil
+}
@@ -2,6 +2,7 @@ package use_cases
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"chaintx/internal/application/dto"
@@ -10,6 +11,13 @@ import (
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
+const (
+	defaultWebhookOverviewWindow           = time.Hour
+	maxWebhookOverviewWindow               = 7 * 24 * time.Hour
+	defaultWebhookOverviewDestinationLimit = 50
+	maxWebhookOverviewDestinationLimit     = 200
+)
+
 type getWebhookOutboxOverviewUseCase struct {
 	readModel portsout.WebhookOutboxReadModel
 }
@@ -35,5 +43,71 @@ func (u *getWebhookOutboxOverviewUseCase) Execute(
 		now = time.Now().UTC()
 	}
 
-	return u.readModel.GetOverview(ctx, now)
+	groupBy := strings.TrimSpace(query.GroupBy)
+	if groupBy != "" && groupBy != dto.WebhookOutboxOverviewGroupByDestination {
+		return dto.WebhookOutboxOverview{}, apperrors.NewValidation(
+			"invalid_request",
+			"group_by must be destination",
+			map[string]any{"field": "group_by"},
+		)
+	}
+
+	window := query.Window
+	if window == 0 {
+		window = defaultWebhookOverviewWindow
+	}
+	if window < time.Second || window > maxWebhookOverviewWindow {
+		return dto.WebhookOutboxOverview{}, apperrors.NewValidation(
+			"invalid_request",
+			"window_seconds must be between 1 and 604800",
+			map[string]any{"field": "window_seconds"},
+		)
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultWebhookOverviewDestinationLimit
+	}
+	if limit < 1 || limit > maxWebhookOverviewDestinationLimit {
+		return dto.WebhookOutboxOverview{}, apperrors.NewValidation(
+			"invalid_request",
+			"limit must be between 1 and 200",
+			map[string]any{"field": "limit"},
+		)
+	}
+
+	output, appErr := u.readModel.GetOverview(ctx, now)
+	if appErr != nil {
+		return dto.WebhookOutboxOverview{}, appErr
+	}
+	if groupBy == "" {
+		return output, nil
+	}
+
+	destinations, appErr := u.readModel.GetDestinationOverview(ctx, now, now.Add(-window), limit)
+	if appErr != nil {
+		return dto.WebhookOutboxOverview{}, appErr
+	}
+	for i := range destinations {
+		destinations[i].SuccessRateBPS = webhookSuccessRateBPS(
+			destinations[i].DeliveredInWindow,
+			destinations[i].FailedInWindow,
+		)
+	}
+
+	windowSeconds := int64(window / time.Second)
+	output.WindowSeconds = &windowSeconds
+	output.Destinations = destinations
+	return output, nil
+}
+
+// webhookSuccessRateBPS is nil when nothing finished in the window, so an idle
+// destination is not reported as either healthy or failing.
+func webhookSuccessRateBPS(delivered int64, failed int64) *int64 {
+	total := delivered + failed
+	if total <= 0 {
+		return nil
+	}
+	rate := delivered * 10000 / total
+	return &rate
 }
@@ -0,0 +1,45 @@
+package use_cases
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type getWebhookRetryPolicyUseCase struct {
+	repository portsout.WebhookRetryPolicyRepository
+}
+
+func NewGetWebhookRetryPolicyUseCase(repository portsout.WebhookRetryPolicyRepository) portsin.GetWebhookRetryPolicyUseCase {
+	return &getWebhookRetryPolicyUseCase{repository: repository}
+}
+
+func (u *getWebhookRetryPolicyUseCase) Execute(
+	ctx context.Context,
+	query dto.GetWebhookRetryPolicyQuery,
+) (dto.WebhookRetryPolicy, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.WebhookRetryPolicy{}, webhookRetryPolicyRepositoryMissing()
+	}
+
+	host, appErr := normalizeWebhookRetryPolicyHost(query.DestinationHost)
+	if appErr != nil {
+		return dto.WebhookRetryPolicy{}, appErr
+	}
+
+	policy, found, appErr := u.repository.Get(ctx, host)
+	if appErr != nil {
+		return dto.WebhookRetryPolicy{}, appErr
+	}
+	if !found {
+		return dto.WebhookRetryPolicy{}, apperrors.NewNotFound(
+			"webhook_retry_policy_not_found",
+			"webhook retry policy was not found",
+			map[string]any{"destination_host": host},
+		)
+	}
+	return policy, nil
+}
@@ -0,0 +1,73 @@
+package use_cases
+
+import (
+	"context"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	defaultAdminAuditLogLimit = 50
+	maxAdminAuditLogLimit     = 200
+)
+
+type listAdminAuditEntriesUseCase struct {
+	repository portsout.AdminAuditLogRepository
+}
+
+func NewListAdminAuditEntriesUseCase(repository portsout.AdminAuditLogRepository) portsin.ListAdminAuditEntriesUseCase {
+	return &listAdminAuditEntriesUseCase{repository: repository}
+}
+
+func (u *listAdminAuditEntriesUseCase) Execute(
+	ctx context.Context,
+	query dto.ListAdminAuditEntriesQuery,
+) (dto.ListAdminAuditEntriesOutput, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.ListAdminAuditEntriesOutput{}, adminAuditLogRepositoryMissing()
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultAdminAuditLogLimit
+	}
+	if limit < 1 || limit > maxAdminAuditLogLimit {
+		return dto.ListAdminAuditEntriesOutput{}, apperrors.NewValidation(
+			"invalid_request",
+			"limit must be between 1 and 200",
+			map[string]any{"field": "limit"},
+		)
+	}
+	if query.BeforeID < 0 {
+		return dto.ListAdminAuditEntriesOutput{}, apperrors.NewValidation(
+			"invalid_request",
+			"before_id must be positive",
+			map[string]any{"field": "before_id"},
+		)
+	}
+
+	entries, appErr := u.repository.List(ctx, dto.ListAdminAuditEntriesQuery{
+		ActorID:  strings.TrimSpace(query.ActorID),
+		Action:   strings.TrimSpace(query.Action),
+		BeforeID: query.BeforeID,
+		Limit:    limit + 1,
+	})
+	if appErr != nil {
+		return dto.ListAdminAuditEntriesOutput{}, appErr
+	}
+	if entries == nil {
+		entries = []dto.AdminAuditEntry{}
+	}
+
+	output := dto.ListAdminAuditEntriesOutput{Entries: entries}
+	if len(entries) > limit {
+		output.Entries = entries[:limit]
+		nextBeforeID := output.Entries[limit-1].ID
+		output.NextBeforeID = &nextBeforeID
+	}
+	return output, nil
+}
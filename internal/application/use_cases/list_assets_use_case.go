@@ -6,6 +6,7 @@ import (
 	"chaintx/internal/application/dto"
 	portsin "chaintx/internal/application/ports/in"
 	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
@@ -17,7 +18,7 @@ func NewListAssetsUseCase(readModel portsout.AssetCatalogReadModel) portsin.List
 	return &listAssetsUseCase{readModel: readModel}
 }
 
-func (u *listAssetsUseCase) Execute(ctx context.Context, _ dto.ListAssetsQuery) (dto.ListAssetsOutput, *apperrors.AppError) {
+func (u *listAssetsUseCase) Execute(ctx context.Context, query dto.ListAssetsQuery) (dto.ListAssetsOutput, *apperrors.AppError) {
 	if u.readModel == nil {
 		return dto.ListAssetsOutput{}, apperrors.NewInternal(
 			"asset_catalog_read_model_missing",
@@ -26,7 +27,7 @@ func (u *listAssetsUseCase) Execute(ctx context.Context, _ dto.ListAssetsQuery)
 		)
 	}
 
-	assets, appErr := u.readModel.ListEnabled(ctx)
+	assets, appErr := u.readModel.ListEnabled(ctx, policies.NormalizeMerchantID(query.MerchantID))
 	if appErr != nil {
 		return dto.ListAssetsOutput{}, appErr
 	}
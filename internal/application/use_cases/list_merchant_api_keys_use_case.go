@@ -0,0 +1,47 @@
+package use_cases
+
+import (
+	"context"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type listMerchantAPIKeysUseCase struct {
+	repository portsout.MerchantAPIKeyRepository
+}
+
+func NewListMerchantAPIKeysUseCase(repository portsout.MerchantAPIKeyRepository) portsin.ListMerchantAPIKeysUseCase {
+	return &listMerchantAPIKeysUseCase{repository: repository}
+}
+
+func (u *listMerchantAPIKeysUseCase) Execute(
+	ctx context.Context,
+	query dto.ListMerchantAPIKeysQuery,
+) (dto.ListMerchantAPIKeysOutput, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.ListMerchantAPIKeysOutput{}, merchantAPIKeyRepositoryMissing()
+	}
+
+	principalID := strings.TrimSpace(query.PrincipalID)
+	if principalID == "" {
+		return dto.ListMerchantAPIKeysOutput{}, apperrors.NewValidation(
+			"invalid_request",
+			"principal_id is required",
+			map[string]any{"field": "principal_id"},
+		)
+	}
+
+	keys, appErr := u.repository.List(ctx, policies.NormalizeMerchantID(query.MerchantID), principalID)
+	if appErr != nil {
+		return dto.ListMerchantAPIKeysOutput{}, appErr
+	}
+	if keys == nil {
+		keys = []dto.MerchantAPIKey{}
+	}
+	return dto.ListMerchantAPIKeysOutput{Keys: keys}, nil
+}
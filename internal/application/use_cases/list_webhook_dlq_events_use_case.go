@@ -46,10 +46,34 @@ func (u *listWebhookDLQEventsUseCase) Execute(
 		)
 	}
 
-	events, appErr := u.readModel.ListDLQ(ctx, limit)
+	filter, appErr := normalizeWebhookOutboxEventFilter(query.Filter)
 	if appErr != nil {
 		return dto.ListWebhookDLQEventsOutput{}, appErr
 	}
+	cursor, appErr := decodeWebhookDLQCursor(query.Cursor)
+	if appErr != nil {
+		return dto.ListWebhookDLQEventsOutput{}, appErr
+	}
+
+	events, appErr := u.readModel.ListDLQ(ctx, filter, cursor, limit)
+	if appErr != nil {
+		return dto.ListWebhookDLQEventsOutput{}, appErr
+	}
+
+	output := dto.ListWebhookDLQEventsOutput{Events: events}
+	// A full page may have more rows behind it; the caller follows next_cursor
+	// until a short page comes back.
+	if len(events) == limit {
+		last := events[len(events)-1]
+		nextCursor := encodeWebhookDLQCursor(dto.WebhookDLQCursor{
+			UpdatedAt: last.UpdatedAt,
+			ID:        last.ID,
+		})
+		output.NextCursor = &nextCursor
+	}
+	if output.Events == nil {
+		output.Events = []dto.WebhookDLQEvent{}
+	}
 
-	return dto.ListWebhookDLQEventsOutput{Events: events}, nil
+	return output, nil
 }
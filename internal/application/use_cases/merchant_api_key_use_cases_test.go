@@ -0,0 +1,262 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestCreateMerchantAPIKeyUseCaseStoresHashOnly(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	expiresIn := int64(3600)
+	repository := newFakeMerchantAPIKeyRepository()
+	useCase := NewCreateMerchantAPIKeyUseCase(repository)
+
+	output, appErr := useCase.Execute(context.Background(), dto.CreateMerchantAPIKeyCommand{
+		PrincipalID:      " merchant_a ",
+		Scopes:           []string{"read", "Create", "read"},
+		Label:            "checkout backend",
+		ExpiresInSeconds: &expiresIn,
+		OperatorID:       "ops-user-1",
+		Now:              now,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if !strings.HasPrefix(output.APIKey, policies.MerchantAPIKeyTokenPrefix+output.Key.KeyPrefix+"_") {
+		t.Fatalf("expected token to embed key prefix, got %q", output.APIKey)
+	}
+	if output.Key.PrincipalID != "merchant_a" || strings.Join(output.Key.Scopes, ",") != "create,read" {
+		t.Fatalf("expected normalized principal and scopes, got %+v", output.Key)
+	}
+	if output.Key.MerchantID != policies.DefaultMerchantID {
+		t.Fatalf("expected keys without merchant_id to belong to the default merchant, got %q", output.Key.MerchantID)
+	}
+	if output.Key.ExpiresAt == nil || !output.Key.ExpiresAt.Equal(now.Add(time.Hour)) {
+		t.Fatalf("expected expires_at one hour out, got %+v", output.Key.ExpiresAt)
+	}
+
+	stored := repository.byPrefix[output.Key.KeyPrefix]
+	if stored.KeyHash == "" || strings.Contains(stored.KeyHash, output.APIKey) {
+		t.Fatalf("expected only a digest to be stored, got %q", stored.KeyHash)
+	}
+	if stored.KeyHash != hashMerchantAPIKey(output.APIKey) {
+		t.Fatalf("expected stored hash to match token digest")
+	}
+}
+
+func TestCreateMerchantAPIKeyUseCaseValidatesFields(t *testing.T) {
+	valid := dto.CreateMerchantAPIKeyCommand{
+		PrincipalID: "merchant_a",
+		Scopes:      []string{"create"},
+		OperatorID:  "ops-user-1",
+	}
+	zero := int64(0)
+	cases := []struct {
+		name   string
+		mutate func(*dto.CreateMerchantAPIKeyCommand)
+		field  string
+	}{
+		{name: "merchant", mutate: func(c *dto.CreateMerchantAPIKeyCommand) { c.MerchantID = "retail eu" }, field: "merchant_id"},
+		{name: "principal", mutate: func(c *dto.CreateMerchantAPIKeyCommand) { c.PrincipalID = " " }, field: "principal_id"},
+		{name: "empty scopes", mutate: func(c *dto.CreateMerchantAPIKeyCommand) { c.Scopes = nil }, field: "scopes"},
+		{name: "unknown scope", mutate: func(c *dto.CreateMerchantAPIKeyCommand) { c.Scopes = []string{"write"} }, field: "scopes"},
+		{name: "operator", mutate: func(c *dto.CreateMerchantAPIKeyCommand) { c.OperatorID = "" }, field: "x_principal_id"},
+		{name: "expiry", mutate: func(c *dto.CreateMerchantAPIKeyCommand) { c.ExpiresInSeconds = &zero }, field: "expires_in_seconds"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			command := valid
+			tc.mutate(&command)
+			_, appErr := NewCreateMerchantAPIKeyUseCase(newFakeMerchantAPIKeyRepository()).Execute(context.Background(), command)
+			if appErr == nil || appErr.Code != "invalid_request" || appErr.Details["field"] != tc.field {
+				t.Fatalf("expected invalid_request on %s, got %+v", tc.field, appErr)
+			}
+		})
+	}
+}
+
+func TestAuthenticateMerchantAPIKeyUseCase(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	repository := newFakeMerchantAPIKeyRepository()
+	created, appErr := NewCreateMerchantAPIKeyUseCase(repository).Execute(context.Background(), dto.CreateMerchantAPIKeyCommand{
+		MerchantID:  "retail",
+		PrincipalID: "merchant_a",
+		Scopes:      []string{"create", "read"},
+		OperatorID:  "ops-user-1",
+		Now:         now,
+	})
+	if appErr != nil {
+		t.Fatalf("expected key creation, got %+v", appErr)
+	}
+	useCase := NewAuthenticateMerchantAPIKeyUseCase(repository)
+
+	principal, appErr := useCase.Execute(context.Background(), dto.AuthenticateMerchantAPIKeyCommand{
+		Token: created.APIKey,
+		Now:   now,
+	})
+	if appErr != nil {
+		t.Fatalf("expected valid key, got %+v", appErr)
+	}
+	if principal.MerchantID != "retail" || principal.PrincipalID != "merchant_a" || principal.KeyID != created.Key.ID || len(principal.Scopes) != 2 {
+		t.Fatalf("unexpected principal %+v", principal)
+	}
+	if repository.touches != 1 {
+		t.Fatalf("expected last_used_at touch, got %d", repository.touches)
+	}
+
+	if _, appErr := useCase.Execute(context.Background(), dto.AuthenticateMerchantAPIKeyCommand{
+		Token: created.APIKey,
+		Now:   now.Add(10 * time.Second),
+	}); appErr != nil {
+		t.Fatalf("expected valid key, got %+v", appErr)
+	}
+	if repository.touches != 1 {
+		t.Fatalf("expected throttled touch, got %d", repository.touches)
+	}
+
+	tampered := created.APIKey[:len(created.APIKey)-1] + "0"
+	if strings.HasSuffix(created.APIKey, "0") {
+		tampered = created.APIKey[:len(created.APIKey)-1] + "1"
+	}
+	for name, token := range map[string]string{
+		"tampered secret": tampered,
+		"unknown prefix":  policies.MerchantAPIKeyTokenPrefix + "0000000000000000_secret",
+		"ops admin key":   "ops-admin-key",
+		"short prefix":    policies.MerchantAPIKeyTokenPrefix + "abc_secret",
+	} {
+		_, appErr := useCase.Execute(context.Background(), dto.AuthenticateMerchantAPIKeyCommand{Token: token, Now: now})
+		if appErr == nil || appErr.Code != "unauthorized" {
+			t.Fatalf("%s: expected unauthorized, got %+v", name, appErr)
+		}
+	}
+
+	if _, appErr := NewRevokeMerchantAPIKeyUseCase(repository).Execute(context.Background(), dto.RevokeMerchantAPIKeyCommand{
+		KeyID: created.Key.ID,
+		Now:   now,
+	}); appErr != nil {
+		t.Fatalf("expected revoke, got %+v", appErr)
+	}
+	_, appErr = useCase.Execute(context.Background(), dto.AuthenticateMerchantAPIKeyCommand{Token: created.APIKey, Now: now})
+	if appErr == nil || appErr.Code != "unauthorized" {
+		t.Fatalf("expected revoked key to be rejected, got %+v", appErr)
+	}
+}
+
+func TestAuthenticateMerchantAPIKeyUseCaseRejectsExpiredKey(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	expiresIn := int64(60)
+	repository := newFakeMerchantAPIKeyRepository()
+	created, appErr := NewCreateMerchantAPIKeyUseCase(repository).Execute(context.Background(), dto.CreateMerchantAPIKeyCommand{
+		PrincipalID:      "merchant_a",
+		Scopes:           []string{"read"},
+		ExpiresInSeconds: &expiresIn,
+		OperatorID:       "ops-user-1",
+		Now:              now,
+	})
+	if appErr != nil {
+		t.Fatalf("expected key creation, got %+v", appErr)
+	}
+
+	_, appErr = NewAuthenticateMerchantAPIKeyUseCase(repository).Execute(context.Background(), dto.AuthenticateMerchantAPIKeyCommand{
+		Token: created.APIKey,
+		Now:   now.Add(time.Minute),
+	})
+	if appErr == nil || appErr.Code != "unauthorized" {
+		t.Fatalf("expected expired key to be rejected, got %+v", appErr)
+	}
+}
+
+func TestRevokeMerchantAPIKeyUseCaseNotFound(t *testing.T) {
+	_, appErr := NewRevokeMerchantAPIKeyUseCase(newFakeMerchantAPIKeyRepository()).Execute(
+		context.Background(),
+		dto.RevokeMerchantAPIKeyCommand{KeyID: "key_missing"},
+	)
+	if appErr == nil || appErr.Code != "merchant_api_key_not_found" {
+		t.Fatalf("expected merchant_api_key_not_found, got %+v", appErr)
+	}
+}
+
+func TestListMerchantAPIKeysUseCaseRequiresPrincipal(t *testing.T) {
+	useCase := NewListMerchantAPIKeysUseCase(newFakeMerchantAPIKeyRepository())
+
+	_, appErr := useCase.Execute(context.Background(), dto.ListMerchantAPIKeysQuery{})
+	if appErr == nil || appErr.Details["field"] != "principal_id" {
+		t.Fatalf("expected principal_id validation, got %+v", appErr)
+	}
+	output, appErr := useCase.Execute(context.Background(), dto.ListMerchantAPIKeysQuery{PrincipalID: "merchant_a"})
+	if appErr != nil || output.Keys == nil {
+		t.Fatalf("expected empty non-nil list, got %+v %+v", output, appErr)
+	}
+}
+
+type fakeMerchantAPIKeyRepository struct {
+	byPrefix map[string]dto.MerchantAPIKeyCredential
+	touches  int
+}
+
+func newFakeMerchantAPIKeyRepository() *fakeMerchantAPIKeyRepository {
+	return &fakeMerchantAPIKeyRepository{byPrefix: map[string]dto.MerchantAPIKeyCredential{}}
+}
+
+func (f *fakeMerchantAPIKeyRepository) Create(
+	_ context.Context,
+	credential dto.MerchantAPIKeyCredential,
+) (dto.MerchantAPIKey, *apperrors.AppError) {
+	f.byPrefix[credential.Key.KeyPrefix] = credential
+	return credential.Key, nil
+}
+
+func (f *fakeMerchantAPIKeyRepository) FindByPrefix(
+	_ context.Context,
+	keyPrefix string,
+) (dto.MerchantAPIKeyCredential, bool, *apperrors.AppError) {
+	credential, found := f.byPrefix[keyPrefix]
+	return credential, found, nil
+}
+
+func (f *fakeMerchantAPIKeyRepository) List(_ context.Context, merchantID string, principalID string) ([]dto.MerchantAPIKey, *apperrors.AppError) {
+	var keys []dto.MerchantAPIKey
+	for _, credential := range f.byPrefix {
+		if credential.Key.MerchantID == merchantID && credential.Key.PrincipalID == principalID {
+			keys = append(keys, credential.Key)
+		}
+	}
+	return keys, nil
+}
+
+func (f *fakeMerchantAPIKeyRepository) Revoke(
+	_ context.Context,
+	keyID string,
+	revokedAt time.Time,
+) (dto.MerchantAPIKey, bool, *apperrors.AppError) {
+	for prefix, credential := range f.byPrefix {
+		if credential.Key.ID != keyID {
+			continue
+		}
+		if credential.Key.RevokedAt == nil {
+			credential.Key.RevokedAt = &revokedAt
+			f.byPrefix[prefix] = credential
+		}
+		return credential.Key, true, nil
+	}
+	return dto.MerchantAPIKey{}, false, nil
+}
+
+func (f *fakeMerchantAPIKeyRepository) TouchLastUsed(_ context.Context, keyID string, usedAt time.Time) *apperrors.AppError {
+	for prefix, credential := range f.byPrefix {
+		if credential.Key.ID == keyID {
+			credential.Key.LastUsedAt = &usedAt
+			f.byPrefix[prefix] = credential
+			f.touches++
+		}
+	}
+	return nil
+}
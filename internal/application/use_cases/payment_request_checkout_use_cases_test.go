@@ -0,0 +1,154 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"testing"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestGetPaymentRequestQRCodeUseCase(t *testing.T) {
+	encoder := &recordingQRCodeEncoder{}
+	useCase := NewGetPaymentRequestQRCodeUseCase(newCheckoutReadModel("pending"), encoder)
+
+	output, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestQRCodeQuery{
+		ID:     "pr_test",
+		Caller: dto.MerchantPrincipal{MerchantID: "acme", PrincipalID: "merchant_a", KeyID: "key_a", Scopes: []string{"read"}},
+		Format: dto.QRCodeFormatPNG,
+	})
+	if appErr != nil {
+		t.Fatalf("expected success, got %+v", appErr)
+	}
+	const expectedURI = "bitcoin:tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx?amount=0.0015"
+	if output.PaymentURI != expectedURI || encoder.content != expectedURI || encoder.format != dto.QRCodeFormatPNG {
+		t.Fatalf("expected %s encoded as png, got output=%+v encoder=%+v", expectedURI, output, encoder)
+	}
+	if output.ContentType != "image/png" || string(output.Content) != "qr:png" {
+		t.Fatalf("unexpected output %+v", output)
+	}
+
+	if _, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestQRCodeQuery{
+		ID:     "pr_test",
+		Format: "gif",
+	}); appErr == nil || appErr.Code != "invalid_request" {
+		t.Fatalf("expected invalid_request for gif, got %+v", appErr)
+	}
+
+	for _, caller := range []dto.MerchantPrincipal{
+		{MerchantID: "acme", PrincipalID: "merchant_b", KeyID: "key_b", Scopes: []string{"read"}},
+		{MerchantID: "other", PrincipalID: "merchant_a", KeyID: "key_c", Scopes: []string{"admin"}},
+	} {
+		_, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestQRCodeQuery{
+			ID:     "pr_test",
+			Caller: caller,
+			Format: dto.QRCodeFormatSVG,
+		})
+		if appErr == nil || appErr.Code != "payment_request_not_found" {
+			t.Fatalf("expected payment_request_not_found for %+v, got %+v", caller, appErr)
+		}
+	}
+}
+
+func TestGetPaymentRequestCheckoutUseCase(t *testing.T) {
+	encoder := &recordingQRCodeEncoder{}
+	useCase := NewGetPaymentRequestCheckoutUseCase(newCheckoutReadModel("pending"), encoder)
+
+	checkout, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestCheckoutQuery{ID: " pr_test "})
+	if appErr != nil {
+		t.Fatalf("expected success, got %+v", appErr)
+	}
+	if checkout.Resource.ID != "pr_test" || !checkout.Open {
+		t.Fatalf("expected open pr_test, got %+v", checkout)
+	}
+	if displayAmount := checkout.Resource.PaymentInstructions.DisplayAmount; displayAmount == nil || *displayAmount != "0.0015" {
+		t.Fatalf("expected display amount 0.0015, got %v", displayAmount)
+	}
+	if checkout.PaymentURI == "" || checkout.QRCodeSVG != "qr:svg" || encoder.format != dto.QRCodeFormatSVG {
+		t.Fatalf("expected payment instructions with svg QR code, got %+v", checkout)
+	}
+
+	if _, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestCheckoutQuery{ID: "pr_missing"}); appErr == nil ||
+		appErr.Code != "payment_request_not_found" {
+		t.Fatalf("expected payment_request_not_found, got %+v", appErr)
+	}
+}
+
+func TestGetPaymentRequestCheckoutUseCaseHidesInstructionsAfterPending(t *testing.T) {
+	for status, open := range map[string]bool{"detected": true, "confirmed": false, "expired": false} {
+		encoder := &recordingQRCodeEncoder{}
+		useCase := NewGetPaymentRequestCheckoutUseCase(newCheckoutReadModel(status), encoder)
+
+		checkout, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestCheckoutQuery{ID: "pr_test"})
+		if appErr != nil {
+			t.Fatalf("%s: expected success, got %+v", status, appErr)
+		}
+		if checkout.Open != open || checkout.PaymentURI != "" || checkout.QRCodeSVG != "" || encoder.content != "" ||
+			checkout.Resource.PaymentInstructions.PaymentURI != nil {
+			t.Fatalf("%s: expected open=%v without payment instructions, got %+v", status, open, checkout)
+		}
+	}
+}
+
+type checkoutReadModel struct {
+	stubPaymentRequestReadModelForSettlements
+
+	resource dto.PaymentRequestResource
+}
+
+func newCheckoutReadModel(status string) checkoutReadModel {
+	amount := "150000"
+	decimals := 8
+	return checkoutReadModel{
+		stubPaymentRequestReadModelForSettlements: stubPaymentRequestReadModelForSettlements{
+			found:       true,
+			merchantID:  "acme",
+			principalID: "merchant_a",
+		},
+		resource: dto.PaymentRequestResource{
+			ID:                  "pr_test",
+			Status:              status,
+			Chain:               "bitcoin",
+			Network:             "testnet",
+			Asset:               "BTC",
+			ExpectedAmountMinor: &amount,
+			PaymentInstructions: dto.PaymentInstructions{
+				Address:       "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+				AddressScheme: "bip84_p2wpkh",
+				MinorUnit:     "sats",
+				Decimals:      &decimals,
+			},
+		},
+	}
+}
+
+func (m checkoutReadModel) GetByID(
+	_ context.Context,
+	merchantID string,
+	id string,
+) (dto.PaymentRequestResource, bool, *apperrors.AppError) {
+	if id != m.resource.ID || !m.ownedBy(merchantID) {
+		return dto.PaymentRequestResource{}, false, nil
+	}
+	return m.resource, true, nil
+}
+
+func (m checkoutReadModel) GetMerchantID(_ context.Context, id string) (string, bool, *apperrors.AppError) {
+	if id != m.resource.ID {
+		return "", false, nil
+	}
+	return m.merchantID, true, nil
+}
+
+type recordingQRCodeEncoder struct {
+	content string
+	format  string
+}
+
+func (e *recordingQRCodeEncoder) Encode(content string, format string) ([]byte, *apperrors.AppError) {
+	e.content = content
+	e.format = format
+	return []byte("qr:" + format), nil
+}
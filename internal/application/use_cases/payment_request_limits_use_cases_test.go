@@ -0,0 +1,186 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestCreatePaymentRequestUseCaseOpenQuota(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	counters := &fakePaymentRequestLimitCounters{}
+	var quotaCheck dto.CheckOpenPaymentRequestQuotaFunc
+	repository := &fakePaymentRequestRepository{
+		onCreate: func(command dto.CreatePaymentRequestPersistenceCommand) {
+			quotaCheck = command.CheckOpenQuota
+		},
+		result: dto.CreatePaymentRequestPersistenceResult{Resource: dto.PaymentRequestResource{ID: "pr_test"}},
+	}
+	useCase := NewCreatePaymentRequestUseCase(
+		fakeAssetCatalogReadModel{entries: []dto.AssetCatalogEntry{
+			{Chain: "bitcoin", Network: "mainnet", Asset: "BTC", AddressScheme: "bip84_p2wpkh", DefaultExpiresInSeconds: 3600},
+		}},
+		repository,
+		&fakeWalletAllocationGateway{result: portsout.DerivedAddress{AddressRaw: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"}},
+		fixedClock{now: now},
+		testWebhookAllowList,
+		"",
+		nil,
+		2,
+		counters,
+	)
+
+	if _, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
+		Chain:      "bitcoin",
+		Network:    "mainnet",
+		Asset:      "BTC",
+		WebhookURL: "https://hooks.example.com/evt",
+	}); appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if quotaCheck == nil {
+		t.Fatalf("expected quota check on persistence command")
+	}
+
+	if appErr := quotaCheck(dto.OpenPaymentRequestUsage{OpenCount: 1}); appErr != nil {
+		t.Fatalf("expected usage below quota to pass, got %+v", appErr)
+	}
+
+	earliest := now.Add(90*time.Second + 200*time.Millisecond)
+	appErr := quotaCheck(dto.OpenPaymentRequestUsage{OpenCount: 2, EarliestExpiresAt: &earliest})
+	if appErr == nil || appErr.Type != apperrors.TypeRateLimited || appErr.Code != "open_payment_request_quota_exceeded" {
+		t.Fatalf("expected open_payment_request_quota_exceeded, got %+v", appErr)
+	}
+	if appErr.Details["retry_after_seconds"] != int64(91) || appErr.Details["max_open_requests"] != 2 {
+		t.Fatalf("unexpected quota details: %+v", appErr.Details)
+	}
+
+	appErr = quotaCheck(dto.OpenPaymentRequestUsage{OpenCount: 3})
+	if appErr == nil || appErr.Details["retry_after_seconds"] != int64(60) {
+		t.Fatalf("expected default retry after without a pending expiry, got %+v", appErr)
+	}
+	if counters.recorded[dto.PaymentRequestLimitOutcomeQuotaExceeded] != 2 {
+		t.Fatalf("expected two quota refusals counted, got %+v", counters.recorded)
+	}
+}
+
+func TestCreatePaymentRequestUseCaseWithoutQuotaSkipsCheck(t *testing.T) {
+	repository := &fakePaymentRequestRepository{
+		onCreate: func(command dto.CreatePaymentRequestPersistenceCommand) {
+			if command.CheckOpenQuota != nil {
+				t.Fatalf("expected no quota check when quota is disabled")
+			}
+		},
+		result: dto.CreatePaymentRequestPersistenceResult{Resource: dto.PaymentRequestResource{ID: "pr_test"}},
+	}
+	useCase := NewCreatePaymentRequestUseCase(
+		fakeAssetCatalogReadModel{entries: []dto.AssetCatalogEntry{
+			{Chain: "bitcoin", Network: "mainnet", Asset: "BTC", AddressScheme: "bip84_p2wpkh", DefaultExpiresInSeconds: 3600},
+		}},
+		repository,
+		&fakeWalletAllocationGateway{result: portsout.DerivedAddress{AddressRaw: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"}},
+		fixedClock{now: time.Now().UTC()},
+		testWebhookAllowList,
+		"",
+		nil,
+		0,
+		nil,
+	)
+
+	if _, appErr := useCase.Execute(context.Background(), dto.CreatePaymentRequestCommand{
+		Chain:      "bitcoin",
+		Network:    "mainnet",
+		Asset:      "BTC",
+		WebhookURL: "https://hooks.example.com/evt",
+	}); appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+}
+
+func TestCheckPaymentRequestRateLimitUseCase(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	store := &fakeRateLimitBucketStore{permits: []dto.RateLimitPermit{
+		{Allowed: true},
+		{Allowed: false, RetryAfter: 1500 * time.Millisecond},
+	}}
+	counters := &fakePaymentRequestLimitCounters{}
+	useCase := NewCheckPaymentRequestRateLimitUseCase(store, dto.RateLimitPolicy{RatePerSecond: 2, Burst: 5}, counters)
+
+	if appErr := useCase.Execute(context.Background(), dto.CheckPaymentRequestRateLimitCommand{MerchantID: "retail", PrincipalID: "merchant_a", Now: now}); appErr != nil {
+		t.Fatalf("expected first request to pass, got %+v", appErr)
+	}
+	appErr := useCase.Execute(context.Background(), dto.CheckPaymentRequestRateLimitCommand{Now: now})
+	if appErr == nil || appErr.Type != apperrors.TypeRateLimited || appErr.Code != "rate_limited" {
+		t.Fatalf("expected rate_limited, got %+v", appErr)
+	}
+	if appErr.Details["retry_after_seconds"] != int64(2) {
+		t.Fatalf("expected retry after rounded up to 2s, got %+v", appErr.Details)
+	}
+	if len(store.keys) != 2 || store.keys[0] != "retail/merchant_a" || store.keys[1] != "default/anonymous" {
+		t.Fatalf("unexpected bucket keys: %+v", store.keys)
+	}
+	if counters.recorded[dto.PaymentRequestLimitOutcomeRateLimited] != 1 {
+		t.Fatalf("expected one rate limited refusal counted, got %+v", counters.recorded)
+	}
+
+	disabled := NewCheckPaymentRequestRateLimitUseCase(nil, dto.RateLimitPolicy{}, counters)
+	if appErr := disabled.Execute(context.Background(), dto.CheckPaymentRequestRateLimitCommand{Now: now}); appErr != nil {
+		t.Fatalf("expected disabled limit to pass without a store, got %+v", appErr)
+	}
+}
+
+func TestGetPaymentRequestLimitsOverviewUseCase(t *testing.T) {
+	since := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	counters := &fakePaymentRequestLimitCounters{since: since}
+	counters.Record(dto.PaymentRequestLimitOutcomeRateLimited)
+	useCase := NewGetPaymentRequestLimitsOverviewUseCase(dto.RateLimitPolicy{RatePerSecond: 5, Burst: 20}, 100, counters)
+
+	overview, appErr := useCase.Execute(context.Background(), dto.GetPaymentRequestLimitsOverviewQuery{})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if !overview.RateLimit.Enabled || overview.RateLimit.Burst != 20 || overview.MaxOpenRequestsPerPrincipal != 100 {
+		t.Fatalf("unexpected settings: %+v", overview)
+	}
+	if overview.Counters.RateLimited != 1 || !overview.Counters.Since.Equal(since) {
+		t.Fatalf("unexpected counters: %+v", overview.Counters)
+	}
+}
+
+type fakeRateLimitBucketStore struct {
+	permits []dto.RateLimitPermit
+	keys    []string
+}
+
+func (f *fakeRateLimitBucketStore) Take(_ context.Context, key string, _ dto.RateLimitPolicy, _ time.Time) (dto.RateLimitPermit, *apperrors.AppError) {
+	f.keys = append(f.keys, key)
+	permit := f.permits[0]
+	f.permits = f.permits[1:]
+	return permit, nil
+}
+
+type fakePaymentRequestLimitCounters struct {
+	since    time.Time
+	recorded map[string]int64
+}
+
+func (f *fakePaymentRequestLimitCounters) Record(outcome string) {
+	if f.recorded == nil {
+		f.recorded = map[string]int64{}
+	}
+	f.recorded[outcome]++
+}
+
+func (f *fakePaymentRequestLimitCounters) Snapshot() dto.PaymentRequestLimitCounters {
+	return dto.PaymentRequestLimitCounters{
+		RateLimited:   f.recorded[dto.PaymentRequestLimitOutcomeRateLimited],
+		QuotaExceeded: f.recorded[dto.PaymentRequestLimitOutcomeQuotaExceeded],
+		Since:         f.since,
+	}
+}
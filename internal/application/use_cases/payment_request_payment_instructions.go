@@ -0,0 +1,41 @@
+package use_cases
+
+import (
+	"chaintx/internal/application/dto"
+	valueobjects "chaintx/internal/domain/value_objects"
+)
+
+// withDerivedPaymentInstructions fills the payment instruction fields that are
+// computed instead of stored, so every wallet reads the same values: the
+// expected amount in major units when the catalog decimals are known, and the
+// wallet URI (BIP21, EIP-681) when a scheme covers the chain and asset. A
+// request no scheme can express, such as a token standard without a URI
+// form, simply has no payment_uri; it never fails the read.
+func withDerivedPaymentInstructions(resource dto.PaymentRequestResource) dto.PaymentRequestResource {
+	instructions := &resource.PaymentInstructions
+	instructions.DisplayAmount = nil
+	instructions.PaymentURI = nil
+
+	if resource.ExpectedAmountMinor != nil && instructions.Decimals != nil {
+		displayAmount := valueobjects.FormatAmountMinor(*resource.ExpectedAmountMinor, *instructions.Decimals)
+		instructions.DisplayAmount = &displayAmount
+	}
+
+	if !valueobjects.SupportsPaymentURI(resource.Chain) {
+		return resource
+	}
+	paymentURI, appErr := valueobjects.BuildPaymentURI(valueobjects.PaymentURIRequest{
+		Chain:         resource.Chain,
+		Address:       instructions.Address,
+		AmountMinor:   resource.ExpectedAmountMinor,
+		ChainID:       instructions.ChainID,
+		TokenStandard: instructions.TokenStandard,
+		TokenContract: instructions.TokenContract,
+	})
+	if appErr != nil {
+		return resource
+	}
+	instructions.PaymentURI = &paymentURI
+
+	return resource
+}
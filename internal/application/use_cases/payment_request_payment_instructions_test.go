@@ -0,0 +1,128 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"testing"
+
+	"chaintx/internal/application/dto"
+)
+
+func TestWithDerivedPaymentInstructions(t *testing.T) {
+	amount := "1500000"
+	btcDecimals := 8
+	tokenDecimals := 6
+	chainID := int64(11155111)
+	erc20 := "ERC20"
+	contract := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	payTo := "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"
+	stale := "stale"
+
+	testCases := []struct {
+		name                  string
+		resource              dto.PaymentRequestResource
+		expectedDisplayAmount string
+		expectedPaymentURI    string
+	}{
+		{
+			name: "bitcoin in btc",
+			resource: dto.PaymentRequestResource{
+				Chain:               "bitcoin",
+				ExpectedAmountMinor: &amount,
+				PaymentInstructions: dto.PaymentInstructions{
+					Address:   "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+					MinorUnit: "sats",
+					Decimals:  &btcDecimals,
+				},
+			},
+			expectedDisplayAmount: "0.015",
+			expectedPaymentURI:    "bitcoin:tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx?amount=0.015",
+		},
+		{
+			name: "erc20 in token units",
+			resource: dto.PaymentRequestResource{
+				Chain:               "ethereum",
+				ExpectedAmountMinor: &amount,
+				PaymentInstructions: dto.PaymentInstructions{
+					Address:       payTo,
+					ChainID:       &chainID,
+					TokenStandard: &erc20,
+					TokenContract: &contract,
+					TokenDecimals: &tokenDecimals,
+					MinorUnit:     "token_minor",
+					Decimals:      &tokenDecimals,
+				},
+			},
+			expectedDisplayAmount: "1.5",
+			expectedPaymentURI:    "ethereum:0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed@11155111/transfer?address=" + payTo + "&uint256=1500000",
+		},
+		{
+			name: "open amount",
+			resource: dto.PaymentRequestResource{
+				Chain: "bitcoin",
+				PaymentInstructions: dto.PaymentInstructions{
+					Address:  "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+					Decimals: &btcDecimals,
+				},
+			},
+			expectedPaymentURI: "bitcoin:tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+		},
+		{
+			name: "no catalog decimals and no uri scheme",
+			resource: dto.PaymentRequestResource{
+				Chain:               "solana",
+				ExpectedAmountMinor: &amount,
+				PaymentInstructions: dto.PaymentInstructions{
+					Address:       "So11111111111111111111111111111111111111112",
+					DisplayAmount: &stale,
+					PaymentURI:    &stale,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		resource := withDerivedPaymentInstructions(testCase.resource)
+		if actual := stringValue(resource.PaymentInstructions.DisplayAmount); actual != testCase.expectedDisplayAmount {
+			t.Fatalf("%s: expected display amount %q, got %q", testCase.name, testCase.expectedDisplayAmount, actual)
+		}
+		if actual := stringValue(resource.PaymentInstructions.PaymentURI); actual != testCase.expectedPaymentURI {
+			t.Fatalf("%s: expected payment uri %q, got %q", testCase.name, testCase.expectedPaymentURI, actual)
+		}
+	}
+}
+
+func TestWithDerivedPaymentInstructionsUnsupportedTokenStandard(t *testing.T) {
+	amount := "1"
+	decimals := 0
+	standard := "ERC721"
+	contract := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	stale := "stale"
+	resource := withDerivedPaymentInstructions(dto.PaymentRequestResource{
+		Chain:               "ethereum",
+		ExpectedAmountMinor: &amount,
+		PaymentInstructions: dto.PaymentInstructions{
+			Address:       "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+			TokenStandard: &standard,
+			TokenContract: &contract,
+			Decimals:      &decimals,
+			PaymentURI:    &stale,
+		},
+	})
+	if resource.PaymentInstructions.PaymentURI != nil {
+		t.Fatalf("expected payment uri to be omitted, got %q", *resource.PaymentInstructions.PaymentURI)
+	}
+	if actual := stringValue(resource.PaymentInstructions.DisplayAmount); actual != "1" {
+		t.Fatalf("expected display amount to still be derived, got %q", actual)
+	}
+	if resource.PaymentInstructions.Address == "" {
+		t.Fatalf("expected the rest of the resource to be kept")
+	}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
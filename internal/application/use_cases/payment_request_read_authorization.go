@@ -0,0 +1,45 @@
+package use_cases
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// authorizePaymentRequestRead limits an authenticated merchant to its own
+// payment requests. Another principal's request is reported as not found so
+// ids cannot be probed. Unauthenticated callers are only let through when the
+// HTTP layer runs in legacy mode, and admin keys read every request of their
+// merchant. The merchant boundary itself is enforced by the read model.
+func authorizePaymentRequestRead(
+	ctx context.Context,
+	readModel portsout.PaymentRequestReadModel,
+	caller dto.MerchantPrincipal,
+	id string,
+) *apperrors.AppError {
+	if caller.KeyID == "" || policies.MerchantAPIKeyScopesAllow(caller.Scopes, policies.MerchantAPIKeyScopeAdmin) {
+		return nil
+	}
+
+	principalID, found, appErr := readModel.GetPrincipalID(ctx, callerMerchantID(caller), id)
+	if appErr != nil {
+		return appErr
+	}
+	if !found || principalID != caller.PrincipalID {
+		return apperrors.NewNotFound(
+			"payment_request_not_found",
+			"payment request was not found",
+			map[string]any{"id": id},
+		)
+	}
+	return nil
+}
+
+// callerMerchantID is the merchant a read or write acts for; callers without a
+// merchant API key act for the default merchant.
+func callerMerchantID(caller dto.MerchantPrincipal) string {
+	return policies.NormalizeMerchantID(caller.MerchantID)
+}
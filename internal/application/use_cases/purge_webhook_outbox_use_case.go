@@ -0,0 +1,98 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type purgeWebhookOutboxUseCase struct {
+	repository portsout.WebhookOutboxRetentionRepository
+}
+
+func NewPurgeWebhookOutboxUseCase(
+	repository portsout.WebhookOutboxRetentionRepository,
+) portsin.PurgeWebhookOutboxUseCase {
+	return &purgeWebhookOutboxUseCase{repository: repository}
+}
+
+func (u *purgeWebhookOutboxUseCase) Execute(
+	ctx context.Context,
+	command dto.PurgeWebhookOutboxCommand,
+) (dto.PurgeWebhookOutboxOutput, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.PurgeWebhookOutboxOutput{}, apperrors.NewInternal(
+			"webhook_outbox_retention_repository_missing",
+			"webhook outbox retention repository is required",
+			nil,
+		)
+	}
+	if command.RetentionAge <= 0 {
+		return dto.PurgeWebhookOutboxOutput{}, apperrors.NewValidation(
+			"webhook_retention_age_invalid",
+			"webhook retention age must be greater than zero",
+			map[string]any{"retention_age": command.RetentionAge.String()},
+		)
+	}
+	if command.BatchSize <= 0 {
+		return dto.PurgeWebhookOutboxOutput{}, apperrors.NewValidation(
+			"webhook_retention_batch_size_invalid",
+			"webhook retention batch size must be greater than zero",
+			map[string]any{"batch_size": command.BatchSize},
+		)
+	}
+	if command.MaxBatches <= 0 {
+		return dto.PurgeWebhookOutboxOutput{}, apperrors.NewValidation(
+			"webhook_retention_max_batches_invalid",
+			"webhook retention max batches must be greater than zero",
+			map[string]any{"max_batches": command.MaxBatches},
+		)
+	}
+
+	startedAt := time.Now()
+	now := command.Now.UTC()
+	if command.Now.IsZero() {
+		now = time.Now().UTC()
+	}
+	output := dto.PurgeWebhookOutboxOutput{
+		Cutoff: now.Add(-command.RetentionAge),
+		DryRun: command.DryRun,
+	}
+
+	eligible, countErr := u.repository.CountArchivable(ctx, output.Cutoff)
+	if countErr != nil {
+		return output, countErr
+	}
+	output.Eligible = eligible
+	if command.DryRun || eligible == 0 {
+		output.Drained = eligible == 0
+		output.LatencyMS = time.Since(startedAt).Milliseconds()
+		return output, nil
+	}
+
+	// Each batch commits on its own so a long backlog never holds one large
+	// transaction; a shutdown between batches leaves no partial state.
+	for output.Batches < command.MaxBatches {
+		if ctx.Err() != nil {
+			break
+		}
+		archived, archiveErr := u.repository.ArchiveBatch(ctx, output.Cutoff, now, command.BatchSize)
+		if archiveErr != nil {
+			output.LatencyMS = time.Since(startedAt).Milliseconds()
+			return output, archiveErr
+		}
+		output.Batches++
+		output.Archived += archived
+		if archived < int64(command.BatchSize) {
+			output.Drained = true
+			break
+		}
+	}
+
+	output.LatencyMS = time.Since(startedAt).Milliseconds()
+	return output, nil
+}
@@ -0,0 +1,154 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestPurgeWebhookOutboxUseCaseArchivesUntilBatchComesBackShort(t *testing.T) {
+	now := time.Date(2026, 10, 19, 3, 0, 0, 0, time.UTC)
+	repository := &fakeWebhookOutboxRetentionRepository{
+		eligible: 250,
+		batches:  []int64{100, 100, 50},
+	}
+	useCase := NewPurgeWebhookOutboxUseCase(repository)
+
+	output, appErr := useCase.Execute(context.Background(), dto.PurgeWebhookOutboxCommand{
+		Now:          now,
+		RetentionAge: 30 * 24 * time.Hour,
+		BatchSize:    100,
+		MaxBatches:   10,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	expectedCutoff := now.Add(-30 * 24 * time.Hour)
+	if !output.Cutoff.Equal(expectedCutoff) || !repository.lastCutoff.Equal(expectedCutoff) {
+		t.Fatalf("expected cutoff %s, got output=%s repository=%s", expectedCutoff, output.Cutoff, repository.lastCutoff)
+	}
+	if !repository.lastArchivedAt.Equal(now) {
+		t.Fatalf("expected archived_at %s, got %s", now, repository.lastArchivedAt)
+	}
+	if output.Eligible != 250 || output.Archived != 250 || output.Batches != 3 || !output.Drained {
+		t.Fatalf("unexpected output %+v", output)
+	}
+}
+
+func TestPurgeWebhookOutboxUseCaseStopsAtMaxBatches(t *testing.T) {
+	repository := &fakeWebhookOutboxRetentionRepository{
+		eligible: 1000,
+		batches:  []int64{100, 100, 100, 100},
+	}
+	useCase := NewPurgeWebhookOutboxUseCase(repository)
+
+	output, appErr := useCase.Execute(context.Background(), dto.PurgeWebhookOutboxCommand{
+		RetentionAge: time.Hour,
+		BatchSize:    100,
+		MaxBatches:   2,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.Archived != 200 || output.Batches != 2 || output.Drained {
+		t.Fatalf("expected two full batches without draining, got %+v", output)
+	}
+}
+
+func TestPurgeWebhookOutboxUseCaseDryRunOnlyCounts(t *testing.T) {
+	repository := &fakeWebhookOutboxRetentionRepository{eligible: 42}
+	useCase := NewPurgeWebhookOutboxUseCase(repository)
+
+	output, appErr := useCase.Execute(context.Background(), dto.PurgeWebhookOutboxCommand{
+		RetentionAge: time.Hour,
+		BatchSize:    100,
+		MaxBatches:   1,
+		DryRun:       true,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if !output.DryRun || output.Eligible != 42 || output.Archived != 0 {
+		t.Fatalf("unexpected dry run output %+v", output)
+	}
+	if repository.archiveCalls != 0 {
+		t.Fatalf("expected no archive calls in dry run, got %d", repository.archiveCalls)
+	}
+}
+
+func TestPurgeWebhookOutboxUseCaseReturnsPartialOutputOnArchiveError(t *testing.T) {
+	repository := &fakeWebhookOutboxRetentionRepository{
+		eligible:   300,
+		batches:    []int64{100},
+		archiveErr: apperrors.NewInternal("webhook_outbox_archive_failed", "boom", nil),
+	}
+	useCase := NewPurgeWebhookOutboxUseCase(repository)
+
+	output, appErr := useCase.Execute(context.Background(), dto.PurgeWebhookOutboxCommand{
+		RetentionAge: time.Hour,
+		BatchSize:    100,
+		MaxBatches:   5,
+	})
+	if appErr == nil || appErr.Code != "webhook_outbox_archive_failed" {
+		t.Fatalf("expected archive error, got %+v", appErr)
+	}
+	if output.Archived != 100 || output.Batches != 1 {
+		t.Fatalf("expected committed batch to be reported, got %+v", output)
+	}
+}
+
+func TestPurgeWebhookOutboxUseCaseRejectsInvalidCommand(t *testing.T) {
+	useCase := NewPurgeWebhookOutboxUseCase(&fakeWebhookOutboxRetentionRepository{})
+	cases := map[string]dto.PurgeWebhookOutboxCommand{
+		"webhook_retention_age_invalid":         {BatchSize: 1, MaxBatches: 1},
+		"webhook_retention_batch_size_invalid":  {RetentionAge: time.Hour, MaxBatches: 1},
+		"webhook_retention_max_batches_invalid": {RetentionAge: time.Hour, BatchSize: 1},
+	}
+	for code, command := range cases {
+		_, appErr := useCase.Execute(context.Background(), command)
+		if appErr == nil || appErr.Code != code {
+			t.Fatalf("expected %s, got %+v", code, appErr)
+		}
+	}
+}
+
+type fakeWebhookOutboxRetentionRepository struct {
+	eligible       int64
+	batches        []int64
+	archiveErr     *apperrors.AppError
+	archiveCalls   int
+	lastCutoff     time.Time
+	lastArchivedAt time.Time
+}
+
+func (f *fakeWebhookOutboxRetentionRepository) CountArchivable(
+	_ context.Context,
+	cutoff time.Time,
+) (int64, *apperrors.AppError) {
+	f.lastCutoff = cutoff
+	return f.eligible, nil
+}
+
+func (f *fakeWebhookOutboxRetentionRepository) ArchiveBatch(
+	_ context.Context,
+	cutoff time.Time,
+	archivedAt time.Time,
+	_ int,
+) (int64, *apperrors.AppError) {
+	f.lastCutoff = cutoff
+	f.lastArchivedAt = archivedAt
+	if f.archiveCalls >= len(f.batches) {
+		if f.archiveErr != nil {
+			return 0, f.archiveErr
+		}
+		return 0, nil
+	}
+	archived := f.batches[f.archiveCalls]
+	f.archiveCalls++
+	return archived, nil
+}
@@ -0,0 +1,133 @@
+package use_cases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	maxWebhookRetryPolicyAttempts       = 100
+	maxWebhookRetryPolicyBackoffSeconds = 86400
+	maxWebhookRetryPolicyMaxAgeSeconds  = 30 * 86400
+)
+
+type putWebhookRetryPolicyUseCase struct {
+	repository portsout.WebhookRetryPolicyRepository
+}
+
+func NewPutWebhookRetryPolicyUseCase(repository portsout.WebhookRetryPolicyRepository) portsin.PutWebhookRetryPolicyUseCase {
+	return &putWebhookRetryPolicyUseCase{repository: repository}
+}
+
+func (u *putWebhookRetryPolicyUseCase) Execute(
+	ctx context.Context,
+	command dto.PutWebhookRetryPolicyCommand,
+) (dto.WebhookRetryPolicy, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.WebhookRetryPolicy{}, webhookRetryPolicyRepositoryMissing()
+	}
+
+	host, appErr := normalizeWebhookRetryPolicyHost(command.DestinationHost)
+	if appErr != nil {
+		return dto.WebhookRetryPolicy{}, appErr
+	}
+	operatorID := strings.TrimSpace(command.OperatorID)
+	if operatorID == "" {
+		return dto.WebhookRetryPolicy{}, apperrors.NewValidation(
+			"invalid_request",
+			"x_principal_id is required",
+			map[string]any{"field": "x_principal_id"},
+		)
+	}
+	if command.MaxAttempts < 1 || command.MaxAttempts > maxWebhookRetryPolicyAttempts {
+		return dto.WebhookRetryPolicy{}, apperrors.NewValidation(
+			"invalid_request",
+			"max_attempts must be between 1 and 100",
+			map[string]any{"field": "max_attempts"},
+		)
+	}
+	curve := strings.TrimSpace(command.BackoffCurve)
+	if curve == "" {
+		curve = policies.WebhookBackoffCurveExponential
+	}
+	if !policies.IsWebhookBackoffCurve(curve) {
+		return dto.WebhookRetryPolicy{}, apperrors.NewValidation(
+			"invalid_request",
+			"backoff_curve must be exponential, linear or fixed",
+			map[string]any{"field": "backoff_curve"},
+		)
+	}
+	if command.InitialBackoffSeconds < 1 || command.InitialBackoffSeconds > maxWebhookRetryPolicyBackoffSeconds {
+		return dto.WebhookRetryPolicy{}, apperrors.NewValidation(
+			"invalid_request",
+			"initial_backoff_seconds must be between 1 and 86400",
+			map[string]any{"field": "initial_backoff_seconds"},
+		)
+	}
+	if command.MaxBackoffSeconds < command.InitialBackoffSeconds ||
+		command.MaxBackoffSeconds > maxWebhookRetryPolicyBackoffSeconds {
+		return dto.WebhookRetryPolicy{}, apperrors.NewValidation(
+			"invalid_request",
+			"max_backoff_seconds must be between initial_backoff_seconds and 86400",
+			map[string]any{"field": "max_backoff_seconds"},
+		)
+	}
+	var maxAge *int
+	if command.MaxAgeSeconds != nil {
+		if *command.MaxAgeSeconds < 1 || *command.MaxAgeSeconds > maxWebhookRetryPolicyMaxAgeSeconds {
+			return dto.WebhookRetryPolicy{}, apperrors.NewValidation(
+				"invalid_request",
+				"max_age_seconds must be between 1 and 2592000",
+				map[string]any{"field": "max_age_seconds"},
+			)
+		}
+		value := *command.MaxAgeSeconds
+		maxAge = &value
+	}
+
+	now := command.Now.UTC()
+	if command.Now.IsZero() {
+		now = time.Now().UTC()
+	}
+
+	return u.repository.Upsert(ctx, dto.WebhookRetryPolicy{
+		DestinationHost:       host,
+		MaxAttempts:           command.MaxAttempts,
+		BackoffCurve:          curve,
+		InitialBackoffSeconds: command.InitialBackoffSeconds,
+		MaxBackoffSeconds:     command.MaxBackoffSeconds,
+		MaxAgeSeconds:         maxAge,
+		UpdatedBy:             &operatorID,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	})
+}
+
+// normalizeWebhookRetryPolicyHost matches the outbox destination_host column:
+// a lower-cased bare host without scheme, port or path.
+func normalizeWebhookRetryPolicyHost(raw string) (string, *apperrors.AppError) {
+	host := strings.ToLower(strings.TrimSpace(raw))
+	if host == "" || len(host) > 253 || strings.ContainsAny(host, "/:@?# \t") {
+		return "", apperrors.NewValidation(
+			"invalid_request",
+			"destination_host must be a bare host name",
+			map[string]any{"field": "destination_host"},
+		)
+	}
+	return host, nil
+}
+
+func webhookRetryPolicyRepositoryMissing() *apperrors.AppError {
+	return apperrors.NewInternal(
+		"webhook_retry_policy_repository_missing",
+		"webhook retry policy repository is required",
+		nil,
+	)
+}
@@ -105,6 +105,14 @@ func (u *reconcilePaymentRequestsUseCase) Execute(
 		currentStatus := strings.ToLower(strings.TrimSpace(row.Status))
 		state := parseReconciliationState(row.Metadata)
 		if shouldExpireRequest(currentStatus, now, row.ExpiresAt) {
+			metadata := dto.ReconcileTransitionMetadata{
+				TransitionReason: "payment_expired",
+				UpdatedAt:        now,
+			}
+			events, eventErr := buildReconcileTransitionEvents(row, currentStatus, "expired", state, metadata, nil, now)
+			if eventErr != nil {
+				return output, eventErr
+			}
 			updated, transitionErr := u.repository.TransitionStatusIfCurrent(
 				ctx,
 				row.ID,
@@ -112,10 +120,8 @@ func (u *reconcilePaymentRequestsUseCase) Execute(
 				"expired",
 				now,
 				workerID,
-				dto.ReconcileTransitionMetadata{
-					TransitionReason: "payment_expired",
-					UpdatedAt:        now,
-				},
+				metadata,
+				events,
 			)
 			if transitionErr != nil {
 				return output, transitionErr
@@ -156,6 +162,7 @@ func (u *reconcilePaymentRequestsUseCase) Execute(
 					false,
 					command.StabilityCycles,
 				),
+				nil,
 			)
 			if transitionErr != nil {
 				return output, transitionErr
@@ -194,6 +201,7 @@ func (u *reconcilePaymentRequestsUseCase) Execute(
 					},
 					UpdatedAt: now,
 				},
+				nil,
 			)
 			if transitionErr != nil {
 				return output, transitionErr
@@ -215,6 +223,7 @@ func (u *reconcilePaymentRequestsUseCase) Execute(
 					},
 					UpdatedAt: now,
 				},
+				nil,
 			)
 			if transitionErr != nil {
 				return output, transitionErr
@@ -231,6 +240,7 @@ func (u *reconcilePaymentRequestsUseCase) Execute(
 			row.Asset,
 			now,
 			observation.Settlements,
+			newSettlementWebhookEventsBuilder(row, now),
 		)
 		if settlementErr != nil {
 			return output, settlementErr
@@ -264,6 +274,29 @@ func (u *reconcilePaymentRequestsUseCase) Execute(
 			transitionReason = "payment_detected"
 		}
 
+		metadata := buildObservationMetadata(
+			now,
+			observation,
+			settlementSummary,
+			state,
+			currentStatus,
+			transitionReason,
+			targetStatus == "confirmed",
+			targetStatus == "reorged",
+			command.StabilityCycles,
+		)
+		events, eventErr := buildReconcileTransitionEvents(
+			row,
+			currentStatus,
+			targetStatus,
+			state,
+			metadata,
+			observation.Settlements,
+			now,
+		)
+		if eventErr != nil {
+			return output, eventErr
+		}
 		updated, transitionErr := u.repository.TransitionStatusIfCurrent(
 			ctx,
 			row.ID,
@@ -271,17 +304,8 @@ func (u *reconcilePaymentRequestsUseCase) Execute(
 			targetStatus,
 			now,
 			workerID,
-			buildObservationMetadata(
-				now,
-				observation,
-				settlementSummary,
-				state,
-				currentStatus,
-				transitionReason,
-				targetStatus == "confirmed",
-				targetStatus == "reorged",
-				command.StabilityCycles,
-			),
+			metadata,
+			events,
 		)
 		if transitionErr != nil {
 			return output, transitionErr
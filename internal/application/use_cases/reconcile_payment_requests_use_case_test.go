@@ -4,6 +4,7 @@ package use_cases
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -123,6 +124,19 @@ func TestReconcilePaymentRequestsUseCaseExpiryTransition(t *testing.T) {
 	if len(repo.transitions) != 1 || repo.transitions[0].nextStatus != "expired" {
 		t.Fatalf("expected expired transition, got %+v", repo.transitions)
 	}
+	events := repo.transitions[0].events
+	if len(events) != 1 || events[0].EventType != "payment_request.status_changed" {
+		t.Fatalf("expected status_changed event, got %+v", events)
+	}
+	data, ok := events[0].Data.(dto.WebhookPaymentRequestStatusChangedData)
+	if !ok {
+		t.Fatalf("expected status changed data, got %T", events[0].Data)
+	}
+	if data.PaymentRequest.PreviousStatus != "pending" ||
+		data.PaymentRequest.CurrentStatus != "expired" ||
+		data.PaymentRequest.TransitionReason != "payment_expired" {
+		t.Fatalf("unexpected status changed payload: %+v", data.PaymentRequest)
+	}
 }
 
 func TestReconcilePaymentRequestsUseCaseDetectedAndConfirmed(t *testing.T) {
@@ -328,6 +342,226 @@ func TestReconcilePaymentRequestsUseCaseReorgAndReconfirm(t *testing.T) {
 	}
 }
 
+func TestReconcilePaymentRequestsUseCaseBuildsWebhookEvents(t *testing.T) {
+	now := time.Date(2026, 2, 20, 14, 0, 0, 0, time.UTC)
+	blockHeight := int64(120)
+	repo := &fakeReconcileRepository{
+		rows: []dto.OpenPaymentRequestForReconciliation{
+			{
+				ID:                  "pr_events",
+				Status:              "detected",
+				Chain:               "bitcoin",
+				Network:             "regtest",
+				Asset:               "BTC",
+				ExpectedAmountMinor: ptrString("1000"),
+				AddressCanonical:    "bcrt1x",
+				ExpiresAt:           now.Add(10 * time.Minute),
+			},
+		},
+		settlementChanges: map[string][]dto.ReconcileSettlementChange{
+			"pr_events": {
+				{
+					Change: "confirmed",
+					Settlement: dto.ObservedSettlementEvidence{
+						EvidenceRef:   "btc:tx:1",
+						AmountMinor:   "1000",
+						Confirmations: 2,
+						IsCanonical:   true,
+						Confirmed:     true,
+						BlockHeight:   &blockHeight,
+					},
+				},
+				{
+					Change: "orphaned",
+					Settlement: dto.ObservedSettlementEvidence{
+						EvidenceRef: "btc:tx:0",
+						AmountMinor: "500",
+					},
+				},
+			},
+		},
+	}
+	observer := &fakeObserverGateway{
+		responses: map[string]dto.ObservePaymentRequestOutput{
+			"pr_events": {
+				Supported:         true,
+				ObservedAmount:    "1000",
+				Detected:          true,
+				Confirmed:         true,
+				FinalityReached:   true,
+				ObservationSource: "btc_esplora",
+			},
+		},
+	}
+	useCase := NewReconcilePaymentRequestsUseCase(repo, observer)
+
+	_, appErr := useCase.Execute(context.Background(), dto.ReconcilePaymentRequestsCommand{
+		Now:                now,
+		BatchSize:          10,
+		WorkerID:           "worker-a",
+		LeaseDuration:      30 * time.Second,
+		ReorgObserveWindow: 24 * time.Hour,
+		StabilityCycles:    1,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+
+	if len(repo.settlementEvents) != 2 {
+		t.Fatalf("expected 2 settlement events, got %+v", repo.settlementEvents)
+	}
+	if repo.settlementEvents[0].EventType != "settlement.confirmed" ||
+		repo.settlementEvents[1].EventType != "settlement.orphaned" {
+		t.Fatalf("unexpected settlement event types: %+v", repo.settlementEvents)
+	}
+	settlementData, ok := repo.settlementEvents[0].Data.(dto.WebhookSettlementEventData)
+	if !ok {
+		t.Fatalf("expected settlement data, got %T", repo.settlementEvents[0].Data)
+	}
+	if settlementData.PaymentRequestID != "pr_events" ||
+		settlementData.Settlement.EvidenceRef != "btc:tx:1" ||
+		settlementData.Settlement.BlockHeight == nil ||
+		*settlementData.Settlement.BlockHeight != 120 {
+		t.Fatalf("unexpected settlement payload: %+v", settlementData)
+	}
+
+	if len(repo.transitions) != 1 {
+		t.Fatalf("expected 1 transition, got %+v", repo.transitions)
+	}
+	events := repo.transitions[0].events
+	if len(events) != 2 {
+		t.Fatalf("expected 2 transition events, got %+v", events)
+	}
+	if events[0].EventType != "payment_request.status_changed" ||
+		events[1].EventType != "payment_request.finality_reached" {
+		t.Fatalf("unexpected transition event types: %+v", events)
+	}
+	finality, ok := events[1].Data.(dto.WebhookPaymentRequestFinalityReachedData)
+	if !ok {
+		t.Fatalf("expected finality data, got %T", events[1].Data)
+	}
+	if finality.PaymentRequest.Status != "confirmed" || !finality.PaymentRequest.FinalityReachedAt.Equal(now) {
+		t.Fatalf("unexpected finality payload: %+v", finality.PaymentRequest)
+	}
+	for _, event := range append(events, repo.settlementEvents...) {
+		if !strings.HasPrefix(event.EventID, "evt_") {
+			t.Fatalf("expected evt_ prefixed event id, got %q", event.EventID)
+		}
+		if event.APIVersion != "v1" {
+			t.Fatalf("expected default api version v1, got %q", event.APIVersion)
+		}
+	}
+}
+
+func TestReconcilePaymentRequestsUseCaseBuildsPinnedV2Payloads(t *testing.T) {
+	now := time.Date(2026, 2, 20, 14, 0, 0, 0, time.UTC)
+	repo := &fakeReconcileRepository{
+		rows: []dto.OpenPaymentRequestForReconciliation{
+			{
+				ID:                "pr_v2",
+				Status:            "detected",
+				Chain:             "ethereum",
+				Network:           "local",
+				Asset:             "ETH",
+				AddressCanonical:  "0xabc",
+				ExpiresAt:         now.Add(10 * time.Minute),
+				WebhookAPIVersion: "v2",
+			},
+		},
+	}
+	observer := &fakeObserverGateway{
+		responses: map[string]dto.ObservePaymentRequestOutput{
+			"pr_v2": {
+				Supported:         true,
+				ObservedAmount:    "200",
+				Detected:          true,
+				Confirmed:         true,
+				FinalityReached:   true,
+				ObservationSource: "evm_rpc",
+				Settlements: []dto.ObservedSettlementEvidence{
+					{EvidenceRef: "evm:tx:a", AmountMinor: "200", Confirmations: 3, IsCanonical: true},
+					{EvidenceRef: "evm:tx:b", AmountMinor: "50", Confirmations: 0, IsCanonical: false},
+				},
+			},
+		},
+	}
+	useCase := NewReconcilePaymentRequestsUseCase(repo, observer)
+
+	_, appErr := useCase.Execute(context.Background(), dto.ReconcilePaymentRequestsCommand{
+		Now:                now,
+		BatchSize:          10,
+		WorkerID:           "worker-a",
+		LeaseDuration:      30 * time.Second,
+		ReorgObserveWindow: 24 * time.Hour,
+		StabilityCycles:    1,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+
+	events := repo.transitions[0].events
+	if len(events) != 2 {
+		t.Fatalf("expected 2 transition events, got %+v", events)
+	}
+	for _, event := range events {
+		if event.APIVersion != "v2" {
+			t.Fatalf("expected pinned api version v2, got %q", event.APIVersion)
+		}
+	}
+	statusChanged, ok := events[0].Data.(dto.WebhookPaymentRequestStatusChangedDataV2)
+	if !ok {
+		t.Fatalf("expected v2 status changed data, got %T", events[0].Data)
+	}
+	if statusChanged.Transition.PreviousStatus != "detected" ||
+		statusChanged.Transition.CurrentStatus != "confirmed" ||
+		statusChanged.PaymentRequest.Status != "confirmed" {
+		t.Fatalf("unexpected v2 status changed payload: %+v", statusChanged)
+	}
+	if len(statusChanged.Settlements) != 1 || statusChanged.Settlements[0].EvidenceRef != "evm:tx:a" {
+		t.Fatalf("expected canonical settlement list, got %+v", statusChanged.Settlements)
+	}
+	if _, ok := events[1].Data.(dto.WebhookPaymentRequestFinalityReachedDataV2); !ok {
+		t.Fatalf("expected v2 finality data, got %T", events[1].Data)
+	}
+}
+
+func TestReconcilePaymentRequestsUseCaseSkipsEventsForMetadataOnlyTransition(t *testing.T) {
+	now := time.Date(2026, 2, 20, 14, 0, 0, 0, time.UTC)
+	repo := &fakeReconcileRepository{
+		rows: []dto.OpenPaymentRequestForReconciliation{
+			{
+				ID:        "pr_quiet",
+				Status:    "pending",
+				Chain:     "bitcoin",
+				Network:   "regtest",
+				Asset:     "BTC",
+				ExpiresAt: now.Add(10 * time.Minute),
+			},
+		},
+	}
+	observer := &fakeObserverGateway{
+		responses: map[string]dto.ObservePaymentRequestOutput{
+			"pr_quiet": {Supported: true, ObservationSource: "btc_esplora"},
+		},
+	}
+	useCase := NewReconcilePaymentRequestsUseCase(repo, observer)
+
+	_, appErr := useCase.Execute(context.Background(), dto.ReconcilePaymentRequestsCommand{
+		Now:                now,
+		BatchSize:          10,
+		WorkerID:           "worker-a",
+		LeaseDuration:      30 * time.Second,
+		ReorgObserveWindow: 24 * time.Hour,
+		StabilityCycles:    1,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if len(repo.transitions) != 1 || len(repo.transitions[0].events) != 0 {
+		t.Fatalf("expected metadata-only transition without events, got %+v", repo.transitions)
+	}
+}
+
 type fakeReconcileRepository struct {
 	rows                []dto.OpenPaymentRequestForReconciliation
 	claimErr            *apperrors.AppError
@@ -337,12 +571,15 @@ type fakeReconcileRepository struct {
 	transitions         []fakeTransition
 	leaseOwners         []string
 	settlementSummaries map[string]dto.ReconcileSettlementSyncResult
+	settlementChanges   map[string][]dto.ReconcileSettlementChange
+	settlementEvents    []dto.WebhookEvent
 }
 
 type fakeTransition struct {
 	id            string
 	currentStatus string
 	nextStatus    string
+	events        []dto.WebhookEvent
 }
 
 func (f *fakeReconcileRepository) ClaimOpenForReconciliation(
@@ -368,6 +605,7 @@ func (f *fakeReconcileRepository) TransitionStatusIfCurrent(
 	_ time.Time,
 	leaseOwner string,
 	_ dto.ReconcileTransitionMetadata,
+	events []dto.WebhookEvent,
 ) (bool, *apperrors.AppError) {
 	if f.transitionErr != nil {
 		return false, f.transitionErr
@@ -376,6 +614,7 @@ func (f *fakeReconcileRepository) TransitionStatusIfCurrent(
 		id:            id,
 		currentStatus: currentStatus,
 		nextStatus:    nextStatus,
+		events:        events,
 	})
 	f.leaseOwners = append(f.leaseOwners, leaseOwner)
 	if f.transitionAccepted {
@@ -392,10 +631,18 @@ func (f *fakeReconcileRepository) SyncObservedSettlements(
 	_ string,
 	_ time.Time,
 	_ []dto.ObservedSettlementEvidence,
+	buildEvents dto.BuildSettlementWebhookEventsFunc,
 ) (dto.ReconcileSettlementSyncResult, *apperrors.AppError) {
 	if f.settlementErr != nil {
 		return dto.ReconcileSettlementSyncResult{}, f.settlementErr
 	}
+	if changes := f.settlementChanges[requestID]; len(changes) > 0 && buildEvents != nil {
+		events, appErr := buildEvents(changes)
+		if appErr != nil {
+			return dto.ReconcileSettlementSyncResult{}, appErr
+		}
+		f.settlementEvents = append(f.settlementEvents, events...)
+	}
 	if f.settlementSummaries != nil {
 		if summary, exists := f.settlementSummaries[requestID]; exists {
 			return summary, nil
@@ -0,0 +1,110 @@
+package use_cases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const maxAdminAuditReasonLength = 1000
+
+type recordAdminAuditEntryUseCase struct {
+	repository portsout.AdminAuditLogRepository
+}
+
+func NewRecordAdminAuditEntryUseCase(repository portsout.AdminAuditLogRepository) portsin.RecordAdminAuditEntryUseCase {
+	return &recordAdminAuditEntryUseCase{repository: repository}
+}
+
+func (u *recordAdminAuditEntryUseCase) Execute(
+	ctx context.Context,
+	command dto.RecordAdminAuditEntryCommand,
+) (dto.AdminAuditEntry, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.AdminAuditEntry{}, adminAuditLogRepositoryMissing()
+	}
+
+	actorID := strings.TrimSpace(command.ActorID)
+	if actorID == "" {
+		return dto.AdminAuditEntry{}, apperrors.NewValidation(
+			"invalid_request",
+			"actor_id is required",
+			map[string]any{"field": "actor_id"},
+		)
+	}
+	if !policies.IsOpsRole(command.ActorRole) {
+		return dto.AdminAuditEntry{}, apperrors.NewValidation(
+			"invalid_request",
+			"actor_role must be viewer, operator or admin",
+			map[string]any{"field": "actor_role"},
+		)
+	}
+	action := strings.TrimSpace(command.Action)
+	if action == "" {
+		return dto.AdminAuditEntry{}, apperrors.NewValidation(
+			"invalid_request",
+			"action is required",
+			map[string]any{"field": "action"},
+		)
+	}
+	switch command.Outcome {
+	case dto.AdminAuditOutcomeSucceeded, dto.AdminAuditOutcomeFailed, dto.AdminAuditOutcomeForbidden:
+	default:
+		return dto.AdminAuditEntry{}, apperrors.NewValidation(
+			"invalid_request",
+			"outcome must be succeeded, failed or forbidden",
+			map[string]any{"field": "outcome"},
+		)
+	}
+
+	// Reasons are operator supplied; keep the row bounded rather than
+	// rejecting an action that already happened.
+	reason := strings.TrimSpace(command.Reason)
+	if len(reason) > maxAdminAuditReasonLength {
+		reason = reason[:maxAdminAuditReasonLength]
+	}
+
+	now := command.Now.UTC()
+	if command.Now.IsZero() {
+		now = time.Now().UTC()
+	}
+	details := command.Details
+	if details == nil {
+		details = map[string]any{}
+	}
+
+	return u.repository.Append(ctx, dto.AdminAuditEntry{
+		OccurredAt: now,
+		ActorID:    actorID,
+		ActorRole:  command.ActorRole,
+		Action:     action,
+		Target:     optionalAuditString(command.Target),
+		Reason:     optionalAuditString(reason),
+		SourceIP:   optionalAuditString(command.SourceIP),
+		Outcome:    command.Outcome,
+		ErrorCode:  optionalAuditString(command.ErrorCode),
+		Details:    details,
+	})
+}
+
+func optionalAuditString(value string) *string {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+func adminAuditLogRepositoryMissing() *apperrors.AppError {
+	return apperrors.NewInternal(
+		"admin_audit_log_repository_missing",
+		"admin audit log repository is required",
+		nil,
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"chaintx/internal/application/dto"
 	portsin "chaintx/internal/application/ports/in"
 	portsout "chaintx/internal/application/ports/out"
+	valueobjects "chaintx/internal/domain/value_objects"
 	apperrors "chaintx/internal/shared_kernel/errors"
 )
 
@@ -39,6 +40,10 @@ func (u *requeueWebhookDLQEventUseCase) Execute(
 			map[string]any{"field": "event_id"},
 		)
 	}
+	sink, appErr := valueobjects.NormalizeOutboxSink(command.Sink)
+	if appErr != nil {
+		return dto.RequeueWebhookDLQEventOutput{}, appErr
+	}
 	operatorID := strings.TrimSpace(command.OperatorID)
 	if operatorID == "" {
 		return dto.RequeueWebhookDLQEventOutput{}, apperrors.NewValidation(
@@ -53,7 +58,7 @@ func (u *requeueWebhookDLQEventUseCase) Execute(
 		now = time.Now().UTC()
 	}
 
-	result, appErr := u.repository.RequeueFailedByEventID(ctx, eventID, operatorID, now)
+	result, appErr := u.repository.RequeueFailedByEventID(ctx, eventID, sink, operatorID, now)
 	if appErr != nil {
 		return dto.RequeueWebhookDLQEventOutput{}, appErr
 	}
@@ -61,7 +66,7 @@ func (u *requeueWebhookDLQEventUseCase) Execute(
 		return dto.RequeueWebhookDLQEventOutput{}, apperrors.NewNotFound(
 			"webhook_outbox_event_not_found",
 			"webhook outbox event was not found",
-			map[string]any{"event_id": eventID},
+			map[string]any{"event_id": eventID, "sink": sink},
 		)
 	}
 	if !result.Updated {
@@ -70,6 +75,7 @@ func (u *requeueWebhookDLQEventUseCase) Execute(
 			"webhook outbox event is not requeueable",
 			map[string]any{
 				"event_id":        eventID,
+				"sink":            sink,
 				"delivery_status": result.CurrentStatus,
 			},
 		)
@@ -77,6 +83,7 @@ func (u *requeueWebhookDLQEventUseCase) Execute(
 
 	return dto.RequeueWebhookDLQEventOutput{
 		EventID:        eventID,
+		Sink:           sink,
 		DeliveryStatus: "pending",
 		UpdatedAt:      now,
 	}, nil
@@ -0,0 +1,57 @@
+package use_cases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type revokeMerchantAPIKeyUseCase struct {
+	repository portsout.MerchantAPIKeyRepository
+}
+
+func NewRevokeMerchantAPIKeyUseCase(repository portsout.MerchantAPIKeyRepository) portsin.RevokeMerchantAPIKeyUseCase {
+	return &revokeMerchantAPIKeyUseCase{repository: repository}
+}
+
+// Execute revokes a key. Revoking an already revoked key keeps the original
+// revoked_at so the call is safe to retry.
+func (u *revokeMerchantAPIKeyUseCase) Execute(
+	ctx context.Context,
+	command dto.RevokeMerchantAPIKeyCommand,
+) (dto.MerchantAPIKey, *apperrors.AppError) {
+	if u.repository == nil {
+		return dto.MerchantAPIKey{}, merchantAPIKeyRepositoryMissing()
+	}
+
+	keyID := strings.TrimSpace(command.KeyID)
+	if keyID == "" {
+		return dto.MerchantAPIKey{}, apperrors.NewValidation(
+			"invalid_request",
+			"key_id is required",
+			map[string]any{"field": "key_id"},
+		)
+	}
+
+	now := command.Now.UTC()
+	if command.Now.IsZero() {
+		now = time.Now().UTC()
+	}
+	key, found, appErr := u.repository.Revoke(ctx, keyID, now)
+	if appErr != nil {
+		return dto.MerchantAPIKey{}, appErr
+	}
+	if !found {
+		return dto.MerchantAPIKey{}, apperrors.NewNotFound(
+			"merchant_api_key_not_found",
+			"merchant api key was not found",
+			map[string]any{"key_id": keyID},
+		)
+	}
+	return key, nil
+}
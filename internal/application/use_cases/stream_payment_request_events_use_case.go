@@ -0,0 +1,167 @@
+package use_cases
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	defaultEventStreamHeartbeatInterval = 15 * time.Second
+	// defaultEventStreamPollInterval bounds latency when a notification is
+	// missed, e.g. while the listener reconnects or no notifier is wired.
+	defaultEventStreamPollInterval = 30 * time.Second
+	eventStreamPageSize            = 100
+)
+
+type streamPaymentRequestEventsUseCase struct {
+	readModel portsout.PaymentRequestReadModel
+	notifier  portsout.PaymentRequestEventNotifier
+}
+
+func NewStreamPaymentRequestEventsUseCase(
+	readModel portsout.PaymentRequestReadModel,
+	notifier portsout.PaymentRequestEventNotifier,
+) portsin.StreamPaymentRequestEventsUseCase {
+	return &streamPaymentRequestEventsUseCase{
+		readModel: readModel,
+		notifier:  notifier,
+	}
+}
+
+func (u *streamPaymentRequestEventsUseCase) Execute(
+	ctx context.Context,
+	command dto.StreamPaymentRequestEventsCommand,
+) *apperrors.AppError {
+	if u.readModel == nil {
+		return apperrors.NewInternal(
+			"payment_request_read_model_missing",
+			"payment request read model is required",
+			nil,
+		)
+	}
+	if command.Open == nil || command.Emit == nil || command.Heartbeat == nil {
+		return apperrors.NewInternal(
+			"event_stream_writer_missing",
+			"event stream callbacks are required",
+			nil,
+		)
+	}
+
+	id := strings.TrimSpace(command.ID)
+	if id == "" {
+		return apperrors.NewValidation(
+			"invalid_request",
+			"payment request id is required",
+			map[string]any{"field": "id"},
+		)
+	}
+	afterSequence, appErr := parseLastEventID(command.LastEventID)
+	if appErr != nil {
+		return appErr
+	}
+	if appErr := authorizePaymentRequestRead(ctx, u.readModel, command.Caller, id); appErr != nil {
+		return appErr
+	}
+	merchantID := callerMerchantID(command.Caller)
+
+	// Subscribe before the first read so events committed in between still
+	// produce a signal.
+	var signals <-chan struct{}
+	if u.notifier != nil {
+		subscription, unsubscribe := u.notifier.Subscribe(id)
+		defer unsubscribe()
+		signals = subscription
+	}
+
+	events, found, appErr := u.readModel.ListEventsAfterSequence(ctx, merchantID, id, afterSequence, eventStreamPageSize)
+	if appErr != nil {
+		return appErr
+	}
+	if !found {
+		return apperrors.NewNotFound(
+			"payment_request_not_found",
+			"payment request was not found",
+			map[string]any{"id": id},
+		)
+	}
+	if err := command.Open(); err != nil {
+		return nil
+	}
+
+	heartbeat := time.NewTicker(positiveDurationOrDefault(command.HeartbeatInterval, defaultEventStreamHeartbeatInterval))
+	defer heartbeat.Stop()
+	poll := time.NewTicker(positiveDurationOrDefault(command.PollInterval, defaultEventStreamPollInterval))
+	defer poll.Stop()
+
+	for {
+		for _, event := range events {
+			if err := command.Emit(event); err != nil {
+				return nil
+			}
+			afterSequence = event.Sequence
+		}
+		if len(events) == eventStreamPageSize {
+			events, _, appErr = u.readModel.ListEventsAfterSequence(ctx, merchantID, id, afterSequence, eventStreamPageSize)
+			if appErr != nil {
+				return streamReadError(ctx, appErr)
+			}
+			continue
+		}
+		events = nil
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if err := command.Heartbeat(); err != nil {
+				return nil
+			}
+			continue
+		case <-signals:
+		case <-poll.C:
+		}
+
+		events, _, appErr = u.readModel.ListEventsAfterSequence(ctx, merchantID, id, afterSequence, eventStreamPageSize)
+		if appErr != nil {
+			return streamReadError(ctx, appErr)
+		}
+	}
+}
+
+func parseLastEventID(raw string) (int64, *apperrors.AppError) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return 0, nil
+	}
+	sequence, err := strconv.ParseInt(trimmed, 10, 64)
+	if err != nil || sequence < 0 {
+		return 0, apperrors.NewValidation(
+			"invalid_request",
+			"Last-Event-ID must be a non-negative event sequence",
+			map[string]any{"field": "Last-Event-ID"},
+		)
+	}
+	return sequence, nil
+}
+
+// streamReadError hides read failures caused by the client going away.
+func streamReadError(ctx context.Context, appErr *apperrors.AppError) *apperrors.AppError {
+	if ctx.Err() != nil {
+		return nil
+	}
+	return appErr
+}
+
+func positiveDurationOrDefault(value time.Duration, fallback time.Duration) time.Duration {
+	if value <= 0 {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,242 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestStreamPaymentRequestEventsUseCaseReturnsNotFoundBeforeOpen(t *testing.T) {
+	useCase := NewStreamPaymentRequestEventsUseCase(&fakeEventJournal{}, nil)
+	writer := &recordingEventStream{}
+
+	appErr := useCase.Execute(context.Background(), writer.command("pr_missing", ""))
+	if appErr == nil || appErr.Code != "payment_request_not_found" {
+		t.Fatalf("expected payment_request_not_found, got %+v", appErr)
+	}
+	if writer.opened {
+		t.Fatalf("expected stream not to be opened")
+	}
+}
+
+func TestStreamPaymentRequestEventsUseCaseRejectsInvalidLastEventID(t *testing.T) {
+	useCase := NewStreamPaymentRequestEventsUseCase(&fakeEventJournal{found: true}, nil)
+	writer := &recordingEventStream{}
+
+	appErr := useCase.Execute(context.Background(), writer.command("pr_1", "evt_1"))
+	if appErr == nil || appErr.Code != "invalid_request" {
+		t.Fatalf("expected invalid_request, got %+v", appErr)
+	}
+}
+
+func TestStreamPaymentRequestEventsUseCaseResumesAfterLastEventID(t *testing.T) {
+	journal := &fakeEventJournal{found: true}
+	journal.append("payment_request.created", "payment_request.status_changed", "settlement.detected")
+	useCase := NewStreamPaymentRequestEventsUseCase(journal, nil)
+	writer := &recordingEventStream{stopAfter: 2}
+
+	appErr := useCase.Execute(context.Background(), writer.command("pr_1", "1"))
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if !writer.opened {
+		t.Fatalf("expected stream to be opened")
+	}
+	sequences := writer.sequences()
+	if len(sequences) != 2 || sequences[0] != 2 || sequences[1] != 3 {
+		t.Fatalf("expected sequences [2 3], got %v", sequences)
+	}
+}
+
+func TestStreamPaymentRequestEventsUseCaseEmitsOnNotification(t *testing.T) {
+	journal := &fakeEventJournal{found: true}
+	journal.append("payment_request.created")
+	notifier := &fakeEventNotifier{signals: make(chan struct{}, 1)}
+	useCase := NewStreamPaymentRequestEventsUseCase(journal, notifier)
+	writer := &recordingEventStream{stopAfter: 2}
+	writer.onEmit = func(event dto.PaymentRequestEvent) {
+		if event.Sequence == 1 {
+			journal.append("payment_request.status_changed")
+			notifier.signals <- struct{}{}
+		}
+	}
+
+	command := writer.command("pr_1", "")
+	command.PollInterval = time.Hour
+	command.HeartbeatInterval = time.Hour
+	done := make(chan *apperrors.AppError, 1)
+	go func() {
+		done <- useCase.Execute(context.Background(), command)
+	}()
+
+	select {
+	case appErr := <-done:
+		if appErr != nil {
+			t.Fatalf("expected no error, got %+v", appErr)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected notification to deliver the second event")
+	}
+	if notifier.subscribedID != "pr_1" || !notifier.unsubscribed {
+		t.Fatalf("expected subscribe/unsubscribe for pr_1, got %+v", notifier)
+	}
+	sequences := writer.sequences()
+	if len(sequences) != 2 || sequences[1] != 2 {
+		t.Fatalf("expected sequences [1 2], got %v", sequences)
+	}
+}
+
+func TestStreamPaymentRequestEventsUseCaseSendsHeartbeatUntilCancelled(t *testing.T) {
+	useCase := NewStreamPaymentRequestEventsUseCase(&fakeEventJournal{found: true}, nil)
+	writer := &recordingEventStream{}
+	ctx, cancel := context.WithCancel(context.Background())
+	writer.onHeartbeat = cancel
+
+	command := writer.command("pr_1", "")
+	command.HeartbeatInterval = 5 * time.Millisecond
+	command.PollInterval = time.Hour
+	appErr := useCase.Execute(ctx, command)
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if writer.heartbeats == 0 {
+		t.Fatalf("expected at least one heartbeat")
+	}
+}
+
+func TestStreamPaymentRequestEventsUseCasePollsWithoutNotifier(t *testing.T) {
+	journal := &fakeEventJournal{found: true}
+	useCase := NewStreamPaymentRequestEventsUseCase(journal, nil)
+	writer := &recordingEventStream{stopAfter: 1}
+
+	command := writer.command("pr_1", "")
+	command.HeartbeatInterval = time.Hour
+	command.PollInterval = 5 * time.Millisecond
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		journal.append("payment_request.created")
+	}()
+
+	appErr := useCase.Execute(context.Background(), command)
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if len(writer.sequences()) != 1 {
+		t.Fatalf("expected polled event, got %v", writer.sequences())
+	}
+}
+
+var errStreamClosed = errors.New("stream closed")
+
+type recordingEventStream struct {
+	mu          sync.Mutex
+	opened      bool
+	events      []dto.PaymentRequestEvent
+	heartbeats  int
+	stopAfter   int
+	onEmit      func(event dto.PaymentRequestEvent)
+	onHeartbeat func()
+}
+
+func (r *recordingEventStream) command(id string, lastEventID string) dto.StreamPaymentRequestEventsCommand {
+	return dto.StreamPaymentRequestEventsCommand{
+		ID:          id,
+		LastEventID: lastEventID,
+		Open: func() error {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.opened = true
+			return nil
+		},
+		Emit: func(event dto.PaymentRequestEvent) error {
+			r.mu.Lock()
+			r.events = append(r.events, event)
+			count := len(r.events)
+			r.mu.Unlock()
+			if r.onEmit != nil {
+				r.onEmit(event)
+			}
+			if r.stopAfter > 0 && count >= r.stopAfter {
+				return errStreamClosed
+			}
+			return nil
+		},
+		Heartbeat: func() error {
+			r.mu.Lock()
+			r.heartbeats++
+			r.mu.Unlock()
+			if r.onHeartbeat != nil {
+				r.onHeartbeat()
+			}
+			return nil
+		},
+	}
+}
+
+func (r *recordingEventStream) sequences() []int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]int64, 0, len(r.events))
+	for _, event := range r.events {
+		out = append(out, event.Sequence)
+	}
+	return out
+}
+
+type fakeEventJournal struct {
+	stubPaymentRequestReadModelForSettlements
+
+	mu     sync.Mutex
+	found  bool
+	events []dto.PaymentRequestEvent
+}
+
+func (f *fakeEventJournal) append(eventTypes ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, eventType := range eventTypes {
+		sequence := int64(len(f.events) + 1)
+		f.events = append(f.events, dto.PaymentRequestEvent{
+			Sequence:  sequence,
+			EventID:   "evt_" + eventType,
+			EventType: eventType,
+			Payload:   []byte(`{}`),
+		})
+	}
+}
+
+func (f *fakeEventJournal) ListEventsAfterSequence(
+	_ context.Context,
+	_ string,
+	_ string,
+	afterSequence int64,
+	limit int,
+) ([]dto.PaymentRequestEvent, bool, *apperrors.AppError) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := []dto.PaymentRequestEvent{}
+	for _, event := range f.events {
+		if event.Sequence > afterSequence && len(out) < limit {
+			out = append(out, event)
+		}
+	}
+	return out, f.found, nil
+}
+
+type fakeEventNotifier struct {
+	signals      chan struct{}
+	subscribedID string
+	unsubscribed bool
+}
+
+func (f *fakeEventNotifier) Subscribe(paymentRequestID string) (<-chan struct{}, func()) {
+	f.subscribedID = paymentRequestID
+	return f.signals, func() { f.unsubscribed = true }
+}
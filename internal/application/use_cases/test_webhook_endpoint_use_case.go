@@ -0,0 +1,187 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+	portsout "chaintx/internal/application/ports/out"
+	"chaintx/internal/domain/policies"
+	valueobjects "chaintx/internal/domain/value_objects"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const webhookPingMessage = "chaintx webhook endpoint test"
+
+type testWebhookEndpointUseCase struct {
+	gateway             portsout.WebhookEventGateway
+	clock               Clock
+	webhookURLAllowList []string
+	webhookAPIVersion   valueobjects.WebhookAPIVersion
+	webhookEndpointPins map[string]valueobjects.WebhookAPIVersion
+}
+
+func NewTestWebhookEndpointUseCase(
+	gateway portsout.WebhookEventGateway,
+	clock Clock,
+	webhookURLAllowList []string,
+	webhookAPIVersion valueobjects.WebhookAPIVersion,
+	webhookEndpointPins map[string]valueobjects.WebhookAPIVersion,
+) portsin.TestWebhookEndpointUseCase {
+	if clock == nil {
+		clock = NewSystemClock()
+	}
+
+	return &testWebhookEndpointUseCase{
+		gateway:             gateway,
+		clock:               clock,
+		webhookURLAllowList: webhookURLAllowList,
+		webhookAPIVersion:   webhookAPIVersion,
+		webhookEndpointPins: webhookEndpointPins,
+	}
+}
+
+func (u *testWebhookEndpointUseCase) Execute(
+	ctx context.Context,
+	command dto.TestWebhookEndpointCommand,
+) (dto.TestWebhookEndpointOutput, *apperrors.AppError) {
+	if u.gateway == nil {
+		return dto.TestWebhookEndpointOutput{}, apperrors.NewInternal(
+			"webhook_gateway_missing",
+			"webhook event gateway is required",
+			nil,
+		)
+	}
+	if len(u.webhookURLAllowList) == 0 {
+		return dto.TestWebhookEndpointOutput{}, apperrors.NewInternal(
+			"webhook_url_allowlist_missing",
+			"webhook url allowlist is required",
+			nil,
+		)
+	}
+
+	principalID := strings.TrimSpace(command.PrincipalID)
+	switch command.Scope {
+	case dto.WebhookEndpointTestScopeAdmin:
+	case dto.WebhookEndpointTestScopeMerchant:
+		if principalID == "" {
+			return dto.TestWebhookEndpointOutput{}, apperrors.NewValidation(
+				"invalid_request",
+				"x_principal_id is required",
+				map[string]any{"field": "x_principal_id"},
+			)
+		}
+	default:
+		return dto.TestWebhookEndpointOutput{}, apperrors.NewInternal(
+			"webhook_endpoint_test_scope_invalid",
+			"webhook endpoint test scope is invalid",
+			map[string]any{"scope": command.Scope},
+		)
+	}
+
+	webhookURL, webhookHost, appErr := valueobjects.NormalizeWebhookURL(command.WebhookURL)
+	if appErr != nil {
+		return dto.TestWebhookEndpointOutput{}, appErr
+	}
+	if !valueobjects.IsWebhookHostAllowed(webhookHost, u.webhookURLAllowList) {
+		return dto.TestWebhookEndpointOutput{}, apperrors.NewValidation(
+			"webhook_url_not_allowed",
+			"webhook_url host is not allowlisted",
+			map[string]any{"field": "webhook_url"},
+		)
+	}
+	var requestedAPIVersion valueobjects.WebhookAPIVersion
+	if strings.TrimSpace(command.WebhookAPIVersion) != "" {
+		requestedAPIVersion, appErr = valueobjects.ParseWebhookAPIVersion(command.WebhookAPIVersion)
+		if appErr != nil {
+			return dto.TestWebhookEndpointOutput{}, appErr
+		}
+	}
+	apiVersion := policies.ResolveWebhookAPIVersion(
+		requestedAPIVersion,
+		webhookHost,
+		u.webhookEndpointPins,
+		u.webhookAPIVersion,
+	)
+
+	testedAt := u.clock.NowUTC()
+	event, appErr := newWebhookEvent(
+		valueobjects.WebhookEventPing,
+		apiVersion,
+		testedAt,
+		dto.WebhookPingData{
+			Message:     webhookPingMessage,
+			Scope:       command.Scope,
+			PrincipalID: principalID,
+		},
+	)
+	if appErr != nil {
+		return dto.TestWebhookEndpointOutput{}, appErr
+	}
+	// Pings are not part of any payment request stream, so they carry
+	// sequence 0 and never touch the outbox.
+	payload, err := json.Marshal(dto.WebhookEventEnvelope{
+		EventID:    event.EventID,
+		EventType:  event.EventType,
+		APIVersion: event.APIVersion,
+		OccurredAt: event.OccurredAt,
+		Data:       event.Data,
+	})
+	if err != nil {
+		return dto.TestWebhookEndpointOutput{}, apperrors.NewInternal(
+			"webhook_ping_encode_failed",
+			"failed to encode webhook ping payload",
+			map[string]any{"error": err.Error()},
+		)
+	}
+
+	sendOutput, sendErr := u.gateway.SendWebhookEvent(ctx, dto.SendWebhookEventInput{
+		MerchantID:      policies.NormalizeMerchantID(command.MerchantID),
+		EventID:         event.EventID,
+		EventType:       event.EventType,
+		DeliveryAttempt: 1,
+		DestinationURL:  webhookURL,
+		Payload:         payload,
+	})
+	latency := u.clock.NowUTC().Sub(testedAt)
+	if sendErr != nil && !isWebhookDeliveryOutcome(sendErr) {
+		return dto.TestWebhookEndpointOutput{}, sendErr
+	}
+
+	output := dto.TestWebhookEndpointOutput{
+		EventID:         event.EventID,
+		WebhookURL:      webhookURL,
+		APIVersion:      event.APIVersion,
+		Delivered:       sendErr == nil,
+		StatusCode:      sendOutput.StatusCode,
+		LatencyMS:       latency.Milliseconds(),
+		ResponsePreview: sendOutput.ResponseBodyPreview,
+		ResolvedIP:      sendOutput.ResolvedIP,
+		TestedAt:        testedAt,
+	}
+	if sendErr != nil {
+		output.Error = webhookDeliveryErrorMessage(sendErr)
+	}
+	return output, nil
+}
+
+// isWebhookDeliveryOutcome reports whether a gateway error describes what
+// happened to the ping (receiver failure, blocked destination) rather than a
+// broken gateway, so the caller gets delivered=false instead of a 500.
+func isWebhookDeliveryOutcome(appErr *apperrors.AppError) bool {
+	switch appErr.Code {
+	case "webhook_delivery_failed", "webhook_destination_blocked":
+		return true
+	default:
+		return false
+	}
+}
+
+func webhookDeliveryErrorMessage(appErr *apperrors.AppError) string {
+	if cause, ok := appErr.Details["error"].(string); ok && strings.TrimSpace(cause) != "" {
+		return appErr.Message + ": " + cause
+	}
+	return appErr.Message
+}
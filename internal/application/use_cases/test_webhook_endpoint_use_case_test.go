@@ -0,0 +1,245 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	valueobjects "chaintx/internal/domain/value_objects"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestTestWebhookEndpointUseCaseSendsSignedPing(t *testing.T) {
+	gateway := &fakePingWebhookEventGateway{
+		output: dto.SendWebhookEventOutput{StatusCode: 200, ResponseBodyPreview: "ok"},
+	}
+	clock := &steppingClock{now: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC), step: 120 * time.Millisecond}
+	useCase := NewTestWebhookEndpointUseCase(
+		gateway,
+		clock,
+		testWebhookAllowList,
+		valueobjects.WebhookAPIVersionV1,
+		map[string]valueobjects.WebhookAPIVersion{"*.partners.example.com": valueobjects.WebhookAPIVersionV2},
+	)
+
+	output, appErr := useCase.Execute(context.Background(), dto.TestWebhookEndpointCommand{
+		WebhookURL:  "HTTPS://Shop.Partners.Example.com/hooks#frag",
+		Scope:       dto.WebhookEndpointTestScopeMerchant,
+		PrincipalID: "merchant_1",
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if !output.Delivered || output.StatusCode != 200 || output.ResponsePreview != "ok" {
+		t.Fatalf("unexpected delivery result: %+v", output)
+	}
+	if output.LatencyMS != 120 {
+		t.Fatalf("expected latency 120ms, got %d", output.LatencyMS)
+	}
+	if output.WebhookURL != "https://shop.partners.example.com/hooks" {
+		t.Fatalf("expected normalized url, got %s", output.WebhookURL)
+	}
+	if output.APIVersion != "v2" {
+		t.Fatalf("expected endpoint pin v2, got %s", output.APIVersion)
+	}
+
+	if len(gateway.inputs) != 1 {
+		t.Fatalf("expected one send, got %d", len(gateway.inputs))
+	}
+	input := gateway.inputs[0]
+	if input.EventType != "ping" || input.EventID != output.EventID || input.DestinationURL != output.WebhookURL {
+		t.Fatalf("unexpected gateway input: %+v", input)
+	}
+	envelope := map[string]any{}
+	if err := json.Unmarshal(input.Payload, &envelope); err != nil {
+		t.Fatalf("expected JSON payload, got %v", err)
+	}
+	if envelope["event_type"] != "ping" || envelope["api_version"] != "v2" || envelope["sequence"] != float64(0) {
+		t.Fatalf("unexpected ping envelope: %s", string(input.Payload))
+	}
+	data, _ := envelope["data"].(map[string]any)
+	if data["scope"] != "merchant" || data["principal_id"] != "merchant_1" {
+		t.Fatalf("unexpected ping data: %v", data)
+	}
+}
+
+func TestTestWebhookEndpointUseCaseReportsReceiverFailure(t *testing.T) {
+	testCases := []struct {
+		name          string
+		output        dto.SendWebhookEventOutput
+		err           *apperrors.AppError
+		expectedError string
+	}{
+		{
+			name:   "non-2xx",
+			output: dto.SendWebhookEventOutput{StatusCode: 503, ResponseBodyPreview: "maintenance"},
+			err: apperrors.NewInternal(
+				"webhook_delivery_failed",
+				"webhook endpoint returned non-2xx status",
+				map[string]any{"status_code": 503, "body": "maintenance"},
+			),
+			expectedError: "webhook endpoint returned non-2xx status",
+		},
+		{
+			name: "network",
+			err: apperrors.NewInternal(
+				"webhook_delivery_failed",
+				"failed to send webhook request",
+				map[string]any{"error": "dial tcp: connection refused"},
+			),
+			expectedError: "failed to send webhook request: dial tcp: connection refused",
+		},
+		{
+			name:   "destination blocked",
+			output: dto.SendWebhookEventOutput{ResolvedIP: "10.0.0.7"},
+			err: apperrors.NewInternal(
+				"webhook_destination_blocked",
+				"webhook destination resolves to a non-public address",
+				map[string]any{"resolved_ip": "10.0.0.7"},
+			),
+			expectedError: "webhook destination resolves to a non-public address",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := NewTestWebhookEndpointUseCase(
+				&fakePingWebhookEventGateway{output: tc.output, err: tc.err},
+				fixedClock{now: time.Now().UTC()},
+				testWebhookAllowList,
+				"",
+				nil,
+			)
+
+			output, appErr := useCase.Execute(context.Background(), dto.TestWebhookEndpointCommand{
+				WebhookURL: "https://hooks.example.com/chaintx",
+				Scope:      dto.WebhookEndpointTestScopeAdmin,
+			})
+			if appErr != nil {
+				t.Fatalf("expected receiver failure in result, got %+v", appErr)
+			}
+			if output.Delivered {
+				t.Fatalf("expected delivered=false")
+			}
+			if output.StatusCode != tc.output.StatusCode ||
+				output.ResponsePreview != tc.output.ResponseBodyPreview ||
+				output.ResolvedIP != tc.output.ResolvedIP {
+				t.Fatalf("unexpected result: %+v", output)
+			}
+			if output.Error != tc.expectedError {
+				t.Fatalf("expected error %q, got %q", tc.expectedError, output.Error)
+			}
+			if output.APIVersion != "v1" {
+				t.Fatalf("expected default v1, got %s", output.APIVersion)
+			}
+		})
+	}
+}
+
+func TestTestWebhookEndpointUseCaseRejectsInvalidRequests(t *testing.T) {
+	testCases := []struct {
+		name     string
+		command  dto.TestWebhookEndpointCommand
+		expected string
+	}{
+		{
+			name: "host not allowlisted",
+			command: dto.TestWebhookEndpointCommand{
+				WebhookURL: "https://evil.example.net/hook",
+				Scope:      dto.WebhookEndpointTestScopeAdmin,
+			},
+			expected: "webhook_url_not_allowed",
+		},
+		{
+			name: "invalid url",
+			command: dto.TestWebhookEndpointCommand{
+				WebhookURL: "ftp://hooks.example.com/hook",
+				Scope:      dto.WebhookEndpointTestScopeAdmin,
+			},
+			expected: "invalid_request",
+		},
+		{
+			name: "merchant without principal",
+			command: dto.TestWebhookEndpointCommand{
+				WebhookURL: "https://hooks.example.com/hook",
+				Scope:      dto.WebhookEndpointTestScopeMerchant,
+			},
+			expected: "invalid_request",
+		},
+		{
+			name: "unsupported api version",
+			command: dto.TestWebhookEndpointCommand{
+				WebhookURL:        "https://hooks.example.com/hook",
+				WebhookAPIVersion: "v9",
+				Scope:             dto.WebhookEndpointTestScopeAdmin,
+			},
+			expected: "invalid_request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &fakePingWebhookEventGateway{}
+			useCase := NewTestWebhookEndpointUseCase(gateway, nil, testWebhookAllowList, "", nil)
+
+			_, appErr := useCase.Execute(context.Background(), tc.command)
+			if appErr == nil || appErr.Code != tc.expected {
+				t.Fatalf("expected %s, got %+v", tc.expected, appErr)
+			}
+			if len(gateway.inputs) != 0 {
+				t.Fatalf("expected no send, got %d", len(gateway.inputs))
+			}
+		})
+	}
+}
+
+func TestTestWebhookEndpointUseCasePropagatesGatewayConfigErrors(t *testing.T) {
+	useCase := NewTestWebhookEndpointUseCase(
+		&fakePingWebhookEventGateway{err: apperrors.NewInternal(
+			"webhook_hmac_secret_missing",
+			"webhook hmac secret is missing",
+			nil,
+		)},
+		nil,
+		testWebhookAllowList,
+		"",
+		nil,
+	)
+
+	_, appErr := useCase.Execute(context.Background(), dto.TestWebhookEndpointCommand{
+		WebhookURL: "https://hooks.example.com/hook",
+		Scope:      dto.WebhookEndpointTestScopeAdmin,
+	})
+	if appErr == nil || appErr.Code != "webhook_hmac_secret_missing" {
+		t.Fatalf("expected webhook_hmac_secret_missing, got %+v", appErr)
+	}
+}
+
+type fakePingWebhookEventGateway struct {
+	output dto.SendWebhookEventOutput
+	err    *apperrors.AppError
+	inputs []dto.SendWebhookEventInput
+}
+
+func (f *fakePingWebhookEventGateway) SendWebhookEvent(
+	_ context.Context,
+	input dto.SendWebhookEventInput,
+) (dto.SendWebhookEventOutput, *apperrors.AppError) {
+	f.inputs = append(f.inputs, input)
+	return f.output, f.err
+}
+
+type steppingClock struct {
+	now  time.Time
+	step time.Duration
+}
+
+func (c *steppingClock) NowUTC() time.Time {
+	current := c.now
+	c.now = c.now.Add(c.step)
+	return current.UTC()
+}
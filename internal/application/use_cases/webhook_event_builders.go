@@ -0,0 +1,181 @@
+package use_cases
+
+import (
+	"time"
+
+	"chaintx/internal/application/dto"
+	valueobjects "chaintx/internal/domain/value_objects"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// webhookPayloadBuilder shapes event data for one webhook API version. Event
+// selection stays version independent; only the data layout differs.
+type webhookPayloadBuilder interface {
+	paymentRequestCreated(resource dto.PaymentRequestResource) any
+	paymentRequestStatusChanged(transition webhookTransition) any
+	paymentRequestFinalityReached(transition webhookTransition) any
+	settlementChanged(row dto.OpenPaymentRequestForReconciliation, settlement dto.ObservedSettlementEvidence) any
+}
+
+type webhookTransition struct {
+	row            dto.OpenPaymentRequestForReconciliation
+	previousStatus string
+	currentStatus  string
+	metadata       dto.ReconcileTransitionMetadata
+	settlements    []dto.ObservedSettlementEvidence
+}
+
+var webhookPayloadBuilders = map[valueobjects.WebhookAPIVersion]webhookPayloadBuilder{
+	valueobjects.WebhookAPIVersionV1: webhookPayloadBuilderV1{},
+	valueobjects.WebhookAPIVersionV2: webhookPayloadBuilderV2{},
+}
+
+// resolveWebhookPayloadBuilder falls back to the default version for rows
+// written before versions were pinned.
+func resolveWebhookPayloadBuilder(raw string) (valueobjects.WebhookAPIVersion, webhookPayloadBuilder) {
+	version, appErr := valueobjects.ParseWebhookAPIVersion(raw)
+	if appErr != nil {
+		version = valueobjects.DefaultWebhookAPIVersion
+	}
+	return version, webhookPayloadBuilders[version]
+}
+
+func newWebhookEvent(
+	eventType valueobjects.WebhookEventType,
+	version valueobjects.WebhookAPIVersion,
+	occurredAt time.Time,
+	data any,
+) (dto.WebhookEvent, *apperrors.AppError) {
+	eventID, appErr := generateID("evt_")
+	if appErr != nil {
+		return dto.WebhookEvent{}, appErr
+	}
+	return dto.WebhookEvent{
+		EventID:    eventID,
+		EventType:  eventType.String(),
+		APIVersion: version.String(),
+		OccurredAt: occurredAt.UTC(),
+		Data:       data,
+	}, nil
+}
+
+func buildPaymentRequestCreatedEvents(resource dto.PaymentRequestResource) ([]dto.WebhookEvent, *apperrors.AppError) {
+	resource = withDerivedPaymentInstructions(resource)
+	version, builder := resolveWebhookPayloadBuilder(resource.WebhookAPIVersion)
+	event, appErr := newWebhookEvent(
+		valueobjects.WebhookEventPaymentRequestCreated,
+		version,
+		resource.CreatedAt,
+		builder.paymentRequestCreated(resource),
+	)
+	if appErr != nil {
+		return nil, appErr
+	}
+	return []dto.WebhookEvent{event}, nil
+}
+
+// buildReconcileTransitionEvents returns the events implied by one reconcile
+// transition: status_changed for merchant-visible status moves, followed by
+// finality_reached the first time finality is recorded.
+func buildReconcileTransitionEvents(
+	row dto.OpenPaymentRequestForReconciliation,
+	currentStatus string,
+	targetStatus string,
+	state reconcileState,
+	metadata dto.ReconcileTransitionMetadata,
+	settlements []dto.ObservedSettlementEvidence,
+	now time.Time,
+) ([]dto.WebhookEvent, *apperrors.AppError) {
+	version, builder := resolveWebhookPayloadBuilder(row.WebhookAPIVersion)
+	transition := webhookTransition{
+		row:            row,
+		previousStatus: currentStatus,
+		currentStatus:  targetStatus,
+		metadata:       metadata,
+		settlements:    settlements,
+	}
+	events := make([]dto.WebhookEvent, 0, 2)
+
+	if currentStatus != targetStatus && isWebhookStatusTransition(targetStatus) {
+		event, appErr := newWebhookEvent(
+			valueobjects.WebhookEventPaymentRequestStatusChanged,
+			version,
+			now,
+			builder.paymentRequestStatusChanged(transition),
+		)
+		if appErr != nil {
+			return nil, appErr
+		}
+		events = append(events, event)
+	}
+
+	if metadata.FinalityReachedAt != nil && state.finalityAt == nil {
+		event, appErr := newWebhookEvent(
+			valueobjects.WebhookEventPaymentRequestFinalityReached,
+			version,
+			*metadata.FinalityReachedAt,
+			builder.paymentRequestFinalityReached(transition),
+		)
+		if appErr != nil {
+			return nil, appErr
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func newSettlementWebhookEventsBuilder(
+	row dto.OpenPaymentRequestForReconciliation,
+	now time.Time,
+) dto.BuildSettlementWebhookEventsFunc {
+	version, builder := resolveWebhookPayloadBuilder(row.WebhookAPIVersion)
+	return func(changes []dto.ReconcileSettlementChange) ([]dto.WebhookEvent, *apperrors.AppError) {
+		events := make([]dto.WebhookEvent, 0, len(changes))
+		for _, change := range changes {
+			eventType, ok := settlementWebhookEventType(change.Change)
+			if !ok {
+				continue
+			}
+			event, appErr := newWebhookEvent(eventType, version, now, builder.settlementChanged(row, change.Settlement))
+			if appErr != nil {
+				return nil, appErr
+			}
+			events = append(events, event)
+		}
+		return events, nil
+	}
+}
+
+func toWebhookSettlementPayload(settlement dto.ObservedSettlementEvidence) dto.WebhookSettlementPayload {
+	return dto.WebhookSettlementPayload{
+		EvidenceRef:   settlement.EvidenceRef,
+		AmountMinor:   settlement.AmountMinor,
+		Confirmations: settlement.Confirmations,
+		BlockHeight:   settlement.BlockHeight,
+		BlockHash:     settlement.BlockHash,
+		IsCanonical:   settlement.IsCanonical,
+	}
+}
+
+func isWebhookStatusTransition(status string) bool {
+	switch status {
+	case "detected", "confirmed", "reorged", "expired":
+		return true
+	default:
+		return false
+	}
+}
+
+func settlementWebhookEventType(change string) (valueobjects.WebhookEventType, bool) {
+	switch change {
+	case "detected":
+		return valueobjects.WebhookEventSettlementDetected, true
+	case "confirmed":
+		return valueobjects.WebhookEventSettlementConfirmed, true
+	case "orphaned":
+		return valueobjects.WebhookEventSettlementOrphaned, true
+	default:
+		return "", false
+	}
+}
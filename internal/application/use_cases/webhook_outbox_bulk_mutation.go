@@ -0,0 +1,74 @@
+package use_cases
+
+import (
+	"context"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	defaultWebhookOutboxBulkMaxEvents = 1000
+	maxWebhookOutboxBulkMaxEvents     = 10000
+	webhookOutboxBulkBatchSize        = 100
+)
+
+type webhookOutboxBulkMutationFunc func(
+	ctx context.Context,
+	afterID int64,
+	limit int,
+) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError)
+
+type webhookOutboxBulkMutationResult struct {
+	eventIDs   []string
+	batchCount int
+	hasMore    bool
+}
+
+func resolveWebhookOutboxBulkMaxEvents(maxEvents int) (int, *apperrors.AppError) {
+	if maxEvents == 0 {
+		return defaultWebhookOutboxBulkMaxEvents, nil
+	}
+	if maxEvents < 1 || maxEvents > maxWebhookOutboxBulkMaxEvents {
+		return 0, apperrors.NewValidation(
+			"invalid_request",
+			"max_events must be between 1 and 10000",
+			map[string]any{"field": "max_events"},
+		)
+	}
+	return maxEvents, nil
+}
+
+// runWebhookOutboxBulkMutation walks matching rows in ascending id order and
+// commits one bounded batch at a time, so a large selection never holds row
+// locks for the whole operation.
+func runWebhookOutboxBulkMutation(
+	ctx context.Context,
+	maxEvents int,
+	mutate webhookOutboxBulkMutationFunc,
+) (webhookOutboxBulkMutationResult, *apperrors.AppError) {
+	result := webhookOutboxBulkMutationResult{eventIDs: []string{}}
+	afterID := int64(0)
+	for {
+		limit := min(webhookOutboxBulkBatchSize, maxEvents-len(result.eventIDs))
+
+		batch, appErr := mutate(ctx, afterID, limit)
+		if appErr != nil {
+			details := map[string]any{"updated_count": len(result.eventIDs)}
+			for key, value := range appErr.Details {
+				details[key] = value
+			}
+			return result, apperrors.NewInternal(appErr.Code, appErr.Message, details)
+		}
+		result.batchCount++
+		result.eventIDs = append(result.eventIDs, batch.EventIDs...)
+		if !batch.HasMore || batch.LastID <= afterID {
+			return result, nil
+		}
+		if len(result.eventIDs) >= maxEvents {
+			result.hasMore = true
+			return result, nil
+		}
+		afterID = batch.LastID
+	}
+}
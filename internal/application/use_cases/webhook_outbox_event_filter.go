@@ -0,0 +1,152 @@
+package use_cases
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const (
+	maxWebhookOutboxFilterErrorLength = 200
+	maxWebhookOutboxBulkEventIDs      = 1000
+)
+
+func normalizeWebhookOutboxEventFilter(
+	filter dto.WebhookOutboxEventFilter,
+) (dto.WebhookOutboxEventFilter, *apperrors.AppError) {
+	normalized := dto.WebhookOutboxEventFilter{
+		EventType:     strings.TrimSpace(filter.EventType),
+		ErrorContains: strings.TrimSpace(filter.ErrorContains),
+	}
+
+	host := strings.ToLower(strings.TrimSpace(filter.DestinationHost))
+	host = strings.TrimSuffix(strings.Trim(host, "[]"), ".")
+	if host != "" &&
+		(strings.Contains(host, "://") || strings.ContainsAny(host, "/?#@* ")) {
+		return dto.WebhookOutboxEventFilter{}, apperrors.NewValidation(
+			"invalid_request",
+			"destination_host must be a plain host name",
+			map[string]any{"field": "destination_host"},
+		)
+	}
+	normalized.DestinationHost = host
+
+	if len(normalized.ErrorContains) > maxWebhookOutboxFilterErrorLength {
+		return dto.WebhookOutboxEventFilter{}, apperrors.NewValidation(
+			"invalid_request",
+			"error_contains must be at most 200 characters",
+			map[string]any{"field": "error_contains"},
+		)
+	}
+
+	if filter.CreatedFrom != nil {
+		value := filter.CreatedFrom.UTC()
+		normalized.CreatedFrom = &value
+	}
+	if filter.CreatedTo != nil {
+		value := filter.CreatedTo.UTC()
+		normalized.CreatedTo = &value
+	}
+	if normalized.CreatedFrom != nil &&
+		normalized.CreatedTo != nil &&
+		normalized.CreatedFrom.After(*normalized.CreatedTo) {
+		return dto.WebhookOutboxEventFilter{}, apperrors.NewValidation(
+			"invalid_request",
+			"created_from must be before or equal to created_to",
+			map[string]any{"field": "created_from"},
+		)
+	}
+
+	return normalized, nil
+}
+
+func hasWebhookOutboxEventFilterCriteria(filter dto.WebhookOutboxEventFilter) bool {
+	return filter.DestinationHost != "" ||
+		filter.EventType != "" ||
+		filter.ErrorContains != "" ||
+		filter.CreatedFrom != nil ||
+		filter.CreatedTo != nil
+}
+
+func normalizeWebhookOutboxBulkSelection(
+	selection dto.WebhookOutboxBulkSelection,
+) (dto.WebhookOutboxBulkSelection, *apperrors.AppError) {
+	filter, appErr := normalizeWebhookOutboxEventFilter(selection.Filter)
+	if appErr != nil {
+		return dto.WebhookOutboxBulkSelection{}, appErr
+	}
+
+	seen := map[string]struct{}{}
+	eventIDs := make([]string, 0, len(selection.EventIDs))
+	for _, eventID := range selection.EventIDs {
+		trimmed := strings.TrimSpace(eventID)
+		if trimmed == "" {
+			continue
+		}
+		if _, exists := seen[trimmed]; exists {
+			continue
+		}
+		seen[trimmed] = struct{}{}
+		eventIDs = append(eventIDs, trimmed)
+	}
+	if len(eventIDs) > maxWebhookOutboxBulkEventIDs {
+		return dto.WebhookOutboxBulkSelection{}, apperrors.NewValidation(
+			"invalid_request",
+			"event_ids must contain at most 1000 entries",
+			map[string]any{"field": "event_ids"},
+		)
+	}
+
+	if len(eventIDs) == 0 && !hasWebhookOutboxEventFilterCriteria(filter) {
+		return dto.WebhookOutboxBulkSelection{}, apperrors.NewValidation(
+			"invalid_request",
+			"event_ids or at least one filter field is required",
+			map[string]any{"field": "filter"},
+		)
+	}
+
+	return dto.WebhookOutboxBulkSelection{
+		EventIDs: eventIDs,
+		Filter:   filter,
+	}, nil
+}
+
+func encodeWebhookDLQCursor(cursor dto.WebhookDLQCursor) string {
+	raw := cursor.UpdatedAt.UTC().Format(time.RFC3339Nano) + "|" + strconv.FormatInt(cursor.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeWebhookDLQCursor(raw string) (*dto.WebhookDLQCursor, *apperrors.AppError) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	invalid := apperrors.NewValidation(
+		"invalid_request",
+		"cursor is invalid",
+		map[string]any{"field": "cursor"},
+	)
+	decoded, err := base64.RawURLEncoding.DecodeString(trimmed)
+	if err != nil {
+		return nil, invalid
+	}
+	parts := strings.Split(string(decoded), "|")
+	if len(parts) != 2 {
+		return nil, invalid
+	}
+	updatedAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, invalid
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || id <= 0 {
+		return nil, invalid
+	}
+
+	return &dto.WebhookDLQCursor{UpdatedAt: updatedAt.UTC(), ID: id}, nil
+}
@@ -30,6 +30,78 @@ func TestGetWebhookOutboxOverviewUseCaseReturnsOverview(t *testing.T) {
 	}
 }
 
+func TestGetWebhookOutboxOverviewUseCaseSkipsDestinationsByDefault(t *testing.T) {
+	readModel := &fakeWebhookOutboxReadModel{}
+	useCase := NewGetWebhookOutboxOverviewUseCase(readModel)
+
+	output, appErr := useCase.Execute(context.Background(), dto.GetWebhookOutboxOverviewQuery{})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if readModel.destinationCalls != 0 || output.Destinations != nil || output.WindowSeconds != nil {
+		t.Fatalf("expected global overview only, got %+v", output)
+	}
+}
+
+func TestGetWebhookOutboxOverviewUseCaseGroupsByDestination(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	readModel := &fakeWebhookOutboxReadModel{
+		destinations: []dto.WebhookOutboxDestinationOverview{
+			{Sink: "webhook", DestinationHost: "down.example", PendingCount: 40, FailedInWindow: 3},
+			{Sink: "webhook", DestinationHost: "ok.example", DeliveredInWindow: 9, FailedInWindow: 1},
+			{Sink: "webhook", DestinationHost: "idle.example"},
+		},
+	}
+	useCase := NewGetWebhookOutboxOverviewUseCase(readModel)
+
+	output, appErr := useCase.Execute(context.Background(), dto.GetWebhookOutboxOverviewQuery{
+		Now:     now,
+		GroupBy: dto.WebhookOutboxOverviewGroupByDestination,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if !readModel.lastDestinationWindow.Equal(now.Add(-time.Hour)) || readModel.lastDestinationLimit != 50 {
+		t.Fatalf("expected default window and limit, got %s limit=%d", readModel.lastDestinationWindow, readModel.lastDestinationLimit)
+	}
+	if output.WindowSeconds == nil || *output.WindowSeconds != 3600 {
+		t.Fatalf("expected window_seconds=3600, got %+v", output.WindowSeconds)
+	}
+	if len(output.Destinations) != 3 {
+		t.Fatalf("expected three destinations, got %+v", output.Destinations)
+	}
+	if rate := output.Destinations[0].SuccessRateBPS; rate == nil || *rate != 0 {
+		t.Fatalf("expected down destination rate 0, got %v", rate)
+	}
+	if rate := output.Destinations[1].SuccessRateBPS; rate == nil || *rate != 9000 {
+		t.Fatalf("expected ok destination rate 9000, got %v", rate)
+	}
+	if output.Destinations[2].SuccessRateBPS != nil {
+		t.Fatalf("expected idle destination without rate, got %d", *output.Destinations[2].SuccessRateBPS)
+	}
+}
+
+func TestGetWebhookOutboxOverviewUseCaseRejectsInvalidBreakdown(t *testing.T) {
+	cases := []struct {
+		name  string
+		query dto.GetWebhookOutboxOverviewQuery
+		field string
+	}{
+		{name: "group_by", query: dto.GetWebhookOutboxOverviewQuery{GroupBy: "event_type"}, field: "group_by"},
+		{name: "window", query: dto.GetWebhookOutboxOverviewQuery{Window: 8 * 24 * time.Hour}, field: "window_seconds"},
+		{name: "limit", query: dto.GetWebhookOutboxOverviewQuery{Limit: 201}, field: "limit"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := NewGetWebhookOutboxOverviewUseCase(&fakeWebhookOutboxReadModel{})
+			_, appErr := useCase.Execute(context.Background(), tc.query)
+			if appErr == nil || appErr.Details["field"] != tc.field {
+				t.Fatalf("expected %s validation error, got %+v", tc.field, appErr)
+			}
+		})
+	}
+}
+
 func TestListWebhookDLQEventsUseCaseDefaultLimit(t *testing.T) {
 	readModel := &fakeWebhookOutboxReadModel{}
 	useCase := NewListWebhookDLQEventsUseCase(readModel)
@@ -55,6 +127,189 @@ func TestListWebhookDLQEventsUseCaseRejectsInvalidLimit(t *testing.T) {
 	}
 }
 
+func TestListWebhookDLQEventsUseCasePassesFilterAndReturnsNextCursor(t *testing.T) {
+	updatedAt := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	readModel := &fakeWebhookOutboxReadModel{
+		dlqEvents: []dto.WebhookDLQEvent{
+			{ID: 9, EventID: "evt_9", UpdatedAt: updatedAt},
+			{ID: 7, EventID: "evt_7", UpdatedAt: updatedAt},
+		},
+	}
+	useCase := NewListWebhookDLQEventsUseCase(readModel)
+
+	output, appErr := useCase.Execute(context.Background(), dto.ListWebhookDLQEventsQuery{
+		Limit: 2,
+		Filter: dto.WebhookOutboxEventFilter{
+			DestinationHost: " Hooks.Example.COM ",
+			ErrorContains:   " timeout ",
+		},
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if readModel.lastDLQFilter.DestinationHost != "hooks.example.com" {
+		t.Fatalf("expected normalized host, got %+v", readModel.lastDLQFilter)
+	}
+	if readModel.lastDLQFilter.ErrorContains != "timeout" {
+		t.Fatalf("expected trimmed error filter, got %+v", readModel.lastDLQFilter)
+	}
+	if output.NextCursor == nil {
+		t.Fatalf("expected next cursor for full page")
+	}
+
+	_, appErr = useCase.Execute(context.Background(), dto.ListWebhookDLQEventsQuery{
+		Limit:  2,
+		Cursor: *output.NextCursor,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if readModel.lastDLQCursor == nil || readModel.lastDLQCursor.ID != 7 || !readModel.lastDLQCursor.UpdatedAt.Equal(updatedAt) {
+		t.Fatalf("expected decoded cursor id=7, got %+v", readModel.lastDLQCursor)
+	}
+}
+
+func TestListWebhookDLQEventsUseCaseRejectsInvalidCursorAndRange(t *testing.T) {
+	useCase := NewListWebhookDLQEventsUseCase(&fakeWebhookOutboxReadModel{})
+
+	_, appErr := useCase.Execute(context.Background(), dto.ListWebhookDLQEventsQuery{Cursor: "not-a-cursor"})
+	if appErr == nil || appErr.Details["field"] != "cursor" {
+		t.Fatalf("expected cursor validation error, got %+v", appErr)
+	}
+
+	from := time.Date(2026, 2, 22, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	_, appErr = useCase.Execute(context.Background(), dto.ListWebhookDLQEventsQuery{
+		Filter: dto.WebhookOutboxEventFilter{CreatedFrom: &from, CreatedTo: &to},
+	})
+	if appErr == nil || appErr.Details["field"] != "created_from" {
+		t.Fatalf("expected created_from validation error, got %+v", appErr)
+	}
+}
+
+func TestBulkRequeueWebhookDLQEventsUseCaseProcessesBatches(t *testing.T) {
+	now := time.Date(2026, 2, 21, 14, 0, 0, 0, time.UTC)
+	firstBatch := make([]string, webhookOutboxBulkBatchSize)
+	for i := range firstBatch {
+		firstBatch[i] = "evt_a"
+	}
+	repo := &fakeWebhookOutboxOpsRepository{
+		bulkBatches: []dto.WebhookOutboxBulkMutationBatch{
+			{EventIDs: firstBatch, LastID: 150, HasMore: true},
+			{EventIDs: []string{"evt_b", "evt_c"}, LastID: 170},
+		},
+	}
+	useCase := NewBulkRequeueWebhookDLQEventsUseCase(repo)
+
+	output, appErr := useCase.Execute(context.Background(), dto.BulkRequeueWebhookDLQEventsCommand{
+		Selection: dto.WebhookOutboxBulkSelection{
+			Filter: dto.WebhookOutboxEventFilter{DestinationHost: "hooks.example.com"},
+		},
+		OperatorID: "ops-user-1",
+		Now:        now,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.UpdatedCount != webhookOutboxBulkBatchSize+2 || output.BatchCount != 2 || output.HasMore {
+		t.Fatalf("unexpected bulk output %+v", output)
+	}
+	if output.DeliveryStatus != "pending" || output.Action != "requeue" {
+		t.Fatalf("unexpected bulk output %+v", output)
+	}
+	if len(repo.bulkAfterIDs) != 2 || repo.bulkAfterIDs[0] != 0 || repo.bulkAfterIDs[1] != 150 {
+		t.Fatalf("expected keyset progression [0 150], got %v", repo.bulkAfterIDs)
+	}
+	if repo.lastBulkOperator != "ops-user-1" {
+		t.Fatalf("expected operator ops-user-1, got %s", repo.lastBulkOperator)
+	}
+}
+
+func TestBulkRequeueWebhookDLQEventsUseCaseStopsAtMaxEvents(t *testing.T) {
+	repo := &fakeWebhookOutboxOpsRepository{
+		bulkBatches: []dto.WebhookOutboxBulkMutationBatch{
+			{EventIDs: []string{"evt_1", "evt_2", "evt_3"}, LastID: 3, HasMore: true},
+		},
+	}
+	useCase := NewBulkRequeueWebhookDLQEventsUseCase(repo)
+
+	output, appErr := useCase.Execute(context.Background(), dto.BulkRequeueWebhookDLQEventsCommand{
+		Selection:  dto.WebhookOutboxBulkSelection{EventIDs: []string{"evt_1", "evt_2", "evt_3", "evt_4"}},
+		MaxEvents:  3,
+		OperatorID: "ops-user-1",
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if !output.HasMore || output.UpdatedCount != 3 || repo.bulkCalls != 1 {
+		t.Fatalf("expected capped run with has_more, got %+v calls=%d", output, repo.bulkCalls)
+	}
+	if len(repo.bulkLimits) != 1 || repo.bulkLimits[0] != 3 {
+		t.Fatalf("expected batch limit 3, got %v", repo.bulkLimits)
+	}
+}
+
+func TestBulkRequeueWebhookDLQEventsUseCaseReportsNoMoreWhenCapMatchesSelection(t *testing.T) {
+	repo := &fakeWebhookOutboxOpsRepository{
+		bulkBatches: []dto.WebhookOutboxBulkMutationBatch{
+			{EventIDs: []string{"evt_1", "evt_2", "evt_3"}, LastID: 3},
+		},
+	}
+	useCase := NewBulkRequeueWebhookDLQEventsUseCase(repo)
+
+	output, appErr := useCase.Execute(context.Background(), dto.BulkRequeueWebhookDLQEventsCommand{
+		Selection:  dto.WebhookOutboxBulkSelection{EventIDs: []string{"evt_1", "evt_2", "evt_3"}},
+		MaxEvents:  3,
+		OperatorID: "ops-user-1",
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.HasMore || output.UpdatedCount != 3 || repo.bulkCalls != 1 {
+		t.Fatalf("expected finished run without has_more, got %+v calls=%d", output, repo.bulkCalls)
+	}
+}
+
+func TestBulkRequeueWebhookDLQEventsUseCaseRejectsEmptySelection(t *testing.T) {
+	repo := &fakeWebhookOutboxOpsRepository{}
+	useCase := NewBulkRequeueWebhookDLQEventsUseCase(repo)
+
+	_, appErr := useCase.Execute(context.Background(), dto.BulkRequeueWebhookDLQEventsCommand{
+		OperatorID: "ops-user-1",
+	})
+	if appErr == nil || appErr.Code != "invalid_request" {
+		t.Fatalf("expected invalid_request, got %+v", appErr)
+	}
+	if repo.bulkCalls != 0 {
+		t.Fatalf("expected no repository calls, got %d", repo.bulkCalls)
+	}
+}
+
+func TestBulkCancelWebhookOutboxEventsUseCaseReportsPartialProgressOnError(t *testing.T) {
+	firstBatch := make([]string, webhookOutboxBulkBatchSize)
+	repo := &fakeWebhookOutboxOpsRepository{
+		bulkBatches: []dto.WebhookOutboxBulkMutationBatch{
+			{EventIDs: firstBatch, LastID: 100, HasMore: true},
+		},
+		bulkErr: apperrors.NewInternal("webhook_outbox_update_failed", "boom", nil),
+	}
+	useCase := NewBulkCancelWebhookOutboxEventsUseCase(repo)
+
+	_, appErr := useCase.Execute(context.Background(), dto.BulkCancelWebhookOutboxEventsCommand{
+		Selection:  dto.WebhookOutboxBulkSelection{Filter: dto.WebhookOutboxEventFilter{EventType: "payment_request.status_changed"}},
+		OperatorID: "ops-user-1",
+	})
+	if appErr == nil {
+		t.Fatalf("expected error")
+	}
+	if appErr.Details["updated_count"] != webhookOutboxBulkBatchSize {
+		t.Fatalf("expected updated_count=%d in details, got %+v", webhookOutboxBulkBatchSize, appErr.Details)
+	}
+	if repo.lastBulkError != "manual_cancelled" {
+		t.Fatalf("expected default cancel reason, got %s", repo.lastBulkError)
+	}
+}
+
 func TestRequeueWebhookDLQEventUseCaseReturnsConflictWhenStatusNotFailed(t *testing.T) {
 	now := time.Date(2026, 2, 21, 13, 5, 0, 0, time.UTC)
 	repo := &fakeWebhookOutboxOpsRepository{
@@ -93,6 +348,56 @@ func TestRequeueWebhookDLQEventUseCaseSuccess(t *testing.T) {
 	if output.DeliveryStatus != "pending" {
 		t.Fatalf("expected pending status, got %+v", output)
 	}
+	if output.Sink != "webhook" || repo.lastSink != "webhook" {
+		t.Fatalf("expected the webhook sink by default, got output=%+v repo=%s", output, repo.lastSink)
+	}
+}
+
+func TestCancelWebhookOutboxEventUseCaseScopesToSink(t *testing.T) {
+	repo := &fakeWebhookOutboxOpsRepository{
+		cancelResult: dto.WebhookOutboxMutationResult{Found: true, Updated: true, CurrentStatus: "pending"},
+	}
+	useCase := NewCancelWebhookOutboxEventUseCase(repo)
+
+	output, appErr := useCase.Execute(context.Background(), dto.CancelWebhookOutboxEventCommand{
+		EventID:    "evt_x",
+		Sink:       " Kafka ",
+		OperatorID: "ops-user-1",
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.Sink != "kafka" || repo.lastSink != "kafka" {
+		t.Fatalf("expected the kafka sink, got output=%+v repo=%s", output, repo.lastSink)
+	}
+
+	_, appErr = useCase.Execute(context.Background(), dto.CancelWebhookOutboxEventCommand{
+		EventID:    "evt_x",
+		Sink:       "sqs",
+		OperatorID: "ops-user-1",
+	})
+	if appErr == nil || appErr.Details["field"] != "sink" {
+		t.Fatalf("expected invalid sink, got %+v", appErr)
+	}
+}
+
+func TestCancelWebhookOutboxEventUseCaseReportsKeptDLQError(t *testing.T) {
+	repo := &fakeWebhookOutboxOpsRepository{
+		cancelResult: dto.WebhookOutboxMutationResult{Found: true, Updated: true, CurrentStatus: "failed", LastError: "http_500"},
+	}
+	useCase := NewCancelWebhookOutboxEventUseCase(repo)
+
+	output, appErr := useCase.Execute(context.Background(), dto.CancelWebhookOutboxEventCommand{
+		EventID:    "evt_x",
+		OperatorID: "ops-user-1",
+		Reason:     "merchant offboarded",
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.LastError != "http_500" {
+		t.Fatalf("expected the stored DLQ error, got %s", output.LastError)
+	}
 }
 
 func TestCancelWebhookOutboxEventUseCaseUsesDefaultReason(t *testing.T) {
@@ -173,6 +478,14 @@ type fakeWebhookOutboxReadModel struct {
 	dlqErr          *apperrors.AppError
 	lastOverviewNow time.Time
 	lastDLQLimit    int
+
+	destinations          []dto.WebhookOutboxDestinationOverview
+	destinationsErr       *apperrors.AppError
+	destinationCalls      int
+	lastDestinationWindow time.Time
+	lastDestinationLimit  int
+	lastDLQFilter         dto.WebhookOutboxEventFilter
+	lastDLQCursor         *dto.WebhookDLQCursor
 }
 
 func (f *fakeWebhookOutboxReadModel) GetOverview(_ context.Context, now time.Time) (dto.WebhookOutboxOverview, *apperrors.AppError) {
@@ -183,8 +496,30 @@ func (f *fakeWebhookOutboxReadModel) GetOverview(_ context.Context, now time.Tim
 	return f.overview, nil
 }
 
-func (f *fakeWebhookOutboxReadModel) ListDLQ(_ context.Context, limit int) ([]dto.WebhookDLQEvent, *apperrors.AppError) {
+func (f *fakeWebhookOutboxReadModel) GetDestinationOverview(
+	_ context.Context,
+	_ time.Time,
+	windowStart time.Time,
+	limit int,
+) ([]dto.WebhookOutboxDestinationOverview, *apperrors.AppError) {
+	f.destinationCalls++
+	f.lastDestinationWindow = windowStart
+	f.lastDestinationLimit = limit
+	if f.destinationsErr != nil {
+		return nil, f.destinationsErr
+	}
+	return f.destinations, nil
+}
+
+func (f *fakeWebhookOutboxReadModel) ListDLQ(
+	_ context.Context,
+	filter dto.WebhookOutboxEventFilter,
+	cursor *dto.WebhookDLQCursor,
+	limit int,
+) ([]dto.WebhookDLQEvent, *apperrors.AppError) {
 	f.lastDLQLimit = limit
+	f.lastDLQFilter = filter
+	f.lastDLQCursor = cursor
 	if f.dlqErr != nil {
 		return nil, f.dlqErr
 	}
@@ -197,6 +532,16 @@ type fakeWebhookOutboxOpsRepository struct {
 	cancelResult    dto.WebhookOutboxMutationResult
 	cancelErr       *apperrors.AppError
 	lastCancelError string
+	lastSink        string
+
+	bulkBatches      []dto.WebhookOutboxBulkMutationBatch
+	bulkErr          *apperrors.AppError
+	bulkCalls        int
+	bulkAfterIDs     []int64
+	bulkLimits       []int
+	lastBulkSelect   dto.WebhookOutboxBulkSelection
+	lastBulkOperator string
+	lastBulkError    string
 }
 
 func (f *fakeWebhookOutboxOpsRepository) ClaimPendingForDispatch(
@@ -205,6 +550,8 @@ func (f *fakeWebhookOutboxOpsRepository) ClaimPendingForDispatch(
 	_ int,
 	_ string,
 	_ time.Time,
+	_ bool,
+	_ []string,
 ) ([]dto.PendingWebhookOutboxEvent, *apperrors.AppError) {
 	return nil, nil
 }
@@ -213,6 +560,7 @@ func (f *fakeWebhookOutboxOpsRepository) MarkDelivered(
 	_ context.Context,
 	_ int64,
 	_ string,
+	_ string,
 	_ time.Time,
 ) (bool, *apperrors.AppError) {
 	return false, nil
@@ -225,6 +573,7 @@ func (f *fakeWebhookOutboxOpsRepository) MarkRetry(
 	_ int,
 	_ time.Time,
 	_ string,
+	_ string,
 	_ time.Time,
 ) (bool, *apperrors.AppError) {
 	return false, nil
@@ -236,6 +585,7 @@ func (f *fakeWebhookOutboxOpsRepository) MarkFailed(
 	_ string,
 	_ int,
 	_ string,
+	_ string,
 	_ time.Time,
 ) (bool, *apperrors.AppError) {
 	return false, nil
@@ -251,12 +601,24 @@ func (f *fakeWebhookOutboxOpsRepository) RenewLease(
 	return false, nil
 }
 
+func (f *fakeWebhookOutboxOpsRepository) DeferDispatch(
+	_ context.Context,
+	_ int64,
+	_ string,
+	_ time.Time,
+	_ time.Time,
+) (bool, *apperrors.AppError) {
+	return false, nil
+}
+
 func (f *fakeWebhookOutboxOpsRepository) RequeueFailedByEventID(
 	_ context.Context,
 	_ string,
+	sink string,
 	_ string,
 	_ time.Time,
 ) (dto.WebhookOutboxMutationResult, *apperrors.AppError) {
+	f.lastSink = sink
 	if f.requeueErr != nil {
 		return dto.WebhookOutboxMutationResult{}, f.requeueErr
 	}
@@ -266,13 +628,65 @@ func (f *fakeWebhookOutboxOpsRepository) RequeueFailedByEventID(
 func (f *fakeWebhookOutboxOpsRepository) CancelByEventID(
 	_ context.Context,
 	_ string,
+	sink string,
 	_ string,
 	lastError string,
 	_ time.Time,
 ) (dto.WebhookOutboxMutationResult, *apperrors.AppError) {
+	f.lastSink = sink
 	f.lastCancelError = lastError
 	if f.cancelErr != nil {
 		return dto.WebhookOutboxMutationResult{}, f.cancelErr
 	}
-	return f.cancelResult, nil
+	result := f.cancelResult
+	if result.Updated && result.CurrentStatus != "failed" {
+		result.LastError = lastError
+	}
+	return result, nil
+}
+
+func (f *fakeWebhookOutboxOpsRepository) RequeueFailedBatch(
+	_ context.Context,
+	selection dto.WebhookOutboxBulkSelection,
+	afterID int64,
+	limit int,
+	operatorID string,
+	_ time.Time,
+) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError) {
+	f.lastBulkOperator = operatorID
+	return f.nextBulkBatch(selection, afterID, limit)
+}
+
+func (f *fakeWebhookOutboxOpsRepository) CancelBatch(
+	_ context.Context,
+	selection dto.WebhookOutboxBulkSelection,
+	afterID int64,
+	limit int,
+	operatorID string,
+	lastError string,
+	_ time.Time,
+) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError) {
+	f.lastBulkOperator = operatorID
+	f.lastBulkError = lastError
+	return f.nextBulkBatch(selection, afterID, limit)
+}
+
+func (f *fakeWebhookOutboxOpsRepository) nextBulkBatch(
+	selection dto.WebhookOutboxBulkSelection,
+	afterID int64,
+	limit int,
+) (dto.WebhookOutboxBulkMutationBatch, *apperrors.AppError) {
+	f.bulkCalls++
+	f.lastBulkSelect = selection
+	f.bulkAfterIDs = append(f.bulkAfterIDs, afterID)
+	f.bulkLimits = append(f.bulkLimits, limit)
+	if len(f.bulkBatches) == 0 {
+		if f.bulkErr != nil {
+			return dto.WebhookOutboxBulkMutationBatch{}, f.bulkErr
+		}
+		return dto.WebhookOutboxBulkMutationBatch{LastID: afterID}, nil
+	}
+	batch := f.bulkBatches[0]
+	f.bulkBatches = f.bulkBatches[1:]
+	return batch, nil
 }
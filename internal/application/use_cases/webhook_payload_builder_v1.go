@@ -0,0 +1,63 @@
+package use_cases
+
+import "chaintx/internal/application/dto"
+
+// webhookPayloadBuilderV1 keeps the original flat payment_request payloads.
+type webhookPayloadBuilderV1 struct{}
+
+func (webhookPayloadBuilderV1) paymentRequestCreated(resource dto.PaymentRequestResource) any {
+	return dto.WebhookPaymentRequestCreatedData{PaymentRequest: resource}
+}
+
+func (webhookPayloadBuilderV1) paymentRequestStatusChanged(transition webhookTransition) any {
+	row := transition.row
+	metadata := transition.metadata
+	return dto.WebhookPaymentRequestStatusChangedData{
+		PaymentRequest: dto.WebhookPaymentRequestStatusPayload{
+			ID:                  row.ID,
+			Chain:               row.Chain,
+			Network:             row.Network,
+			Asset:               row.Asset,
+			ExpectedAmountMinor: row.ExpectedAmountMinor,
+			AddressCanonical:    row.AddressCanonical,
+			ExpiresAt:           row.ExpiresAt.UTC(),
+			PreviousStatus:      transition.previousStatus,
+			CurrentStatus:       transition.currentStatus,
+			ObservedAmountMinor: metadata.ObservedAmountMinor,
+			ObservationSource:   metadata.ObservationSource,
+			TransitionReason:    metadata.TransitionReason,
+			FinalityReached:     metadata.FinalityReached,
+			EvidenceSummary:     metadata.EvidenceSummary,
+		},
+	}
+}
+
+func (webhookPayloadBuilderV1) paymentRequestFinalityReached(transition webhookTransition) any {
+	row := transition.row
+	metadata := transition.metadata
+	return dto.WebhookPaymentRequestFinalityReachedData{
+		PaymentRequest: dto.WebhookPaymentRequestFinalityPayload{
+			ID:                  row.ID,
+			Chain:               row.Chain,
+			Network:             row.Network,
+			Asset:               row.Asset,
+			Status:              transition.currentStatus,
+			ObservedAmountMinor: metadata.ObservedAmountMinor,
+			FirstConfirmedAt:    metadata.FirstConfirmedAt,
+			FinalityReachedAt:   metadata.FinalityReachedAt.UTC(),
+		},
+	}
+}
+
+func (webhookPayloadBuilderV1) settlementChanged(
+	row dto.OpenPaymentRequestForReconciliation,
+	settlement dto.ObservedSettlementEvidence,
+) any {
+	return dto.WebhookSettlementEventData{
+		PaymentRequestID: row.ID,
+		Chain:            row.Chain,
+		Network:          row.Network,
+		Asset:            row.Asset,
+		Settlement:       toWebhookSettlementPayload(settlement),
+	}
+}
@@ -0,0 +1,70 @@
+package use_cases
+
+import "chaintx/internal/application/dto"
+
+// webhookPayloadBuilderV2 separates the payment request snapshot from the
+// transition and attaches the canonical settlements seen in the same cycle.
+type webhookPayloadBuilderV2 struct{}
+
+func (webhookPayloadBuilderV2) paymentRequestCreated(resource dto.PaymentRequestResource) any {
+	return dto.WebhookPaymentRequestCreatedData{PaymentRequest: resource}
+}
+
+func (webhookPayloadBuilderV2) paymentRequestStatusChanged(transition webhookTransition) any {
+	metadata := transition.metadata
+	return dto.WebhookPaymentRequestStatusChangedDataV2{
+		PaymentRequest: webhookPaymentRequestSnapshotV2(transition),
+		Transition: dto.WebhookStatusTransitionV2{
+			PreviousStatus:    transition.previousStatus,
+			CurrentStatus:     transition.currentStatus,
+			Reason:            metadata.TransitionReason,
+			ObservationSource: metadata.ObservationSource,
+			FinalityReached:   metadata.FinalityReached,
+		},
+		EvidenceSummary: metadata.EvidenceSummary,
+		Settlements:     canonicalWebhookSettlements(transition.settlements),
+	}
+}
+
+func (webhookPayloadBuilderV2) paymentRequestFinalityReached(transition webhookTransition) any {
+	metadata := transition.metadata
+	return dto.WebhookPaymentRequestFinalityReachedDataV2{
+		PaymentRequest:    webhookPaymentRequestSnapshotV2(transition),
+		FirstConfirmedAt:  metadata.FirstConfirmedAt,
+		FinalityReachedAt: metadata.FinalityReachedAt.UTC(),
+		Settlements:       canonicalWebhookSettlements(transition.settlements),
+	}
+}
+
+func (webhookPayloadBuilderV2) settlementChanged(
+	row dto.OpenPaymentRequestForReconciliation,
+	settlement dto.ObservedSettlementEvidence,
+) any {
+	return webhookPayloadBuilderV1{}.settlementChanged(row, settlement)
+}
+
+func webhookPaymentRequestSnapshotV2(transition webhookTransition) dto.WebhookPaymentRequestSnapshotV2 {
+	row := transition.row
+	return dto.WebhookPaymentRequestSnapshotV2{
+		ID:                  row.ID,
+		Status:              transition.currentStatus,
+		Chain:               row.Chain,
+		Network:             row.Network,
+		Asset:               row.Asset,
+		ExpectedAmountMinor: row.ExpectedAmountMinor,
+		ObservedAmountMinor: transition.metadata.ObservedAmountMinor,
+		AddressCanonical:    row.AddressCanonical,
+		ExpiresAt:           row.ExpiresAt.UTC(),
+	}
+}
+
+func canonicalWebhookSettlements(settlements []dto.ObservedSettlementEvidence) []dto.WebhookSettlementPayload {
+	out := make([]dto.WebhookSettlementPayload, 0, len(settlements))
+	for _, settlement := range settlements {
+		if !settlement.IsCanonical {
+			continue
+		}
+		out = append(out, toWebhookSettlementPayload(settlement))
+	}
+	return out
+}
@@ -0,0 +1,106 @@
+//go:build !integration
+
+package use_cases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	"chaintx/internal/domain/policies"
+)
+
+func TestPutWebhookRetryPolicyUseCaseNormalizesAndStores(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	repository := &fakeWebhookRetryPolicyRepository{}
+	useCase := NewPutWebhookRetryPolicyUseCase(repository)
+
+	output, appErr := useCase.Execute(context.Background(), dto.PutWebhookRetryPolicyCommand{
+		DestinationHost:       " Hooks.Example.com ",
+		MaxAttempts:           12,
+		InitialBackoffSeconds: 30,
+		MaxBackoffSeconds:     3600,
+		OperatorID:            "ops-user-1",
+		Now:                   now,
+	})
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if output.DestinationHost != "hooks.example.com" || output.BackoffCurve != policies.WebhookBackoffCurveExponential {
+		t.Fatalf("expected normalized host and default curve, got %+v", output)
+	}
+	if output.UpdatedBy == nil || *output.UpdatedBy != "ops-user-1" || !output.UpdatedAt.Equal(now) {
+		t.Fatalf("expected audit fields, got %+v", output)
+	}
+	if _, stored := repository.policies["hooks.example.com"]; !stored {
+		t.Fatalf("expected policy stored, got %+v", repository.policies)
+	}
+}
+
+func TestPutWebhookRetryPolicyUseCaseValidatesFields(t *testing.T) {
+	valid := dto.PutWebhookRetryPolicyCommand{
+		DestinationHost:       "hooks.example.com",
+		MaxAttempts:           5,
+		InitialBackoffSeconds: 10,
+		MaxBackoffSeconds:     60,
+		OperatorID:            "ops-user-1",
+	}
+	zeroAge := 0
+	cases := []struct {
+		name   string
+		mutate func(*dto.PutWebhookRetryPolicyCommand)
+		field  string
+	}{
+		{name: "host with scheme", mutate: func(c *dto.PutWebhookRetryPolicyCommand) { c.DestinationHost = "https://hooks.example.com" }, field: "destination_host"},
+		{name: "operator", mutate: func(c *dto.PutWebhookRetryPolicyCommand) { c.OperatorID = " " }, field: "x_principal_id"},
+		{name: "attempts", mutate: func(c *dto.PutWebhookRetryPolicyCommand) { c.MaxAttempts = 0 }, field: "max_attempts"},
+		{name: "curve", mutate: func(c *dto.PutWebhookRetryPolicyCommand) { c.BackoffCurve = "random" }, field: "backoff_curve"},
+		{name: "initial", mutate: func(c *dto.PutWebhookRetryPolicyCommand) { c.InitialBackoffSeconds = 0 }, field: "initial_backoff_seconds"},
+		{name: "max below initial", mutate: func(c *dto.PutWebhookRetryPolicyCommand) { c.MaxBackoffSeconds = 5 }, field: "max_backoff_seconds"},
+		{name: "max age", mutate: func(c *dto.PutWebhookRetryPolicyCommand) { c.MaxAgeSeconds = &zeroAge }, field: "max_age_seconds"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			command := valid
+			tc.mutate(&command)
+			_, appErr := NewPutWebhookRetryPolicyUseCase(&fakeWebhookRetryPolicyRepository{}).Execute(context.Background(), command)
+			if appErr == nil || appErr.Details["field"] != tc.field {
+				t.Fatalf("expected %s validation error, got %+v", tc.field, appErr)
+			}
+		})
+	}
+}
+
+func TestGetAndDeleteWebhookRetryPolicyUseCasesReportNotFound(t *testing.T) {
+	repository := &fakeWebhookRetryPolicyRepository{
+		policies: map[string]dto.WebhookRetryPolicy{
+			"hooks.example.com": {DestinationHost: "hooks.example.com", MaxAttempts: 3},
+		},
+	}
+
+	policy, appErr := NewGetWebhookRetryPolicyUseCase(repository).Execute(
+		context.Background(),
+		dto.GetWebhookRetryPolicyQuery{DestinationHost: "HOOKS.example.com"},
+	)
+	if appErr != nil || policy.MaxAttempts != 3 {
+		t.Fatalf("expected stored policy, got %+v err=%+v", policy, appErr)
+	}
+
+	deleteUseCase := NewDeleteWebhookRetryPolicyUseCase(repository)
+	output, appErr := deleteUseCase.Execute(context.Background(), dto.DeleteWebhookRetryPolicyCommand{DestinationHost: "hooks.example.com"})
+	if appErr != nil || !output.Deleted {
+		t.Fatalf("expected delete, got %+v err=%+v", output, appErr)
+	}
+	_, appErr = deleteUseCase.Execute(context.Background(), dto.DeleteWebhookRetryPolicyCommand{DestinationHost: "hooks.example.com"})
+	if appErr == nil || appErr.Code != "webhook_retry_policy_not_found" {
+		t.Fatalf("expected not found on second delete, got %+v", appErr)
+	}
+	_, appErr = NewGetWebhookRetryPolicyUseCase(repository).Execute(
+		context.Background(),
+		dto.GetWebhookRetryPolicyQuery{DestinationHost: "hooks.example.com"},
+	)
+	if appErr == nil || appErr.Code != "webhook_retry_policy_not_found" {
+		t.Fatalf("expected not found after delete, got %+v", appErr)
+	}
+}
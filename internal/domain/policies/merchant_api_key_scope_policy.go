@@ -0,0 +1,44 @@
+package policies
+
+import "strings"
+
+const (
+	// MerchantAPIKeyTokenPrefix marks bearer tokens that are merchant API keys
+	// (ctxk_<prefix>_<secret>), so they are never confused with ops admin keys.
+	MerchantAPIKeyTokenPrefix = "ctxk_"
+
+	// AccessTokenKeyID stands in for the key id of principals authenticated by
+	// a JWT, so read authorization limits them to their own principal exactly
+	// like merchant API key holders.
+	AccessTokenKeyID = "access_token"
+
+	MerchantAPIKeyScopeCreate = "create"
+	MerchantAPIKeyScopeRead   = "read"
+	MerchantAPIKeyScopeAdmin  = "admin"
+)
+
+func IsMerchantAPIKeyScope(scope string) bool {
+	switch scope {
+	case MerchantAPIKeyScopeCreate, MerchantAPIKeyScopeRead, MerchantAPIKeyScopeAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// MerchantAPIKeyScopesAllow reports whether a key holding granted may perform
+// an action that needs required. The admin scope implies every other scope.
+func MerchantAPIKeyScopesAllow(granted []string, required string) bool {
+	for _, scope := range granted {
+		if scope == required || scope == MerchantAPIKeyScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAccessToken recognizes compact JWTs: three dot separated segments whose
+// header is a base64url JSON object, which always encodes to "eyJ".
+func IsAccessToken(token string) bool {
+	return strings.HasPrefix(token, "eyJ") && strings.Count(token, ".") == 2
+}
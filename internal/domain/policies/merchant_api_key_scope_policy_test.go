@@ -0,0 +1,39 @@
+//go:build !integration
+
+package policies
+
+import "testing"
+
+func TestMerchantAPIKeyScopesAllow(t *testing.T) {
+	testCases := []struct {
+		name     string
+		granted  []string
+		required string
+		expected bool
+	}{
+		{name: "exact scope", granted: []string{"read"}, required: "read", expected: true},
+		{name: "missing scope", granted: []string{"read"}, required: "create", expected: false},
+		{name: "admin implies create", granted: []string{"admin"}, required: "create", expected: true},
+		{name: "admin implies read", granted: []string{"admin"}, required: "read", expected: true},
+		{name: "no scopes", granted: nil, required: "read", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := MerchantAPIKeyScopesAllow(testCase.granted, testCase.required); got != testCase.expected {
+				t.Fatalf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsMerchantAPIKeyScope(t *testing.T) {
+	for _, scope := range []string{"create", "read", "admin"} {
+		if !IsMerchantAPIKeyScope(scope) {
+			t.Fatalf("expected %q to be a valid scope", scope)
+		}
+	}
+	if IsMerchantAPIKeyScope("write") {
+		t.Fatal("expected write to be rejected")
+	}
+}
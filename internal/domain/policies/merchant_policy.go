@@ -0,0 +1,35 @@
+package policies
+
+import "strings"
+
+// DefaultMerchantID owns every row written before merchants existed and every
+// request made without a merchant API key.
+const DefaultMerchantID = "default"
+
+const maxMerchantIDLength = 64
+
+// NormalizeMerchantID maps an empty merchant to the default merchant.
+func NormalizeMerchantID(merchantID string) string {
+	normalized := strings.ToLower(strings.TrimSpace(merchantID))
+	if normalized == "" {
+		return DefaultMerchantID
+	}
+	return normalized
+}
+
+// IsValidMerchantID accepts lowercase slugs: a letter or digit followed by
+// letters, digits, '_' or '-', at most 64 characters.
+func IsValidMerchantID(merchantID string) bool {
+	if merchantID == "" || len(merchantID) > maxMerchantIDLength {
+		return false
+	}
+	for index, char := range merchantID {
+		switch {
+		case char >= 'a' && char <= 'z', char >= '0' && char <= '9':
+		case (char == '_' || char == '-') && index > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+//go:build !integration
+
+package policies
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeMerchantID(t *testing.T) {
+	if got := NormalizeMerchantID("  "); got != DefaultMerchantID {
+		t.Fatalf("expected default merchant, got %q", got)
+	}
+	if got := NormalizeMerchantID(" Retail "); got != "retail" {
+		t.Fatalf("expected retail, got %q", got)
+	}
+}
+
+func TestIsValidMerchantID(t *testing.T) {
+	testCases := []struct {
+		merchantID string
+		expected   bool
+	}{
+		{merchantID: "default", expected: true},
+		{merchantID: "bu_retail-2", expected: true},
+		{merchantID: "", expected: false},
+		{merchantID: "_retail", expected: false},
+		{merchantID: "Retail", expected: false},
+		{merchantID: "retail eu", expected: false},
+		{merchantID: strings.Repeat("a", 65), expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := IsValidMerchantID(testCase.merchantID); got != testCase.expected {
+			t.Fatalf("merchant_id=%q expected %v, got %v", testCase.merchantID, testCase.expected, got)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package policies
+
+const (
+	OpsRoleViewer   = "viewer"
+	OpsRoleOperator = "operator"
+	OpsRoleAdmin    = "admin"
+)
+
+// Ops actions name each ops endpoint for permission checks and the admin
+// audit log.
+const (
+	OpsActionWebhookOutboxOverviewRead = "webhook_outbox.overview.read"
+	OpsActionWebhookOutboxDLQRead      = "webhook_outbox.dlq.read"
+	OpsActionWebhookOutboxRequeue      = "webhook_outbox.requeue"
+	OpsActionWebhookOutboxCancel       = "webhook_outbox.cancel"
+	OpsActionWebhookOutboxBulkRequeue  = "webhook_outbox.bulk_requeue"
+	OpsActionWebhookOutboxBulkCancel   = "webhook_outbox.bulk_cancel"
+	OpsActionWebhookEndpointTest       = "webhook_endpoint.test"
+	OpsActionWebhookRetryPolicyRead    = "webhook_retry_policy.read"
+	OpsActionWebhookRetryPolicyPut     = "webhook_retry_policy.put"
+	OpsActionWebhookRetryPolicyDelete  = "webhook_retry_policy.delete"
+	OpsActionMerchantAPIKeyRead        = "merchant_api_key.read"
+	OpsActionMerchantAPIKeyCreate      = "merchant_api_key.create"
+	OpsActionMerchantAPIKeyRevoke      = "merchant_api_key.revoke"
+	OpsActionAdminAuditLogRead         = "admin_audit_log.read"
+	OpsActionPaymentRequestLimitsRead  = "payment_request_limits.read"
+)
+
+var opsRoleRanks = map[string]int{
+	OpsRoleViewer:   1,
+	OpsRoleOperator: 2,
+	OpsRoleAdmin:    3,
+}
+
+// opsActionRoles holds the least role allowed to perform each action.
+// Viewers read, operators change individual deliveries, and admins change
+// configuration and credentials.
+var opsActionRoles = map[string]string{
+	OpsActionWebhookOutboxOverviewRead: OpsRoleViewer,
+	OpsActionWebhookOutboxDLQRead:      OpsRoleViewer,
+	OpsActionWebhookOutboxRequeue:      OpsRoleOperator,
+	OpsActionWebhookOutboxCancel:       OpsRoleOperator,
+	OpsActionWebhookOutboxBulkRequeue:  OpsRoleOperator,
+	OpsActionWebhookOutboxBulkCancel:   OpsRoleOperator,
+	OpsActionWebhookEndpointTest:       OpsRoleOperator,
+	OpsActionWebhookRetryPolicyRead:    OpsRoleViewer,
+	OpsActionWebhookRetryPolicyPut:     OpsRoleAdmin,
+	OpsActionWebhookRetryPolicyDelete:  OpsRoleAdmin,
+	OpsActionMerchantAPIKeyRead:        OpsRoleViewer,
+	OpsActionMerchantAPIKeyCreate:      OpsRoleAdmin,
+	OpsActionMerchantAPIKeyRevoke:      OpsRoleAdmin,
+	OpsActionAdminAuditLogRead:         OpsRoleAdmin,
+	OpsActionPaymentRequestLimitsRead:  OpsRoleViewer,
+}
+
+func IsOpsRole(role string) bool {
+	_, exists := opsRoleRanks[role]
+	return exists
+}
+
+// RequiredOpsRole returns the least role allowed to perform action. Unknown
+// actions require admin.
+func RequiredOpsRole(action string) string {
+	if role, exists := opsActionRoles[action]; exists {
+		return role
+	}
+	return OpsRoleAdmin
+}
+
+// OpsRoleAllows reports whether role may perform action. Roles are ordered,
+// so each role holds every permission of the roles below it.
+func OpsRoleAllows(role string, action string) bool {
+	rank, exists := opsRoleRanks[role]
+	if !exists {
+		return false
+	}
+	return rank >= opsRoleRanks[RequiredOpsRole(action)]
+}
+
+// HighestOpsRole returns the highest known role in roles, or "" when none is
+// known. Identity providers often send several groups in one claim.
+func HighestOpsRole(roles []string) string {
+	highest := ""
+	for _, role := range roles {
+		if opsRoleRanks[role] > opsRoleRanks[highest] {
+			highest = role
+		}
+	}
+	return highest
+}
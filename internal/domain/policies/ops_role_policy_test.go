@@ -0,0 +1,66 @@
+//go:build !integration
+
+package policies
+
+import "testing"
+
+func TestOpsRoleAllows(t *testing.T) {
+	testCases := []struct {
+		name     string
+		role     string
+		action   string
+		expected bool
+	}{
+		{name: "viewer reads dlq", role: "viewer", action: OpsActionWebhookOutboxDLQRead, expected: true},
+		{name: "viewer cannot requeue", role: "viewer", action: OpsActionWebhookOutboxRequeue, expected: false},
+		{name: "operator requeues", role: "operator", action: OpsActionWebhookOutboxRequeue, expected: true},
+		{name: "operator reads overview", role: "operator", action: OpsActionWebhookOutboxOverviewRead, expected: true},
+		{name: "operator cannot change retry policy", role: "operator", action: OpsActionWebhookRetryPolicyPut, expected: false},
+		{name: "operator cannot issue merchant keys", role: "operator", action: OpsActionMerchantAPIKeyCreate, expected: false},
+		{name: "admin issues merchant keys", role: "admin", action: OpsActionMerchantAPIKeyCreate, expected: true},
+		{name: "unknown action needs admin", role: "operator", action: "webhook_outbox.purge", expected: false},
+		{name: "admin performs unknown action", role: "admin", action: "webhook_outbox.purge", expected: true},
+		{name: "unknown role", role: "root", action: OpsActionWebhookOutboxDLQRead, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := OpsRoleAllows(testCase.role, testCase.action); got != testCase.expected {
+				t.Fatalf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsOpsRole(t *testing.T) {
+	for _, role := range []string{"viewer", "operator", "admin"} {
+		if !IsOpsRole(role) {
+			t.Fatalf("expected %q to be a valid role", role)
+		}
+	}
+	if IsOpsRole("Admin") {
+		t.Fatal("expected roles to be case sensitive")
+	}
+}
+
+func TestHighestOpsRole(t *testing.T) {
+	testCases := []struct {
+		name     string
+		roles    []string
+		expected string
+	}{
+		{name: "empty", roles: nil, expected: ""},
+		{name: "single", roles: []string{"viewer"}, expected: "viewer"},
+		{name: "highest wins", roles: []string{"viewer", "admin", "operator"}, expected: "admin"},
+		{name: "unknown ignored", roles: []string{"billing", "operator"}, expected: "operator"},
+		{name: "only unknown", roles: []string{"billing"}, expected: ""},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := HighestOpsRole(testCase.roles); got != testCase.expected {
+				t.Fatalf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
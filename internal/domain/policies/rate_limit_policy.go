@@ -0,0 +1,67 @@
+package policies
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimitPolicy is a token bucket refilled at RatePerSecond up to Burst
+// tokens. A zero rate or burst disables the limit.
+type RateLimitPolicy struct {
+	RatePerSecond float64
+	Burst         int
+}
+
+type RateLimitBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+type RateLimitPermit struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+func (p RateLimitPolicy) Enabled() bool {
+	return p.RatePerSecond > 0 && p.Burst > 0
+}
+
+// RefillDuration is how long an empty bucket takes to fill up again; a bucket
+// untouched for that long is indistinguishable from a new one.
+func (p RateLimitPolicy) RefillDuration() time.Duration {
+	if !p.Enabled() {
+		return 0
+	}
+	return time.Duration(float64(p.Burst) / p.RatePerSecond * float64(time.Second))
+}
+
+// TakeRateLimitToken refills bucket for the time elapsed since its last update
+// and takes one token. A zero bucket starts full. When no token is left, the
+// permit says how long until one is.
+func TakeRateLimitToken(bucket RateLimitBucket, policy RateLimitPolicy, now time.Time) (RateLimitBucket, RateLimitPermit) {
+	if !policy.Enabled() {
+		return bucket, RateLimitPermit{Allowed: true}
+	}
+
+	next := bucket
+	burst := float64(policy.Burst)
+	if next.UpdatedAt.IsZero() {
+		next.Tokens = burst
+	} else if elapsed := now.Sub(next.UpdatedAt); elapsed > 0 {
+		next.Tokens = math.Min(burst, next.Tokens+elapsed.Seconds()*policy.RatePerSecond)
+	}
+	if now.After(next.UpdatedAt) {
+		next.UpdatedAt = now
+	}
+
+	if next.Tokens >= 1 {
+		next.Tokens--
+		return next, RateLimitPermit{Allowed: true}
+	}
+
+	missing := 1 - next.Tokens
+	return next, RateLimitPermit{
+		Allowed:    false,
+		RetryAfter: time.Duration(math.Ceil(missing / policy.RatePerSecond * float64(time.Second))),
+	}
+}
@@ -0,0 +1,67 @@
+//go:build !integration
+
+package policies
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTakeRateLimitTokenSpendsBurstThenRefills(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	policy := RateLimitPolicy{RatePerSecond: 2, Burst: 3}
+
+	bucket := RateLimitBucket{}
+	var permit RateLimitPermit
+	for i := 0; i < 3; i++ {
+		bucket, permit = TakeRateLimitToken(bucket, policy, now)
+		if !permit.Allowed {
+			t.Fatalf("expected token %d of the burst to be allowed", i+1)
+		}
+	}
+
+	bucket, permit = TakeRateLimitToken(bucket, policy, now)
+	if permit.Allowed {
+		t.Fatalf("expected empty bucket to deny")
+	}
+	if permit.RetryAfter != 500*time.Millisecond {
+		t.Fatalf("expected retry after 500ms, got %s", permit.RetryAfter)
+	}
+
+	bucket, permit = TakeRateLimitToken(bucket, policy, now.Add(500*time.Millisecond))
+	if !permit.Allowed {
+		t.Fatalf("expected refilled token to be allowed")
+	}
+
+	bucket, _ = TakeRateLimitToken(bucket, policy, now.Add(time.Hour))
+	if bucket.Tokens != 2 {
+		t.Fatalf("expected refill capped at burst, got %v tokens after taking one", bucket.Tokens)
+	}
+}
+
+func TestTakeRateLimitTokenIgnoresClockGoingBackwards(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	policy := RateLimitPolicy{RatePerSecond: 1, Burst: 1}
+
+	bucket, _ := TakeRateLimitToken(RateLimitBucket{}, policy, now)
+	bucket, permit := TakeRateLimitToken(bucket, policy, now.Add(-time.Minute))
+	if permit.Allowed {
+		t.Fatalf("expected earlier timestamp not to refill the bucket")
+	}
+	if !bucket.UpdatedAt.Equal(now) {
+		t.Fatalf("expected updated_at to stay at %s, got %s", now, bucket.UpdatedAt)
+	}
+}
+
+func TestRateLimitPolicyDisabled(t *testing.T) {
+	policy := RateLimitPolicy{}
+	if policy.Enabled() || policy.RefillDuration() != 0 {
+		t.Fatalf("expected zero policy to be disabled")
+	}
+	if _, permit := TakeRateLimitToken(RateLimitBucket{}, policy, time.Now()); !permit.Allowed {
+		t.Fatalf("expected disabled policy to allow")
+	}
+	if got := (RateLimitPolicy{RatePerSecond: 0.5, Burst: 10}).RefillDuration(); got != 20*time.Second {
+		t.Fatalf("expected refill duration 20s, got %s", got)
+	}
+}
@@ -0,0 +1,45 @@
+package policies
+
+import (
+	"strings"
+
+	valueobjects "chaintx/internal/domain/value_objects"
+)
+
+// ResolveWebhookAPIVersion picks the payload version pinned on a new payment
+// request. An explicit request value wins, then the most specific endpoint
+// host pin (exact host before the longest matching wildcard), then the
+// service default.
+func ResolveWebhookAPIVersion(
+	requested valueobjects.WebhookAPIVersion,
+	webhookHost string,
+	endpointPins map[string]valueobjects.WebhookAPIVersion,
+	defaultVersion valueobjects.WebhookAPIVersion,
+) valueobjects.WebhookAPIVersion {
+	if requested != "" {
+		return requested
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(webhookHost)), ".")
+	if version, exists := endpointPins[host]; exists && host != "" {
+		return version
+	}
+
+	bestPattern := ""
+	for pattern := range endpointPins {
+		if !strings.HasPrefix(pattern, "*.") || len(pattern) <= len(bestPattern) {
+			continue
+		}
+		if valueobjects.IsWebhookHostAllowed(host, []string{pattern}) {
+			bestPattern = pattern
+		}
+	}
+	if bestPattern != "" {
+		return endpointPins[bestPattern]
+	}
+
+	if defaultVersion == "" {
+		return valueobjects.DefaultWebhookAPIVersion
+	}
+	return defaultVersion
+}
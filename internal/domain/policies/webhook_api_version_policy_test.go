@@ -0,0 +1,46 @@
+//go:build !integration
+
+package policies
+
+import (
+	"testing"
+
+	valueobjects "chaintx/internal/domain/value_objects"
+)
+
+func TestResolveWebhookAPIVersion(t *testing.T) {
+	pins := map[string]valueobjects.WebhookAPIVersion{
+		"hooks.example.com":     valueobjects.WebhookAPIVersionV2,
+		"*.example.com":         valueobjects.WebhookAPIVersionV1,
+		"*.partner.example.com": valueobjects.WebhookAPIVersionV2,
+	}
+
+	testCases := []struct {
+		name      string
+		requested valueobjects.WebhookAPIVersion
+		host      string
+		expected  valueobjects.WebhookAPIVersion
+	}{
+		{name: "explicit request wins", requested: valueobjects.WebhookAPIVersionV1, host: "hooks.example.com", expected: valueobjects.WebhookAPIVersionV1},
+		{name: "exact host pin", host: "Hooks.Example.com.", expected: valueobjects.WebhookAPIVersionV2},
+		{name: "longest wildcard pin", host: "a.partner.example.com", expected: valueobjects.WebhookAPIVersionV2},
+		{name: "shorter wildcard pin", host: "other.example.com", expected: valueobjects.WebhookAPIVersionV1},
+		{name: "service default", host: "receiver.test", expected: valueobjects.WebhookAPIVersionV2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resolved := ResolveWebhookAPIVersion(tc.requested, tc.host, pins, valueobjects.WebhookAPIVersionV2)
+			if resolved != tc.expected {
+				t.Fatalf("expected %s, got %s", tc.expected, resolved)
+			}
+		})
+	}
+}
+
+func TestResolveWebhookAPIVersionFallsBackToV1(t *testing.T) {
+	resolved := ResolveWebhookAPIVersion("", "hooks.example.com", nil, "")
+	if resolved != valueobjects.WebhookAPIVersionV1 {
+		t.Fatalf("expected v1 fallback, got %s", resolved)
+	}
+}
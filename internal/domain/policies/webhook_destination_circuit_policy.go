@@ -0,0 +1,146 @@
+package policies
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	WebhookCircuitStateClosed   = "closed"
+	WebhookCircuitStateOpen     = "open"
+	WebhookCircuitStateHalfOpen = "half_open"
+
+	WebhookDispatchDeniedCircuitOpen = "circuit_open"
+	WebhookDispatchDeniedRateLimited = "rate_limited"
+)
+
+type WebhookDestinationCircuitPolicy struct {
+	FailureThreshold     int
+	OpenDuration         time.Duration
+	ProbeLease           time.Duration
+	MaxRequestsPerSecond int
+}
+
+type WebhookDestinationCircuit struct {
+	State               string
+	ConsecutiveFailures int
+	OpenUntil           time.Time
+	ProbeOwner          string
+	ProbeUntil          time.Time
+	RateWindowStart     time.Time
+	RateWindowCount     int
+}
+
+type WebhookDispatchPermit struct {
+	Allowed bool
+	Probe   bool
+	Reason  string
+	RetryAt time.Time
+}
+
+func (p WebhookDestinationCircuitPolicy) CircuitEnabled() bool {
+	return p.FailureThreshold > 0 && p.OpenDuration > 0
+}
+
+func (p WebhookDestinationCircuitPolicy) RateLimitEnabled() bool {
+	return p.MaxRequestsPerSecond > 0
+}
+
+// AcquireWebhookDispatchPermit decides whether one delivery to the destination
+// may start now. An open circuit turns half-open once its cool-down elapses and
+// then admits exactly one in-flight probe: every further request, including
+// one from the probe owner, is refused until the probe's outcome is recorded
+// or its lease expires.
+func AcquireWebhookDispatchPermit(
+	circuit WebhookDestinationCircuit,
+	policy WebhookDestinationCircuitPolicy,
+	owner string,
+	now time.Time,
+) (WebhookDestinationCircuit, WebhookDispatchPermit) {
+	next := circuit
+	if next.State == "" {
+		next.State = WebhookCircuitStateClosed
+	}
+	owner = strings.TrimSpace(owner)
+
+	probe := false
+	if policy.CircuitEnabled() {
+		if next.State == WebhookCircuitStateOpen {
+			if now.Before(next.OpenUntil) {
+				return circuit, WebhookDispatchPermit{
+					Reason:  WebhookDispatchDeniedCircuitOpen,
+					RetryAt: next.OpenUntil,
+				}
+			}
+			next.State = WebhookCircuitStateHalfOpen
+			next.ProbeOwner = ""
+			next.ProbeUntil = time.Time{}
+		}
+		if next.State == WebhookCircuitStateHalfOpen {
+			if next.ProbeOwner != "" && now.Before(next.ProbeUntil) {
+				return circuit, WebhookDispatchPermit{
+					Reason:  WebhookDispatchDeniedCircuitOpen,
+					RetryAt: next.ProbeUntil,
+				}
+			}
+			probe = true
+		}
+	}
+
+	if policy.RateLimitEnabled() {
+		window := now.Truncate(time.Second)
+		if !next.RateWindowStart.Equal(window) {
+			next.RateWindowStart = window
+			next.RateWindowCount = 0
+		}
+		if next.RateWindowCount >= policy.MaxRequestsPerSecond {
+			return circuit, WebhookDispatchPermit{
+				Reason:  WebhookDispatchDeniedRateLimited,
+				RetryAt: window.Add(time.Second),
+			}
+		}
+		next.RateWindowCount++
+	}
+
+	if probe {
+		next.ProbeOwner = owner
+		next.ProbeUntil = now.Add(policy.ProbeLease)
+	}
+	return next, WebhookDispatchPermit{Allowed: true, Probe: probe}
+}
+
+// RecordWebhookDispatchResult folds one delivery outcome into the circuit. A
+// success closes the circuit; a failed probe or reaching the failure threshold
+// opens it for OpenDuration.
+func RecordWebhookDispatchResult(
+	circuit WebhookDestinationCircuit,
+	policy WebhookDestinationCircuitPolicy,
+	success bool,
+	now time.Time,
+) WebhookDestinationCircuit {
+	next := circuit
+	if next.State == "" {
+		next.State = WebhookCircuitStateClosed
+	}
+
+	if success {
+		next.State = WebhookCircuitStateClosed
+		next.ConsecutiveFailures = 0
+		next.OpenUntil = time.Time{}
+		next.ProbeOwner = ""
+		next.ProbeUntil = time.Time{}
+		return next
+	}
+
+	next.ConsecutiveFailures++
+	if !policy.CircuitEnabled() {
+		return next
+	}
+	if next.State == WebhookCircuitStateHalfOpen || next.ConsecutiveFailures >= policy.FailureThreshold {
+		next.State = WebhookCircuitStateOpen
+		next.OpenUntil = now.Add(policy.OpenDuration)
+		next.ProbeOwner = ""
+		next.ProbeUntil = time.Time{}
+	}
+	return next
+}
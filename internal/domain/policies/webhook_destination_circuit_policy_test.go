@@ -0,0 +1,142 @@
+//go:build !integration
+
+package policies
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecordWebhookDispatchResultOpensAfterThreshold(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	policy := WebhookDestinationCircuitPolicy{FailureThreshold: 3, OpenDuration: time.Minute, ProbeLease: 30 * time.Second}
+
+	circuit := WebhookDestinationCircuit{}
+	for i := 0; i < 2; i++ {
+		circuit = RecordWebhookDispatchResult(circuit, policy, false, now)
+	}
+	if circuit.State != WebhookCircuitStateClosed || circuit.ConsecutiveFailures != 2 {
+		t.Fatalf("expected closed circuit with 2 failures, got %+v", circuit)
+	}
+
+	circuit = RecordWebhookDispatchResult(circuit, policy, false, now)
+	if circuit.State != WebhookCircuitStateOpen {
+		t.Fatalf("expected open circuit, got %+v", circuit)
+	}
+	if !circuit.OpenUntil.Equal(now.Add(time.Minute)) {
+		t.Fatalf("expected open_until %s, got %s", now.Add(time.Minute), circuit.OpenUntil)
+	}
+
+	_, permit := AcquireWebhookDispatchPermit(circuit, policy, "worker-a", now.Add(10*time.Second))
+	if permit.Allowed || permit.Reason != WebhookDispatchDeniedCircuitOpen {
+		t.Fatalf("expected circuit_open denial, got %+v", permit)
+	}
+	if !permit.RetryAt.Equal(circuit.OpenUntil) {
+		t.Fatalf("expected retry at open_until, got %s", permit.RetryAt)
+	}
+}
+
+func TestAcquireWebhookDispatchPermitAdmitsSingleHalfOpenProbe(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	policy := WebhookDestinationCircuitPolicy{FailureThreshold: 1, OpenDuration: time.Minute, ProbeLease: 30 * time.Second}
+	circuit := WebhookDestinationCircuit{
+		State:               WebhookCircuitStateOpen,
+		ConsecutiveFailures: 1,
+		OpenUntil:           now,
+	}
+
+	circuit, permit := AcquireWebhookDispatchPermit(circuit, policy, "worker-a", now)
+	if !permit.Allowed || !permit.Probe {
+		t.Fatalf("expected probe permit, got %+v", permit)
+	}
+	if circuit.State != WebhookCircuitStateHalfOpen || circuit.ProbeOwner != "worker-a" {
+		t.Fatalf("expected half-open circuit owned by worker-a, got %+v", circuit)
+	}
+
+	_, permit = AcquireWebhookDispatchPermit(circuit, policy, "worker-b", now.Add(time.Second))
+	if permit.Allowed {
+		t.Fatalf("expected second probe to be denied, got %+v", permit)
+	}
+
+	_, permit = AcquireWebhookDispatchPermit(circuit, policy, "worker-b", now.Add(31*time.Second))
+	if !permit.Allowed || !permit.Probe {
+		t.Fatalf("expected expired probe lease to be reclaimable, got %+v", permit)
+	}
+}
+
+func TestAcquireWebhookDispatchPermitRefusesProbeOwnerWhileProbeInFlight(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	policy := WebhookDestinationCircuitPolicy{FailureThreshold: 1, OpenDuration: time.Minute, ProbeLease: 30 * time.Second}
+	circuit := WebhookDestinationCircuit{
+		State:               WebhookCircuitStateOpen,
+		ConsecutiveFailures: 1,
+		OpenUntil:           now,
+	}
+
+	circuit, permit := AcquireWebhookDispatchPermit(circuit, policy, "worker-a", now)
+	if !permit.Allowed || !permit.Probe {
+		t.Fatalf("expected probe permit, got %+v", permit)
+	}
+
+	denied, permit := AcquireWebhookDispatchPermit(circuit, policy, "worker-a", now.Add(time.Second))
+	if permit.Allowed || permit.Reason != WebhookDispatchDeniedCircuitOpen {
+		t.Fatalf("expected probe owner to be refused while its probe is in flight, got %+v", permit)
+	}
+	if !permit.RetryAt.Equal(now.Add(30*time.Second)) || denied != circuit {
+		t.Fatalf("expected unchanged circuit and retry at lease expiry, got %+v %+v", denied, permit)
+	}
+
+	closed := RecordWebhookDispatchResult(circuit, policy, true, now.Add(2*time.Second))
+	_, permit = AcquireWebhookDispatchPermit(closed, policy, "worker-a", now.Add(3*time.Second))
+	if !permit.Allowed || permit.Probe {
+		t.Fatalf("expected closed circuit to admit normal sends, got %+v", permit)
+	}
+}
+
+func TestRecordWebhookDispatchResultProbeOutcome(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	policy := WebhookDestinationCircuitPolicy{FailureThreshold: 5, OpenDuration: time.Minute, ProbeLease: 30 * time.Second}
+	halfOpen := WebhookDestinationCircuit{
+		State:               WebhookCircuitStateHalfOpen,
+		ConsecutiveFailures: 5,
+		ProbeOwner:          "worker-a",
+		ProbeUntil:          now.Add(30 * time.Second),
+	}
+
+	reopened := RecordWebhookDispatchResult(halfOpen, policy, false, now)
+	if reopened.State != WebhookCircuitStateOpen || reopened.ProbeOwner != "" {
+		t.Fatalf("expected failed probe to reopen circuit, got %+v", reopened)
+	}
+
+	closed := RecordWebhookDispatchResult(halfOpen, policy, true, now)
+	if closed.State != WebhookCircuitStateClosed || closed.ConsecutiveFailures != 0 {
+		t.Fatalf("expected successful probe to close circuit, got %+v", closed)
+	}
+}
+
+func TestAcquireWebhookDispatchPermitRateLimitsPerSecond(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 200_000_000, time.UTC)
+	policy := WebhookDestinationCircuitPolicy{MaxRequestsPerSecond: 2}
+
+	circuit := WebhookDestinationCircuit{}
+	var permit WebhookDispatchPermit
+	for i := 0; i < 2; i++ {
+		circuit, permit = AcquireWebhookDispatchPermit(circuit, policy, "worker-a", now)
+		if !permit.Allowed {
+			t.Fatalf("expected permit %d to be allowed", i)
+		}
+	}
+
+	_, permit = AcquireWebhookDispatchPermit(circuit, policy, "worker-a", now.Add(100*time.Millisecond))
+	if permit.Allowed || permit.Reason != WebhookDispatchDeniedRateLimited {
+		t.Fatalf("expected rate_limited denial, got %+v", permit)
+	}
+	if !permit.RetryAt.Equal(now.Truncate(time.Second).Add(time.Second)) {
+		t.Fatalf("expected retry at next window, got %s", permit.RetryAt)
+	}
+
+	_, permit = AcquireWebhookDispatchPermit(circuit, policy, "worker-a", now.Add(time.Second))
+	if !permit.Allowed {
+		t.Fatalf("expected next window to allow dispatch, got %+v", permit)
+	}
+}
@@ -0,0 +1,78 @@
+package policies
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	WebhookBackoffCurveExponential = "exponential"
+	WebhookBackoffCurveLinear      = "linear"
+	WebhookBackoffCurveFixed       = "fixed"
+)
+
+// WebhookRetryPolicy overrides the dispatcher-wide retry settings for one
+// destination. A zero MaxAge means deliveries are bounded by attempts only.
+type WebhookRetryPolicy struct {
+	MaxAttempts    int
+	BackoffCurve   string
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	MaxAge         time.Duration
+}
+
+func IsWebhookBackoffCurve(curve string) bool {
+	switch strings.TrimSpace(curve) {
+	case WebhookBackoffCurveExponential, WebhookBackoffCurveLinear, WebhookBackoffCurveFixed:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookRetryBackoff returns the delay before the next delivery once
+// attempts deliveries have failed. Unknown curves fall back to exponential so
+// a stale stored value never stops retries.
+func WebhookRetryBackoff(curve string, attempts int, initial time.Duration, max time.Duration) time.Duration {
+	if attempts <= 1 {
+		return minDuration(initial, max)
+	}
+
+	switch strings.TrimSpace(curve) {
+	case WebhookBackoffCurveFixed:
+		return minDuration(initial, max)
+	case WebhookBackoffCurveLinear:
+		if initial > 0 && time.Duration(attempts) > max/initial {
+			return max
+		}
+		return minDuration(initial*time.Duration(attempts), max)
+	}
+
+	backoff := initial
+	for i := 1; i < attempts; i++ {
+		if backoff >= max {
+			return max
+		}
+		backoff *= 2
+		if backoff > max {
+			return max
+		}
+	}
+	return backoff
+}
+
+// Expired reports whether a retry scheduled at nextAttemptAt would
+// land past the policy's max age for an event created at createdAt.
+func (p WebhookRetryPolicy) Expired(createdAt time.Time, nextAttemptAt time.Time) bool {
+	if p.MaxAge <= 0 || createdAt.IsZero() {
+		return false
+	}
+	return nextAttemptAt.After(createdAt.Add(p.MaxAge))
+}
+
+func minDuration(a time.Duration, b time.Duration) time.Duration {
+	if b > 0 && a > b {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,45 @@
+//go:build !integration
+
+package policies
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWebhookRetryBackoffCurves(t *testing.T) {
+	cases := []struct {
+		curve    string
+		attempts int
+		want     time.Duration
+	}{
+		{curve: WebhookBackoffCurveExponential, attempts: 1, want: 5 * time.Second},
+		{curve: WebhookBackoffCurveExponential, attempts: 3, want: 20 * time.Second},
+		{curve: WebhookBackoffCurveExponential, attempts: 10, want: time.Minute},
+		{curve: WebhookBackoffCurveLinear, attempts: 3, want: 15 * time.Second},
+		{curve: WebhookBackoffCurveLinear, attempts: 100, want: time.Minute},
+		{curve: WebhookBackoffCurveFixed, attempts: 7, want: 5 * time.Second},
+		{curve: "unknown", attempts: 3, want: 20 * time.Second},
+	}
+	for _, tc := range cases {
+		got := WebhookRetryBackoff(tc.curve, tc.attempts, 5*time.Second, time.Minute)
+		if got != tc.want {
+			t.Fatalf("curve=%s attempts=%d: expected %s, got %s", tc.curve, tc.attempts, tc.want, got)
+		}
+	}
+}
+
+func TestWebhookRetryPolicyExpired(t *testing.T) {
+	createdAt := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	policy := WebhookRetryPolicy{MaxAge: time.Hour}
+
+	if policy.Expired(createdAt, createdAt.Add(time.Hour)) {
+		t.Fatal("expected retry exactly at max age to be allowed")
+	}
+	if !policy.Expired(createdAt, createdAt.Add(time.Hour+time.Second)) {
+		t.Fatal("expected retry past max age to expire")
+	}
+	if (WebhookRetryPolicy{}).Expired(createdAt, createdAt.Add(1000*time.Hour)) {
+		t.Fatal("expected no expiry without max age")
+	}
+}
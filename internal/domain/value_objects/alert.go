@@ -0,0 +1,93 @@
+package valueobjects
+
+import (
+	"strings"
+
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// Alert signals evaluated by the alert worker. AlertSignalAny is only valid
+// in notification routes, where it matches every signal without its own route.
+const (
+	AlertSignalAny                     = "*"
+	AlertSignalWebhookFailedCount      = "failed_count"
+	AlertSignalWebhookPendingReady     = "pending_ready_count"
+	AlertSignalWebhookOldestPendingAge = "oldest_pending_age_seconds"
+
+	AlertSignalReconcileDetectedStuck       = "detected_stuck_count"
+	AlertSignalReconcileObservationErrorBPS = "observation_error_rate_bps"
+	AlertSignalReconcileChainTipStale       = "chain_tip_stale_seconds"
+	AlertSignalReconcileReorgCount          = "reorg_count"
+)
+
+var knownAlertSignals = map[string]struct{}{
+	AlertSignalWebhookFailedCount:      {},
+	AlertSignalWebhookPendingReady:     {},
+	AlertSignalWebhookOldestPendingAge: {},
+
+	AlertSignalReconcileDetectedStuck:       {},
+	AlertSignalReconcileObservationErrorBPS: {},
+	AlertSignalReconcileChainTipStale:       {},
+	AlertSignalReconcileReorgCount:          {},
+}
+
+func IsKnownAlertSignal(signal string) bool {
+	_, known := knownAlertSignals[signal]
+	return known
+}
+
+type AlertSeverity string
+
+const (
+	AlertSeverityInfo     AlertSeverity = "info"
+	AlertSeverityWarning  AlertSeverity = "warning"
+	AlertSeverityCritical AlertSeverity = "critical"
+)
+
+// ParseAlertSeverity defaults an empty value to warning.
+func ParseAlertSeverity(raw string) (AlertSeverity, *apperrors.AppError) {
+	normalized := AlertSeverity(strings.ToLower(strings.TrimSpace(raw)))
+	switch normalized {
+	case "":
+		return AlertSeverityWarning, nil
+	case AlertSeverityInfo, AlertSeverityWarning, AlertSeverityCritical:
+		return normalized, nil
+	default:
+		return "", apperrors.NewValidation(
+			"invalid_request",
+			"alert severity must be one of info, warning, critical",
+			map[string]any{"severity": raw},
+		)
+	}
+}
+
+func (s AlertSeverity) String() string {
+	return string(s)
+}
+
+type AlertChannelType string
+
+const (
+	AlertChannelHTTP      AlertChannelType = "http"
+	AlertChannelSlack     AlertChannelType = "slack"
+	AlertChannelPagerDuty AlertChannelType = "pagerduty"
+	AlertChannelSMTP      AlertChannelType = "smtp"
+)
+
+func ParseAlertChannelType(raw string) (AlertChannelType, *apperrors.AppError) {
+	normalized := AlertChannelType(strings.ToLower(strings.TrimSpace(raw)))
+	switch normalized {
+	case AlertChannelHTTP, AlertChannelSlack, AlertChannelPagerDuty, AlertChannelSMTP:
+		return normalized, nil
+	default:
+		return "", apperrors.NewValidation(
+			"invalid_request",
+			"alert channel type must be one of http, slack, pagerduty, smtp",
+			map[string]any{"type": raw},
+		)
+	}
+}
+
+func (t AlertChannelType) String() string {
+	return string(t)
+}
@@ -0,0 +1,47 @@
+//go:build !integration
+
+package valueobjects
+
+import "testing"
+
+func TestParseAlertSeverity(t *testing.T) {
+	testCases := []struct {
+		raw      string
+		expected AlertSeverity
+		valid    bool
+	}{
+		{raw: "", expected: AlertSeverityWarning, valid: true},
+		{raw: " Critical ", expected: AlertSeverityCritical, valid: true},
+		{raw: "info", expected: AlertSeverityInfo, valid: true},
+		{raw: "error", valid: false},
+	}
+
+	for _, tc := range testCases {
+		severity, appErr := ParseAlertSeverity(tc.raw)
+		if !tc.valid {
+			if appErr == nil {
+				t.Fatalf("expected %q to be rejected", tc.raw)
+			}
+			continue
+		}
+		if appErr != nil {
+			t.Fatalf("expected %q to parse, got %+v", tc.raw, appErr)
+		}
+		if severity != tc.expected {
+			t.Fatalf("expected %s, got %s", tc.expected, severity)
+		}
+	}
+}
+
+func TestParseAlertChannelType(t *testing.T) {
+	for _, raw := range []string{"http", "Slack", " pagerduty", "SMTP"} {
+		if _, appErr := ParseAlertChannelType(raw); appErr != nil {
+			t.Fatalf("expected %q to parse, got %+v", raw, appErr)
+		}
+	}
+	for _, raw := range []string{"", "opsgenie"} {
+		if _, appErr := ParseAlertChannelType(raw); appErr == nil {
+			t.Fatalf("expected %q to be rejected", raw)
+		}
+	}
+}
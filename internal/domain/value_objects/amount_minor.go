@@ -21,3 +21,25 @@ func NormalizeExpectedAmountMinor(raw string) (string, *apperrors.AppError) {
 
 	return value, nil
 }
+
+// FormatAmountMinor renders an integer minor unit amount as a decimal in major
+// units, without trailing zeros: "150000" with 8 decimals is "0.0015".
+func FormatAmountMinor(amountMinor string, decimals int) string {
+	digits := strings.TrimLeft(amountMinor, "0")
+	if decimals <= 0 {
+		if digits == "" {
+			return "0"
+		}
+		return digits
+	}
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	whole := digits[:len(digits)-decimals]
+	fraction := strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if fraction == "" {
+		return whole
+	}
+	return whole + "." + fraction
+}
@@ -0,0 +1,95 @@
+package valueobjects
+
+import (
+	"regexp"
+	"strings"
+
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+// OutboxSinkWebhook is the outbox channel for merchant HTTP webhooks. Message
+// bus sinks use their driver name as the channel.
+const OutboxSinkWebhook = "webhook"
+
+type EventSinkDriver string
+
+const (
+	EventSinkDriverNone  EventSinkDriver = "none"
+	EventSinkDriverKafka EventSinkDriver = "kafka"
+	EventSinkDriverNATS  EventSinkDriver = "nats"
+	EventSinkDriverAMQP  EventSinkDriver = "amqp"
+)
+
+// eventSinkTopicPattern is the intersection of Kafka topic, NATS subject and
+// AMQP exchange naming rules, so one value works for every driver.
+var eventSinkTopicPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`)
+
+const maxEventSinkTopicLength = 249
+
+func ParseEventSinkDriver(raw string) (EventSinkDriver, *apperrors.AppError) {
+	normalized := EventSinkDriver(strings.ToLower(strings.TrimSpace(raw)))
+	switch normalized {
+	case "":
+		return EventSinkDriverNone, nil
+	case EventSinkDriverNone, EventSinkDriverKafka, EventSinkDriverNATS, EventSinkDriverAMQP:
+		return normalized, nil
+	default:
+		return "", apperrors.NewValidation(
+			"invalid_request",
+			"event sink driver must be one of none, kafka, nats, amqp",
+			map[string]any{"driver": raw},
+		)
+	}
+}
+
+// NormalizeOutboxSink validates an outbox channel named by an operator. Empty
+// means the webhook channel.
+func NormalizeOutboxSink(raw string) (string, *apperrors.AppError) {
+	normalized := strings.ToLower(strings.TrimSpace(raw))
+	switch normalized {
+	case "":
+		return OutboxSinkWebhook, nil
+	case OutboxSinkWebhook, EventSinkDriverKafka.String(), EventSinkDriverNATS.String(), EventSinkDriverAMQP.String():
+		return normalized, nil
+	default:
+		return "", apperrors.NewValidation(
+			"invalid_request",
+			"sink must be one of webhook, kafka, nats, amqp",
+			map[string]any{"field": "sink"},
+		)
+	}
+}
+
+func (d EventSinkDriver) Enabled() bool {
+	return d != "" && d != EventSinkDriverNone
+}
+
+func (d EventSinkDriver) String() string {
+	return string(d)
+}
+
+func NormalizeEventSinkTopic(raw string) (string, *apperrors.AppError) {
+	trimmed := strings.TrimSpace(raw)
+	if len(trimmed) > maxEventSinkTopicLength || !eventSinkTopicPattern.MatchString(trimmed) {
+		return "", apperrors.NewValidation(
+			"invalid_request",
+			"event sink topic must be dot-separated tokens of letters, digits, '_' or '-'",
+			map[string]any{"topic": raw},
+		)
+	}
+	return trimmed, nil
+}
+
+// EventSinkDestinationURL is stored as the outbox destination so the claimed
+// row carries its topic and DLQ host filters match on it.
+func EventSinkDestinationURL(driver EventSinkDriver, topic string) string {
+	return driver.String() + "://" + topic
+}
+
+func EventSinkTopicFromDestinationURL(destinationURL string) string {
+	_, topic, found := strings.Cut(strings.TrimSpace(destinationURL), "://")
+	if !found {
+		return ""
+	}
+	return topic
+}
@@ -0,0 +1,73 @@
+//go:build !integration
+
+package valueobjects
+
+import "testing"
+
+func TestParseEventSinkDriver(t *testing.T) {
+	testCases := []struct {
+		raw      string
+		expected EventSinkDriver
+		valid    bool
+	}{
+		{raw: "", expected: EventSinkDriverNone, valid: true},
+		{raw: " Kafka ", expected: EventSinkDriverKafka, valid: true},
+		{raw: "nats", expected: EventSinkDriverNATS, valid: true},
+		{raw: "AMQP", expected: EventSinkDriverAMQP, valid: true},
+		{raw: "sqs", valid: false},
+	}
+
+	for _, tc := range testCases {
+		driver, appErr := ParseEventSinkDriver(tc.raw)
+		if !tc.valid {
+			if appErr == nil {
+				t.Fatalf("expected %q to be rejected", tc.raw)
+			}
+			continue
+		}
+		if appErr != nil {
+			t.Fatalf("expected %q to parse, got %+v", tc.raw, appErr)
+		}
+		if driver != tc.expected {
+			t.Fatalf("expected %s, got %s", tc.expected, driver)
+		}
+	}
+}
+
+func TestNormalizeOutboxSink(t *testing.T) {
+	for raw, expected := range map[string]string{"": OutboxSinkWebhook, " Webhook ": OutboxSinkWebhook, "kafka": "kafka", "AMQP": "amqp"} {
+		sink, appErr := NormalizeOutboxSink(raw)
+		if appErr != nil || sink != expected {
+			t.Fatalf("expected %q to normalize to %s, got %q %+v", raw, expected, sink, appErr)
+		}
+	}
+	if _, appErr := NormalizeOutboxSink("none"); appErr == nil || appErr.Details["field"] != "sink" {
+		t.Fatalf("expected none to be rejected, got %+v", appErr)
+	}
+}
+
+func TestNormalizeEventSinkTopic(t *testing.T) {
+	for _, topic := range []string{"chaintx.payment-events", "payments_v1", "a"} {
+		if _, appErr := NormalizeEventSinkTopic(topic); appErr != nil {
+			t.Fatalf("expected %q to be valid, got %+v", topic, appErr)
+		}
+	}
+	for _, topic := range []string{"", "payments/events", "payments..events", ".payments", "pay ments", "payments.*"} {
+		if _, appErr := NormalizeEventSinkTopic(topic); appErr == nil {
+			t.Fatalf("expected %q to be rejected", topic)
+		}
+	}
+}
+
+func TestEventSinkDestinationURLRoundTrip(t *testing.T) {
+	destinationURL := EventSinkDestinationURL(EventSinkDriverKafka, "chaintx.payment-events")
+	if destinationURL != "kafka://chaintx.payment-events" {
+		t.Fatalf("unexpected destination url %s", destinationURL)
+	}
+	if topic := EventSinkTopicFromDestinationURL(destinationURL); topic != "chaintx.payment-events" {
+		t.Fatalf("unexpected topic %s", topic)
+	}
+	if topic := EventSinkTopicFromDestinationURL("chaintx.payment-events"); topic != "" {
+		t.Fatalf("expected empty topic, got %s", topic)
+	}
+}
@@ -43,3 +43,9 @@ func ParsePaymentRequestStatus(raw string) (PaymentRequestStatus, *apperrors.App
 func (s PaymentRequestStatus) String() string {
 	return string(s)
 }
+
+// IsOpen reports whether the request still awaits payment or its
+// confirmation; these are the requests counted by the open request quota.
+func (s PaymentRequestStatus) IsOpen() bool {
+	return s == PaymentRequestStatusPending || s == PaymentRequestStatusDetected
+}
@@ -39,3 +39,19 @@ func TestParsePaymentRequestStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestPaymentRequestStatusIsOpen(t *testing.T) {
+	open := map[PaymentRequestStatus]bool{
+		PaymentRequestStatusPending:   true,
+		PaymentRequestStatusDetected:  true,
+		PaymentRequestStatusConfirmed: false,
+		PaymentRequestStatusReorged:   false,
+		PaymentRequestStatusExpired:   false,
+		PaymentRequestStatusFailed:    false,
+	}
+	for status, expected := range open {
+		if status.IsOpen() != expected {
+			t.Fatalf("expected %s open=%v", status, expected)
+		}
+	}
+}
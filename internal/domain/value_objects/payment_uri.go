@@ -0,0 +1,101 @@
+package valueobjects
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+const tokenStandardERC20 = "ERC20"
+
+// bitcoinDecimals is fixed by the protocol: BIP21 amounts are in BTC, one
+// hundred million satoshis each.
+const bitcoinDecimals = 8
+
+// paymentURISchemes maps each chain to the builder of its wallet URI scheme.
+// A chain without an entry has no payment URI.
+var paymentURISchemes = map[string]func(PaymentURIRequest) (string, *apperrors.AppError){
+	"bitcoin":  buildBitcoinPaymentURI,
+	"ethereum": buildEthereumPaymentURI,
+}
+
+// PaymentURIRequest is what a wallet needs to pay a request. Address is the
+// response formatted address; AmountMinor is omitted from the URI when nil.
+type PaymentURIRequest struct {
+	Chain         string
+	Address       string
+	AmountMinor   *string
+	ChainID       *int64
+	TokenStandard *string
+	TokenContract *string
+}
+
+// SupportsPaymentURI reports whether BuildPaymentURI has a scheme for chain.
+func SupportsPaymentURI(chain string) bool {
+	_, ok := paymentURISchemes[chain]
+	return ok
+}
+
+// BuildPaymentURI returns the wallet URI for a payment: BIP21 for bitcoin
+// (amount in BTC) and EIP-681 for ether (value in wei) and ERC20 tokens
+// (a transfer call with the amount in token minor units).
+func BuildPaymentURI(request PaymentURIRequest) (string, *apperrors.AppError) {
+	build, ok := paymentURISchemes[request.Chain]
+	if !ok {
+		return "", apperrors.NewInternal(
+			"payment_uri_chain_unsupported",
+			"payment URI is not supported for chain",
+			map[string]any{"chain": request.Chain},
+		)
+	}
+	return build(request)
+}
+
+func buildBitcoinPaymentURI(request PaymentURIRequest) (string, *apperrors.AppError) {
+	uri := "bitcoin:" + request.Address
+	if request.AmountMinor != nil {
+		uri += "?amount=" + FormatAmountMinor(*request.AmountMinor, bitcoinDecimals)
+	}
+	return uri, nil
+}
+
+func buildEthereumPaymentURI(request PaymentURIRequest) (string, *apperrors.AppError) {
+	chainIDSuffix := ""
+	if request.ChainID != nil {
+		chainIDSuffix = "@" + strconv.FormatInt(*request.ChainID, 10)
+	}
+
+	if request.TokenContract == nil {
+		uri := "ethereum:" + request.Address + chainIDSuffix
+		if request.AmountMinor != nil {
+			uri += "?value=" + *request.AmountMinor
+		}
+		return uri, nil
+	}
+
+	if request.TokenStandard == nil || !strings.EqualFold(*request.TokenStandard, tokenStandardERC20) {
+		standard := ""
+		if request.TokenStandard != nil {
+			standard = *request.TokenStandard
+		}
+		return "", apperrors.NewInternal(
+			"payment_uri_token_standard_unsupported",
+			"payment URI is not supported for token standard",
+			map[string]any{"token_standard": standard},
+		)
+	}
+	contract, appErr := ToEIP55Checksum(*request.TokenContract)
+	if appErr != nil {
+		return "", appErr
+	}
+
+	query := url.Values{}
+	query.Set("address", request.Address)
+	if request.AmountMinor != nil {
+		query.Set("uint256", *request.AmountMinor)
+	}
+	// url.Values sorts keys, which keeps address ahead of uint256.
+	return "ethereum:" + contract + chainIDSuffix + "/transfer?" + query.Encode(), nil
+}
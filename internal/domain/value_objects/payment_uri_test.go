@@ -0,0 +1,110 @@
+//go:build !integration
+
+package valueobjects
+
+import "testing"
+
+func TestFormatAmountMinor(t *testing.T) {
+	testCases := []struct {
+		amount   string
+		decimals int
+		expected string
+	}{
+		{amount: "150000", decimals: 8, expected: "0.0015"},
+		{amount: "100000000", decimals: 8, expected: "1"},
+		{amount: "123456789", decimals: 8, expected: "1.23456789"},
+		{amount: "1", decimals: 18, expected: "0.000000000000000001"},
+		{amount: "0", decimals: 8, expected: "0"},
+		{amount: "00250", decimals: 2, expected: "2.5"},
+		{amount: "42", decimals: 0, expected: "42"},
+	}
+
+	for _, testCase := range testCases {
+		if actual := FormatAmountMinor(testCase.amount, testCase.decimals); actual != testCase.expected {
+			t.Fatalf("expected %s for %s/%d, got %s", testCase.expected, testCase.amount, testCase.decimals, actual)
+		}
+	}
+}
+
+func TestBuildPaymentURI(t *testing.T) {
+	amount := "1500000"
+	chainID := int64(11155111)
+	erc20 := "ERC20"
+	contract := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	payTo := "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"
+
+	testCases := []struct {
+		name     string
+		request  PaymentURIRequest
+		expected string
+	}{
+		{
+			name:     "bip21 with amount in btc",
+			request:  PaymentURIRequest{Chain: "bitcoin", Address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", AmountMinor: &amount},
+			expected: "bitcoin:tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx?amount=0.015",
+		},
+		{
+			name:     "bip21 without amount",
+			request:  PaymentURIRequest{Chain: "bitcoin", Address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"},
+			expected: "bitcoin:tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+		},
+		{
+			name:     "eip681 ether value in wei",
+			request:  PaymentURIRequest{Chain: "ethereum", Address: payTo, AmountMinor: &amount, ChainID: &chainID},
+			expected: "ethereum:" + payTo + "@11155111?value=1500000",
+		},
+		{
+			name: "eip681 erc20 transfer",
+			request: PaymentURIRequest{
+				Chain:         "ethereum",
+				Address:       payTo,
+				AmountMinor:   &amount,
+				ChainID:       &chainID,
+				TokenStandard: &erc20,
+				TokenContract: &contract,
+			},
+			expected: "ethereum:0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed@11155111/transfer?address=" + payTo + "&uint256=1500000",
+		},
+		{
+			name: "eip681 erc20 transfer without amount or chain id",
+			request: PaymentURIRequest{
+				Chain:         "ethereum",
+				Address:       payTo,
+				TokenStandard: &erc20,
+				TokenContract: &contract,
+			},
+			expected: "ethereum:0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed/transfer?address=" + payTo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual, appErr := BuildPaymentURI(testCase.request)
+		if appErr != nil {
+			t.Fatalf("%s: expected no error, got %+v", testCase.name, appErr)
+		}
+		if actual != testCase.expected {
+			t.Fatalf("%s: expected %s, got %s", testCase.name, testCase.expected, actual)
+		}
+	}
+}
+
+func TestBuildPaymentURIUnsupported(t *testing.T) {
+	if SupportsPaymentURI("solana") || !SupportsPaymentURI("bitcoin") || !SupportsPaymentURI("ethereum") {
+		t.Fatalf("unexpected payment URI scheme support")
+	}
+	if _, appErr := BuildPaymentURI(PaymentURIRequest{Chain: "solana", Address: "x"}); appErr == nil || appErr.Code != "payment_uri_chain_unsupported" {
+		t.Fatalf("expected payment_uri_chain_unsupported, got %+v", appErr)
+	}
+
+	standard := "ERC721"
+	contract := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	_, appErr := BuildPaymentURI(PaymentURIRequest{
+		Chain:         "ethereum",
+		Address:       "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		TokenStandard: &standard,
+		TokenContract: &contract,
+	})
+	if appErr == nil || appErr.Code != "payment_uri_token_standard_unsupported" {
+		t.Fatalf("expected payment_uri_token_standard_unsupported, got %+v", appErr)
+	}
+}
@@ -0,0 +1,39 @@
+package valueobjects
+
+import (
+	"strings"
+
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+type WebhookAPIVersion string
+
+const (
+	WebhookAPIVersionV1 WebhookAPIVersion = "v1"
+	WebhookAPIVersionV2 WebhookAPIVersion = "v2"
+
+	DefaultWebhookAPIVersion = WebhookAPIVersionV1
+)
+
+func SupportedWebhookAPIVersions() []WebhookAPIVersion {
+	return []WebhookAPIVersion{WebhookAPIVersionV1, WebhookAPIVersionV2}
+}
+
+func ParseWebhookAPIVersion(raw string) (WebhookAPIVersion, *apperrors.AppError) {
+	switch WebhookAPIVersion(strings.ToLower(strings.TrimSpace(raw))) {
+	case WebhookAPIVersionV1:
+		return WebhookAPIVersionV1, nil
+	case WebhookAPIVersionV2:
+		return WebhookAPIVersionV2, nil
+	default:
+		return "", apperrors.NewValidation(
+			"invalid_request",
+			"webhook_api_version is not supported",
+			map[string]any{"field": "webhook_api_version", "supported": []string{"v1", "v2"}},
+		)
+	}
+}
+
+func (v WebhookAPIVersion) String() string {
+	return string(v)
+}
@@ -0,0 +1,20 @@
+//go:build !integration
+
+package valueobjects
+
+import "testing"
+
+func TestParseWebhookAPIVersion(t *testing.T) {
+	version, appErr := ParseWebhookAPIVersion(" V2 ")
+	if appErr != nil {
+		t.Fatalf("expected no error, got %+v", appErr)
+	}
+	if version != WebhookAPIVersionV2 {
+		t.Fatalf("expected v2, got %s", version)
+	}
+
+	_, appErr = ParseWebhookAPIVersion("2026-01-01")
+	if appErr == nil || appErr.Code != "invalid_request" {
+		t.Fatalf("expected invalid_request, got %+v", appErr)
+	}
+}
@@ -0,0 +1,17 @@
+package valueobjects
+
+type WebhookEventType string
+
+const (
+	WebhookEventPaymentRequestCreated         WebhookEventType = "payment_request.created"
+	WebhookEventPaymentRequestStatusChanged   WebhookEventType = "payment_request.status_changed"
+	WebhookEventPaymentRequestFinalityReached WebhookEventType = "payment_request.finality_reached"
+	WebhookEventSettlementDetected            WebhookEventType = "settlement.detected"
+	WebhookEventSettlementConfirmed           WebhookEventType = "settlement.confirmed"
+	WebhookEventSettlementOrphaned            WebhookEventType = "settlement.orphaned"
+	WebhookEventPing                          WebhookEventType = "ping"
+)
+
+func (t WebhookEventType) String() string {
+	return string(t)
+}
@@ -2,12 +2,18 @@ package config
 
 import (
 	"encoding/json"
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/textproto"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"chaintx/internal/domain/policies"
 	valueobjects "chaintx/internal/domain/value_objects"
 )
 
@@ -40,12 +46,29 @@ const (
 	defaultWebhookMaxBackoff        = 300 * time.Second
 	defaultWebhookRetryJitterBPS    = 0
 	defaultWebhookRetryBudget       = 0
+	defaultWebhookCircuitThreshold  = int64(5)
+	defaultWebhookCircuitOpen       = 60 * time.Second
+	defaultWebhookHostRateLimit     = int64(0)
 	defaultWebhookAlertCooldown     = 300 * time.Second
 	defaultWebhookAlertThreshold    = int64(0)
+	defaultWebhookAlertNotifyTime   = 10 * time.Second
+	defaultReconcilerAlertStuck     = 30 * time.Minute
+	defaultReconcilerAlertWindow    = 900 * time.Second
+	defaultWebhookRetentionDays     = 30
+	defaultWebhookRetentionBatch    = 1000
+	defaultWebhookRetentionBatches  = 50
+	defaultWebhookRetentionPoll     = 3600 * time.Second
+	defaultJWTLeeway                = 60 * time.Second
+	maxJWTLeeway                    = 300 * time.Second
+	defaultCreateRateLimit          = int64(10)
+	defaultCreateRateLimitBurst     = 20
+	defaultMaxOpenRequests          = int64(1000)
 )
 
+const grpcPortEnv = "GRPC_PORT"
 const addressSchemeAllowListEnv = "PAYMENT_REQUEST_ADDRESS_SCHEME_ALLOW_LIST_JSON"
 const devtestKeysetsEnv = "PAYMENT_REQUEST_DEVTEST_KEYSETS_JSON"
+const merchantsEnv = "PAYMENT_REQUEST_MERCHANTS_JSON"
 const keysetHashHMACSecretEnv = "PAYMENT_REQUEST_KEYSET_HASH_HMAC_SECRET"
 const keysetHashHMACPreviousSecretsEnv = "PAYMENT_REQUEST_KEYSET_HASH_HMAC_PREVIOUS_SECRETS_JSON"
 const reconcilerEnabledEnv = "PAYMENT_REQUEST_RECONCILER_ENABLED"
@@ -74,12 +97,51 @@ const webhookInitialBackoffSecondsEnv = "PAYMENT_REQUEST_WEBHOOK_INITIAL_BACKOFF
 const webhookMaxBackoffSecondsEnv = "PAYMENT_REQUEST_WEBHOOK_MAX_BACKOFF_SECONDS"
 const webhookRetryJitterBPSEnv = "PAYMENT_REQUEST_WEBHOOK_RETRY_JITTER_BPS"
 const webhookRetryBudgetEnv = "PAYMENT_REQUEST_WEBHOOK_RETRY_BUDGET"
+const webhookCircuitFailureThresholdEnv = "PAYMENT_REQUEST_WEBHOOK_CIRCUIT_FAILURE_THRESHOLD"
+const webhookCircuitOpenSecondsEnv = "PAYMENT_REQUEST_WEBHOOK_CIRCUIT_OPEN_SECONDS"
+const webhookHostRateLimitPerSecondEnv = "PAYMENT_REQUEST_WEBHOOK_HOST_RATE_LIMIT_PER_SECOND"
+const webhookOrderedDeliveryEnv = "PAYMENT_REQUEST_WEBHOOK_ORDERED_DELIVERY"
 const webhookAlertEnabledEnv = "PAYMENT_REQUEST_WEBHOOK_ALERT_ENABLED"
 const webhookAlertCooldownSecondsEnv = "PAYMENT_REQUEST_WEBHOOK_ALERT_COOLDOWN_SECONDS"
 const webhookAlertFailedCountThresholdEnv = "PAYMENT_REQUEST_WEBHOOK_ALERT_FAILED_COUNT_THRESHOLD"
 const webhookAlertPendingReadyThresholdEnv = "PAYMENT_REQUEST_WEBHOOK_ALERT_PENDING_READY_THRESHOLD"
 const webhookAlertOldestPendingAgeSecondsEnv = "PAYMENT_REQUEST_WEBHOOK_ALERT_OLDEST_PENDING_AGE_SECONDS"
+const webhookAlertNotifiersEnv = "PAYMENT_REQUEST_WEBHOOK_ALERT_NOTIFIERS_JSON"
+const webhookAlertNotifyTimeoutEnv = "PAYMENT_REQUEST_WEBHOOK_ALERT_NOTIFY_TIMEOUT_SECONDS"
+const reconcilerAlertDetectedStuckMinutesEnv = "PAYMENT_REQUEST_RECONCILER_ALERT_DETECTED_STUCK_MINUTES"
+const reconcilerAlertDetectedStuckThresholdEnv = "PAYMENT_REQUEST_RECONCILER_ALERT_DETECTED_STUCK_THRESHOLD"
+const reconcilerAlertWindowSecondsEnv = "PAYMENT_REQUEST_RECONCILER_ALERT_WINDOW_SECONDS"
+const reconcilerAlertObservationErrorRateBPSEnv = "PAYMENT_REQUEST_RECONCILER_ALERT_OBSERVATION_ERROR_RATE_BPS"
+const reconcilerAlertChainTipStaleSecondsEnv = "PAYMENT_REQUEST_RECONCILER_ALERT_CHAIN_TIP_STALE_SECONDS"
+const reconcilerAlertReorgCountThresholdEnv = "PAYMENT_REQUEST_RECONCILER_ALERT_REORG_COUNT_THRESHOLD"
+const webhookAPIVersionEnv = "PAYMENT_REQUEST_WEBHOOK_API_VERSION"
+const webhookEndpointAPIVersionsEnv = "PAYMENT_REQUEST_WEBHOOK_ENDPOINT_API_VERSIONS_JSON"
+const webhookEndpointsFileEnv = "PAYMENT_REQUEST_WEBHOOK_ENDPOINTS_FILE"
+const webhookCustomHeaderAllowListEnv = "PAYMENT_REQUEST_WEBHOOK_CUSTOM_HEADER_ALLOWLIST_JSON"
+const webhookPrivateNetworkAllowListEnv = "PAYMENT_REQUEST_WEBHOOK_PRIVATE_NETWORK_ALLOWLIST_JSON"
+const webhookRetentionEnabledEnv = "PAYMENT_REQUEST_WEBHOOK_RETENTION_ENABLED"
+const webhookRetentionDaysEnv = "PAYMENT_REQUEST_WEBHOOK_RETENTION_DAYS"
+const webhookRetentionBatchSizeEnv = "PAYMENT_REQUEST_WEBHOOK_RETENTION_BATCH_SIZE"
+const webhookRetentionMaxBatchesEnv = "PAYMENT_REQUEST_WEBHOOK_RETENTION_MAX_BATCHES"
+const webhookRetentionPollIntervalEnv = "PAYMENT_REQUEST_WEBHOOK_RETENTION_POLL_INTERVAL_SECONDS"
+const webhookRetentionDryRunEnv = "PAYMENT_REQUEST_WEBHOOK_RETENTION_DRY_RUN"
+const eventSinkDriverEnv = "PAYMENT_REQUEST_EVENT_SINK_DRIVER"
+const eventSinkURLEnv = "PAYMENT_REQUEST_EVENT_SINK_URL"
+const eventSinkTopicEnv = "PAYMENT_REQUEST_EVENT_SINK_TOPIC"
+const defaultEventSinkTopic = "chaintx.payment_requests"
 const webhookOpsAdminKeysEnv = "PAYMENT_REQUEST_WEBHOOK_OPS_ADMIN_KEYS_JSON"
+const opsOperatorsEnv = "PAYMENT_REQUEST_OPS_OPERATORS_JSON"
+const merchantAPIKeysRequiredEnv = "PAYMENT_REQUEST_MERCHANT_API_KEYS_REQUIRED"
+const jwtJWKSURLEnv = "PAYMENT_REQUEST_JWT_JWKS_URL"
+const jwtJWKSFileEnv = "PAYMENT_REQUEST_JWT_JWKS_FILE"
+const jwtIssuerEnv = "PAYMENT_REQUEST_JWT_ISSUER"
+const jwtAudienceEnv = "PAYMENT_REQUEST_JWT_AUDIENCE"
+const jwtClaimsEnv = "PAYMENT_REQUEST_JWT_CLAIMS_JSON"
+const jwtLeewaySecondsEnv = "PAYMENT_REQUEST_JWT_LEEWAY_SECONDS"
+const jwtDefaultMerchantFallbackEnv = "PAYMENT_REQUEST_JWT_DEFAULT_MERCHANT_FALLBACK"
+const createRateLimitPerSecondEnv = "PAYMENT_REQUEST_CREATE_RATE_LIMIT_PER_SECOND"
+const createRateLimitBurstEnv = "PAYMENT_REQUEST_CREATE_RATE_LIMIT_BURST"
+const maxOpenRequestsPerPrincipalEnv = "PAYMENT_REQUEST_MAX_OPEN_REQUESTS_PER_PRINCIPAL"
 const btcExploraBaseURLEnv = "PAYMENT_REQUEST_BTC_ESPLORA_BASE_URL"
 const evmRPCURLsEnv = "PAYMENT_REQUEST_EVM_RPC_URLS_JSON"
 
@@ -99,6 +161,7 @@ func (e *ConfigError) Error() string {
 
 type Config struct {
 	Port                         string
+	GRPCPort                     string
 	OpenAPISpecPath              string
 	ShutdownTimeout              time.Duration
 	DatabaseURL                  string
@@ -110,6 +173,7 @@ type Config struct {
 	DevtestAllowMainnet          bool
 	DevtestKeysets               map[string]string
 	DevtestKeysetPreflights      []DevtestKeysetPreflightEntry
+	Merchants                    []MerchantConfig
 	KeysetHashAlgorithm          string
 	KeysetHashHMACSecret         string
 	KeysetHashHMACLegacyKeys     []string
@@ -139,12 +203,51 @@ type Config struct {
 	WebhookMaxBackoff            time.Duration
 	WebhookRetryJitterBPS        int
 	WebhookRetryBudget           int
+	WebhookCircuitThreshold      int
+	WebhookCircuitOpenDuration   time.Duration
+	WebhookHostRateLimit         int
+	WebhookOrderedDelivery       bool
+	WebhookAPIVersion            valueobjects.WebhookAPIVersion
+	WebhookEndpointAPIVersions   map[string]valueobjects.WebhookAPIVersion
 	WebhookAlertEnabled          bool
 	WebhookAlertCooldown         time.Duration
 	WebhookAlertFailedCount      int64
 	WebhookAlertPendingReady     int64
 	WebhookAlertOldestAgeSec     int64
+	WebhookAlertChannels         []AlertChannelConfig
+	WebhookAlertRoutes           []AlertRouteConfig
+	WebhookAlertNotifyTimeout    time.Duration
+	ReconcilerAlertStuckAfter    time.Duration
+	ReconcilerAlertStuckCount    int64
+	ReconcilerAlertWindow        time.Duration
+	ReconcilerAlertErrorRateBPS  int64
+	ReconcilerAlertTipStaleSec   int64
+	ReconcilerAlertReorgCount    int64
 	WebhookOpsAdminKeys          []string
+	OpsOperators                 []OpsOperatorConfig
+	MerchantAPIKeysRequired      bool
+	JWTJWKSURL                   string
+	JWTJWKSFile                  string
+	JWTIssuer                    string
+	JWTAudience                  string
+	JWTClaims                    JWTClaimsConfig
+	JWTLeeway                    time.Duration
+	JWTDefaultMerchantFallback   bool
+	CreateRateLimitPerSecond     int
+	CreateRateLimitBurst         int
+	MaxOpenRequestsPerPrincipal  int
+	WebhookEndpointsFile         string
+	WebhookCustomHeaderAllowList []string
+	WebhookPrivateNetworkAllow   []netip.Prefix
+	WebhookRetentionEnabled      bool
+	WebhookRetentionAge          time.Duration
+	WebhookRetentionBatchSize    int
+	WebhookRetentionMaxBatches   int
+	WebhookRetentionPollInterval time.Duration
+	WebhookRetentionDryRun       bool
+	EventSinkDriver              valueobjects.EventSinkDriver
+	EventSinkURL                 string
+	EventSinkTopic               string
 	BTCExploraBaseURL            string
 	EVMRPCURLs                   map[string]string
 	AddressSchemeAllowList       map[string]map[string]struct{}
@@ -168,6 +271,10 @@ func LoadConfig() (Config, *ConfigError) {
 	if port == "" {
 		port = defaultPort
 	}
+	grpcPort, grpcPortErr := parseGRPCPort(port)
+	if grpcPortErr != nil {
+		return Config{}, grpcPortErr
+	}
 
 	openAPISpecPath := os.Getenv("OPENAPI_SPEC_PATH")
 	if openAPISpecPath == "" {
@@ -197,6 +304,18 @@ func LoadConfig() (Config, *ConfigError) {
 	if keysetErr != nil {
 		return Config{}, keysetErr
 	}
+	merchants, merchantsErr := parseMerchants(strings.TrimSpace(os.Getenv(merchantsEnv)))
+	if merchantsErr != nil {
+		return Config{}, merchantsErr
+	}
+	devtestKeysets, devtestKeysetPreflights, merchantKeysetErr := mergeMerchantKeysets(
+		devtestKeysets,
+		devtestKeysetPreflights,
+		merchants,
+	)
+	if merchantKeysetErr != nil {
+		return Config{}, merchantKeysetErr
+	}
 	if allocationMode == "devtest" && len(devtestKeysets) == 0 {
 		return Config{}, &ConfigError{
 			Code:    "CONFIG_DEVTEST_KEYSETS_REQUIRED",
@@ -222,6 +341,55 @@ func LoadConfig() (Config, *ConfigError) {
 	if webhookOpsAdminKeysErr != nil {
 		return Config{}, webhookOpsAdminKeysErr
 	}
+	opsOperators, opsOperatorsErr := parseOpsOperators(
+		strings.TrimSpace(os.Getenv(opsOperatorsEnv)),
+		webhookOpsAdminKeys,
+	)
+	if opsOperatorsErr != nil {
+		return Config{}, opsOperatorsErr
+	}
+	merchantAPIKeysRequired := true
+	if raw := strings.TrimSpace(os.Getenv(merchantAPIKeysRequiredEnv)); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return Config{}, &ConfigError{
+				Code:    "CONFIG_MERCHANT_API_KEYS_REQUIRED_INVALID",
+				Message: merchantAPIKeysRequiredEnv + " must be a boolean",
+			}
+		}
+		merchantAPIKeysRequired = parsed
+	}
+	jwtCfg, jwtErr := parseJWTAuthConfig()
+	if jwtErr != nil {
+		return Config{}, jwtErr
+	}
+	createRateLimit, createRateLimitErr := parseNonNegativeInt64(
+		createRateLimitPerSecondEnv,
+		"CONFIG_CREATE_RATE_LIMIT_INVALID",
+		" must be a non-negative integer",
+		defaultCreateRateLimit,
+	)
+	if createRateLimitErr != nil {
+		return Config{}, createRateLimitErr
+	}
+	createRateLimitBurst, createRateLimitBurstErr := parsePositiveInt(
+		createRateLimitBurstEnv,
+		"CONFIG_CREATE_RATE_LIMIT_BURST_INVALID",
+		" must be a positive integer",
+		defaultCreateRateLimitBurst,
+	)
+	if createRateLimitBurstErr != nil {
+		return Config{}, createRateLimitBurstErr
+	}
+	maxOpenRequests, maxOpenRequestsErr := parseNonNegativeInt64(
+		maxOpenRequestsPerPrincipalEnv,
+		"CONFIG_MAX_OPEN_REQUESTS_INVALID",
+		" must be a non-negative integer",
+		defaultMaxOpenRequests,
+	)
+	if maxOpenRequestsErr != nil {
+		return Config{}, maxOpenRequestsErr
+	}
 	reconcilerCfg, reconcilerErr := parseReconcilerConfig()
 	if reconcilerErr != nil {
 		return Config{}, reconcilerErr
@@ -234,6 +402,53 @@ func LoadConfig() (Config, *ConfigError) {
 	if webhookAllowListErr != nil {
 		return Config{}, webhookAllowListErr
 	}
+	webhookAPIVersion, webhookEndpointAPIVersions, webhookAPIVersionErr := parseWebhookAPIVersions()
+	if webhookAPIVersionErr != nil {
+		return Config{}, webhookAPIVersionErr
+	}
+	webhookCustomHeaderAllowList, webhookCustomHeaderAllowListErr := parseWebhookCustomHeaderAllowList()
+	if webhookCustomHeaderAllowListErr != nil {
+		return Config{}, webhookCustomHeaderAllowListErr
+	}
+	webhookPrivateNetworkAllow, webhookPrivateNetworkAllowErr := parseWebhookPrivateNetworkAllowList()
+	if webhookPrivateNetworkAllowErr != nil {
+		return Config{}, webhookPrivateNetworkAllowErr
+	}
+	webhookAlertChannels, webhookAlertRoutes, webhookAlertNotifiersErr := parseWebhookAlertNotifiers()
+	if webhookAlertNotifiersErr != nil {
+		return Config{}, webhookAlertNotifiersErr
+	}
+	webhookAlertNotifyTimeout, webhookAlertNotifyTimeoutErr := parsePositiveSeconds(
+		webhookAlertNotifyTimeoutEnv,
+		"CONFIG_WEBHOOK_ALERT_NOTIFY_TIMEOUT_INVALID",
+		" must be a positive integer in seconds",
+		defaultWebhookAlertNotifyTime,
+	)
+	if webhookAlertNotifyTimeoutErr != nil {
+		return Config{}, webhookAlertNotifyTimeoutErr
+	}
+	reconcilerAlertCfg, reconcilerAlertErr := parseReconcilerAlertConfig()
+	if reconcilerAlertErr != nil {
+		return Config{}, reconcilerAlertErr
+	}
+	if webhookCfg.AlertEnabled &&
+		webhookCfg.AlertFailedCountThreshold == 0 &&
+		webhookCfg.AlertPendingReadyThreshold == 0 &&
+		webhookCfg.AlertOldestPendingAgeSeconds == 0 &&
+		!reconcilerAlertCfg.anyThreshold() {
+		return Config{}, &ConfigError{
+			Code:    "CONFIG_WEBHOOK_ALERT_THRESHOLD_REQUIRED",
+			Message: "at least one webhook or reconciler alert threshold must be > 0 when webhook alert is enabled",
+		}
+	}
+	webhookRetentionCfg, webhookRetentionErr := parseWebhookRetentionConfig()
+	if webhookRetentionErr != nil {
+		return Config{}, webhookRetentionErr
+	}
+	eventSinkDriver, eventSinkURL, eventSinkTopic, eventSinkErr := parseEventSinkConfig()
+	if eventSinkErr != nil {
+		return Config{}, eventSinkErr
+	}
 	reconcilerWorkerID := strings.TrimSpace(os.Getenv(reconcilerWorkerIDEnv))
 	if reconcilerWorkerID == "" {
 		reconcilerWorkerID = defaultRuntimeWorkerID()
@@ -261,6 +476,7 @@ func LoadConfig() (Config, *ConfigError) {
 
 	return Config{
 		Port:                         port,
+		GRPCPort:                     grpcPort,
 		OpenAPISpecPath:              openAPISpecPath,
 		ShutdownTimeout:              defaultShutdownTimeout,
 		DatabaseURL:                  databaseURL,
@@ -272,6 +488,7 @@ func LoadConfig() (Config, *ConfigError) {
 		DevtestAllowMainnet:          allowMainnet,
 		DevtestKeysets:               devtestKeysets,
 		DevtestKeysetPreflights:      devtestKeysetPreflights,
+		Merchants:                    merchants,
 		KeysetHashAlgorithm:          defaultKeysetHashAlgo,
 		KeysetHashHMACSecret:         keysetHashHMACSecret,
 		KeysetHashHMACLegacyKeys:     keysetHashLegacyKeys,
@@ -301,12 +518,51 @@ func LoadConfig() (Config, *ConfigError) {
 		WebhookMaxBackoff:            webhookCfg.MaxBackoff,
 		WebhookRetryJitterBPS:        webhookCfg.RetryJitterBPS,
 		WebhookRetryBudget:           webhookCfg.RetryBudget,
+		WebhookCircuitThreshold:      webhookCfg.CircuitFailureThreshold,
+		WebhookCircuitOpenDuration:   webhookCfg.CircuitOpenDuration,
+		WebhookHostRateLimit:         webhookCfg.HostRateLimitPerSecond,
+		WebhookOrderedDelivery:       webhookCfg.OrderedDelivery,
+		WebhookAPIVersion:            webhookAPIVersion,
+		WebhookEndpointAPIVersions:   webhookEndpointAPIVersions,
 		WebhookAlertEnabled:          webhookCfg.AlertEnabled,
 		WebhookAlertCooldown:         webhookCfg.AlertCooldown,
 		WebhookAlertFailedCount:      webhookCfg.AlertFailedCountThreshold,
 		WebhookAlertPendingReady:     webhookCfg.AlertPendingReadyThreshold,
 		WebhookAlertOldestAgeSec:     webhookCfg.AlertOldestPendingAgeSeconds,
+		WebhookAlertChannels:         webhookAlertChannels,
+		WebhookAlertRoutes:           webhookAlertRoutes,
+		WebhookAlertNotifyTimeout:    webhookAlertNotifyTimeout,
+		ReconcilerAlertStuckAfter:    reconcilerAlertCfg.DetectedStuckAfter,
+		ReconcilerAlertStuckCount:    reconcilerAlertCfg.DetectedStuckThreshold,
+		ReconcilerAlertWindow:        reconcilerAlertCfg.Window,
+		ReconcilerAlertErrorRateBPS:  reconcilerAlertCfg.ObservationErrorRateBPS,
+		ReconcilerAlertTipStaleSec:   reconcilerAlertCfg.ChainTipStaleSeconds,
+		ReconcilerAlertReorgCount:    reconcilerAlertCfg.ReorgCountThreshold,
 		WebhookOpsAdminKeys:          webhookOpsAdminKeys,
+		OpsOperators:                 opsOperators,
+		MerchantAPIKeysRequired:      merchantAPIKeysRequired,
+		JWTJWKSURL:                   jwtCfg.JWKSURL,
+		JWTJWKSFile:                  jwtCfg.JWKSFile,
+		JWTIssuer:                    jwtCfg.Issuer,
+		JWTAudience:                  jwtCfg.Audience,
+		JWTClaims:                    jwtCfg.Claims,
+		JWTLeeway:                    jwtCfg.Leeway,
+		JWTDefaultMerchantFallback:   jwtCfg.DefaultMerchantFallback,
+		CreateRateLimitPerSecond:     int(createRateLimit),
+		CreateRateLimitBurst:         createRateLimitBurst,
+		MaxOpenRequestsPerPrincipal:  int(maxOpenRequests),
+		WebhookEndpointsFile:         strings.TrimSpace(os.Getenv(webhookEndpointsFileEnv)),
+		WebhookCustomHeaderAllowList: webhookCustomHeaderAllowList,
+		WebhookPrivateNetworkAllow:   webhookPrivateNetworkAllow,
+		WebhookRetentionEnabled:      webhookRetentionCfg.Enabled,
+		WebhookRetentionAge:          webhookRetentionCfg.Age,
+		WebhookRetentionBatchSize:    webhookRetentionCfg.BatchSize,
+		WebhookRetentionMaxBatches:   webhookRetentionCfg.MaxBatches,
+		WebhookRetentionPollInterval: webhookRetentionCfg.PollInterval,
+		WebhookRetentionDryRun:       webhookRetentionCfg.DryRun,
+		EventSinkDriver:              eventSinkDriver,
+		EventSinkURL:                 eventSinkURL,
+		EventSinkTopic:               eventSinkTopic,
 		BTCExploraBaseURL:            btcExploraBaseURL,
 		EVMRPCURLs:                   evmRPCURLs,
 		AddressSchemeAllowList:       addressSchemeAllowList,
@@ -317,6 +573,31 @@ func (c Config) Address() string {
 	return ":" + c.Port
 }
 
+// GRPCAddress is empty when the gRPC server is disabled.
+func (c Config) GRPCAddress() string {
+	if c.GRPCPort == "" {
+		return ""
+	}
+	return ":" + c.GRPCPort
+}
+
+// parseGRPCPort reads the optional gRPC port; the gRPC server only starts
+// when it is set, and it cannot share the HTTP port.
+func parseGRPCPort(httpPort string) (string, *ConfigError) {
+	raw := strings.TrimSpace(os.Getenv(grpcPortEnv))
+	if raw == "" {
+		return "", nil
+	}
+	parsed, err := strconv.Atoi(raw)
+	if err != nil || parsed <= 0 || parsed > 65535 || raw == httpPort {
+		return "", &ConfigError{
+			Code:    "CONFIG_GRPC_PORT_INVALID",
+			Message: grpcPortEnv + " must be a TCP port other than PORT",
+		}
+	}
+	return raw, nil
+}
+
 func parseDatabaseTarget(databaseURL string) (string, *ConfigError) {
 	parsed, err := url.Parse(databaseURL)
 	if err != nil {
@@ -422,7 +703,11 @@ type devtestScopedKeysetEnvelope struct {
 	ExpectedAddress   string `json:"expected_index0_address"`
 }
 
+// DevtestKeysetPreflightEntry is a nested keyset entry. MerchantID is empty
+// for PAYMENT_REQUEST_DEVTEST_KEYSETS_JSON entries, which belong to the
+// default merchant.
 type DevtestKeysetPreflightEntry struct {
+	MerchantID            string
 	Chain                 string
 	Network               string
 	KeysetID              string
@@ -592,6 +877,160 @@ func parseNestedDevtestKeysets(entries map[string]json.RawMessage) (map[string]s
 	return keysets, preflights, nil
 }
 
+// MerchantConfig is one tenant from PAYMENT_REQUEST_MERCHANTS_JSON. The
+// default merchant is implicit and keeps using the global keysets and webhook
+// secret.
+type MerchantConfig struct {
+	ID                string
+	DisplayName       string
+	WebhookHMACSecret string
+	KeysetPreflights  []DevtestKeysetPreflightEntry
+}
+
+type merchantEnvelope struct {
+	DisplayName       string                     `json:"display_name"`
+	WebhookHMACSecret string                     `json:"webhook_hmac_secret"`
+	Keysets           map[string]json.RawMessage `json:"keysets"`
+}
+
+func parseMerchants(raw string) ([]MerchantConfig, *ConfigError) {
+	if raw == "" {
+		return []MerchantConfig{}, nil
+	}
+
+	entries := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(raw), &entries); err != nil {
+		return nil, &ConfigError{
+			Code:    "CONFIG_MERCHANTS_INVALID",
+			Message: merchantsEnv + " must be a JSON object keyed by merchant_id",
+		}
+	}
+
+	merchantIDs := make([]string, 0, len(entries))
+	for merchantID := range entries {
+		merchantIDs = append(merchantIDs, merchantID)
+	}
+	sort.Strings(merchantIDs)
+
+	merchants := make([]MerchantConfig, 0, len(entries))
+	for _, rawMerchantID := range merchantIDs {
+		merchantID := strings.TrimSpace(rawMerchantID)
+		if !policies.IsValidMerchantID(merchantID) || merchantID == policies.DefaultMerchantID {
+			return nil, &ConfigError{
+				Code:    "CONFIG_MERCHANTS_INVALID",
+				Message: merchantsEnv + " merchant_id must be a lowercase slug other than default",
+				Metadata: map[string]string{
+					"merchant_id": rawMerchantID,
+				},
+			}
+		}
+
+		envelope := merchantEnvelope{}
+		decoder := json.NewDecoder(strings.NewReader(string(entries[rawMerchantID])))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&envelope); err != nil {
+			return nil, &ConfigError{
+				Code:    "CONFIG_MERCHANTS_INVALID",
+				Message: merchantsEnv + " entries must be objects with display_name, webhook_hmac_secret and keysets",
+				Metadata: map[string]string{
+					"merchant_id": merchantID,
+				},
+			}
+		}
+
+		_, preflights, keysetErr := parseNestedDevtestKeysets(envelope.Keysets)
+		if keysetErr != nil {
+			metadata := map[string]string{"merchant_id": merchantID}
+			for key, value := range keysetErr.Metadata {
+				metadata[key] = value
+			}
+			return nil, &ConfigError{
+				Code:     "CONFIG_MERCHANTS_INVALID",
+				Message:  merchantsEnv + " keysets for merchant are invalid: " + keysetErr.Message,
+				Metadata: metadata,
+			}
+		}
+		for index := range preflights {
+			preflights[index].MerchantID = merchantID
+		}
+
+		displayName := strings.TrimSpace(envelope.DisplayName)
+		if displayName == "" {
+			displayName = merchantID
+		}
+		merchants = append(merchants, MerchantConfig{
+			ID:                merchantID,
+			DisplayName:       displayName,
+			WebhookHMACSecret: strings.TrimSpace(envelope.WebhookHMACSecret),
+			KeysetPreflights:  preflights,
+		})
+	}
+
+	return merchants, nil
+}
+
+// mergeMerchantKeysets adds merchant keysets to the shared keyset map used for
+// derivation. keyset_id must stay unique across merchants, and no two
+// merchants may hold the same key material, or they would derive the same
+// addresses.
+func mergeMerchantKeysets(
+	keysets map[string]string,
+	preflights []DevtestKeysetPreflightEntry,
+	merchants []MerchantConfig,
+) (map[string]string, []DevtestKeysetPreflightEntry, *ConfigError) {
+	if len(merchants) == 0 {
+		return keysets, preflights, nil
+	}
+
+	materialOwners := map[string]string{}
+	for _, keyMaterial := range keysets {
+		materialOwners[keyMaterial] = policies.DefaultMerchantID
+	}
+	keysetOwners := map[string]string{}
+	for keysetID := range keysets {
+		keysetOwners[keysetID] = policies.DefaultMerchantID
+	}
+
+	merged := make(map[string]string, len(keysets))
+	for keysetID, keyMaterial := range keysets {
+		merged[keysetID] = keyMaterial
+	}
+	mergedPreflights := append([]DevtestKeysetPreflightEntry{}, preflights...)
+
+	for _, merchant := range merchants {
+		for _, entry := range merchant.KeysetPreflights {
+			if owner, exists := keysetOwners[entry.KeysetID]; exists && owner != merchant.ID {
+				return nil, nil, &ConfigError{
+					Code:    "CONFIG_MERCHANTS_INVALID",
+					Message: merchantsEnv + " keyset_id is already used by another merchant",
+					Metadata: map[string]string{
+						"merchant_id": merchant.ID,
+						"keyset_id":   entry.KeysetID,
+						"owner":       owner,
+					},
+				}
+			}
+			if owner, exists := materialOwners[entry.ExtendedPublicKey]; exists && owner != merchant.ID {
+				return nil, nil, &ConfigError{
+					Code:    "CONFIG_MERCHANTS_INVALID",
+					Message: merchantsEnv + " merchants must not share key material",
+					Metadata: map[string]string{
+						"merchant_id": merchant.ID,
+						"keyset_id":   entry.KeysetID,
+						"owner":       owner,
+					},
+				}
+			}
+			keysetOwners[entry.KeysetID] = merchant.ID
+			materialOwners[entry.ExtendedPublicKey] = merchant.ID
+			merged[entry.KeysetID] = entry.ExtendedPublicKey
+			mergedPreflights = append(mergedPreflights, entry)
+		}
+	}
+
+	return merged, mergedPreflights, nil
+}
+
 func parseLegacyHMACSecrets(raw string) ([]string, *ConfigError) {
 	if raw == "" {
 		return []string{}, nil
@@ -652,6 +1091,245 @@ func parseWebhookOpsAdminKeys(raw string) ([]string, *ConfigError) {
 	return out, nil
 }
 
+// OpsOperatorConfig is a named ops credential from
+// PAYMENT_REQUEST_OPS_OPERATORS_JSON.
+type OpsOperatorConfig struct {
+	ID   string `json:"id"`
+	Role string `json:"role"`
+	Key  string `json:"key"`
+}
+
+func parseOpsOperators(raw string, legacyAdminKeys []string) ([]OpsOperatorConfig, *ConfigError) {
+	if raw == "" {
+		return []OpsOperatorConfig{}, nil
+	}
+
+	rawOperators := []OpsOperatorConfig{}
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&rawOperators); err != nil {
+		return nil, &ConfigError{
+			Code:    "CONFIG_OPS_OPERATORS_INVALID",
+			Message: opsOperatorsEnv + " must be a JSON array of {id, role, key} objects",
+		}
+	}
+
+	keys := map[string]struct{}{}
+	for _, key := range legacyAdminKeys {
+		keys[key] = struct{}{}
+	}
+	ids := map[string]struct{}{}
+	operators := make([]OpsOperatorConfig, 0, len(rawOperators))
+	for index, rawOperator := range rawOperators {
+		operator := OpsOperatorConfig{
+			ID:   strings.TrimSpace(rawOperator.ID),
+			Role: strings.ToLower(strings.TrimSpace(rawOperator.Role)),
+			Key:  strings.TrimSpace(rawOperator.Key),
+		}
+		metadata := map[string]string{"index": strconv.Itoa(index), "id": operator.ID}
+		if operator.ID == "" || len(operator.ID) > 128 {
+			return nil, &ConfigError{
+				Code:     "CONFIG_OPS_OPERATORS_INVALID",
+				Message:  opsOperatorsEnv + " id is required and must be at most 128 characters",
+				Metadata: metadata,
+			}
+		}
+		if _, exists := ids[operator.ID]; exists {
+			return nil, &ConfigError{
+				Code:     "CONFIG_OPS_OPERATORS_INVALID",
+				Message:  opsOperatorsEnv + " ids must be unique",
+				Metadata: metadata,
+			}
+		}
+		if !policies.IsOpsRole(operator.Role) {
+			return nil, &ConfigError{
+				Code:     "CONFIG_OPS_OPERATORS_INVALID",
+				Message:  opsOperatorsEnv + " role must be viewer, operator or admin",
+				Metadata: metadata,
+			}
+		}
+		// Merchant routes tell keys apart by prefix, so an ops key must never
+		// look like a merchant API key.
+		if len(operator.Key) < 16 || strings.HasPrefix(operator.Key, policies.MerchantAPIKeyTokenPrefix) {
+			return nil, &ConfigError{
+				Code:     "CONFIG_OPS_OPERATORS_INVALID",
+				Message:  opsOperatorsEnv + " key must be at least 16 characters and must not start with " + policies.MerchantAPIKeyTokenPrefix,
+				Metadata: metadata,
+			}
+		}
+		if _, exists := keys[operator.Key]; exists {
+			return nil, &ConfigError{
+				Code:     "CONFIG_OPS_OPERATORS_INVALID",
+				Message:  opsOperatorsEnv + " keys must be unique and must not reuse a " + webhookOpsAdminKeysEnv + " key",
+				Metadata: metadata,
+			}
+		}
+		ids[operator.ID] = struct{}{}
+		keys[operator.Key] = struct{}{}
+		operators = append(operators, operator)
+	}
+
+	return operators, nil
+}
+
+// JWTClaimsConfig names the JWT claims mapped onto the principal, the
+// merchant, the merchant API scopes and the ops role.
+type JWTClaimsConfig struct {
+	Principal string `json:"principal"`
+	Merchant  string `json:"merchant"`
+	Scopes    string `json:"scopes"`
+	OpsRole   string `json:"ops_role"`
+}
+
+type jwtAuthRuntimeConfig struct {
+	JWKSURL  string
+	JWKSFile string
+	Issuer   string
+	Audience string
+	Claims   JWTClaimsConfig
+	Leeway   time.Duration
+	// DefaultMerchantFallback lets a token without the merchant claim act for
+	// the default merchant. Off by default: such tokens are ops-only.
+	DefaultMerchantFallback bool
+}
+
+func defaultJWTClaims() JWTClaimsConfig {
+	return JWTClaimsConfig{
+		Principal: "sub",
+		Merchant:  "merchant_id",
+		Scopes:    "scope",
+		OpsRole:   "ops_role",
+	}
+}
+
+// parseJWTAuthConfig reads the optional JWT bearer auth settings. JWT auth is
+// enabled by a JWKS URL or file, and then needs an issuer and an audience so a
+// token minted by the same provider for another application is refused.
+func parseJWTAuthConfig() (jwtAuthRuntimeConfig, *ConfigError) {
+	cfg := jwtAuthRuntimeConfig{
+		JWKSURL:  strings.TrimSpace(os.Getenv(jwtJWKSURLEnv)),
+		JWKSFile: strings.TrimSpace(os.Getenv(jwtJWKSFileEnv)),
+		Issuer:   strings.TrimSpace(os.Getenv(jwtIssuerEnv)),
+		Audience: strings.TrimSpace(os.Getenv(jwtAudienceEnv)),
+		Claims:   defaultJWTClaims(),
+		Leeway:   defaultJWTLeeway,
+	}
+	if cfg.JWKSURL == "" && cfg.JWKSFile == "" {
+		if cfg.Issuer != "" || cfg.Audience != "" {
+			return jwtAuthRuntimeConfig{}, &ConfigError{
+				Code:    "CONFIG_JWT_JWKS_REQUIRED",
+				Message: jwtJWKSURLEnv + " or " + jwtJWKSFileEnv + " is required when " + jwtIssuerEnv + " or " + jwtAudienceEnv + " is set",
+			}
+		}
+		return jwtAuthRuntimeConfig{Claims: defaultJWTClaims()}, nil
+	}
+	if cfg.JWKSURL != "" && cfg.JWKSFile != "" {
+		return jwtAuthRuntimeConfig{}, &ConfigError{
+			Code:    "CONFIG_JWT_JWKS_SOURCE_INVALID",
+			Message: "set only one of " + jwtJWKSURLEnv + " and " + jwtJWKSFileEnv,
+		}
+	}
+	if cfg.JWKSURL != "" && !isSecureJWKSURL(cfg.JWKSURL) {
+		return jwtAuthRuntimeConfig{}, &ConfigError{
+			Code:    "CONFIG_JWT_JWKS_URL_INVALID",
+			Message: jwtJWKSURLEnv + " must be an https URL, or http on a loopback host",
+		}
+	}
+	if cfg.Issuer == "" {
+		return jwtAuthRuntimeConfig{}, &ConfigError{
+			Code:    "CONFIG_JWT_ISSUER_REQUIRED",
+			Message: jwtIssuerEnv + " is required when JWT auth is enabled",
+		}
+	}
+	if cfg.Audience == "" {
+		return jwtAuthRuntimeConfig{}, &ConfigError{
+			Code:    "CONFIG_JWT_AUDIENCE_REQUIRED",
+			Message: jwtAudienceEnv + " is required when JWT auth is enabled",
+		}
+	}
+
+	if raw := strings.TrimSpace(os.Getenv(jwtClaimsEnv)); raw != "" {
+		overrides := JWTClaimsConfig{}
+		decoder := json.NewDecoder(strings.NewReader(raw))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&overrides); err != nil {
+			return jwtAuthRuntimeConfig{}, &ConfigError{
+				Code:    "CONFIG_JWT_CLAIMS_INVALID",
+				Message: jwtClaimsEnv + " must be a JSON object with principal, merchant, scopes and ops_role claim names",
+			}
+		}
+		overrideJWTClaim(&cfg.Claims.Principal, overrides.Principal)
+		overrideJWTClaim(&cfg.Claims.Merchant, overrides.Merchant)
+		overrideJWTClaim(&cfg.Claims.Scopes, overrides.Scopes)
+		overrideJWTClaim(&cfg.Claims.OpsRole, overrides.OpsRole)
+	}
+	claimNames := map[string]struct{}{}
+	for _, name := range []string{cfg.Claims.Principal, cfg.Claims.Merchant, cfg.Claims.Scopes, cfg.Claims.OpsRole} {
+		claimNames[name] = struct{}{}
+	}
+	if len(claimNames) != 4 {
+		return jwtAuthRuntimeConfig{}, &ConfigError{
+			Code:    "CONFIG_JWT_CLAIMS_INVALID",
+			Message: jwtClaimsEnv + " must map principal, merchant, scopes and ops_role to distinct claims",
+		}
+	}
+
+	leewaySeconds, leewayErr := parseNonNegativeInt64(
+		jwtLeewaySecondsEnv,
+		"CONFIG_JWT_LEEWAY_INVALID",
+		" must be a non-negative integer in seconds, at most 300",
+		int64(defaultJWTLeeway/time.Second),
+	)
+	if leewayErr != nil {
+		return jwtAuthRuntimeConfig{}, leewayErr
+	}
+	cfg.Leeway = time.Duration(leewaySeconds) * time.Second
+	if cfg.Leeway > maxJWTLeeway {
+		return jwtAuthRuntimeConfig{}, &ConfigError{
+			Code:    "CONFIG_JWT_LEEWAY_INVALID",
+			Message: jwtLeewaySecondsEnv + " must be a non-negative integer in seconds, at most 300",
+		}
+	}
+	if raw := strings.TrimSpace(os.Getenv(jwtDefaultMerchantFallbackEnv)); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return jwtAuthRuntimeConfig{}, &ConfigError{
+				Code:    "CONFIG_JWT_DEFAULT_MERCHANT_FALLBACK_INVALID",
+				Message: jwtDefaultMerchantFallbackEnv + " must be a boolean",
+			}
+		}
+		cfg.DefaultMerchantFallback = parsed
+	}
+
+	return cfg, nil
+}
+
+func overrideJWTClaim(target *string, override string) {
+	if trimmed := strings.TrimSpace(override); trimmed != "" {
+		*target = trimmed
+	}
+}
+
+func isSecureJWKSURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+	switch parsed.Scheme {
+	case "https":
+		return true
+	case "http":
+		host := parsed.Hostname()
+		if host == "localhost" {
+			return true
+		}
+		ip := net.ParseIP(host)
+		return ip != nil && ip.IsLoopback()
+	default:
+		return false
+	}
+}
+
 type reconcilerRuntimeConfig struct {
 	Enabled                     bool
 	PollInterval                time.Duration
@@ -679,6 +1357,10 @@ type webhookRuntimeConfig struct {
 	MaxBackoff                   time.Duration
 	RetryJitterBPS               int
 	RetryBudget                  int
+	CircuitFailureThreshold      int
+	CircuitOpenDuration          time.Duration
+	HostRateLimitPerSecond       int
+	OrderedDelivery              bool
 	AlertEnabled                 bool
 	AlertCooldown                time.Duration
 	AlertFailedCountThreshold    int64
@@ -686,6 +1368,33 @@ type webhookRuntimeConfig struct {
 	AlertOldestPendingAgeSeconds int64
 }
 
+type webhookRetentionRuntimeConfig struct {
+	Enabled      bool
+	Age          time.Duration
+	BatchSize    int
+	MaxBatches   int
+	PollInterval time.Duration
+	DryRun       bool
+}
+
+// reconcilerAlertRuntimeConfig holds the reconciler and chain health alert
+// thresholds evaluated by the webhook alert worker. Zero disables a signal.
+type reconcilerAlertRuntimeConfig struct {
+	DetectedStuckAfter      time.Duration
+	DetectedStuckThreshold  int64
+	Window                  time.Duration
+	ObservationErrorRateBPS int64
+	ChainTipStaleSeconds    int64
+	ReorgCountThreshold     int64
+}
+
+func (c reconcilerAlertRuntimeConfig) anyThreshold() bool {
+	return c.DetectedStuckThreshold > 0 ||
+		c.ObservationErrorRateBPS > 0 ||
+		c.ChainTipStaleSeconds > 0 ||
+		c.ReorgCountThreshold > 0
+}
+
 func parseReconcilerConfig() (reconcilerRuntimeConfig, *ConfigError) {
 	enabled := false
 	rawEnabled := strings.TrimSpace(os.Getenv(reconcilerEnabledEnv))
@@ -996,6 +1705,47 @@ func parseWebhookConfig() (webhookRuntimeConfig, *ConfigError) {
 		retryBudget = parsed
 	}
 
+	circuitFailureThreshold, circuitFailureThresholdErr := parseNonNegativeInt64(
+		webhookCircuitFailureThresholdEnv,
+		"CONFIG_WEBHOOK_CIRCUIT_FAILURE_THRESHOLD_INVALID",
+		" must be a non-negative integer",
+		defaultWebhookCircuitThreshold,
+	)
+	if circuitFailureThresholdErr != nil {
+		return webhookRuntimeConfig{}, circuitFailureThresholdErr
+	}
+	circuitOpenDuration, circuitOpenErr := parsePositiveSeconds(
+		webhookCircuitOpenSecondsEnv,
+		"CONFIG_WEBHOOK_CIRCUIT_OPEN_SECONDS_INVALID",
+		" must be a positive integer in seconds",
+		defaultWebhookCircuitOpen,
+	)
+	if circuitOpenErr != nil {
+		return webhookRuntimeConfig{}, circuitOpenErr
+	}
+	hostRateLimitPerSecond, hostRateLimitErr := parseNonNegativeInt64(
+		webhookHostRateLimitPerSecondEnv,
+		"CONFIG_WEBHOOK_HOST_RATE_LIMIT_INVALID",
+		" must be a non-negative integer",
+		defaultWebhookHostRateLimit,
+	)
+	if hostRateLimitErr != nil {
+		return webhookRuntimeConfig{}, hostRateLimitErr
+	}
+
+	orderedDelivery := false
+	rawOrderedDelivery := strings.TrimSpace(os.Getenv(webhookOrderedDeliveryEnv))
+	if rawOrderedDelivery != "" {
+		parsed, err := strconv.ParseBool(rawOrderedDelivery)
+		if err != nil {
+			return webhookRuntimeConfig{}, &ConfigError{
+				Code:    "CONFIG_WEBHOOK_ORDERED_DELIVERY_INVALID",
+				Message: webhookOrderedDeliveryEnv + " must be a boolean",
+			}
+		}
+		orderedDelivery = parsed
+	}
+
 	alertEnabled := false
 	rawAlertEnabled := strings.TrimSpace(os.Getenv(webhookAlertEnabledEnv))
 	if rawAlertEnabled != "" {
@@ -1044,16 +1794,6 @@ func parseWebhookConfig() (webhookRuntimeConfig, *ConfigError) {
 	if alertOldestPendingAgeErr != nil {
 		return webhookRuntimeConfig{}, alertOldestPendingAgeErr
 	}
-	if alertEnabled &&
-		alertFailedCountThreshold == 0 &&
-		alertPendingReadyThreshold == 0 &&
-		alertOldestPendingAgeSeconds == 0 {
-		return webhookRuntimeConfig{}, &ConfigError{
-			Code:    "CONFIG_WEBHOOK_ALERT_THRESHOLD_REQUIRED",
-			Message: "at least one webhook alert threshold must be > 0 when webhook alert is enabled",
-		}
-	}
-
 	return webhookRuntimeConfig{
 		Enabled:                      enabled,
 		HMACSecret:                   hmacSecret,
@@ -1066,6 +1806,10 @@ func parseWebhookConfig() (webhookRuntimeConfig, *ConfigError) {
 		MaxBackoff:                   maxBackoff,
 		RetryJitterBPS:               retryJitterBPS,
 		RetryBudget:                  retryBudget,
+		CircuitFailureThreshold:      int(circuitFailureThreshold),
+		CircuitOpenDuration:          circuitOpenDuration,
+		HostRateLimitPerSecond:       int(hostRateLimitPerSecond),
+		OrderedDelivery:              orderedDelivery,
 		AlertEnabled:                 alertEnabled,
 		AlertCooldown:                alertCooldown,
 		AlertFailedCountThreshold:    alertFailedCountThreshold,
@@ -1074,6 +1818,149 @@ func parseWebhookConfig() (webhookRuntimeConfig, *ConfigError) {
 	}, nil
 }
 
+func parseReconcilerAlertConfig() (reconcilerAlertRuntimeConfig, *ConfigError) {
+	stuckMinutes, stuckMinutesErr := parsePositiveInt(
+		reconcilerAlertDetectedStuckMinutesEnv,
+		"CONFIG_RECONCILER_ALERT_DETECTED_STUCK_MINUTES_INVALID",
+		" must be a positive integer in minutes",
+		int(defaultReconcilerAlertStuck/time.Minute),
+	)
+	if stuckMinutesErr != nil {
+		return reconcilerAlertRuntimeConfig{}, stuckMinutesErr
+	}
+	stuckThreshold, stuckThresholdErr := parseNonNegativeInt64(
+		reconcilerAlertDetectedStuckThresholdEnv,
+		"CONFIG_RECONCILER_ALERT_DETECTED_STUCK_THRESHOLD_INVALID",
+		" must be a non-negative integer",
+		defaultWebhookAlertThreshold,
+	)
+	if stuckThresholdErr != nil {
+		return reconcilerAlertRuntimeConfig{}, stuckThresholdErr
+	}
+	window, windowErr := parsePositiveSeconds(
+		reconcilerAlertWindowSecondsEnv,
+		"CONFIG_RECONCILER_ALERT_WINDOW_SECONDS_INVALID",
+		" must be a positive integer in seconds",
+		defaultReconcilerAlertWindow,
+	)
+	if windowErr != nil {
+		return reconcilerAlertRuntimeConfig{}, windowErr
+	}
+	errorRateBPS, errorRateErr := parseNonNegativeInt64(
+		reconcilerAlertObservationErrorRateBPSEnv,
+		"CONFIG_RECONCILER_ALERT_OBSERVATION_ERROR_RATE_BPS_INVALID",
+		" must be an integer between 0 and 10000",
+		defaultWebhookAlertThreshold,
+	)
+	if errorRateErr != nil {
+		return reconcilerAlertRuntimeConfig{}, errorRateErr
+	}
+	if errorRateBPS > 10000 {
+		return reconcilerAlertRuntimeConfig{}, &ConfigError{
+			Code:    "CONFIG_RECONCILER_ALERT_OBSERVATION_ERROR_RATE_BPS_INVALID",
+			Message: reconcilerAlertObservationErrorRateBPSEnv + " must be an integer between 0 and 10000",
+		}
+	}
+	tipStaleSeconds, tipStaleErr := parseNonNegativeInt64(
+		reconcilerAlertChainTipStaleSecondsEnv,
+		"CONFIG_RECONCILER_ALERT_CHAIN_TIP_STALE_SECONDS_INVALID",
+		" must be a non-negative integer",
+		defaultWebhookAlertThreshold,
+	)
+	if tipStaleErr != nil {
+		return reconcilerAlertRuntimeConfig{}, tipStaleErr
+	}
+	reorgCount, reorgCountErr := parseNonNegativeInt64(
+		reconcilerAlertReorgCountThresholdEnv,
+		"CONFIG_RECONCILER_ALERT_REORG_COUNT_THRESHOLD_INVALID",
+		" must be a non-negative integer",
+		defaultWebhookAlertThreshold,
+	)
+	if reorgCountErr != nil {
+		return reconcilerAlertRuntimeConfig{}, reorgCountErr
+	}
+
+	return reconcilerAlertRuntimeConfig{
+		DetectedStuckAfter:      time.Duration(stuckMinutes) * time.Minute,
+		DetectedStuckThreshold:  stuckThreshold,
+		Window:                  window,
+		ObservationErrorRateBPS: errorRateBPS,
+		ChainTipStaleSeconds:    tipStaleSeconds,
+		ReorgCountThreshold:     reorgCount,
+	}, nil
+}
+
+func parseWebhookRetentionConfig() (webhookRetentionRuntimeConfig, *ConfigError) {
+	enabled := false
+	if raw := strings.TrimSpace(os.Getenv(webhookRetentionEnabledEnv)); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return webhookRetentionRuntimeConfig{}, &ConfigError{
+				Code:    "CONFIG_WEBHOOK_RETENTION_ENABLED_INVALID",
+				Message: webhookRetentionEnabledEnv + " must be a boolean",
+			}
+		}
+		enabled = parsed
+	}
+	dryRun := false
+	if raw := strings.TrimSpace(os.Getenv(webhookRetentionDryRunEnv)); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return webhookRetentionRuntimeConfig{}, &ConfigError{
+				Code:    "CONFIG_WEBHOOK_RETENTION_DRY_RUN_INVALID",
+				Message: webhookRetentionDryRunEnv + " must be a boolean",
+			}
+		}
+		dryRun = parsed
+	}
+
+	days, daysErr := parsePositiveInt(
+		webhookRetentionDaysEnv,
+		"CONFIG_WEBHOOK_RETENTION_DAYS_INVALID",
+		" must be a positive integer",
+		defaultWebhookRetentionDays,
+	)
+	if daysErr != nil {
+		return webhookRetentionRuntimeConfig{}, daysErr
+	}
+	batchSize, batchErr := parsePositiveInt(
+		webhookRetentionBatchSizeEnv,
+		"CONFIG_WEBHOOK_RETENTION_BATCH_SIZE_INVALID",
+		" must be a positive integer",
+		defaultWebhookRetentionBatch,
+	)
+	if batchErr != nil {
+		return webhookRetentionRuntimeConfig{}, batchErr
+	}
+	maxBatches, maxBatchesErr := parsePositiveInt(
+		webhookRetentionMaxBatchesEnv,
+		"CONFIG_WEBHOOK_RETENTION_MAX_BATCHES_INVALID",
+		" must be a positive integer",
+		defaultWebhookRetentionBatches,
+	)
+	if maxBatchesErr != nil {
+		return webhookRetentionRuntimeConfig{}, maxBatchesErr
+	}
+	pollInterval, pollErr := parsePositiveSeconds(
+		webhookRetentionPollIntervalEnv,
+		"CONFIG_WEBHOOK_RETENTION_POLL_INTERVAL_INVALID",
+		" must be a positive integer in seconds",
+		defaultWebhookRetentionPoll,
+	)
+	if pollErr != nil {
+		return webhookRetentionRuntimeConfig{}, pollErr
+	}
+
+	return webhookRetentionRuntimeConfig{
+		Enabled:      enabled,
+		Age:          time.Duration(days) * 24 * time.Hour,
+		BatchSize:    batchSize,
+		MaxBatches:   maxBatches,
+		PollInterval: pollInterval,
+		DryRun:       dryRun,
+	}, nil
+}
+
 func parseWebhookURLAllowList() ([]string, *ConfigError) {
 	raw := strings.TrimSpace(os.Getenv(webhookURLAllowListEnv))
 	if raw == "" {
@@ -1115,10 +2002,369 @@ func parseWebhookURLAllowList() ([]string, *ConfigError) {
 	return allowlist, nil
 }
 
+func parseWebhookAPIVersions() (
+	valueobjects.WebhookAPIVersion,
+	map[string]valueobjects.WebhookAPIVersion,
+	*ConfigError,
+) {
+	defaultVersion := valueobjects.DefaultWebhookAPIVersion
+	if raw := strings.TrimSpace(os.Getenv(webhookAPIVersionEnv)); raw != "" {
+		parsed, appErr := valueobjects.ParseWebhookAPIVersion(raw)
+		if appErr != nil {
+			return "", nil, &ConfigError{
+				Code:    "CONFIG_WEBHOOK_API_VERSION_INVALID",
+				Message: webhookAPIVersionEnv + " must be one of v1, v2",
+			}
+		}
+		defaultVersion = parsed
+	}
+
+	pins := map[string]valueobjects.WebhookAPIVersion{}
+	raw := strings.TrimSpace(os.Getenv(webhookEndpointAPIVersionsEnv))
+	if raw == "" {
+		return defaultVersion, pins, nil
+	}
+
+	decoded := map[string]string{}
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		return "", nil, &ConfigError{
+			Code:    "CONFIG_WEBHOOK_ENDPOINT_API_VERSIONS_INVALID",
+			Message: webhookEndpointAPIVersionsEnv + " must be a JSON object of host pattern to version",
+		}
+	}
+	for pattern, version := range decoded {
+		normalizedPattern, appErr := valueobjects.NormalizeWebhookHostPattern(pattern)
+		if appErr != nil {
+			return "", nil, &ConfigError{
+				Code:    "CONFIG_WEBHOOK_ENDPOINT_API_VERSIONS_INVALID",
+				Message: webhookEndpointAPIVersionsEnv + " must contain valid host patterns",
+			}
+		}
+		parsedVersion, appErr := valueobjects.ParseWebhookAPIVersion(version)
+		if appErr != nil {
+			return "", nil, &ConfigError{
+				Code:    "CONFIG_WEBHOOK_ENDPOINT_API_VERSIONS_INVALID",
+				Message: webhookEndpointAPIVersionsEnv + " must map host patterns to v1 or v2",
+			}
+		}
+		pins[normalizedPattern] = parsedVersion
+	}
+
+	return defaultVersion, pins, nil
+}
+
+func parseWebhookCustomHeaderAllowList() ([]string, *ConfigError) {
+	raw := strings.TrimSpace(os.Getenv(webhookCustomHeaderAllowListEnv))
+	if raw == "" {
+		return defaultWebhookCustomHeaderAllowList(), nil
+	}
+
+	decoded := []string{}
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		return nil, &ConfigError{
+			Code:    "CONFIG_WEBHOOK_CUSTOM_HEADER_ALLOWLIST_INVALID",
+			Message: webhookCustomHeaderAllowListEnv + " must be a JSON array of header names",
+		}
+	}
+
+	seen := map[string]struct{}{}
+	allowlist := make([]string, 0, len(decoded))
+	for _, name := range decoded {
+		trimmed := strings.TrimSpace(name)
+		if !isHTTPHeaderToken(trimmed) {
+			return nil, &ConfigError{
+				Code:    "CONFIG_WEBHOOK_CUSTOM_HEADER_ALLOWLIST_INVALID",
+				Message: webhookCustomHeaderAllowListEnv + " must contain valid header names",
+			}
+		}
+		canonical := textproto.CanonicalMIMEHeaderKey(trimmed)
+		if _, exists := seen[canonical]; exists {
+			continue
+		}
+		seen[canonical] = struct{}{}
+		allowlist = append(allowlist, canonical)
+	}
+
+	return allowlist, nil
+}
+
+// parseWebhookPrivateNetworkAllowList accepts CIDR prefixes or bare
+// addresses; a bare address allows exactly that host.
+func parseWebhookPrivateNetworkAllowList() ([]netip.Prefix, *ConfigError) {
+	raw := strings.TrimSpace(os.Getenv(webhookPrivateNetworkAllowListEnv))
+	if raw == "" {
+		return defaultWebhookPrivateNetworkAllowList(), nil
+	}
+
+	decoded := []string{}
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		return nil, &ConfigError{
+			Code:    "CONFIG_WEBHOOK_PRIVATE_NETWORK_ALLOWLIST_INVALID",
+			Message: webhookPrivateNetworkAllowListEnv + " must be a JSON array of CIDR prefixes",
+		}
+	}
+
+	allowlist := make([]netip.Prefix, 0, len(decoded))
+	for _, value := range decoded {
+		trimmed := strings.TrimSpace(value)
+		prefix, err := netip.ParsePrefix(trimmed)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(trimmed)
+			if addrErr != nil {
+				return nil, &ConfigError{
+					Code:    "CONFIG_WEBHOOK_PRIVATE_NETWORK_ALLOWLIST_INVALID",
+					Message: webhookPrivateNetworkAllowListEnv + " must contain valid CIDR prefixes or addresses",
+				}
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		allowlist = append(allowlist, prefix.Masked())
+	}
+
+	return allowlist, nil
+}
+
+// AlertChannelConfig is one notification destination. Which fields are used
+// depends on Type: URL and Headers for http, URL for slack, RoutingKey and an
+// optional URL override for pagerduty, and the SMTP fields for smtp.
+type AlertChannelConfig struct {
+	Name         string
+	Type         valueobjects.AlertChannelType
+	URL          string
+	Headers      map[string]string
+	RoutingKey   string
+	SMTPAddr     string
+	SMTPFrom     string
+	SMTPTo       []string
+	SMTPUsername string
+	SMTPPassword string
+}
+
+type AlertRouteConfig struct {
+	Signal   string
+	Severity valueobjects.AlertSeverity
+	Channels []string
+}
+
+type alertNotifiersEnvelope struct {
+	Channels []alertChannelEnvelope `json:"channels"`
+	Routes   []alertRouteEnvelope   `json:"routes"`
+}
+
+type alertChannelEnvelope struct {
+	Name         string            `json:"name"`
+	Type         string            `json:"type"`
+	URL          string            `json:"url"`
+	Headers      map[string]string `json:"headers"`
+	RoutingKey   string            `json:"routing_key"`
+	SMTPAddr     string            `json:"smtp_addr"`
+	SMTPFrom     string            `json:"smtp_from"`
+	SMTPTo       []string          `json:"smtp_to"`
+	SMTPUsername string            `json:"smtp_username"`
+	SMTPPassword string            `json:"smtp_password"`
+}
+
+type alertRouteEnvelope struct {
+	Signal   string   `json:"signal"`
+	Severity string   `json:"severity"`
+	Channels []string `json:"channels"`
+}
+
+func parseWebhookAlertNotifiers() ([]AlertChannelConfig, []AlertRouteConfig, *ConfigError) {
+	raw := strings.TrimSpace(os.Getenv(webhookAlertNotifiersEnv))
+	if raw == "" {
+		return nil, nil, nil
+	}
+	invalid := func(message string) ([]AlertChannelConfig, []AlertRouteConfig, *ConfigError) {
+		return nil, nil, &ConfigError{
+			Code:    "CONFIG_WEBHOOK_ALERT_NOTIFIERS_INVALID",
+			Message: webhookAlertNotifiersEnv + " " + message,
+		}
+	}
+
+	decoded := alertNotifiersEnvelope{}
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&decoded); err != nil {
+		return invalid("must be a JSON object with channels and routes")
+	}
+
+	channels := make([]AlertChannelConfig, 0, len(decoded.Channels))
+	channelNames := map[string]struct{}{}
+	for _, entry := range decoded.Channels {
+		name := strings.TrimSpace(entry.Name)
+		if name == "" {
+			return invalid("channels must have a name")
+		}
+		if _, exists := channelNames[name]; exists {
+			return invalid("has duplicate channel " + name)
+		}
+		channelNames[name] = struct{}{}
+
+		channelType, appErr := valueobjects.ParseAlertChannelType(entry.Type)
+		if appErr != nil {
+			return invalid("channel " + name + " type must be one of http, slack, pagerduty, smtp")
+		}
+		channel := AlertChannelConfig{
+			Name:         name,
+			Type:         channelType,
+			URL:          strings.TrimSpace(entry.URL),
+			RoutingKey:   strings.TrimSpace(entry.RoutingKey),
+			SMTPAddr:     strings.TrimSpace(entry.SMTPAddr),
+			SMTPFrom:     strings.TrimSpace(entry.SMTPFrom),
+			SMTPUsername: entry.SMTPUsername,
+			SMTPPassword: entry.SMTPPassword,
+		}
+
+		switch channelType {
+		case valueobjects.AlertChannelHTTP, valueobjects.AlertChannelSlack:
+			if !isAlertChannelURL(channel.URL) {
+				return invalid("channel " + name + " requires an http(s) url")
+			}
+		case valueobjects.AlertChannelPagerDuty:
+			if channel.RoutingKey == "" {
+				return invalid("channel " + name + " requires routing_key")
+			}
+			if channel.URL != "" && !isAlertChannelURL(channel.URL) {
+				return invalid("channel " + name + " url must be http(s)")
+			}
+		case valueobjects.AlertChannelSMTP:
+			if _, _, err := net.SplitHostPort(channel.SMTPAddr); err != nil {
+				return invalid("channel " + name + " smtp_addr must be host:port")
+			}
+			if _, err := mail.ParseAddress(channel.SMTPFrom); err != nil {
+				return invalid("channel " + name + " smtp_from must be an email address")
+			}
+			if len(entry.SMTPTo) == 0 {
+				return invalid("channel " + name + " requires smtp_to")
+			}
+			for _, recipient := range entry.SMTPTo {
+				trimmed := strings.TrimSpace(recipient)
+				if _, err := mail.ParseAddress(trimmed); err != nil {
+					return invalid("channel " + name + " smtp_to must contain email addresses")
+				}
+				channel.SMTPTo = append(channel.SMTPTo, trimmed)
+			}
+		}
+
+		if channelType == valueobjects.AlertChannelHTTP && len(entry.Headers) > 0 {
+			channel.Headers = make(map[string]string, len(entry.Headers))
+			for headerName, value := range entry.Headers {
+				trimmedName := strings.TrimSpace(headerName)
+				if !isHTTPHeaderToken(trimmedName) || strings.ContainsAny(value, "\r\n") {
+					return invalid("channel " + name + " headers must be valid header names and values")
+				}
+				channel.Headers[textproto.CanonicalMIMEHeaderKey(trimmedName)] = strings.TrimSpace(value)
+			}
+		}
+		channels = append(channels, channel)
+	}
+
+	routes := make([]AlertRouteConfig, 0, len(decoded.Routes))
+	routeSignals := map[string]struct{}{}
+	for _, entry := range decoded.Routes {
+		signal := strings.TrimSpace(entry.Signal)
+		if signal != valueobjects.AlertSignalAny && !valueobjects.IsKnownAlertSignal(signal) {
+			return invalid("route signal " + signal + " is unknown")
+		}
+		if _, exists := routeSignals[signal]; exists {
+			return invalid("has duplicate route for signal " + signal)
+		}
+		routeSignals[signal] = struct{}{}
+
+		severity, appErr := valueobjects.ParseAlertSeverity(entry.Severity)
+		if appErr != nil {
+			return invalid("route " + signal + " severity must be one of info, warning, critical")
+		}
+		if len(entry.Channels) == 0 {
+			return invalid("route " + signal + " requires channels")
+		}
+		route := AlertRouteConfig{Signal: signal, Severity: severity}
+		for _, channelName := range entry.Channels {
+			trimmed := strings.TrimSpace(channelName)
+			if _, exists := channelNames[trimmed]; !exists {
+				return invalid("route " + signal + " references unknown channel " + trimmed)
+			}
+			route.Channels = append(route.Channels, trimmed)
+		}
+		routes = append(routes, route)
+	}
+
+	return channels, routes, nil
+}
+
+func isAlertChannelURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+	return parsed.Scheme == "http" || parsed.Scheme == "https"
+}
+
+func isHTTPHeaderToken(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, char := range value {
+		switch {
+		case char >= 'a' && char <= 'z', char >= 'A' && char <= 'Z', char >= '0' && char <= '9':
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", char):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func parseEventSinkConfig() (valueobjects.EventSinkDriver, string, string, *ConfigError) {
+	driver, appErr := valueobjects.ParseEventSinkDriver(os.Getenv(eventSinkDriverEnv))
+	if appErr != nil {
+		return "", "", "", &ConfigError{
+			Code:    "CONFIG_EVENT_SINK_DRIVER_INVALID",
+			Message: eventSinkDriverEnv + " must be one of none, kafka, nats, amqp",
+		}
+	}
+
+	rawTopic := os.Getenv(eventSinkTopicEnv)
+	if strings.TrimSpace(rawTopic) == "" {
+		rawTopic = defaultEventSinkTopic
+	}
+	topic, appErr := valueobjects.NormalizeEventSinkTopic(rawTopic)
+	if appErr != nil {
+		return "", "", "", &ConfigError{
+			Code:    "CONFIG_EVENT_SINK_TOPIC_INVALID",
+			Message: eventSinkTopicEnv + " must be dot-separated tokens of letters, digits, '_' or '-'",
+		}
+	}
+
+	sinkURL := strings.TrimSpace(os.Getenv(eventSinkURLEnv))
+	if driver.Enabled() && sinkURL == "" {
+		return "", "", "", &ConfigError{
+			Code:    "CONFIG_EVENT_SINK_URL_REQUIRED",
+			Message: eventSinkURLEnv + " is required when " + eventSinkDriverEnv + " is set",
+		}
+	}
+
+	return driver, sinkURL, topic, nil
+}
+
 func defaultWebhookURLAllowList() []string {
 	return []string{"localhost", "127.0.0.1", "::1"}
 }
 
+func defaultWebhookCustomHeaderAllowList() []string {
+	return []string{"Authorization", "X-Api-Key"}
+}
+
+// defaultWebhookPrivateNetworkAllowList matches the loopback-only default
+// of the webhook URL allowlist.
+func defaultWebhookPrivateNetworkAllowList() []netip.Prefix {
+	return []netip.Prefix{
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("::1/128"),
+	}
+}
+
 func defaultRuntimeWorkerID() string {
 	hostname, err := os.Hostname()
 	if err != nil {
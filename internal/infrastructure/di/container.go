@@ -3,37 +3,61 @@ package di
 import (
 	"database/sql"
 	"fmt"
+	"io"
 	"log"
 	"strings"
 	"sync"
+	"time"
 
+	"google.golang.org/grpc"
+
+	"chaintx/internal/adapters/inbound/grpc/chaintxv1"
+	grpcservices "chaintx/internal/adapters/inbound/grpc/services"
 	"chaintx/internal/adapters/inbound/http/controllers"
 	httpRouter "chaintx/internal/adapters/inbound/http/router"
+	accesstokenjwt "chaintx/internal/adapters/outbound/accesstoken/jwt"
+	alerthttp "chaintx/internal/adapters/outbound/alertnotifier/http"
+	alertpagerduty "chaintx/internal/adapters/outbound/alertnotifier/pagerduty"
+	alertslack "chaintx/internal/adapters/outbound/alertnotifier/slack"
+	alertsmtp "chaintx/internal/adapters/outbound/alertnotifier/smtp"
 	chainobserverdevtest "chaintx/internal/adapters/outbound/chainobserver/devtest"
 	"chaintx/internal/adapters/outbound/docs"
+	amqpeventsink "chaintx/internal/adapters/outbound/eventsink/amqp"
+	kafkaeventsink "chaintx/internal/adapters/outbound/eventsink/kafka"
+	natseventsink "chaintx/internal/adapters/outbound/eventsink/nats"
+	postgresqladminauditlog "chaintx/internal/adapters/outbound/persistence/postgresql/adminauditlog"
 	postgresqlassetcatalog "chaintx/internal/adapters/outbound/persistence/postgresql/assetcatalog"
 	postgresqlbootstrap "chaintx/internal/adapters/outbound/persistence/postgresql/bootstrap"
+	postgresqlmerchantapikey "chaintx/internal/adapters/outbound/persistence/postgresql/merchantapikey"
 	postgresqlpaymentrequest "chaintx/internal/adapters/outbound/persistence/postgresql/paymentrequest"
 	postgresqlshared "chaintx/internal/adapters/outbound/persistence/postgresql/shared"
 	postgresqlwebhookoutbox "chaintx/internal/adapters/outbound/persistence/postgresql/webhookoutbox"
+	"chaintx/internal/adapters/outbound/qrcode"
+	ratelimitmemory "chaintx/internal/adapters/outbound/ratelimit/memory"
 	devtestwallet "chaintx/internal/adapters/outbound/wallet/devtest"
 	prodwallet "chaintx/internal/adapters/outbound/wallet/prod"
 	webhookhttp "chaintx/internal/adapters/outbound/webhook/http"
+	"chaintx/internal/application/dto"
 	portsin "chaintx/internal/application/ports/in"
 	portsout "chaintx/internal/application/ports/out"
 	"chaintx/internal/application/use_cases"
+	valueobjects "chaintx/internal/domain/value_objects"
 	"chaintx/internal/infrastructure/config"
+	"chaintx/internal/infrastructure/grpcserver"
 	"chaintx/internal/infrastructure/httpserver"
 	"chaintx/internal/infrastructure/reconciler"
 	"chaintx/internal/infrastructure/webhook"
 	"chaintx/internal/infrastructure/webhookalert"
+	"chaintx/internal/infrastructure/webhookretention"
 )
 
 type ServerContainer struct {
 	Database                     *sql.DB
 	Server                       *httpserver.Server
+	GRPCServer                   *grpcserver.Server
 	InitializePersistenceUseCase portsin.InitializePersistenceUseCase
 	ReconcilerWorker             *reconciler.Worker
+	PaymentRequestEventListener  *postgresqlpaymentrequest.EventListener
 }
 
 type ReconcilerContainer struct {
@@ -46,6 +70,7 @@ type WebhookDispatcherContainer struct {
 	Database                     *sql.DB
 	InitializePersistenceUseCase portsin.InitializePersistenceUseCase
 	WebhookWorker                *webhook.Worker
+	EventSink                    io.Closer
 }
 
 type WebhookAlertWorkerContainer struct {
@@ -54,6 +79,12 @@ type WebhookAlertWorkerContainer struct {
 	WebhookAlertWorker           *webhookalert.Worker
 }
 
+type WebhookRetentionWorkerContainer struct {
+	Database                     *sql.DB
+	InitializePersistenceUseCase portsin.InitializePersistenceUseCase
+	WebhookRetentionWorker       *webhookretention.Worker
+}
+
 type runtimeDependencies struct {
 	databasePool                 *sql.DB
 	initializePersistenceUseCase portsin.InitializePersistenceUseCase
@@ -104,15 +135,45 @@ func BuildServer(cfg config.Config, logger *log.Logger) (ServerContainer, error)
 	chainObserverGateway := buildChainObserverGateway(cfg)
 
 	listAssetsUseCase := use_cases.NewListAssetsUseCase(assetCatalogReadModel)
+	createRateLimitPolicy := dto.RateLimitPolicy{
+		RatePerSecond: float64(cfg.CreateRateLimitPerSecond),
+		Burst:         cfg.CreateRateLimitBurst,
+	}
+	paymentRequestLimitCounters := ratelimitmemory.NewCounters(time.Now().UTC())
 	createPaymentRequestUseCase := use_cases.NewCreatePaymentRequestUseCase(
 		assetCatalogReadModel,
 		paymentRequestRepository,
 		walletGateway,
 		use_cases.NewSystemClock(),
 		cfg.WebhookURLAllowList,
+		cfg.WebhookAPIVersion,
+		cfg.WebhookEndpointAPIVersions,
+		cfg.MaxOpenRequestsPerPrincipal,
+		paymentRequestLimitCounters,
+	)
+	checkPaymentRequestRateLimitUseCase := use_cases.NewCheckPaymentRequestRateLimitUseCase(
+		ratelimitmemory.NewBucketStore(),
+		createRateLimitPolicy,
+		paymentRequestLimitCounters,
+	)
+	getPaymentRequestLimitsOverviewUseCase := use_cases.NewGetPaymentRequestLimitsOverviewUseCase(
+		createRateLimitPolicy,
+		cfg.MaxOpenRequestsPerPrincipal,
+		paymentRequestLimitCounters,
 	)
-	getPaymentRequestUseCase := use_cases.NewGetPaymentRequestUseCase(paymentRequestReadModel)
 	getPaymentRequestSettlementsUseCase := use_cases.NewGetPaymentRequestSettlementsUseCase(paymentRequestReadModel)
+	qrCodeEncoder := qrcode.NewEncoder()
+	getPaymentRequestQRCodeUseCase := use_cases.NewGetPaymentRequestQRCodeUseCase(paymentRequestReadModel, qrCodeEncoder)
+	getPaymentRequestCheckoutUseCase := use_cases.NewGetPaymentRequestCheckoutUseCase(paymentRequestReadModel, qrCodeEncoder)
+	paymentRequestEventListener := postgresqlpaymentrequest.NewEventListener(cfg.DatabaseURL, logger)
+	getPaymentRequestUseCase := use_cases.NewGetPaymentRequestUseCase(
+		paymentRequestReadModel,
+		paymentRequestEventListener,
+	)
+	streamPaymentRequestEventsUseCase := use_cases.NewStreamPaymentRequestEventsUseCase(
+		paymentRequestReadModel,
+		paymentRequestEventListener,
+	)
 	getWebhookOutboxOverviewUseCase := use_cases.NewGetWebhookOutboxOverviewUseCase(
 		webhookOutboxRepository,
 	)
@@ -125,6 +186,36 @@ func BuildServer(cfg config.Config, logger *log.Logger) (ServerContainer, error)
 	cancelWebhookOutboxEventUseCase := use_cases.NewCancelWebhookOutboxEventUseCase(
 		webhookOutboxRepository,
 	)
+	bulkRequeueWebhookDLQEventsUseCase := use_cases.NewBulkRequeueWebhookDLQEventsUseCase(
+		webhookOutboxRepository,
+	)
+	bulkCancelWebhookOutboxEventsUseCase := use_cases.NewBulkCancelWebhookOutboxEventsUseCase(
+		webhookOutboxRepository,
+	)
+	webhookRetryPolicyRepository := postgresqlwebhookoutbox.NewRetryPolicyRepository(runtimeDeps.databasePool)
+	putWebhookRetryPolicyUseCase := use_cases.NewPutWebhookRetryPolicyUseCase(webhookRetryPolicyRepository)
+	getWebhookRetryPolicyUseCase := use_cases.NewGetWebhookRetryPolicyUseCase(webhookRetryPolicyRepository)
+	deleteWebhookRetryPolicyUseCase := use_cases.NewDeleteWebhookRetryPolicyUseCase(webhookRetryPolicyRepository)
+	merchantAPIKeyRepository := postgresqlmerchantapikey.NewRepository(runtimeDeps.databasePool)
+	authenticateMerchantAPIKeyUseCase := use_cases.NewAuthenticateMerchantAPIKeyUseCase(merchantAPIKeyRepository)
+	createMerchantAPIKeyUseCase := use_cases.NewCreateMerchantAPIKeyUseCase(merchantAPIKeyRepository)
+	listMerchantAPIKeysUseCase := use_cases.NewListMerchantAPIKeysUseCase(merchantAPIKeyRepository)
+	revokeMerchantAPIKeyUseCase := use_cases.NewRevokeMerchantAPIKeyUseCase(merchantAPIKeyRepository)
+	adminAuditLogRepository := postgresqladminauditlog.NewRepository(runtimeDeps.databasePool)
+	recordAdminAuditEntryUseCase := use_cases.NewRecordAdminAuditEntryUseCase(adminAuditLogRepository)
+	listAdminAuditEntriesUseCase := use_cases.NewListAdminAuditEntriesUseCase(adminAuditLogRepository)
+	authenticateAccessTokenUseCase := buildAuthenticateAccessTokenUseCase(cfg)
+	testWebhookGateway, testWebhookGatewayErr := buildWebhookGateway(cfg, logger)
+	if testWebhookGatewayErr != nil {
+		return ServerContainer{}, testWebhookGatewayErr
+	}
+	testWebhookEndpointUseCase := use_cases.NewTestWebhookEndpointUseCase(
+		testWebhookGateway,
+		use_cases.NewSystemClock(),
+		cfg.WebhookURLAllowList,
+		cfg.WebhookAPIVersion,
+		cfg.WebhookEndpointAPIVersions,
+	)
 	reconcilePaymentRequestsUseCase := use_cases.NewReconcilePaymentRequestsUseCase(
 		paymentRequestRepository,
 		chainObserverGateway,
@@ -140,30 +231,120 @@ func BuildServer(cfg config.Config, logger *log.Logger) (ServerContainer, error)
 		getPaymentRequestSettlementsUseCase,
 		logger,
 	)
+	paymentRequestEventsController := controllers.NewPaymentRequestEventsController(
+		streamPaymentRequestEventsUseCase,
+		logger,
+	)
+	paymentRequestCheckoutController := controllers.NewPaymentRequestCheckoutController(
+		getPaymentRequestCheckoutUseCase,
+		getPaymentRequestQRCodeUseCase,
+		logger,
+	)
+	opsAuth := controllers.NewOpsAuth(
+		mapOpsOperators(cfg.OpsOperators),
+		cfg.WebhookOpsAdminKeys,
+		authenticateAccessTokenUseCase,
+		recordAdminAuditEntryUseCase,
+		logger,
+	)
 	webhookOutboxController := controllers.NewWebhookOutboxController(
 		getWebhookOutboxOverviewUseCase,
 		listWebhookDLQEventsUseCase,
 		requeueWebhookDLQEventUseCase,
 		cancelWebhookOutboxEventUseCase,
-		cfg.WebhookOpsAdminKeys,
+		bulkRequeueWebhookDLQEventsUseCase,
+		bulkCancelWebhookOutboxEventsUseCase,
+		opsAuth,
+		logger,
+	)
+	webhookEndpointsController := controllers.NewWebhookEndpointsController(
+		testWebhookEndpointUseCase,
+		opsAuth,
+		logger,
+	)
+	webhookDestinationsController := controllers.NewWebhookDestinationsController(
+		putWebhookRetryPolicyUseCase,
+		getWebhookRetryPolicyUseCase,
+		deleteWebhookRetryPolicyUseCase,
+		opsAuth,
+		logger,
+	)
+	merchantAPIKeysController := controllers.NewMerchantAPIKeysController(
+		createMerchantAPIKeyUseCase,
+		listMerchantAPIKeysUseCase,
+		revokeMerchantAPIKeyUseCase,
+		opsAuth,
+		logger,
+	)
+	adminAuditLogController := controllers.NewAdminAuditLogController(listAdminAuditEntriesUseCase, opsAuth, logger)
+	paymentRequestLimitsController := controllers.NewPaymentRequestLimitsController(
+		checkPaymentRequestRateLimitUseCase,
+		getPaymentRequestLimitsOverviewUseCase,
+		opsAuth,
+		logger,
+	)
+	merchantAuth := controllers.NewMerchantAuth(
+		authenticateMerchantAPIKeyUseCase,
+		authenticateAccessTokenUseCase,
+		cfg.MerchantAPIKeysRequired,
 		logger,
 	)
 
 	router := httpRouter.New(httpRouter.Dependencies{
-		HealthController:          healthController,
-		SwaggerController:         swaggerController,
-		AssetsController:          assetsController,
-		PaymentRequestsController: paymentRequestsController,
-		WebhookOutboxController:   webhookOutboxController,
+		HealthController:                 healthController,
+		SwaggerController:                swaggerController,
+		AssetsController:                 assetsController,
+		PaymentRequestsController:        paymentRequestsController,
+		PaymentRequestEventsController:   paymentRequestEventsController,
+		PaymentRequestCheckoutController: paymentRequestCheckoutController,
+		WebhookOutboxController:          webhookOutboxController,
+		WebhookEndpointsController:       webhookEndpointsController,
+		WebhookDestinationsController:    webhookDestinationsController,
+		MerchantAPIKeysController:        merchantAPIKeysController,
+		AdminAuditLogController:          adminAuditLogController,
+		PaymentRequestLimitsController:   paymentRequestLimitsController,
+		MerchantAuth:                     merchantAuth,
 	})
 
 	server := httpserver.New(cfg.Address(), router, logger)
+	server.RegisterOnShutdown(paymentRequestEventsController.Shutdown)
+	server.RegisterOnShutdown(paymentRequestsController.Shutdown)
+
+	var grpcServer *grpcserver.Server
+	if grpcAddress := cfg.GRPCAddress(); grpcAddress != "" {
+		paymentRequestService := grpcservices.NewPaymentRequestService(
+			createPaymentRequestUseCase,
+			getPaymentRequestUseCase,
+			getPaymentRequestSettlementsUseCase,
+			listAssetsUseCase,
+			streamPaymentRequestEventsUseCase,
+			checkPaymentRequestRateLimitUseCase,
+			logger,
+		)
+		grpcMerchantAuth := grpcservices.NewMerchantAuth(
+			authenticateMerchantAPIKeyUseCase,
+			authenticateAccessTokenUseCase,
+			cfg.MerchantAPIKeysRequired,
+			logger,
+		)
+		grpcServer = grpcserver.New(
+			grpcAddress,
+			func(registrar grpc.ServiceRegistrar) {
+				chaintxv1.RegisterPaymentRequestServiceServer(registrar, paymentRequestService)
+			},
+			logger,
+			grpc.ChainUnaryInterceptor(grpcMerchantAuth.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(grpcMerchantAuth.StreamInterceptor()),
+		)
+	}
 
 	return ServerContainer{
 		Database:                     runtimeDeps.databasePool,
 		Server:                       server,
+		GRPCServer:                   grpcServer,
 		InitializePersistenceUseCase: runtimeDeps.initializePersistenceUseCase,
 		ReconcilerWorker:             reconcilerWorker,
+		PaymentRequestEventListener:  paymentRequestEventListener,
 	}, nil
 }
 
@@ -187,13 +368,24 @@ func BuildReconciler(cfg config.Config, logger *log.Logger) (ReconcilerContainer
 func BuildWebhookDispatcher(cfg config.Config, logger *log.Logger) (WebhookDispatcherContainer, error) {
 	runtimeDeps := buildRuntimeDependencies(cfg, logger)
 	webhookOutboxRepository := postgresqlwebhookoutbox.NewRepository(runtimeDeps.databasePool)
-	webhookEventGateway := webhookhttp.NewGateway(webhookhttp.Config{
-		HMACSecret: cfg.WebhookHMACSecret,
-		Timeout:    cfg.WebhookTimeout,
-	})
+	webhookEventGateway, webhookEventGatewayErr := buildWebhookGateway(cfg, logger)
+	if webhookEventGatewayErr != nil {
+		return WebhookDispatcherContainer{}, webhookEventGatewayErr
+	}
+	eventSinks := map[string]portsout.EventSink{}
+	eventSink, eventSinkErr := buildEventSink(cfg)
+	if eventSinkErr != nil {
+		return WebhookDispatcherContainer{}, eventSinkErr
+	}
+	if eventSink != nil {
+		eventSinks[cfg.EventSinkDriver.String()] = eventSink
+	}
 	dispatchWebhookEventsUseCase := use_cases.NewDispatchWebhookEventsUseCase(
 		webhookOutboxRepository,
 		webhookEventGateway,
+		postgresqlwebhookoutbox.NewCircuitRepository(runtimeDeps.databasePool),
+		postgresqlwebhookoutbox.NewRetryPolicyRepository(runtimeDeps.databasePool),
+		eventSinks,
 	)
 	webhookWorker := webhook.NewWorker(
 		cfg.WebhookEnabled,
@@ -205,6 +397,12 @@ func BuildWebhookDispatcher(cfg config.Config, logger *log.Logger) (WebhookDispa
 		cfg.WebhookMaxBackoff,
 		cfg.WebhookRetryJitterBPS,
 		cfg.WebhookRetryBudget,
+		dto.WebhookDestinationPolicy{
+			FailureThreshold:     cfg.WebhookCircuitThreshold,
+			OpenDuration:         cfg.WebhookCircuitOpenDuration,
+			MaxRequestsPerSecond: cfg.WebhookHostRateLimit,
+		},
+		cfg.WebhookOrderedDelivery,
 		dispatchWebhookEventsUseCase,
 		logger,
 	)
@@ -213,26 +411,88 @@ func BuildWebhookDispatcher(cfg config.Config, logger *log.Logger) (WebhookDispa
 		Database:                     runtimeDeps.databasePool,
 		InitializePersistenceUseCase: runtimeDeps.initializePersistenceUseCase,
 		WebhookWorker:                webhookWorker,
+		EventSink:                    eventSink,
 	}, nil
 }
 
+type closableEventSink interface {
+	portsout.EventSink
+	io.Closer
+}
+
+func buildWebhookGateway(cfg config.Config, logger *log.Logger) (*webhookhttp.Gateway, error) {
+	var endpoints *webhookhttp.EndpointRegistry
+	if cfg.WebhookEndpointsFile != "" {
+		registry, err := webhookhttp.NewEndpointRegistry(webhookhttp.EndpointRegistryConfig{
+			Path:                    cfg.WebhookEndpointsFile,
+			HeaderAllowList:         cfg.WebhookCustomHeaderAllowList,
+			Timeout:                 cfg.WebhookTimeout,
+			Logger:                  logger,
+			PrivateNetworkAllowList: cfg.WebhookPrivateNetworkAllow,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("load webhook endpoints: %w", err)
+		}
+		endpoints = registry
+	}
+
+	return webhookhttp.NewGateway(webhookhttp.Config{
+		HMACSecret:              cfg.WebhookHMACSecret,
+		MerchantHMACSecrets:     merchantWebhookSecrets(cfg.Merchants),
+		Timeout:                 cfg.WebhookTimeout,
+		Endpoints:               endpoints,
+		PrivateNetworkAllowList: cfg.WebhookPrivateNetworkAllow,
+	}), nil
+}
+
+func buildEventSink(cfg config.Config) (closableEventSink, error) {
+	switch cfg.EventSinkDriver {
+	case valueobjects.EventSinkDriverKafka:
+		return kafkaeventsink.NewSink(kafkaeventsink.Config{BrokerURL: cfg.EventSinkURL}), nil
+	case valueobjects.EventSinkDriverNATS:
+		sink, err := natseventsink.NewSink(natseventsink.Config{ServerURL: cfg.EventSinkURL})
+		if err != nil {
+			return nil, fmt.Errorf("connect nats event sink: %w", err)
+		}
+		return sink, nil
+	case valueobjects.EventSinkDriverAMQP:
+		return amqpeventsink.NewSink(amqpeventsink.Config{BrokerURL: cfg.EventSinkURL}), nil
+	default:
+		return nil, nil
+	}
+}
+
 func BuildWebhookAlertWorker(cfg config.Config, logger *log.Logger) (WebhookAlertWorkerContainer, error) {
 	runtimeDeps := buildRuntimeDependencies(cfg, logger)
 	webhookOutboxRepository := postgresqlwebhookoutbox.NewRepository(runtimeDeps.databasePool)
 	getWebhookOutboxOverviewUseCase := use_cases.NewGetWebhookOutboxOverviewUseCase(
 		webhookOutboxRepository,
 	)
+	getReconcileHealthOverviewUseCase := use_cases.NewGetReconcileHealthOverviewUseCase(
+		postgresqlpaymentrequest.NewReadModel(runtimeDeps.databasePool),
+	)
 	alertWorker := webhookalert.NewWorker(
 		cfg.WebhookAlertEnabled,
 		cfg.WebhookPollInterval,
 		cfg.WebhookWorkerID,
 		getWebhookOutboxOverviewUseCase,
+		getReconcileHealthOverviewUseCase,
 		webhookalert.AlertConfig{
 			Enabled:                  cfg.WebhookAlertEnabled,
 			Cooldown:                 cfg.WebhookAlertCooldown,
 			FailedCountThreshold:     cfg.WebhookAlertFailedCount,
 			PendingReadyThreshold:    cfg.WebhookAlertPendingReady,
 			OldestPendingAgeSecLimit: cfg.WebhookAlertOldestAgeSec,
+			Reconcile: webhookalert.ReconcileAlertConfig{
+				DetectedStuckAfter:       cfg.ReconcilerAlertStuckAfter,
+				Window:                   cfg.ReconcilerAlertWindow,
+				DetectedStuckThreshold:   cfg.ReconcilerAlertStuckCount,
+				ObservationErrorBPSLimit: cfg.ReconcilerAlertErrorRateBPS,
+				ChainTipStaleSecLimit:    cfg.ReconcilerAlertTipStaleSec,
+				ReorgCountThreshold:      cfg.ReconcilerAlertReorgCount,
+			},
+			Routes:        buildAlertRoutes(cfg),
+			NotifyTimeout: cfg.WebhookAlertNotifyTimeout,
 		},
 		logger,
 	)
@@ -243,6 +503,79 @@ func BuildWebhookAlertWorker(cfg config.Config, logger *log.Logger) (WebhookAler
 	}, nil
 }
 
+// buildAlertRoutes shares one notifier per configured channel across every
+// route that references it.
+func buildAlertRoutes(cfg config.Config) []webhookalert.AlertRoute {
+	notifiers := make(map[string]portsout.AlertNotifier, len(cfg.WebhookAlertChannels))
+	for _, channel := range cfg.WebhookAlertChannels {
+		notifiers[channel.Name] = buildAlertNotifier(channel, cfg.WebhookAlertNotifyTimeout)
+	}
+
+	routes := make([]webhookalert.AlertRoute, 0, len(cfg.WebhookAlertRoutes))
+	for _, routeCfg := range cfg.WebhookAlertRoutes {
+		route := webhookalert.AlertRoute{
+			Signal:   routeCfg.Signal,
+			Severity: routeCfg.Severity,
+		}
+		for _, name := range routeCfg.Channels {
+			route.Channels = append(route.Channels, webhookalert.AlertChannel{
+				Name:     name,
+				Notifier: notifiers[name],
+			})
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+func buildAlertNotifier(channel config.AlertChannelConfig, timeout time.Duration) portsout.AlertNotifier {
+	switch channel.Type {
+	case valueobjects.AlertChannelSlack:
+		return alertslack.NewNotifier(alertslack.Config{WebhookURL: channel.URL, Timeout: timeout})
+	case valueobjects.AlertChannelPagerDuty:
+		return alertpagerduty.NewNotifier(alertpagerduty.Config{
+			RoutingKey: channel.RoutingKey,
+			EventsURL:  channel.URL,
+			Timeout:    timeout,
+		})
+	case valueobjects.AlertChannelSMTP:
+		return alertsmtp.NewNotifier(alertsmtp.Config{
+			Addr:     channel.SMTPAddr,
+			From:     channel.SMTPFrom,
+			To:       channel.SMTPTo,
+			Username: channel.SMTPUsername,
+			Password: channel.SMTPPassword,
+			Timeout:  timeout,
+		})
+	default:
+		return alerthttp.NewNotifier(alerthttp.Config{URL: channel.URL, Headers: channel.Headers, Timeout: timeout})
+	}
+}
+
+func BuildWebhookRetentionWorker(cfg config.Config, logger *log.Logger) (WebhookRetentionWorkerContainer, error) {
+	runtimeDeps := buildRuntimeDependencies(cfg, logger)
+	retentionRepository := postgresqlwebhookoutbox.NewRetentionRepository(runtimeDeps.databasePool)
+	purgeWebhookOutboxUseCase := use_cases.NewPurgeWebhookOutboxUseCase(retentionRepository)
+	retentionWorker := webhookretention.NewWorker(
+		cfg.WebhookRetentionEnabled,
+		cfg.WebhookRetentionPollInterval,
+		cfg.WebhookWorkerID,
+		webhookretention.Config{
+			RetentionAge: cfg.WebhookRetentionAge,
+			BatchSize:    cfg.WebhookRetentionBatchSize,
+			MaxBatches:   cfg.WebhookRetentionMaxBatches,
+			DryRun:       cfg.WebhookRetentionDryRun,
+		},
+		purgeWebhookOutboxUseCase,
+		logger,
+	)
+	return WebhookRetentionWorkerContainer{
+		Database:                     runtimeDeps.databasePool,
+		InitializePersistenceUseCase: runtimeDeps.initializePersistenceUseCase,
+		WebhookRetentionWorker:       retentionWorker,
+	}, nil
+}
+
 func buildRuntimeDependencies(cfg config.Config, logger *log.Logger) runtimeDependencies {
 	persistenceGateway := postgresqlbootstrap.NewGateway(
 		cfg.DatabaseURL,
@@ -253,6 +586,7 @@ func buildRuntimeDependencies(cfg config.Config, logger *log.Logger) runtimeDepe
 			DevtestAllowMainnet:    cfg.DevtestAllowMainnet,
 			DevtestKeysets:         cfg.DevtestKeysets,
 			DevtestKeysetPreflight: mapPreflightEntries(cfg.DevtestKeysetPreflights),
+			Merchants:              mapMerchants(cfg.Merchants),
 			KeysetHashAlgorithm:    cfg.KeysetHashAlgorithm,
 			KeysetHashHMACSecret:   cfg.KeysetHashHMACSecret,
 			KeysetHashHMACLegacy:   cfg.KeysetHashHMACLegacyKeys,
@@ -292,6 +626,8 @@ func newPaymentRequestRepository(
 		postgresqlpaymentrequest.Config{
 			WebhookOutboxEnabled: cfg.WebhookEnabled,
 			WebhookMaxAttempts:   cfg.WebhookMaxAttempts,
+			EventSinkDriver:      cfg.EventSinkDriver,
+			EventSinkTopic:       cfg.EventSinkTopic,
 		},
 	)
 }
@@ -322,6 +658,7 @@ func mapPreflightEntries(entries []config.DevtestKeysetPreflightEntry) []postgre
 	out := make([]postgresqlbootstrap.DevtestKeysetPreflightEntry, 0, len(entries))
 	for _, entry := range entries {
 		out = append(out, postgresqlbootstrap.DevtestKeysetPreflightEntry{
+			MerchantID:            entry.MerchantID,
 			Chain:                 entry.Chain,
 			Network:               entry.Network,
 			KeysetID:              entry.KeysetID,
@@ -332,6 +669,60 @@ func mapPreflightEntries(entries []config.DevtestKeysetPreflightEntry) []postgre
 	return out
 }
 
+func mapMerchants(merchants []config.MerchantConfig) []postgresqlbootstrap.Merchant {
+	out := make([]postgresqlbootstrap.Merchant, 0, len(merchants))
+	for _, merchant := range merchants {
+		out = append(out, postgresqlbootstrap.Merchant{
+			ID:          merchant.ID,
+			DisplayName: merchant.DisplayName,
+		})
+	}
+	return out
+}
+
+// buildAuthenticateAccessTokenUseCase returns nil when no JWKS source is
+// configured, which leaves merchant and ops routes on static keys only.
+func buildAuthenticateAccessTokenUseCase(cfg config.Config) portsin.AuthenticateAccessTokenUseCase {
+	if cfg.JWTJWKSURL == "" && cfg.JWTJWKSFile == "" {
+		return nil
+	}
+	verifier := accesstokenjwt.NewVerifier(accesstokenjwt.Config{
+		JWKSURL:  cfg.JWTJWKSURL,
+		JWKSFile: cfg.JWTJWKSFile,
+		Issuer:   cfg.JWTIssuer,
+		Audience: cfg.JWTAudience,
+		Leeway:   cfg.JWTLeeway,
+	})
+	return use_cases.NewAuthenticateAccessTokenUseCase(verifier, dto.AccessTokenClaimMapping{
+		Principal: cfg.JWTClaims.Principal,
+		Merchant:  cfg.JWTClaims.Merchant,
+		Scopes:    cfg.JWTClaims.Scopes,
+		OpsRole:   cfg.JWTClaims.OpsRole,
+	}, cfg.JWTDefaultMerchantFallback)
+}
+
+func mapOpsOperators(operators []config.OpsOperatorConfig) []controllers.OpsOperator {
+	out := make([]controllers.OpsOperator, 0, len(operators))
+	for _, operator := range operators {
+		out = append(out, controllers.OpsOperator{
+			ID:   operator.ID,
+			Role: operator.Role,
+			Key:  operator.Key,
+		})
+	}
+	return out
+}
+
+func merchantWebhookSecrets(merchants []config.MerchantConfig) map[string]string {
+	secrets := map[string]string{}
+	for _, merchant := range merchants {
+		if merchant.WebhookHMACSecret != "" {
+			secrets[merchant.ID] = merchant.WebhookHMACSecret
+		}
+	}
+	return secrets
+}
+
 func buildWalletGateway(cfg config.Config, logger *log.Logger) (portsout.WalletAllocationGateway, error) {
 	mode := strings.ToLower(strings.TrimSpace(cfg.AllocationMode))
 
@@ -0,0 +1,90 @@
+package grpcserver
+
+import (
+	"context"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+)
+
+type Server struct {
+	address    string
+	grpcServer *grpc.Server
+	cancelBase context.CancelFunc
+	logger     *log.Logger
+}
+
+// New builds a gRPC server; register adds the services before it starts.
+func New(address string, register func(grpc.ServiceRegistrar), logger *log.Logger, options ...grpc.ServerOption) *Server {
+	// GracefulStop waits for open streams, so long-lived streams need their
+	// context cancelled when shutdown starts, like the HTTP event stream does.
+	baseCtx, cancelBase := context.WithCancel(context.Background())
+	options = append(options, grpc.ChainStreamInterceptor(cancelOnShutdown(baseCtx)))
+
+	grpcServer := grpc.NewServer(options...)
+	register(grpcServer)
+
+	return &Server{
+		address:    address,
+		grpcServer: grpcServer,
+		cancelBase: cancelBase,
+		logger:     logger,
+	}
+}
+
+func (s *Server) Start() error {
+	s.logger.Printf("grpc server starting address=%s", s.address)
+
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	err = s.grpcServer.Serve(listener)
+	if err != nil && err != grpc.ErrServerStopped {
+		return err
+	}
+
+	return nil
+}
+
+// Shutdown stops accepting calls and waits for running ones until ctx ends,
+// then closes the remaining connections.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.logger.Printf("grpc server shutting down")
+	s.cancelBase()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		return ctx.Err()
+	}
+}
+
+func cancelOnShutdown(baseCtx context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := context.WithCancel(stream.Context())
+		defer cancel()
+		stop := context.AfterFunc(baseCtx, cancel)
+		defer stop()
+
+		return handler(srv, &contextServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -22,6 +22,12 @@ func New(address string, handler http.Handler, logger *log.Logger) *Server {
 	}
 }
 
+// RegisterOnShutdown registers f to run when Shutdown starts. Shutdown waits
+// for active handlers, so long-lived handlers use it to end their streams.
+func (s *Server) RegisterOnShutdown(f func()) {
+	s.httpServer.RegisterOnShutdown(f)
+}
+
 func (s *Server) Start() error {
 	s.logger.Printf("server starting address=%s", s.httpServer.Addr)
 
@@ -19,6 +19,8 @@ type Worker struct {
 	maxBackoff      time.Duration
 	retryJitterBPS  int
 	retryBudget     int
+	destination     dto.WebhookDestinationPolicy
+	orderedDelivery bool
 	dispatchUseCase portsin.DispatchWebhookEventsUseCase
 	logger          *log.Logger
 }
@@ -33,6 +35,8 @@ func NewWorker(
 	maxBackoff time.Duration,
 	retryJitterBPS int,
 	retryBudget int,
+	destination dto.WebhookDestinationPolicy,
+	orderedDelivery bool,
 	dispatchUseCase portsin.DispatchWebhookEventsUseCase,
 	logger *log.Logger,
 ) *Worker {
@@ -46,6 +50,8 @@ func NewWorker(
 		maxBackoff:      maxBackoff,
 		retryJitterBPS:  retryJitterBPS,
 		retryBudget:     retryBudget,
+		destination:     destination,
+		orderedDelivery: orderedDelivery,
 		dispatchUseCase: dispatchUseCase,
 		logger:          logger,
 	}
@@ -61,11 +67,12 @@ func (w *Worker) Start(ctx context.Context) {
 	}
 
 	w.logf(
-		"webhook dispatcher started worker_id=%s poll_interval=%s batch_size=%d lease_duration=%s",
+		"webhook dispatcher started worker_id=%s poll_interval=%s batch_size=%d lease_duration=%s ordered_delivery=%t",
 		w.workerID,
 		w.pollInterval,
 		w.batchSize,
 		w.leaseDuration,
+		w.orderedDelivery,
 	)
 
 	w.runCycle(ctx)
@@ -86,14 +93,16 @@ func (w *Worker) Start(ctx context.Context) {
 func (w *Worker) runCycle(ctx context.Context) {
 	startedAt := time.Now().UTC()
 	output, appErr := w.dispatchUseCase.Execute(ctx, dto.DispatchWebhookEventsCommand{
-		Now:            startedAt,
-		BatchSize:      w.batchSize,
-		WorkerID:       w.workerID,
-		LeaseDuration:  w.leaseDuration,
-		InitialBackoff: w.initialBackoff,
-		MaxBackoff:     w.maxBackoff,
-		RetryJitterBPS: w.retryJitterBPS,
-		RetryBudget:    w.retryBudget,
+		Now:             startedAt,
+		BatchSize:       w.batchSize,
+		WorkerID:        w.workerID,
+		LeaseDuration:   w.leaseDuration,
+		InitialBackoff:  w.initialBackoff,
+		MaxBackoff:      w.maxBackoff,
+		RetryJitterBPS:  w.retryJitterBPS,
+		RetryBudget:     w.retryBudget,
+		Destination:     w.destination,
+		OrderedDelivery: w.orderedDelivery,
 	})
 	if appErr != nil {
 		w.logf(
@@ -106,18 +115,24 @@ func (w *Worker) runCycle(ctx context.Context) {
 	}
 
 	w.logf(
-		"webhook dispatch cycle completed worker_id=%s claimed=%d sent=%d retried=%d failed=%d skipped=%d errors=%d http_2xx=%d http_4xx=%d http_5xx=%d network_error=%d latency_ms=%d",
+		"webhook dispatch cycle completed worker_id=%s claimed=%d sent=%d retried=%d failed=%d retry_expired=%d skipped=%d deferred=%d circuit_open=%d rate_limited=%d errors=%d http_2xx=%d http_4xx=%d http_5xx=%d network_error=%d sink_published=%d sink_error=%d latency_ms=%d",
 		w.workerID,
 		output.Claimed,
 		output.Sent,
 		output.Retried,
 		output.Failed,
+		output.RetryExpiredCount,
 		output.Skipped,
+		output.Deferred,
+		output.CircuitOpenCount,
+		output.RateLimitedCount,
 		output.Errors,
 		output.HTTP2xxCount,
 		output.HTTP4xxCount,
 		output.HTTP5xxCount,
 		output.NetworkErrorCount,
+		output.SinkPublished,
+		output.SinkErrorCount,
 		time.Since(startedAt).Milliseconds(),
 	)
 }
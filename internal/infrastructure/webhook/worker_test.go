@@ -24,6 +24,8 @@ func TestWorkerDisabled(t *testing.T) {
 		60*time.Second,
 		2000,
 		3,
+		dto.WebhookDestinationPolicy{},
+		false,
 		fakeDispatchUseCase,
 		nil,
 	)
@@ -49,6 +51,12 @@ func TestWorkerRunsCycleWithRetryConfig(t *testing.T) {
 		60*time.Second,
 		1500,
 		2,
+		dto.WebhookDestinationPolicy{
+			FailureThreshold:     4,
+			OpenDuration:         45 * time.Second,
+			MaxRequestsPerSecond: 10,
+		},
+		true,
 		fakeDispatchUseCase,
 		nil,
 	)
@@ -77,6 +85,14 @@ func TestWorkerRunsCycleWithRetryConfig(t *testing.T) {
 	if last.RetryBudget != 2 {
 		t.Fatalf("expected retry budget 2, got %d", last.RetryBudget)
 	}
+	if last.Destination.FailureThreshold != 4 ||
+		last.Destination.OpenDuration != 45*time.Second ||
+		last.Destination.MaxRequestsPerSecond != 10 {
+		t.Fatalf("unexpected destination policy: %+v", last.Destination)
+	}
+	if !last.OrderedDelivery {
+		t.Fatalf("expected ordered delivery to be enabled")
+	}
 }
 
 type fakeDispatchUseCase struct {
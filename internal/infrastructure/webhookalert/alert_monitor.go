@@ -1,15 +1,23 @@
 package webhookalert
 
 import (
+	"strings"
 	"time"
 
 	"chaintx/internal/application/dto"
+	valueobjects "chaintx/internal/domain/value_objects"
 )
 
 const (
-	alertSignalFailedCount   = "failed_count"
-	alertSignalPendingReady  = "pending_ready_count"
-	alertSignalOldestPending = "oldest_pending_age_seconds"
+	alertSignalFailedCount   = valueobjects.AlertSignalWebhookFailedCount
+	alertSignalPendingReady  = valueobjects.AlertSignalWebhookPendingReady
+	alertSignalOldestPending = valueobjects.AlertSignalWebhookOldestPendingAge
+	alertSignalDetectedStuck = valueobjects.AlertSignalReconcileDetectedStuck
+	alertSignalObservationBP = valueobjects.AlertSignalReconcileObservationErrorBPS
+	alertSignalChainTipStale = valueobjects.AlertSignalReconcileChainTipStale
+	alertSignalReorgCount    = valueobjects.AlertSignalReconcileReorgCount
+	alertSourceWebhook       = "webhook_outbox"
+	alertSourceReconciler    = "reconciler"
 	defaultAlertCooldown     = 300 * time.Second
 )
 
@@ -19,11 +27,44 @@ type AlertConfig struct {
 	FailedCountThreshold     int64
 	PendingReadyThreshold    int64
 	OldestPendingAgeSecLimit int64
+	Reconcile                ReconcileAlertConfig
+	// Routes sends alert events to notification channels; without routes the
+	// worker only logs them.
+	Routes        []AlertRoute
+	NotifyTimeout time.Duration
+}
+
+// ReconcileAlertConfig holds the reconciler and chain health thresholds. A
+// zero threshold disables its signal. DetectedStuckAfter and Window feed the
+// reconcile health overview query.
+type ReconcileAlertConfig struct {
+	DetectedStuckAfter       time.Duration
+	Window                   time.Duration
+	DetectedStuckThreshold   int64
+	ObservationErrorBPSLimit int64
+	ChainTipStaleSecLimit    int64
+	ReorgCountThreshold      int64
+}
+
+func (c ReconcileAlertConfig) enabled() bool {
+	return c.DetectedStuckThreshold > 0 ||
+		c.ObservationErrorBPSLimit > 0 ||
+		c.ChainTipStaleSecLimit > 0 ||
+		c.ReorgCountThreshold > 0
 }
 
 type alertMonitor struct {
 	cfg    AlertConfig
 	states map[string]alertSignalState
+	tips   map[string]chainTipState
+}
+
+// chainTipState remembers when the tip of one chain network last advanced.
+// The overview only reports the current height, so staleness is measured from
+// the first evaluation that saw it.
+type chainTipState struct {
+	height     int64
+	advancedAt time.Time
 }
 
 type alertSignalState struct {
@@ -33,8 +74,10 @@ type alertSignalState struct {
 }
 
 type alertEvent struct {
+	Source    string
 	State     string
 	Signal    string
+	Scope     string
 	Current   int64
 	Threshold int64
 	Cooldown  time.Duration
@@ -47,6 +90,7 @@ func newAlertMonitor(cfg AlertConfig) *alertMonitor {
 	return &alertMonitor{
 		cfg:    cfg,
 		states: map[string]alertSignalState{},
+		tips:   map[string]chainTipState{},
 	}
 }
 
@@ -63,7 +107,9 @@ func (m *alertMonitor) evaluate(now time.Time, overview dto.WebhookOutboxOvervie
 	if m.cfg.FailedCountThreshold > 0 {
 		events = append(events, m.evaluateSignal(
 			now,
+			alertSourceWebhook,
 			alertSignalFailedCount,
+			"",
 			overview.FailedCount,
 			m.cfg.FailedCountThreshold,
 			overview.FailedCount >= m.cfg.FailedCountThreshold,
@@ -72,7 +118,9 @@ func (m *alertMonitor) evaluate(now time.Time, overview dto.WebhookOutboxOvervie
 	if m.cfg.PendingReadyThreshold > 0 {
 		events = append(events, m.evaluateSignal(
 			now,
+			alertSourceWebhook,
 			alertSignalPendingReady,
+			"",
 			overview.PendingReadyCount,
 			m.cfg.PendingReadyThreshold,
 			overview.PendingReadyCount >= m.cfg.PendingReadyThreshold,
@@ -87,7 +135,9 @@ func (m *alertMonitor) evaluate(now time.Time, overview dto.WebhookOutboxOvervie
 		}
 		events = append(events, m.evaluateSignal(
 			now,
+			alertSourceWebhook,
 			alertSignalOldestPending,
+			"",
 			oldestAge,
 			m.cfg.OldestPendingAgeSecLimit,
 			breached,
@@ -97,24 +147,126 @@ func (m *alertMonitor) evaluate(now time.Time, overview dto.WebhookOutboxOvervie
 	return events
 }
 
+func (m *alertMonitor) reconcileEnabled() bool {
+	return m.enabled() && m.cfg.Reconcile.enabled()
+}
+
+func (m *alertMonitor) evaluateReconcile(now time.Time, overview dto.ReconcileHealthOverview) []alertEvent {
+	if !m.reconcileEnabled() {
+		return nil
+	}
+
+	cfg := m.cfg.Reconcile
+	events := []alertEvent{}
+	if cfg.DetectedStuckThreshold > 0 {
+		events = append(events, m.evaluateSignal(
+			now,
+			alertSourceReconciler,
+			alertSignalDetectedStuck,
+			"",
+			overview.DetectedStuckCount,
+			cfg.DetectedStuckThreshold,
+			overview.DetectedStuckCount >= cfg.DetectedStuckThreshold,
+		)...)
+	}
+	if cfg.ObservationErrorBPSLimit > 0 {
+		events = append(events, m.evaluateSignal(
+			now,
+			alertSourceReconciler,
+			alertSignalObservationBP,
+			"",
+			overview.ObservationErrorRateBPS,
+			cfg.ObservationErrorBPSLimit,
+			overview.ObservedCount > 0 && overview.ObservationErrorRateBPS >= cfg.ObservationErrorBPSLimit,
+		)...)
+	}
+	if cfg.ChainTipStaleSecLimit > 0 {
+		events = append(events, m.evaluateChainTips(now, overview.ChainTips)...)
+	}
+	if cfg.ReorgCountThreshold > 0 {
+		events = append(events, m.evaluateSignal(
+			now,
+			alertSourceReconciler,
+			alertSignalReorgCount,
+			"",
+			overview.ReorgCount,
+			cfg.ReorgCountThreshold,
+			overview.ReorgCount >= cfg.ReorgCountThreshold,
+		)...)
+	}
+
+	return events
+}
+
+// evaluateChainTips raises one chain_tip_stale_seconds alert per chain
+// network. A network without open requests drops out of the overview; its
+// alert resolves and its tip is forgotten.
+func (m *alertMonitor) evaluateChainTips(now time.Time, tips []dto.ReconcileChainTip) []alertEvent {
+	limit := m.cfg.Reconcile.ChainTipStaleSecLimit
+	events := []alertEvent{}
+	seen := make(map[string]struct{}, len(tips))
+	for _, tip := range tips {
+		scope := tip.Chain + ":" + tip.Network
+		seen[scope] = struct{}{}
+
+		tracked, exists := m.tips[scope]
+		if !exists || tip.TipHeight > tracked.height {
+			tracked = chainTipState{height: tip.TipHeight, advancedAt: now}
+			m.tips[scope] = tracked
+		}
+		staleSeconds := int64(now.Sub(tracked.advancedAt).Seconds())
+		events = append(events, m.evaluateSignal(
+			now,
+			alertSourceReconciler,
+			alertSignalChainTipStale,
+			scope,
+			staleSeconds,
+			limit,
+			staleSeconds >= limit,
+		)...)
+	}
+
+	for scope, tracked := range m.tips {
+		if _, exists := seen[scope]; exists {
+			continue
+		}
+		delete(m.tips, scope)
+		events = append(events, m.evaluateSignal(
+			now,
+			alertSourceReconciler,
+			alertSignalChainTipStale,
+			scope,
+			int64(now.Sub(tracked.advancedAt).Seconds()),
+			limit,
+			false,
+		)...)
+	}
+	return events
+}
+
 func (m *alertMonitor) evaluateSignal(
 	now time.Time,
+	source string,
 	signal string,
+	scope string,
 	current int64,
 	threshold int64,
 	breached bool,
 ) []alertEvent {
-	state := m.states[signal]
+	key := strings.Join([]string{source, signal, scope}, "|")
+	state := m.states[key]
 
 	if breached {
 		if !state.active {
 			state.active = true
 			state.triggeredAt = now
 			state.lastNotifiedAt = now
-			m.states[signal] = state
+			m.states[key] = state
 			return []alertEvent{{
+				Source:    source,
 				State:     "triggered",
 				Signal:    signal,
+				Scope:     scope,
 				Current:   current,
 				Threshold: threshold,
 				Cooldown:  m.cfg.Cooldown,
@@ -125,10 +277,12 @@ func (m *alertMonitor) evaluateSignal(
 			return nil
 		}
 		state.lastNotifiedAt = now
-		m.states[signal] = state
+		m.states[key] = state
 		return []alertEvent{{
+			Source:    source,
 			State:     "ongoing",
 			Signal:    signal,
+			Scope:     scope,
 			Current:   current,
 			Threshold: threshold,
 			Cooldown:  m.cfg.Cooldown,
@@ -136,10 +290,12 @@ func (m *alertMonitor) evaluateSignal(
 	}
 
 	if state.active {
-		delete(m.states, signal)
+		delete(m.states, key)
 		return []alertEvent{{
+			Source:    source,
 			State:     "resolved",
 			Signal:    signal,
+			Scope:     scope,
 			Current:   current,
 			Threshold: threshold,
 			Cooldown:  m.cfg.Cooldown,
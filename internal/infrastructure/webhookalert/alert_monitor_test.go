@@ -45,6 +45,96 @@ func TestAlertMonitorOldestPendingAgeNilDoesNotTrigger(t *testing.T) {
 	}
 }
 
+func TestAlertMonitorReconcileSignals(t *testing.T) {
+	monitor := newAlertMonitor(AlertConfig{
+		Enabled:  true,
+		Cooldown: 60 * time.Second,
+		Reconcile: ReconcileAlertConfig{
+			DetectedStuckThreshold:   1,
+			ObservationErrorBPSLimit: 5000,
+			ReorgCountThreshold:      2,
+		},
+	})
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	events := monitor.evaluateReconcile(now, dto.ReconcileHealthOverview{
+		DetectedStuckCount:      2,
+		ObservedCount:           4,
+		ObservationErrorRateBPS: 7500,
+		ReorgCount:              1,
+	})
+	if len(events) != 2 {
+		t.Fatalf("expected detected stuck and error rate to trigger, got %+v", events)
+	}
+	for _, event := range events {
+		if event.State != "triggered" || event.Source != alertSourceReconciler {
+			t.Fatalf("unexpected event %+v", event)
+		}
+	}
+
+	events = monitor.evaluateReconcile(now.Add(10*time.Second), dto.ReconcileHealthOverview{ReorgCount: 3})
+	if len(events) != 3 {
+		t.Fatalf("expected two resolves and one reorg trigger, got %+v", events)
+	}
+	if events[2].Signal != alertSignalReorgCount || events[2].State != "triggered" {
+		t.Fatalf("expected reorg_count trigger, got %+v", events[2])
+	}
+}
+
+func TestAlertMonitorObservationErrorRateNeedsObservations(t *testing.T) {
+	monitor := newAlertMonitor(AlertConfig{
+		Enabled:   true,
+		Reconcile: ReconcileAlertConfig{ObservationErrorBPSLimit: 1},
+	})
+
+	events := monitor.evaluateReconcile(time.Now(), dto.ReconcileHealthOverview{ObservationErrorRateBPS: 10000})
+	if len(events) != 0 {
+		t.Fatalf("expected no event without observations, got %+v", events)
+	}
+}
+
+func TestAlertMonitorChainTipStalenessPerNetwork(t *testing.T) {
+	monitor := newAlertMonitor(AlertConfig{
+		Enabled:   true,
+		Cooldown:  time.Hour,
+		Reconcile: ReconcileAlertConfig{ChainTipStaleSecLimit: 600},
+	})
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	tips := func(btc int64, eth int64) dto.ReconcileHealthOverview {
+		return dto.ReconcileHealthOverview{ChainTips: []dto.ReconcileChainTip{
+			{Chain: "bitcoin", Network: "mainnet", TipHeight: btc},
+			{Chain: "ethereum", Network: "mainnet", TipHeight: eth},
+		}}
+	}
+
+	if events := monitor.evaluateReconcile(now, tips(100, 5000)); len(events) != 0 {
+		t.Fatalf("expected no event on first sight, got %+v", events)
+	}
+	events := monitor.evaluateReconcile(now.Add(11*time.Minute), tips(100, 5050))
+	if len(events) != 1 {
+		t.Fatalf("expected only bitcoin to go stale, got %+v", events)
+	}
+	if events[0].Scope != "bitcoin:mainnet" || events[0].State != "triggered" || events[0].Current != 660 {
+		t.Fatalf("unexpected stale event %+v", events[0])
+	}
+
+	events = monitor.evaluateReconcile(now.Add(12*time.Minute), tips(101, 5051))
+	assertSingleAlertEvent(t, events, "resolved", alertSignalChainTipStale)
+	if events[0].Scope != "bitcoin:mainnet" {
+		t.Fatalf("expected bitcoin resolve, got %+v", events[0])
+	}
+
+	events = monitor.evaluateReconcile(now.Add(30*time.Minute), dto.ReconcileHealthOverview{ChainTips: []dto.ReconcileChainTip{
+		{Chain: "bitcoin", Network: "mainnet", TipHeight: 101},
+	}})
+	if len(events) != 1 || events[0].Scope != "bitcoin:mainnet" || events[0].State != "triggered" {
+		t.Fatalf("expected bitcoin stale again and ethereum dropped silently, got %+v", events)
+	}
+	if _, tracked := monitor.tips["ethereum:mainnet"]; tracked {
+		t.Fatalf("expected ethereum tip to be forgotten once it leaves the overview")
+	}
+}
+
 func assertSingleAlertEvent(t *testing.T, events []alertEvent, expectedState string, expectedSignal string) {
 	t.Helper()
 	if len(events) != 1 {
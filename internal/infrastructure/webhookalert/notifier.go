@@ -0,0 +1,129 @@
+package webhookalert
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsout "chaintx/internal/application/ports/out"
+	valueobjects "chaintx/internal/domain/value_objects"
+)
+
+const defaultNotifyTimeout = 10 * time.Second
+
+type AlertChannel struct {
+	Name     string
+	Notifier portsout.AlertNotifier
+}
+
+// AlertRoute sends one signal, or every signal without its own route when
+// Signal is valueobjects.AlertSignalAny, to its channels at Severity.
+type AlertRoute struct {
+	Signal   string
+	Severity valueobjects.AlertSeverity
+	Channels []AlertChannel
+}
+
+type alertRouter struct {
+	exact    map[string]AlertRoute
+	fallback *AlertRoute
+	timeout  time.Duration
+	logger   *log.Logger
+}
+
+func newAlertRouter(routes []AlertRoute, timeout time.Duration, logger *log.Logger) *alertRouter {
+	if timeout <= 0 {
+		timeout = defaultNotifyTimeout
+	}
+	router := &alertRouter{
+		exact:   map[string]AlertRoute{},
+		timeout: timeout,
+		logger:  logger,
+	}
+	for _, route := range routes {
+		if route.Signal == valueobjects.AlertSignalAny {
+			fallback := route
+			router.fallback = &fallback
+			continue
+		}
+		router.exact[route.Signal] = route
+	}
+	return router
+}
+
+func (r *alertRouter) route(signal string) (AlertRoute, bool) {
+	if route, exists := r.exact[signal]; exists {
+		return route, true
+	}
+	if r.fallback != nil {
+		return *r.fallback, true
+	}
+	return AlertRoute{}, false
+}
+
+// notify delivers to every channel of the matching route. A failed channel is
+// logged and does not stop the others.
+func (r *alertRouter) notify(ctx context.Context, workerID string, now time.Time, event alertEvent) {
+	if r == nil {
+		return
+	}
+	route, exists := r.route(event.Signal)
+	if !exists {
+		return
+	}
+
+	notification := dto.AlertNotification{
+		Source:     event.Source,
+		Signal:     event.Signal,
+		Scope:      event.Scope,
+		State:      event.State,
+		Severity:   route.Severity.String(),
+		Current:    event.Current,
+		Threshold:  event.Threshold,
+		OccurredAt: now,
+	}
+	for _, channel := range route.Channels {
+		notifyCtx, cancel := context.WithTimeout(ctx, r.timeout)
+		appErr := channel.Notifier.NotifyAlert(notifyCtx, notification)
+		cancel()
+		if appErr != nil {
+			r.logf(
+				"%s notification failed worker_id=%s channel=%s signal=%s state=%s code=%s details=%v",
+				alertLogPrefix(event.Source),
+				workerID,
+				channel.Name,
+				event.Signal,
+				event.State,
+				appErr.Code,
+				appErr.Details,
+			)
+			continue
+		}
+		r.logf(
+			"%s notification sent worker_id=%s channel=%s signal=%s state=%s severity=%s",
+			alertLogPrefix(event.Source),
+			workerID,
+			channel.Name,
+			event.Signal,
+			event.State,
+			notification.Severity,
+		)
+	}
+}
+
+// alertLogPrefix keeps the webhook log lines unchanged now that the worker
+// also raises reconciler alerts.
+func alertLogPrefix(source string) string {
+	if source == alertSourceReconciler {
+		return "reconcile alert"
+	}
+	return "webhook alert"
+}
+
+func (r *alertRouter) logf(format string, args ...any) {
+	if r.logger == nil {
+		return
+	}
+	r.logger.Printf(format, args...)
+}
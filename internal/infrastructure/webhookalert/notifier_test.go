@@ -0,0 +1,119 @@
+//go:build !integration
+
+package webhookalert
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	valueobjects "chaintx/internal/domain/value_objects"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestWorkerRoutesAlertEventsToSignalChannels(t *testing.T) {
+	pager := &fakeAlertNotifier{}
+	chat := &fakeAlertNotifier{}
+	worker := NewWorker(
+		true,
+		time.Minute,
+		"worker-a",
+		&fakeOverviewUseCase{overviews: []dto.WebhookOutboxOverview{
+			{FailedCount: 3, PendingReadyCount: 50},
+			{FailedCount: 0, PendingReadyCount: 0},
+		}},
+		nil,
+		AlertConfig{
+			Enabled:               true,
+			FailedCountThreshold:  2,
+			PendingReadyThreshold: 10,
+			Routes: []AlertRoute{
+				{
+					Signal:   valueobjects.AlertSignalWebhookFailedCount,
+					Severity: valueobjects.AlertSeverityCritical,
+					Channels: []AlertChannel{{Name: "pager", Notifier: pager}, {Name: "chat", Notifier: chat}},
+				},
+				{
+					Signal:   valueobjects.AlertSignalAny,
+					Severity: valueobjects.AlertSeverityWarning,
+					Channels: []AlertChannel{{Name: "chat", Notifier: chat}},
+				},
+			},
+		},
+		nil,
+	)
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	worker.now = func() time.Time { return now }
+
+	worker.runCycle(context.Background())
+	now = now.Add(time.Minute)
+	worker.runCycle(context.Background())
+
+	if len(pager.notifications) != 2 {
+		t.Fatalf("expected pager to get trigger and resolve, got %+v", pager.notifications)
+	}
+	if pager.notifications[0].State != "triggered" || pager.notifications[1].State != "resolved" {
+		t.Fatalf("unexpected pager states %+v", pager.notifications)
+	}
+	if pager.notifications[0].Severity != "critical" || pager.notifications[0].Source != "webhook_outbox" {
+		t.Fatalf("unexpected pager notification %+v", pager.notifications[0])
+	}
+	if !pager.notifications[1].OccurredAt.Equal(now) {
+		t.Fatalf("expected resolve at %s, got %s", now, pager.notifications[1].OccurredAt)
+	}
+
+	severities := map[string]string{}
+	for _, notification := range chat.notifications {
+		severities[notification.Signal] = notification.Severity
+	}
+	if len(chat.notifications) != 4 ||
+		severities[valueobjects.AlertSignalWebhookFailedCount] != "critical" ||
+		severities[valueobjects.AlertSignalWebhookPendingReady] != "warning" {
+		t.Fatalf("expected chat to get both signals with route severities, got %+v", chat.notifications)
+	}
+}
+
+func TestAlertRouterContinuesAfterChannelFailure(t *testing.T) {
+	failing := &fakeAlertNotifier{err: apperrors.NewInternal("alert_notification_failed", "boom", nil)}
+	healthy := &fakeAlertNotifier{}
+	var logBuffer bytes.Buffer
+	router := newAlertRouter([]AlertRoute{{
+		Signal:   valueobjects.AlertSignalWebhookFailedCount,
+		Severity: valueobjects.AlertSeverityCritical,
+		Channels: []AlertChannel{{Name: "broken", Notifier: failing}, {Name: "healthy", Notifier: healthy}},
+	}}, time.Second, log.New(&logBuffer, "", 0))
+
+	router.notify(context.Background(), "worker-a", time.Now(), alertEvent{
+		State:  "triggered",
+		Signal: valueobjects.AlertSignalWebhookFailedCount,
+	})
+	router.notify(context.Background(), "worker-a", time.Now(), alertEvent{
+		State:  "triggered",
+		Signal: valueobjects.AlertSignalWebhookPendingReady,
+	})
+
+	if len(healthy.notifications) != 1 {
+		t.Fatalf("expected healthy channel to receive only the routed signal, got %d", len(healthy.notifications))
+	}
+	logs := logBuffer.String()
+	if !strings.Contains(logs, "webhook alert notification failed worker_id=worker-a channel=broken") {
+		t.Fatalf("expected failure log, got:\n%s", logs)
+	}
+	if !strings.Contains(logs, "webhook alert notification sent worker_id=worker-a channel=healthy") {
+		t.Fatalf("expected sent log, got:\n%s", logs)
+	}
+}
+
+type fakeAlertNotifier struct {
+	err           *apperrors.AppError
+	notifications []dto.AlertNotification
+}
+
+func (f *fakeAlertNotifier) NotifyAlert(_ context.Context, notification dto.AlertNotification) *apperrors.AppError {
+	f.notifications = append(f.notifications, notification)
+	return f.err
+}
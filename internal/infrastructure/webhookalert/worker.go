@@ -16,7 +16,9 @@ type Worker struct {
 	pollInterval    time.Duration
 	workerID        string
 	overviewUseCase portsin.GetWebhookOutboxOverviewUseCase
+	healthUseCase   portsin.GetReconcileHealthOverviewUseCase
 	alertMonitor    *alertMonitor
+	alertRouter     *alertRouter
 	now             func() time.Time
 	logger          *log.Logger
 }
@@ -26,6 +28,7 @@ func NewWorker(
 	pollInterval time.Duration,
 	workerID string,
 	overviewUseCase portsin.GetWebhookOutboxOverviewUseCase,
+	healthUseCase portsin.GetReconcileHealthOverviewUseCase,
 	alertConfig AlertConfig,
 	logger *log.Logger,
 ) *Worker {
@@ -37,7 +40,9 @@ func NewWorker(
 		pollInterval:    pollInterval,
 		workerID:        workerID,
 		overviewUseCase: overviewUseCase,
+		healthUseCase:   healthUseCase,
 		alertMonitor:    newAlertMonitor(alertConfig),
+		alertRouter:     newAlertRouter(alertConfig.Routes, alertConfig.NotifyTimeout, logger),
 		now:             time.Now,
 		logger:          logger,
 	}
@@ -75,6 +80,11 @@ func (w *Worker) Start(ctx context.Context) {
 
 func (w *Worker) runCycle(ctx context.Context) {
 	now := w.nowUTC()
+	w.evaluateWebhookOutbox(ctx, now)
+	w.evaluateReconcileHealth(ctx, now)
+}
+
+func (w *Worker) evaluateWebhookOutbox(ctx context.Context, now time.Time) {
 	overview, appErr := w.overviewUseCase.Execute(ctx, dto.GetWebhookOutboxOverviewQuery{Now: now})
 	if appErr != nil {
 		w.logf(
@@ -97,6 +107,44 @@ func (w *Worker) runCycle(ctx context.Context) {
 			event.Threshold,
 			event.Cooldown,
 		)
+		w.alertRouter.notify(ctx, w.workerID, now, event)
+	}
+}
+
+// evaluateReconcileHealth runs only when a reconcile threshold is configured.
+func (w *Worker) evaluateReconcileHealth(ctx context.Context, now time.Time) {
+	if w.healthUseCase == nil || !w.alertMonitor.reconcileEnabled() {
+		return
+	}
+	cfg := w.alertMonitor.cfg.Reconcile
+	overview, appErr := w.healthUseCase.Execute(ctx, dto.GetReconcileHealthOverviewQuery{
+		Now:                now,
+		DetectedStuckAfter: cfg.DetectedStuckAfter,
+		Window:             cfg.Window,
+	})
+	if appErr != nil {
+		w.logf(
+			"reconcile alert evaluation failed worker_id=%s code=%s message=%s details=%v",
+			w.workerID,
+			appErr.Code,
+			appErr.Message,
+			appErr.Details,
+		)
+		return
+	}
+
+	for _, event := range w.alertMonitor.evaluateReconcile(now, overview) {
+		w.logf(
+			"reconcile alert %s worker_id=%s signal=%s scope=%s current=%d threshold=%d cooldown=%s",
+			event.State,
+			w.workerID,
+			event.Signal,
+			event.Scope,
+			event.Current,
+			event.Threshold,
+			event.Cooldown,
+		)
+		w.alertRouter.notify(ctx, w.workerID, now, event)
 	}
 }
 
@@ -22,6 +22,7 @@ func TestWorkerDisabled(t *testing.T) {
 		10*time.Millisecond,
 		"worker-a",
 		fakeOverviewUseCase,
+		nil,
 		AlertConfig{Enabled: true, FailedCountThreshold: 1},
 		nil,
 	)
@@ -52,6 +53,7 @@ func TestWorkerRunsCycleWithAlertLifecycleLogs(t *testing.T) {
 		10*time.Millisecond,
 		"worker-a",
 		fakeOverviewUseCase,
+		nil,
 		AlertConfig{
 			Enabled:              true,
 			Cooldown:             60 * time.Second,
@@ -96,6 +98,7 @@ func TestWorkerOverviewFailureDoesNotStopCycle(t *testing.T) {
 		10*time.Millisecond,
 		"worker-a",
 		fakeOverviewUseCase,
+		nil,
 		AlertConfig{
 			Enabled:              true,
 			Cooldown:             60 * time.Second,
@@ -115,6 +118,72 @@ func TestWorkerOverviewFailureDoesNotStopCycle(t *testing.T) {
 	}
 }
 
+func TestWorkerEvaluatesReconcileHealth(t *testing.T) {
+	healthUseCase := &fakeReconcileHealthUseCase{overview: dto.ReconcileHealthOverview{DetectedStuckCount: 4}}
+	var logBuffer bytes.Buffer
+	worker := NewWorker(
+		true,
+		time.Minute,
+		"worker-a",
+		&fakeOverviewUseCase{},
+		healthUseCase,
+		AlertConfig{
+			Enabled: true,
+			Reconcile: ReconcileAlertConfig{
+				DetectedStuckAfter:     30 * time.Minute,
+				Window:                 15 * time.Minute,
+				DetectedStuckThreshold: 3,
+			},
+		},
+		log.New(&logBuffer, "", 0),
+	)
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	worker.now = func() time.Time { return now }
+
+	worker.runCycle(context.Background())
+
+	if healthUseCase.query.DetectedStuckAfter != 30*time.Minute || healthUseCase.query.Window != 15*time.Minute {
+		t.Fatalf("unexpected health query %+v", healthUseCase.query)
+	}
+	if !strings.Contains(logBuffer.String(), "reconcile alert triggered worker_id=worker-a signal=detected_stuck_count scope= current=4 threshold=3") {
+		t.Fatalf("expected reconcile trigger log, got:\n%s", logBuffer.String())
+	}
+}
+
+func TestWorkerSkipsReconcileHealthWithoutThresholds(t *testing.T) {
+	healthUseCase := &fakeReconcileHealthUseCase{}
+	worker := NewWorker(
+		true,
+		time.Minute,
+		"worker-a",
+		&fakeOverviewUseCase{},
+		healthUseCase,
+		AlertConfig{Enabled: true, FailedCountThreshold: 1},
+		nil,
+	)
+
+	worker.runCycle(context.Background())
+
+	if healthUseCase.calls != 0 {
+		t.Fatalf("expected no reconcile health query, got %d", healthUseCase.calls)
+	}
+}
+
+type fakeReconcileHealthUseCase struct {
+	calls    int
+	query    dto.GetReconcileHealthOverviewQuery
+	overview dto.ReconcileHealthOverview
+}
+
+func (f *fakeReconcileHealthUseCase) Execute(
+	_ context.Context,
+	query dto.GetReconcileHealthOverviewQuery,
+) (dto.ReconcileHealthOverview, *apperrors.AppError) {
+	f.calls++
+	f.query = query
+	return f.overview, nil
+}
+
 type fakeOverviewUseCase struct {
 	mu        sync.Mutex
 	callCount int
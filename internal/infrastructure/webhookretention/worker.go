@@ -0,0 +1,133 @@
+package webhookretention
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"chaintx/internal/application/dto"
+	portsin "chaintx/internal/application/ports/in"
+)
+
+const defaultPollInterval = time.Hour
+
+type Config struct {
+	RetentionAge time.Duration
+	BatchSize    int
+	MaxBatches   int
+	DryRun       bool
+}
+
+type Worker struct {
+	enabled      bool
+	pollInterval time.Duration
+	workerID     string
+	config       Config
+	purgeUseCase portsin.PurgeWebhookOutboxUseCase
+	now          func() time.Time
+	logger       *log.Logger
+}
+
+func NewWorker(
+	enabled bool,
+	pollInterval time.Duration,
+	workerID string,
+	config Config,
+	purgeUseCase portsin.PurgeWebhookOutboxUseCase,
+	logger *log.Logger,
+) *Worker {
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	return &Worker{
+		enabled:      enabled,
+		pollInterval: pollInterval,
+		workerID:     workerID,
+		config:       config,
+		purgeUseCase: purgeUseCase,
+		now:          time.Now,
+		logger:       logger,
+	}
+}
+
+func (w *Worker) Enabled() bool {
+	return w != nil && w.enabled
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	if w == nil || !w.enabled || w.purgeUseCase == nil {
+		return
+	}
+
+	w.logf(
+		"webhook outbox retention worker started worker_id=%s poll_interval=%s retention_age=%s batch_size=%d max_batches=%d dry_run=%t",
+		w.workerID,
+		w.pollInterval,
+		w.config.RetentionAge,
+		w.config.BatchSize,
+		w.config.MaxBatches,
+		w.config.DryRun,
+	)
+
+	w.runCycle(ctx)
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logf("webhook outbox retention worker stopped")
+			return
+		case <-ticker.C:
+			w.runCycle(ctx)
+		}
+	}
+}
+
+func (w *Worker) runCycle(ctx context.Context) {
+	output, appErr := w.purgeUseCase.Execute(ctx, dto.PurgeWebhookOutboxCommand{
+		Now:          w.nowUTC(),
+		RetentionAge: w.config.RetentionAge,
+		BatchSize:    w.config.BatchSize,
+		MaxBatches:   w.config.MaxBatches,
+		DryRun:       w.config.DryRun,
+	})
+	if appErr != nil {
+		w.logf(
+			"webhook outbox retention cycle failed worker_id=%s archived=%d batches=%d code=%s message=%s details=%v",
+			w.workerID,
+			output.Archived,
+			output.Batches,
+			appErr.Code,
+			appErr.Message,
+			appErr.Details,
+		)
+		return
+	}
+
+	w.logf(
+		"webhook outbox retention cycle worker_id=%s dry_run=%t cutoff=%s eligible=%d archived=%d batches=%d drained=%t latency_ms=%d",
+		w.workerID,
+		output.DryRun,
+		output.Cutoff.Format(time.RFC3339),
+		output.Eligible,
+		output.Archived,
+		output.Batches,
+		output.Drained,
+		output.LatencyMS,
+	)
+}
+
+func (w *Worker) nowUTC() time.Time {
+	if w == nil || w.now == nil {
+		return time.Now().UTC()
+	}
+	return w.now().UTC()
+}
+
+func (w *Worker) logf(format string, args ...any) {
+	if w.logger == nil {
+		return
+	}
+	w.logger.Printf(format, args...)
+}
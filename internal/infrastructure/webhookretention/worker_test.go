@@ -0,0 +1,95 @@
+//go:build !integration
+
+package webhookretention
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"chaintx/internal/application/dto"
+	apperrors "chaintx/internal/shared_kernel/errors"
+)
+
+func TestWorkerDisabled(t *testing.T) {
+	purgeUseCase := &fakePurgeUseCase{}
+	worker := NewWorker(false, 10*time.Millisecond, "worker-a", Config{}, purgeUseCase, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	worker.Start(ctx)
+
+	if len(purgeUseCase.commands) != 0 {
+		t.Fatalf("expected no calls for disabled worker, got %d", len(purgeUseCase.commands))
+	}
+}
+
+func TestWorkerRunCyclePassesConfigAndLogsMetrics(t *testing.T) {
+	now := time.Date(2026, 10, 19, 3, 0, 0, 0, time.UTC)
+	purgeUseCase := &fakePurgeUseCase{
+		output: dto.PurgeWebhookOutboxOutput{
+			Cutoff:   now.Add(-24 * time.Hour),
+			DryRun:   true,
+			Eligible: 12,
+			Drained:  false,
+		},
+	}
+	var logBuffer bytes.Buffer
+	worker := NewWorker(
+		true,
+		time.Minute,
+		"worker-a",
+		Config{RetentionAge: 24 * time.Hour, BatchSize: 500, MaxBatches: 3, DryRun: true},
+		purgeUseCase,
+		log.New(&logBuffer, "", 0),
+	)
+	worker.now = func() time.Time { return now }
+
+	worker.runCycle(context.Background())
+
+	if len(purgeUseCase.commands) != 1 {
+		t.Fatalf("expected one purge call, got %d", len(purgeUseCase.commands))
+	}
+	command := purgeUseCase.commands[0]
+	if !command.Now.Equal(now) || command.RetentionAge != 24*time.Hour ||
+		command.BatchSize != 500 || command.MaxBatches != 3 || !command.DryRun {
+		t.Fatalf("unexpected purge command %+v", command)
+	}
+	logs := logBuffer.String()
+	if !strings.Contains(logs, "dry_run=true cutoff=2026-10-18T03:00:00Z eligible=12 archived=0") {
+		t.Fatalf("expected retention metrics log, got:\n%s", logs)
+	}
+}
+
+func TestWorkerRunCycleLogsFailureWithPartialProgress(t *testing.T) {
+	purgeUseCase := &fakePurgeUseCase{
+		output: dto.PurgeWebhookOutboxOutput{Archived: 100, Batches: 1},
+		err:    apperrors.NewInternal("webhook_outbox_archive_failed", "boom", nil),
+	}
+	var logBuffer bytes.Buffer
+	worker := NewWorker(true, time.Minute, "worker-a", Config{}, purgeUseCase, log.New(&logBuffer, "", 0))
+
+	worker.runCycle(context.Background())
+
+	logs := logBuffer.String()
+	if !strings.Contains(logs, "webhook outbox retention cycle failed worker_id=worker-a archived=100 batches=1 code=webhook_outbox_archive_failed") {
+		t.Fatalf("expected failure log, got:\n%s", logs)
+	}
+}
+
+type fakePurgeUseCase struct {
+	output   dto.PurgeWebhookOutboxOutput
+	err      *apperrors.AppError
+	commands []dto.PurgeWebhookOutboxCommand
+}
+
+func (f *fakePurgeUseCase) Execute(
+	_ context.Context,
+	command dto.PurgeWebhookOutboxCommand,
+) (dto.PurgeWebhookOutboxOutput, *apperrors.AppError) {
+	f.commands = append(f.commands, command)
+	return f.output, f.err
+}
@@ -3,10 +3,11 @@ package apperrors
 type Type string
 
 const (
-	TypeValidation Type = "validation"
-	TypeNotFound   Type = "not_found"
-	TypeConflict   Type = "conflict"
-	TypeInternal   Type = "internal"
+	TypeValidation  Type = "validation"
+	TypeNotFound    Type = "not_found"
+	TypeConflict    Type = "conflict"
+	TypeRateLimited Type = "rate_limited"
+	TypeInternal    Type = "internal"
 )
 
 type AppError struct {
@@ -59,3 +60,14 @@ func NewConflict(code, message string, details map[string]any) *AppError {
 		Details: details,
 	}
 }
+
+// NewRateLimited reports a caller over a rate or quota limit. Details should
+// carry retry_after_seconds so HTTP adapters can set Retry-After.
+func NewRateLimited(code, message string, details map[string]any) *AppError {
+	return &AppError{
+		Type:    TypeRateLimited,
+		Code:    code,
+		Message: message,
+		Details: details,
+	}
+}